@@ -127,6 +127,27 @@ func (p *Platform) Deploy(
 		},
 	}
 
+	// The entrypoint uses these to exec in the other containers of the
+	// pod through the Kubernetes API.
+	env = append(env,
+		corev1.EnvVar{
+			Name: "WAYPOINT_K8S_NAMESPACE",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+			},
+		},
+		corev1.EnvVar{
+			Name: "WAYPOINT_K8S_POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+			},
+		},
+		corev1.EnvVar{
+			Name:  "WAYPOINT_K8S_CONTAINER_NAME",
+			Value: result.Name,
+		},
+	)
+
 	for k, v := range p.config.StaticEnvVars {
 		env = append(env, corev1.EnvVar{
 			Name:  k,
@@ -301,6 +322,17 @@ func (p *Platform) Deploy(
 		return nil, err
 	}
 
+	// Record the named ports so that they can be referred to by name, such
+	// as "http" when port forwarding.
+	result.Ports = map[string]int32{}
+	for _, c := range deployment.Spec.Template.Spec.Containers {
+		for _, port := range c.Ports {
+			if port.Name != "" {
+				result.Ports[port.Name] = port.ContainerPort
+			}
+		}
+	}
+
 	step.Done()
 	step = sg.Add("Waiting for deployment...")
 
@@ -20,6 +20,12 @@ func main() {
 }
 
 func realMain() int {
+	// The entrypoint starts itself to apply limits to exec'd commands
+	// before they run. This only returns if the command can't run.
+	if ceb.IsExecLimitsWrapper() {
+		return ceb.ExecLimitsWrapper()
+	}
+
 	flag.Usage = usage
 	flag.Parse()
 
@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/version"
@@ -25,10 +30,63 @@ const (
 	envCEBDisable          = "WAYPOINT_CEB_DISABLE"
 	envCEBServerRequired   = "WAYPOINT_CEB_SERVER_REQUIRED"
 	envCEBToken            = "WAYPOINT_CEB_INVITE_TOKEN"
+	envExecTargetPid       = "WAYPOINT_EXEC_TARGET_PID"
+	envExecTargetContainer = "WAYPOINT_EXEC_TARGET_CONTAINER"
+	envExecMaxSessions     = "WAYPOINT_EXEC_MAX_SESSIONS"
+	envExecScrubPatterns   = "WAYPOINT_EXEC_SCRUB_PATTERNS"
+	envInstanceLabels      = "WAYPOINT_INSTANCE_LABELS"
+	envK8SNamespace        = "WAYPOINT_K8S_NAMESPACE"
+	envK8SPodName          = "WAYPOINT_K8S_POD_NAME"
+	envK8SContainerName    = "WAYPOINT_K8S_CONTAINER_NAME"
+	envExecTasks           = "WAYPOINT_EXEC_TASKS"
+	envExecResumeGrace     = "WAYPOINT_EXEC_RESUME_GRACE_PERIOD"
+	envExecResumeBuffer    = "WAYPOINT_EXEC_RESUME_BUFFER_SIZE"
+	envExecResumeMemory    = "WAYPOINT_EXEC_RESUME_MAX_MEMORY"
+	envLogBufferSize       = "WAYPOINT_LOG_BUFFER_SIZE"
+	envLogBatchSize        = "WAYPOINT_LOG_BATCH_SIZE"
+	envLogFlushInterval    = "WAYPOINT_LOG_FLUSH_INTERVAL"
+	envExecLogMirrorRate   = "WAYPOINT_EXEC_LOG_MIRROR_RATE"
+	envNomadAllocId        = "NOMAD_ALLOC_ID"
+	envNomadTaskName       = "NOMAD_TASK_NAME"
 )
 
 const (
 	DefaultPort = 5000
+
+	// DefaultExecMaxSessions is the default maximum number of concurrent
+	// exec sessions an instance runs.
+	DefaultExecMaxSessions = 10
+
+	// DefaultExecResumeGracePeriod is the default time an exec session
+	// whose stream dropped waits to be resumed before its command is
+	// stopped. DefaultExecResumeBufferSize is how much output each such
+	// session holds meanwhile by default, and DefaultExecResumeMaxMemory
+	// how much all of them hold together.
+	DefaultExecResumeGracePeriod = 2 * time.Minute
+	DefaultExecResumeBufferSize  = 1024 * 1024
+	DefaultExecResumeMaxMemory   = 16 * 1024 * 1024
+
+	// DefaultMaxMessageSize is the default maximum size of a message we
+	// send to the server. This matches the default gRPC receive limit.
+	DefaultMaxMessageSize = 4 * 1024 * 1024
+
+	// DefaultLogBufferSize is the default number of log lines buffered
+	// while waiting to be sent to the server. Older lines are dropped
+	// once the buffer is full.
+	DefaultLogBufferSize = 8192
+
+	// DefaultLogBatchSize is the default maximum number of log lines
+	// sent to the server at once.
+	DefaultLogBatchSize = 256
+
+	// DefaultLogFlushInterval is the default time we wait for a batch of
+	// log lines to fill before sending it anyway.
+	DefaultLogFlushInterval = 100 * time.Millisecond
+
+	// DefaultExecLogMirrorRate is the default number of lines a second
+	// of the output of exec sessions that are mirrored to the logs, for
+	// all sessions together.
+	DefaultExecLogMirrorRate = 100
 )
 
 // CEB represents the state of a running CEB.
@@ -41,6 +99,78 @@ type CEB struct {
 	childCmd     *exec.Cmd
 	execIdx      int64
 
+	// execTargetPid is the PID of a process in the application container
+	// whose namespaces exec sessions join if they request it. This is zero
+	// if not configured.
+	execTargetPid int
+
+	// execContainer is the name of the container we run in and
+	// execTargetContainer is the name of the container execTargetPid is
+	// in, if known. Sessions for other named containers are brokered
+	// through execKube, which is nil if we aren't in a Kubernetes pod.
+	execContainer       string
+	execTargetContainer string
+	execKube            *execKube
+
+	// allocId is the ID of the Nomad allocation we run in, if any, and
+	// execTasks are its tasks that exec sessions can run in.
+	allocId   string
+	execTasks []*execTask
+
+	// labels are the labels this instance registers with, such as those of
+	// the pod or allocation it runs in.
+	labels map[string]string
+
+	// execMax is the maximum number of concurrent exec sessions, zero
+	// for no limit. execCount is the number of active sessions and must
+	// be accessed atomically.
+	execMax   int32
+	execCount int32
+
+	// execResumeGrace is how long an exec session whose stream dropped
+	// waits to be resumed, zero if sessions can't be resumed. Meanwhile
+	// it holds up to execResumeBufferSize bytes of events, taken from
+	// execResumeBudget. execResumable are the sessions waiting, by ID.
+	execResumeGrace      time.Duration
+	execResumeBufferSize int64
+	execResumeBudget     execReplayBudget
+	execResumeLock       sync.Mutex
+	execResumable        map[string]*execResumable
+
+	// execSubreaperOnce ensures we only try to become a child
+	// subreaper once, when the first exec session starts. execReaper
+	// then reaps the orphans reparented to us, and every process we
+	// start must be started through it.
+	execSubreaperOnce sync.Once
+	execReaper        execReaper
+
+	// scrubPatterns are additional patterns to redact from the exec data
+	// we log. scrubValues are the secret values we know about, such as
+	// config variables, which are always redacted.
+	scrubPatterns []*regexp.Regexp
+	scrubLock     sync.Mutex
+	scrubValues   []string
+
+	// maxMessageSize is the maximum size of a message sent to the server.
+	// Exec output is chunked so that each message fits within this.
+	maxMessageSize int
+
+	// logBufferSize is the number of log lines buffered while waiting to
+	// be sent. Lines are sent in batches of up to logBatchSize lines, or
+	// whatever is buffered after logFlushInterval.
+	logBufferSize    int
+	logBatchSize     int
+	logFlushInterval time.Duration
+
+	// logLines are the log lines waiting to be sent, created once by
+	// logLineBuffer since exec sessions mirroring their output to the
+	// logs may start before the log stream.
+	logLines     *logBuffer
+	logLinesOnce sync.Once
+
+	// execLogRate limits the lines of exec output mirrored to the logs.
+	execLogRate execLogRate
+
 	cleanupFunc func()
 }
 
@@ -62,6 +192,19 @@ func Run(ctx context.Context, os ...Option) error {
 		id:      id,
 		logger:  hclog.L(),
 		context: ctx,
+		execMax: DefaultExecMaxSessions,
+
+		execResumeGrace:      DefaultExecResumeGracePeriod,
+		execResumeBufferSize: DefaultExecResumeBufferSize,
+		execResumeBudget:     execReplayBudget{max: DefaultExecResumeMaxMemory},
+
+		maxMessageSize: DefaultMaxMessageSize,
+
+		logBufferSize:    DefaultLogBufferSize,
+		logBatchSize:     DefaultLogBatchSize,
+		logFlushInterval: DefaultLogFlushInterval,
+
+		execLogRate: execLogRate{rate: DefaultExecLogMirrorRate},
 	}
 	defer ceb.Close()
 
@@ -138,6 +281,26 @@ func (ceb *CEB) cleanup(f func()) {
 	}
 }
 
+// addScrubValues adds secret values that should be redacted from the
+// exec data that we log.
+func (ceb *CEB) addScrubValues(vs ...string) {
+	ceb.scrubLock.Lock()
+	defer ceb.scrubLock.Unlock()
+	ceb.scrubValues = append(ceb.scrubValues, vs...)
+}
+
+// scrubber returns the scrubber to use for any exec data that we log.
+// Data sent to the client must never be scrubbed.
+func (ceb *CEB) scrubber() *scrub.Scrubber {
+	ceb.scrubLock.Lock()
+	defer ceb.scrubLock.Unlock()
+
+	var patterns []*regexp.Regexp
+	patterns = append(patterns, scrub.DefaultPatterns...)
+	patterns = append(patterns, ceb.scrubPatterns...)
+	return scrub.New(patterns, ceb.scrubValues)
+}
+
 // DeploymentId returns the deployment ID that this CEB represents.
 func (ceb *CEB) DeploymentId() string {
 	return ceb.deploymentId
@@ -183,10 +346,151 @@ func WithEnvDefaults() Option {
 		cfg.ServerTls = os.Getenv(envServerTls) != ""
 		cfg.ServerTlsSkipVerify = os.Getenv(envServerTlsSkipVerify) != ""
 		cfg.InviteToken = os.Getenv(envCEBToken)
+		if v := cfg.InviteToken; v != "" {
+			ceb.addScrubValues(v)
+		}
 		cfg.disable = os.Getenv(envCEBDisable) != ""
 
 		ceb.deploymentId = os.Getenv(envDeploymentId)
 
+		if v := os.Getenv(envExecTargetPid); v != "" {
+			pid, err := strconv.Atoi(v)
+			if err != nil || pid <= 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envExecTargetPid, v)
+			}
+
+			ceb.execTargetPid = pid
+		}
+		ceb.execTargetContainer = os.Getenv(envExecTargetContainer)
+
+		// Within a Kubernetes pod, we can exec in the other containers of
+		// the pod through the Kubernetes API.
+		ceb.execContainer = os.Getenv(envK8SContainerName)
+		if pod := os.Getenv(envK8SPodName); pod != "" {
+			ceb.execKube = &execKube{
+				Namespace: os.Getenv(envK8SNamespace),
+				Pod:       pod,
+			}
+		}
+
+		if v := os.Getenv(envExecMaxSessions); v != "" {
+			max, err := strconv.Atoi(v)
+			if err != nil || max < 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envExecMaxSessions, v)
+			}
+
+			ceb.execMax = int32(max)
+		}
+
+		if v := os.Getenv(envExecResumeGrace); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d < 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envExecResumeGrace, v)
+			}
+
+			ceb.execResumeGrace = d
+		}
+
+		if v := os.Getenv(envExecResumeBuffer); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envExecResumeBuffer, v)
+			}
+
+			ceb.execResumeBufferSize = n
+		}
+
+		if v := os.Getenv(envExecResumeMemory); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envExecResumeMemory, v)
+			}
+
+			ceb.execResumeBudget.max = n
+		}
+
+		if v := os.Getenv(envLogBufferSize); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envLogBufferSize, v)
+			}
+
+			ceb.logBufferSize = n
+		}
+
+		if v := os.Getenv(envLogBatchSize); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envLogBatchSize, v)
+			}
+
+			ceb.logBatchSize = n
+		}
+
+		if v := os.Getenv(envLogFlushInterval); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d < 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envLogFlushInterval, v)
+			}
+
+			ceb.logFlushInterval = d
+		}
+
+		if v := os.Getenv(envExecLogMirrorRate); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("Invalid value of %s: %q", envExecLogMirrorRate, v)
+			}
+
+			ceb.execLogRate.rate = n
+		}
+
+		// In a Nomad allocation we run in our own task, and optionally in
+		// the other tasks of the allocation we're told about.
+		ceb.allocId = os.Getenv(envNomadAllocId)
+		if v := os.Getenv(envNomadTaskName); v != "" {
+			ceb.execTasks = append(ceb.execTasks, &execTask{Name: v})
+		}
+		if v := os.Getenv(envExecTasks); v != "" {
+			tasks, err := parseExecTasks(v)
+			if err != nil {
+				return fmt.Errorf("Invalid value of %s: %s", envExecTasks, err)
+			}
+
+			ceb.execTasks = append(ceb.execTasks, tasks...)
+		}
+
+		// Labels are comma-separated key=value pairs.
+		if v := os.Getenv(envInstanceLabels); v != "" {
+			ceb.labels = map[string]string{}
+			for _, pair := range strings.Split(v, ",") {
+				idx := strings.Index(pair, "=")
+				if idx <= 0 {
+					return fmt.Errorf("Invalid label in %s: %q", envInstanceLabels, pair)
+				}
+
+				key := strings.TrimSpace(pair[:idx])
+				ceb.labels[key] = strings.TrimSpace(pair[idx+1:])
+			}
+		}
+
+		// Patterns are separated by newlines since they may contain
+		// any other character.
+		if v := os.Getenv(envExecScrubPatterns); v != "" {
+			for _, line := range strings.Split(v, "\n") {
+				if line = strings.TrimSpace(line); line == "" {
+					continue
+				}
+
+				re, err := regexp.Compile(line)
+				if err != nil {
+					return fmt.Errorf("Invalid pattern in %s: %s", envExecScrubPatterns, err)
+				}
+
+				ceb.scrubPatterns = append(ceb.scrubPatterns, re)
+			}
+		}
+
 		return nil
 	}
 }
@@ -210,6 +514,20 @@ func WithClient(client pb.WaypointClient) Option {
 	}
 }
 
+// WithMaxMessageSize sets the maximum size of a message sent to the
+// server. This should be set if the server is configured with a receive
+// limit lower than the gRPC default.
+func WithMaxMessageSize(n int) Option {
+	return func(ceb *CEB, cfg *config) error {
+		if n <= 0 {
+			return fmt.Errorf("max message size must be positive")
+		}
+
+		ceb.maxMessageSize = n
+		return nil
+	}
+}
+
 // withCEBValue is used by tests to get the CEB struct pointer from Run.
 // This is a nasty pattern but its encapsulated behind test helpers.
 func withCEBValue(cebCh chan<- *CEB) Option {
@@ -1,12 +1,15 @@
 package ceb
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"testing"
 	"time"
 
@@ -330,6 +333,13 @@ func TestMain(m *testing.M) {
 			fmt.Println(time.Now().String())
 		}
 
+	case "logs-stdout-stderr":
+		for {
+			time.Sleep(250 * time.Millisecond)
+			fmt.Println("out")
+			fmt.Fprintln(os.Stderr, "err")
+		}
+
 	case "write-file":
 		path := os.Getenv("HELPER_PATH")
 		if path == "" {
@@ -339,6 +349,36 @@ func TestMain(m *testing.M) {
 		ioutil.WriteFile(path, []byte("hello"), 0600)
 		time.Sleep(10 * time.Minute)
 
+	case "write-file-on-signal":
+		path := os.Getenv("HELPER_PATH")
+		if path == "" {
+			panic("bad")
+		}
+
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGUSR1)
+		fmt.Println("ready")
+		sig := <-sigCh
+
+		ioutil.WriteFile(path, []byte(sig.String()), 0600)
+
+	case "print-limits":
+		// Wait for a line of input so that the limits are applied.
+		bufio.NewReader(os.Stdin).ReadString('\n')
+
+		var rlim syscall.Rlimit
+		if err := syscall.Getrlimit(syscall.RLIMIT_AS, &rlim); err != nil {
+			panic(err)
+		}
+
+		// The raw getpriority syscall on Linux returns 20 - nice.
+		prio, err := syscall.Getpriority(syscall.PRIO_PROCESS, 0)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%d %d\n", rlim.Cur, 20-prio)
+
 	default:
 		panic("invalid helperfunc")
 	}
@@ -2,6 +2,7 @@ package ceb
 
 import (
 	"context"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -35,7 +36,7 @@ func (ceb *CEB) execChildCmd(ctx context.Context) <-chan error {
 		"args", cmd.Args,
 	)
 	log.Info("starting child process")
-	if err := cmd.Start(); err != nil {
+	if err := ceb.execReaper.Start(cmd); err != nil {
 		ch <- status.Errorf(codes.Aborted,
 			"failed to execute subprocess: %s", err)
 		return ch
@@ -44,6 +45,7 @@ func (ceb *CEB) execChildCmd(ctx context.Context) <-chan error {
 	// Start a goroutine to wait for completion
 	go func() {
 		err := cmd.Wait()
+		ceb.execReaper.Done(cmd)
 		if err == nil {
 			log.Info("subprocess gracefully exited")
 		} else {
@@ -68,7 +70,15 @@ func (ceb *CEB) buildCmd(ctx context.Context, args []string) (*exec.Cmd, error)
 	if !filepath.IsAbs(args[0]) {
 		path, err := exec.LookPath(args[0])
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument,
+			code := codes.InvalidArgument
+			switch {
+			case errors.Is(err, os.ErrPermission):
+				code = codes.PermissionDenied
+			case errors.Is(err, exec.ErrNotFound):
+				code = codes.NotFound
+			}
+
+			return nil, status.Errorf(code,
 				"failed to find command %q on PATH: %s", args[0], err)
 		}
 
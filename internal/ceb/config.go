@@ -18,6 +18,9 @@ func (ceb *CEB) initConfigStream(ctx context.Context, cfg *config, isRetry bool)
 	client, err := ceb.client.EntrypointConfig(ctx, &pb.EntrypointConfigRequest{
 		DeploymentId: ceb.deploymentId,
 		InstanceId:   ceb.id,
+		Labels:       ceb.labels,
+		AllocationId: ceb.allocId,
+		Tasks:        ceb.execTaskNames(),
 	}, grpc.WaitForReady(isRetry || cfg.ServerRequired))
 	if err != nil {
 		// If the server is unavailable and this is our first time, then
@@ -43,9 +46,12 @@ func (ceb *CEB) initConfigStream(ctx context.Context, cfg *config, isRetry bool)
 	}
 	log.Trace("first config received")
 
-	// Modify childCmd to contain any passed variables as environment variables
+	// Modify childCmd to contain any passed variables as environment
+	// variables. Config values are often secrets so we scrub them from
+	// any exec data that we log.
 	for _, cv := range resp.Config.EnvVars {
 		ceb.childCmd.Env = append(ceb.childCmd.Env, cv.Name+"="+cv.Value)
+		ceb.addScrubValues(cv.Value)
 	}
 
 	// If we have URL service configuration, start it.
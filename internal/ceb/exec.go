@@ -2,21 +2,65 @@ package ceb
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/creack/pty"
 	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
 	"github.com/mitchellh/go-grpc-net-conn"
+	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
 
+// defaultKillGracePeriod is how long we wait for an exec'd command to exit
+// after sending it the termination signal before we SIGKILL it.
+const defaultKillGracePeriod = 5 * time.Second
+
+// maxKillGracePeriod is the longest we wait for an exec'd command to exit
+// after sending it the termination signal. The server lowers longer grace
+// periods already, but older servers don't.
+const maxKillGracePeriod = 10 * time.Minute
+
+// execReapTimeout is how long we wait for the remaining processes in an
+// exec'd command's process group to exit after we kill them.
+const execReapTimeout = 5 * time.Second
+
+// execOutputDrainTimeout is how long we wait for the output of an exec'd
+// command to be copied after it exits. Output can remain open if a
+// background process holds it.
+const execOutputDrainTimeout = 1 * time.Second
+
+// minStatsInterval is the shortest interval we send exec stats at.
+const minStatsInterval = 1 * time.Second
+
+// execPtyOpen allocates a PTY for exec sessions. This is a variable so
+// tests can simulate instances where PTYs are unavailable.
+var execPtyOpen = pty.Open
+
+// execCapabilities are the optional features of the exec protocol that we
+// support in command sessions. The client only uses the features that it,
+// the server and we all support.
+var execCapabilities = []string{"ping", "stdin_eof"}
+
+// execCapabilitySequence is the capability of running a sequence of
+// commands, which we only support for commands we run ourselves.
+const execCapabilitySequence = "sequence"
+
 func (ceb *CEB) startExecGroup(es []*pb.EntrypointConfig_Exec) {
 	idx := ceb.execIdx
 	for _, exec := range es {
@@ -39,10 +83,16 @@ func (ceb *CEB) startExecGroup(es []*pb.EntrypointConfig_Exec) {
 }
 
 func (ceb *CEB) startExec(execConfig *pb.EntrypointConfig_Exec) {
-	log := ceb.logger.Named("exec").With("index", execConfig.Index)
+	log := ceb.logger.Named("exec").With(
+		"index", execConfig.Index, "session_id", execConfig.SessionId)
+	scrubber := ceb.scrubber()
+
+	// A named container may be one we can run the command in ourselves.
+	// If Container is still set after this, the session is brokered.
+	execConfig = ceb.execResolveContainer(execConfig)
 
 	// Open the stream
-	log.Info("starting exec stream", "args", execConfig.Args)
+	log.Info("starting exec stream", "args", scrubber.Args(execConfig.Args))
 	client, err := ceb.client.EntrypointExecStream(ceb.context)
 	if err != nil {
 		log.Warn("error opening exec stream", "err", err)
@@ -50,154 +100,626 @@ func (ceb *CEB) startExec(execConfig *pb.EntrypointConfig_Exec) {
 	}
 	defer client.CloseSend()
 
+	// Our output writers and this goroutine all send on the stream
+	// concurrently so we need to serialize that.
+	stream := &execStream{Waypoint_EntrypointExecStreamClient: client}
+	client = stream
+
+	// A resume hands its stream to the session it resumes.
+	if execConfig.Resume != nil {
+		ceb.execResume(log, client, execConfig)
+		return
+	}
+
+	// Track our active session count
+	count := atomic.AddInt32(&ceb.execCount, 1)
+	defer func() {
+		count := atomic.AddInt32(&ceb.execCount, -1)
+		log.Debug("exec session ended", "active_sessions", count)
+	}()
+	log.Debug("exec session starting", "active_sessions", count)
+
+	// Determine which of the requested limits we can apply so that we
+	// can report the rest in our open message. We can't apply any to a
+	// command in another container.
+	var limiter *execLimiter
+	var unsupported []string
+	if execConfig.Container != "" {
+		unsupported = execLimitNames(execConfig.Limits)
+	} else {
+		limiter, unsupported = newExecLimiter(log, execConfig.Index, execConfig.Limits)
+		defer limiter.Close()
+	}
+	if len(unsupported) > 0 {
+		log.Warn("some requested limits are not supported", "limits", unsupported)
+	}
+
+	// Allocate our PTY up front if one was requested. If we can't, we note
+	// that in our open message so the client knows not to put its terminal
+	// into raw mode and we run the command without a PTY. A brokered
+	// session gets its PTY from the platform.
+	var ptyFile, ttyFile *os.File
+	var ptyErr error
+	ptyReq := execConfig.Pty
+	if ptyReq != nil && ptyReq.Enable && execConfig.Container == "" {
+		log.Info("pty requested, allocating a pty")
+		ptyFile, ttyFile, ptyErr = execPtyOpen()
+		if ptyErr != nil {
+			log.Warn("error allocating pty", "err", ptyErr)
+		} else {
+			defer ptyFile.Close()
+			defer ttyFile.Close()
+		}
+	}
+
+	// Determine the Nomad task we run in, if any. An error is reported
+	// once the session is open.
+	task, taskErr := ceb.execResolveTask(execConfig.Task)
+	var taskName string
+	if task != nil {
+		taskName = task.Name
+	}
+
+	// Announce the optional features of the exec protocol we support.
+	// Copy and port forwarding sessions don't answer pings, and commands
+	// in other containers are run by the platform one at a time.
+	var caps []string
+	if execConfig.CopyTo == nil && execConfig.CopyFrom == nil && execConfig.PortForward == nil {
+		caps = execCapabilities
+		if execConfig.Container == "" {
+			caps = append(caps[:len(caps):len(caps)], execCapabilitySequence)
+		}
+	}
+	log.Debug("exec capabilities",
+		"client", execConfig.ClientCapabilities, "instance", caps)
+
+	// Report the locale of the command if it gets our PTY so that the
+	// client can warn if it can't show the characters it expects.
+	var locale string
+	if ptyFile != nil {
+		locale = execLocale(append(os.Environ(), execConfig.Env...))
+	}
+
+	// Commands that we run ourselves can be resumed should their stream
+	// drop, such as when the server restarts, within a grace period.
+	var resumeToken string
+	if ceb.execResumeGrace > 0 && execConfig.SessionId != "" && caps != nil &&
+		execConfig.Container == "" {
+		resumeToken, err = newExecResumeToken()
+		if err != nil {
+			log.Warn("error generating resume token, session can't be resumed", "err", err)
+		}
+	}
+
 	// Send our open message
 	log.Trace("sending open message")
 	if err := client.Send(&pb.EntrypointExecRequest{
 		Event: &pb.EntrypointExecRequest_Open_{
 			Open: &pb.EntrypointExecRequest_Open{
-				InstanceId: ceb.id,
-				Index:      execConfig.Index,
+				InstanceId:        ceb.id,
+				Index:             execConfig.Index,
+				UnsupportedLimits: unsupported,
+				PtyUnavailable:    ptyErr != nil,
+				AllocationId:      ceb.allocId,
+				Task:              taskName,
+				Capabilities:      caps,
+				Locale:            locale,
+				ResumeToken:       resumeToken,
 			},
 		},
 	}); err != nil {
 		log.Warn("error opening exec stream", "err", err)
 		return
 	}
+	if resumeToken != "" {
+		stream.newReplay = func() *execReplayBuffer {
+			return newExecReplayBuffer(ceb.execResumeBufferSize, &ceb.execResumeBudget)
+		}
+		defer stream.release()
+	}
 
-	// Build our command
-	cmd, err := ceb.buildCmd(ceb.context, execConfig.Args)
-	if err != nil {
-		log.Warn("error building exec command", "err", err)
-		st, ok := status.FromError(err)
-		if !ok {
-			st = status.New(codes.Unknown, err.Error())
+	// If we're over our session limit then we reject this session. We use
+	// ResourceExhausted so the server can tell this apart from other errors.
+	if max := ceb.execMax; max > 0 && count > max {
+		log.Warn("rejecting exec session, too many active sessions",
+			"active_sessions", count, "max", max)
+		ceb.execError(log, client, status.Errorf(codes.ResourceExhausted,
+			"instance has reached its limit of %d concurrent exec sessions", max))
+		return
+	}
+
+	if taskErr != nil {
+		log.Warn("rejecting exec session, invalid task", "err", taskErr)
+		ceb.execError(log, client, taskErr)
+		return
+	}
+
+	// File copy and port forwarding sessions don't run a command, and
+	// commands in other containers are run by the platform.
+	switch {
+	case execConfig.CopyTo != nil:
+		ceb.execCopyTo(log, client, execConfig)
+		return
+
+	case execConfig.CopyFrom != nil:
+		ceb.execCopyFrom(log, client, execConfig)
+		return
+
+	case execConfig.PortForward != nil:
+		ceb.execPortForward(log, client, execConfig)
+		return
+
+	case execConfig.Container != "":
+		ceb.execKubeSession(log, client, execConfig)
+		return
+	}
+
+	// Become a subreaper so that descendants of exec'd commands are
+	// reparented to us and we can reap them when the session ends, or
+	// once they exit if they outlive it.
+	ceb.execSubreaperOnce.Do(func() {
+		if err := execSetSubreaper(); err != nil {
+			log.Warn("unable to become a child subreaper, orphaned processes "+
+				"of exec sessions may not be reaped", "err", err)
+			return
 		}
 
-		if err := client.Send(&pb.EntrypointExecRequest{
-			Event: &pb.EntrypointExecRequest_Error_{
-				Error: &pb.EntrypointExecRequest_Error{
-					Error: st.Proto(),
-				},
-			},
-		}); err != nil {
-			log.Warn("error sending error message", "err", err)
+		go ceb.execReaper.Run(ceb.context, ceb.logger.Named("reaper"))
+	})
+
+	// Note the command name as requested since building the command
+	// replaces it with the full path.
+	name := execCommandName(execConfig.Args)
+
+	// If we couldn't get a PTY, either fail or let the user know that
+	// we're continuing without one.
+	if ptyErr != nil {
+		if execConfig.Pty.Require {
+			ceb.execStartFailed(log, client, name, status.Errorf(codes.FailedPrecondition,
+				"a PTY is required but couldn't be allocated: %s", ptyErr))
 			return
 		}
 
-		return
+		ceb.execWarning(log, client, "PTY unavailable on instance, continuing without one")
 	}
 
-	// Create our pipe for stdin so that we can send data
-	stdinR, stdinW := io.Pipe()
-	defer stdinW.Close()
+	// A script is written out and run in place of a command from the image.
+	args := execConfig.Args
+	if len(execConfig.Script) > 0 {
+		var cleanup func()
+		args, cleanup, err = ceb.execScriptArgs(execConfig)
+		if err != nil {
+			ceb.execStartFailed(log, client, "script", err)
+			return
+		}
+		defer cleanup()
 
-	// Start our receive data loop
-	respCh := make(chan *pb.EntrypointExecResponse)
-	go func() {
-		for {
-			resp, err := client.Recv()
+		name = args[0]
+	}
+
+	// The commands to run are args followed by those of the sequence, if
+	// any. They run one after another with the same stdin and PTY.
+	commands := [][]string{args}
+	names := []string{name}
+	for _, c := range execConfig.Sequence {
+		commands = append(commands, c.Args)
+		names = append(names, execCommandName(c.Args))
+	}
+
+	// If we're running in the application container then we wrap the
+	// command so that it runs within the application's namespaces.
+	if execConfig.TargetContainer {
+		for i := range commands {
+			commands[i], err = ceb.execTargetArgs(commands[i])
 			if err != nil {
-				// TODO
+				ceb.execStartFailed(log, client, names[i], err)
 				return
 			}
+		}
+	}
+
+	// Create our pipe for stdin so that we can send data. This is an OS
+	// pipe so the command reads from it directly. With an in-memory pipe,
+	// Wait would block copying stdin until we close it, even after the
+	// command exits, so the session would never end.
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		ceb.execStartFailed(log, client, name, err)
+		return
+	}
+	defer stdinR.Close()
+	defer stdinW.Close()
+
+	// Determine how long we give the command to exit gracefully if
+	// the stream closes out from under us.
+	grace := defaultKillGracePeriod
+	if v := execConfig.KillGracePeriod; v != "" {
+		d, err := time.ParseDuration(v)
+		switch {
+		case err != nil:
+			log.Warn("invalid kill grace period, using default",
+				"value", v, "err", err)
+
+		case d < 0:
+			log.Warn("negative kill grace period, using default", "value", v)
 
-			respCh <- resp
+		case d > maxKillGracePeriod:
+			log.Warn("kill grace period too long, using maximum",
+				"value", v, "max", maxKillGracePeriod)
+			grace = maxKillGracePeriod
+
+		default:
+			grace = d
 		}
-	}()
+	}
 
-	// We need to modify our command so the input/output is all over gRPC
-	cmd.Stdin = stdinR
-	cmd.Stdout = ceb.execOutputWriter(client, pb.EntrypointExecRequest_Output_STDOUT)
-	cmd.Stderr = ceb.execOutputWriter(client, pb.EntrypointExecRequest_Output_STDERR)
+	// Input is only logged at trace level and we scrub it first since
+	// it may contain secrets, such as those typed at a password prompt.
+	var inputLog *scrub.Writer
+	if log.IsTrace() {
+		inputLog = scrubber.Writer(log.StandardWriter(&hclog.StandardLoggerOptions{
+			ForceLevel: hclog.Trace,
+		}))
+		defer inputLog.Close()
+	}
 
-	// PTY
-	var ptyFile *os.File
-	if ptyReq := execConfig.Pty; ptyReq != nil && ptyReq.Enable {
-		log.Info("pty requested, allocating a pty")
+	// This context is cancelled when this exec session ends so that
+	// our helper goroutines exit.
+	ctx, cancel := context.WithCancel(ceb.context)
+	defer cancel()
 
-		// If we're setting a pty we'll be overriding our stdin/out/err
-		// so we need to get access to the original gRPC writers so we can
-		// copy later.
-		stdin := cmd.Stdin
-		stdout := cmd.Stdout
-
-		// We need to nil these so they get set to the pty below
-		cmd.Stdin = nil
-		cmd.Stdout = nil
-		cmd.Stderr = nil
-
-		// Set our TERM value
-		if ptyReq.Term != "" {
-			cmd.Env = append(cmd.Env, "TERM="+ptyReq.Term)
-		}
-
-		// Start with a pty
-		ptyFile, err = pty.StartWithSize(cmd, &pty.Winsize{
-			Rows: uint16(ptyReq.WindowSize.Rows),
-			Cols: uint16(ptyReq.WindowSize.Cols),
-			X:    uint16(ptyReq.WindowSize.Width),
-			Y:    uint16(ptyReq.WindowSize.Height),
-		})
-		if err != nil {
-			log.Warn("error building exec command", "err", err)
-			st, ok := status.FromError(err)
-			if !ok {
-				st = status.New(codes.Unknown, err.Error())
+	// Start our receive data loop. The streamCloseCh is closed when we
+	// can no longer receive from the stream, which means the client is gone.
+	// recvErr is then the error if the stream dropped rather than closed.
+	// A resumed session starts a new loop on its new stream.
+	respCh := make(chan *pb.EntrypointExecResponse)
+	var streamCloseCh chan struct{}
+	var recvErr error
+	recv := func(client pb.Waypoint_EntrypointExecStreamClient) {
+		closeCh := make(chan struct{})
+		streamCloseCh = closeCh
+		recvErr = nil
+		go func() {
+			defer close(closeCh)
+			for {
+				resp, err := client.Recv()
+				if err != nil {
+					if err != io.EOF {
+						log.Warn("error receiving from exec stream", "err", err)
+						recvErr = err
+					}
+
+					return
+				}
+
+				select {
+				case respCh <- resp:
+				case <-ctx.Done():
+					return
+				}
 			}
+		}()
+	}
+	recv(stream.Waypoint_EntrypointExecStreamClient)
 
-			if err := client.Send(&pb.EntrypointExecRequest{
-				Event: &pb.EntrypointExecRequest_Error_{
-					Error: &pb.EntrypointExecRequest_Error{
-						Error: st.Proto(),
-					},
-				},
+	// The output of every command is sent with the same writers so that
+	// it is numbered in order.
+	chunk := execOutputChunkSize(execConfig.OutputChunkSize)
+	if chunk > 0 {
+		log.Debug("using the output chunk size asked for", "size", chunk)
+	}
+	stdout := ceb.execOutputWriter(client, pb.EntrypointExecRequest_Output_STDOUT, chunk)
+	stderr := ceb.execOutputWriter(client, pb.EntrypointExecRequest_Output_STDERR, chunk)
+
+	// A detached command keeps running if its stream drops, so we keep
+	// reading its output even once we can't send it. Otherwise it would
+	// block once the pipe or PTY fills up.
+	if execConfig.Detach {
+		stdout = &execDiscardOnError{W: stdout}
+		stderr = &execDiscardOnError{W: stderr}
+	}
+
+	// If asked, the output also goes to the logs of the app. With a PTY,
+	// all of it is stdout.
+	if execConfig.MirrorToLogs {
+		log.Info("mirroring output to the logs")
+		stdoutMirror := ceb.execLogMirror(execConfig.SessionId, pb.LogBatch_Entry_STDOUT, scrubber)
+		stderrMirror := ceb.execLogMirror(execConfig.SessionId, pb.LogBatch_Entry_STDERR, scrubber)
+		defer stdoutMirror.Close()
+		defer stderrMirror.Close()
+		stdout = io.MultiWriter(stdout, stdoutMirror)
+		stderr = io.MultiWriter(stderr, stderrMirror)
+	}
+
+	// PTY
+	var ptyOutputDoneCh chan struct{}
+	if ptyFile != nil {
+		// Set our initial window size
+		if sz := ptyReq.WindowSize; sz != nil {
+			if err := pty.Setsize(ptyFile, &pty.Winsize{
+				Rows: uint16(sz.Rows),
+				Cols: uint16(sz.Cols),
+				X:    uint16(sz.Width),
+				Y:    uint16(sz.Height),
 			}); err != nil {
-				log.Warn("error sending error message", "err", err)
-				return
+				log.Warn("error setting initial window size", "err", err)
 			}
 		}
-		defer ptyFile.Close()
 
-		// Copy stdin to the pty
-		go io.Copy(ptyFile, stdin)
-		go io.Copy(stdout, ptyFile)
-	} else {
-		if err := cmd.Start(); err != nil {
-			log.Warn("error building exec command", "err", err)
-			st, ok := status.FromError(err)
-			if !ok {
-				st = status.New(codes.Unknown, err.Error())
+		// Copy stdin to the pty, and the output of the pty back. Reading
+		// the pty fails once nothing has the tty open anymore, so the copy
+		// ends once the command and anything it started have exited.
+		ptyOutputDoneCh = make(chan struct{})
+		go io.Copy(ptyFile, stdinR)
+		go func() {
+			defer close(ptyOutputDoneCh)
+			execCopyOutput(stdout, ptyFile, chunk)
+		}()
+	}
+
+	// startCommand builds and starts the command with the given index.
+	// Without a PTY, this also returns the pipes its output is copied from.
+	startCommand := func(i int) (*exec.Cmd, *execOutputPipes, error) {
+		cmd, err := ceb.buildCmd(ceb.context, commands[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		cmd.Env = append(cmd.Env, execConfig.Env...)
+		task.Apply(cmd)
+		limiter.Wrap(cmd)
+
+		if ptyFile != nil {
+			// The command gets the tty side of our pty and runs in a new
+			// session with the tty as its controlling terminal.
+			cmd.Stdin = ttyFile
+			cmd.Stdout = ttyFile
+			cmd.Stderr = ttyFile
+			cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+
+			// Set our TERM value
+			if ptyReq.Term != "" {
+				cmd.Env = append(cmd.Env, "TERM="+ptyReq.Term)
 			}
 
-			if err := client.Send(&pb.EntrypointExecRequest{
-				Event: &pb.EntrypointExecRequest_Error_{
-					Error: &pb.EntrypointExecRequest_Error{
-						Error: st.Proto(),
-					},
-				},
-			}); err != nil {
-				log.Warn("error sending error message", "err", err)
-				return
+			return cmd, nil, ceb.execReaper.Start(cmd)
+		}
+
+		// Run the command in its own process group so that on teardown we
+		// can signal it along with anything it started. PTY sessions get
+		// this automatically since the child becomes a session leader.
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+		cmd.Stdin = stdinR
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+
+		// We copy output from pipes we own rather than letting exec do it
+		// so that Wait returns once the command exits, even if background
+		// processes it started still have its stdout or stderr open.
+		output, err := execPipeOutput(cmd, chunk)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = ceb.execReaper.Start(cmd)
+		output.Started()
+		if err != nil {
+			output.Close()
+			return nil, nil, err
+		}
+
+		return cmd, output, nil
+	}
+
+	// runCommand starts the command with the given index and waits for it
+	// in a goroutine so we can handle concurrent events below. cmdExitCh
+	// receives the result of Wait and cmdDoneCh is closed once it returns.
+	// statsDoneCh is closed once we stop sending resource usage so that we
+	// never send it after the exit.
+	var cmd *exec.Cmd
+	var output *execOutputPipes
+	var cmdExitCh chan error
+	var cmdDoneCh, statsDoneCh chan struct{}
+	runCommand := func(i int) error {
+		var err error
+		cmd, output, err = startCommand(i)
+		if err != nil {
+			return err
+		}
+
+		// Once the last command started, nothing else needs the tty or
+		// the read side of stdin. We close ours so that writes to stdin
+		// fail rather than block once the command exits.
+		if i == len(commands)-1 {
+			if ptyFile != nil {
+				ttyFile.Close()
+			} else {
+				stdinR.Close()
+			}
+		}
+
+		exitCh := make(chan error, 1)
+		doneCh := make(chan struct{})
+		go func(cmd *exec.Cmd) {
+			defer close(doneCh)
+			err := cmd.Wait()
+			ceb.execReaper.Done(cmd)
+			exitCh <- err
+		}(cmd)
+		cmdExitCh, cmdDoneCh = exitCh, doneCh
+
+		// Send resource usage if requested.
+		statsDoneCh = make(chan struct{})
+		if v := execConfig.StatsInterval; v != "" {
+			interval, err := time.ParseDuration(v)
+			if err != nil {
+				log.Warn("invalid stats interval, not sending stats", "value", v, "err", err)
+				close(statsDoneCh)
+			} else {
+				if interval < minStatsInterval {
+					interval = minStatsInterval
+				}
+
+				go ceb.execStats(log, client, cmd.Process.Pid, interval, cmdDoneCh, statsDoneCh)
 			}
+		} else {
+			close(statsDoneCh)
 		}
+
+		return nil
 	}
 
-	// Wait for the command to exit in a goroutine so we can handle
-	// concurrent events happening below.
-	cmdExitCh := make(chan error, 1)
-	go func() {
-		cmdExitCh <- cmd.Wait()
+	current := 0
+	if err := runCommand(current); err != nil {
+		ceb.execStartFailed(log, client, names[current], err)
+		return
+	}
+
+	// If the client won't send input, close stdin so the command sees EOF
+	// rather than waiting forever.
+	if execConfig.NoStdin && ptyFile == nil {
+		stdinW.Close()
+	}
+
+	// If our stream drops, resumeCh receives the stream of a resume until
+	// resumeTimer fires at the end of the grace period. The sessions that
+	// resumed us wait until we're done with their streams.
+	var resumeCh <-chan *execResumeStream
+	var resumeTimer <-chan time.Time
+	var resumes []*execResumeStream
+	defer func() {
+		if r := ceb.execResumeStop(execConfig.SessionId, resumeCh); r != nil {
+			resumes = append(resumes, r)
+		}
+		for _, r := range resumes {
+			close(r.doneCh)
+		}
 	}()
 
+	// resume continues the session on the stream of a resume, sending what
+	// we held meanwhile first.
+	resume := func(r *execResumeStream) {
+		resumeCh, resumeTimer = nil, nil
+		resumes = append(resumes, r)
+		log.Info("exec session resumed")
+		if err := stream.attach(r.stream); err != nil {
+			log.Warn("error replaying exec events to resumed stream", "err", err)
+		}
+		recv(r.stream)
+	}
+
+	// stopping is true once the client is gone, after which we don't start
+	// any more commands of the sequence. exited is true once we sent the
+	// exit but wait for a resume to deliver it, unless the entrypoint exits
+	// first and exitedDoneCh is closed.
+	stopping := false
+	exited := false
+	var exitedDoneCh <-chan struct{}
 	for {
 		select {
+		case <-exitedDoneCh:
+			return
+
+		case <-streamCloseCh:
+			streamCloseCh = nil
+
+			// If the stream dropped rather than closed, we keep the command
+			// running and hold its events for a while so that the session
+			// can be resumed.
+			if recvErr != nil && resumeToken != "" && !stopping {
+				log.Info("exec stream dropped, waiting for the session to be resumed",
+					"grace_period", ceb.execResumeGrace)
+				stream.detach()
+				resumeCh = ceb.execResumeWait(execConfig.SessionId, resumeToken)
+				resumeTimer = time.After(ceb.execResumeGrace)
+				continue
+			}
+			if exited {
+				return
+			}
+
+			// A detached session has no client to go away, so its command
+			// keeps running if the stream dropped. We only stop it if the
+			// server ended the stream, such as when the deployment is
+			// destroyed.
+			if execConfig.Detach && recvErr != nil {
+				log.Info("exec stream dropped, keeping the detached command running")
+				continue
+			}
+
+			// The client went away. We ask the command to exit and continue
+			// looping so that we still report the exit if we can.
+			stopping = true
+			log.Info("exec stream closed, stopping command", "grace_period", grace)
+			go execTerminate(log, cmd, ptyFile != nil, grace, cmdDoneCh)
+
+		case r := <-resumeCh:
+			resume(r)
+			if exited && !stream.detached() {
+				return
+			}
+
+		case <-resumeTimer:
+			if r := ceb.execResumeStop(execConfig.SessionId, resumeCh); r != nil {
+				resume(r)
+				if exited && !stream.detached() {
+					return
+				}
+
+				continue
+			}
+
+			resumeCh, resumeTimer = nil, nil
+			if exited {
+				return
+			}
+
+			if execConfig.Detach {
+				log.Info("exec session wasn't resumed in time, keeping the detached command running")
+				continue
+			}
+
+			// Nobody resumed the session, so we stop the command like when
+			// the client goes away.
+			stopping = true
+			log.Info("exec session wasn't resumed in time, stopping command",
+				"grace_period", grace)
+			go execTerminate(log, cmd, ptyFile != nil, grace, cmdDoneCh)
+
 		case resp := <-respCh:
 			switch event := resp.Event.(type) {
 			case *pb.EntrypointExecResponse_Input:
 				// Copy the input to stdin
-				log.Trace("input received", "data", event.Input)
+				if inputLog != nil {
+					inputLog.Write(event.Input)
+				}
 				io.Copy(stdinW, bytes.NewReader(event.Input))
 
+			case *pb.EntrypointExecResponse_InputEof:
+				// A PTY has no end of input of its own, so we type the EOF
+				// character like a user would.
+				if ptyFile != nil {
+					io.WriteString(stdinW, "\x04")
+				} else {
+					stdinW.Close()
+				}
+
+			case *pb.EntrypointExecResponse_Signal:
+				name := event.Signal.Name
+				sig, ok := execSignal(name)
+				if !ok {
+					log.Warn("rejecting unsupported signal", "signal", name)
+					ceb.execWarning(log, client, fmt.Sprintf(
+						"signal %q is not supported, ignoring", name))
+					continue
+				}
+
+				log.Info("delivering signal to command", "signal", sig)
+				if err := syscall.Kill(-cmd.Process.Pid, sig); err != nil {
+					log.Warn("error delivering signal", "signal", sig, "err", err)
+					ceb.execWarning(log, client, fmt.Sprintf(
+						"failed to deliver signal %s: %s", name, err))
+				}
+
 			case *pb.EntrypointExecResponse_Winch:
 				log.Debug("window size change event, changing")
 
@@ -211,47 +733,563 @@ func (ceb *CEB) startExec(execConfig *pb.EntrypointConfig_Exec) {
 					log.Warn("error changing window size, this doesn't quit the stream",
 						"err", err)
 				}
+
+			case *pb.EntrypointExecResponse_Ping:
+				ceb.execPong(log, client, event.Ping)
 			}
 
 		case err := <-cmdExitCh:
-			var exitCode int
-			if err != nil {
-				if exiterr, ok := err.(*exec.ExitError); ok {
-					if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
-						exitCode = status.ExitStatus()
-					}
-				} else {
-					exitCode = 1
+			exit := execExit(cmd.ProcessState)
+			if err != nil && cmd.ProcessState == nil {
+				exit.Code = 1
+			}
+
+			// Unless the session allows orphans, kill anything the command
+			// left running and reap it so nothing accumulates over time.
+			if execConfig.OrphanPolicy == pb.ExecStreamRequest_KILL_GROUP {
+				execReapGroup(log, cmd.Process.Pid, execReapTimeout)
+			}
+
+			// Make sure all output is sent before the exit
+			if output != nil && !output.Wait(execOutputDrainTimeout) {
+				log.Warn("timed out waiting for command output, discarding the rest")
+			}
+			if output != nil {
+				output.Close()
+			}
+			<-statsDoneCh
+
+			// With a sequence, we report the exit of each command and start
+			// the next one, unless it failed and we don't keep going. A
+			// command that can't start counts as one that failed.
+			next := false
+			for len(commands) > 1 && !next {
+				log.Info("exec command exited", "index", current, "code", exit.Code)
+				if err := client.Send(&pb.EntrypointExecRequest{
+					Event: &pb.EntrypointExecRequest_CommandExit_{
+						CommandExit: &pb.EntrypointExecRequest_CommandExit{
+							Index: int32(current),
+							Exit:  exit,
+						},
+					},
+				}); err != nil {
+					log.Warn("error sending command exit message", "err", err)
+				}
+
+				if current == len(commands)-1 || stopping ||
+					(exit.Code != 0 && !execConfig.KeepGoing) {
+					break
+				}
+
+				current++
+				if err := runCommand(current); err != nil {
+					log.Warn("error starting exec command", "err", err)
+					exit = execStartExit(names[current], err)
+					continue
+				}
+
+				next = true
+			}
+			if next {
+				continue
+			}
+
+			// With a PTY, make sure all output is sent before the exit too.
+			// We only get here after the last command, once we closed our
+			// tty, so there is nothing else holding it open.
+			if ptyOutputDoneCh != nil {
+				select {
+				case <-ptyOutputDoneCh:
+				case <-time.After(execOutputDrainTimeout):
+					log.Warn("timed out waiting for command output, discarding the rest")
 				}
 			}
 
 			// Send our exit code
-			log.Info("exec stream exited", "code", exitCode)
+			log.Info("exec stream exited", "code", exit.Code, "signal", exit.Signal)
 			if err := client.Send(&pb.EntrypointExecRequest{
 				Event: &pb.EntrypointExecRequest_Exit_{
-					Exit: &pb.EntrypointExecRequest_Exit{
-						Code: int32(exitCode),
-					},
+					Exit: exit,
 				},
 			}); err != nil {
 				log.Warn("error sending exit message", "err", err)
 			}
 
+			// If our stream dropped, we wait for a resume to deliver the
+			// exit, or for the stream to turn out to be gone for good.
+			if resumeCh != nil || (stream.detached() && streamCloseCh != nil) {
+				exited = true
+				exitedDoneCh = ctx.Done()
+				continue
+			}
+
 			// Exit!
 			return
 		}
 	}
+}
 
+// execExit builds the exit event for a command from its process state.
+// If the command was terminated by a signal, the code is 128 plus the
+// signal number like a shell.
+func execExit(state *os.ProcessState) *pb.EntrypointExecRequest_Exit {
+	var exit pb.EntrypointExecRequest_Exit
+	if state == nil {
+		return &exit
+	}
+
+	exit.Code = int32(state.ExitCode())
+	if ws, ok := state.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		exit.Code = 128 + int32(ws.Signal())
+		exit.Signal = unix.SignalName(ws.Signal())
+		if exit.Signal == "" {
+			exit.Signal = fmt.Sprintf("signal %d", int(ws.Signal()))
+		}
+		exit.CoreDumped = ws.CoreDump()
+	}
+
+	exit.Usage = &pb.ExecStreamResponse_Exit_Usage{
+		UserTimeMs:   state.UserTime().Milliseconds(),
+		SystemTimeMs: state.SystemTime().Milliseconds(),
+	}
+	if ru, ok := state.SysUsage().(*syscall.Rusage); ok {
+		exit.Usage.MaxRssBytes = rusageMaxRSS(ru)
+	}
+
+	return &exit
 }
 
+// execLimitNames returns the names of the limits that are set.
+func execLimitNames(limits *pb.ExecStreamRequest_Limits) []string {
+	if limits == nil {
+		return nil
+	}
+
+	var result []string
+	if limits.MemoryBytes > 0 {
+		result = append(result, "memory_bytes")
+	}
+	if limits.CpuShares > 0 {
+		result = append(result, "cpu_shares")
+	}
+	if limits.CpuQuotaPercent > 0 {
+		result = append(result, "cpu_quota_percent")
+	}
+	if limits.Nice != 0 {
+		result = append(result, "nice")
+	}
+
+	return result
+}
+
+// execSignals are the signals that a client may send to an exec'd command.
+// Anything else is rejected so clients can't send signals that are only
+// meant to be generated by the kernel (SIGSEGV, etc.).
+var execSignals = map[string]syscall.Signal{
+	"HUP":   syscall.SIGHUP,
+	"INT":   syscall.SIGINT,
+	"QUIT":  syscall.SIGQUIT,
+	"KILL":  syscall.SIGKILL,
+	"USR1":  syscall.SIGUSR1,
+	"USR2":  syscall.SIGUSR2,
+	"ALRM":  syscall.SIGALRM,
+	"TERM":  syscall.SIGTERM,
+	"CONT":  syscall.SIGCONT,
+	"STOP":  syscall.SIGSTOP,
+	"TSTP":  syscall.SIGTSTP,
+	"WINCH": syscall.SIGWINCH,
+}
+
+// execSignal returns the signal for the given name. The name is case
+// insensitive and may optionally have a "SIG" prefix.
+func execSignal(name string) (syscall.Signal, bool) {
+	name = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(name)), "SIG")
+	sig, ok := execSignals[name]
+	return sig, ok
+}
+
+// execStats sends the resource usage of the process with the given pid
+// every interval until doneCh is closed. Errors reading usage are logged
+// and skipped since stats should never fail the session. This closes
+// statsDoneCh when it returns.
+func (ceb *CEB) execStats(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	pid int,
+	interval time.Duration,
+	doneCh <-chan struct{},
+	statsDoneCh chan<- struct{},
+) {
+	defer close(statsDoneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-doneCh:
+			return
+
+		case <-ticker.C:
+		}
+
+		stats, err := readExecStats(pid)
+		if err != nil {
+			log.Debug("error reading exec stats", "err", err)
+			continue
+		}
+
+		if err := client.Send(&pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_Stats{
+				Stats: stats,
+			},
+		}); err != nil {
+			log.Warn("error sending stats", "err", err)
+		}
+	}
+}
+
+// execStartFailed reports to the client that the command could not be
+// started. This is sent as an exit so the client gets an exit code. Like
+// a shell, we exit with 127 if the command doesn't exist and 126 if it
+// isn't executable so that scripts can tell these apart.
+func (ceb *CEB) execStartFailed(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	name string,
+	err error,
+) {
+	log.Warn("error starting exec command", "err", err)
+	if err := client.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: execStartExit(name, err),
+		},
+	}); err != nil {
+		log.Warn("error sending exit message", "err", err)
+	}
+}
+
+// execStartExit builds the exit event for the command name that couldn't
+// be started because of err.
+func execStartExit(name string, err error) *pb.EntrypointExecRequest_Exit {
+	startErr := &pb.ExecStreamResponse_StartError{
+		Command: name,
+		Message: status.Convert(err).Message(),
+	}
+
+	code := int32(1)
+	switch {
+	case status.Code(err) == codes.NotFound ||
+		errors.Is(err, exec.ErrNotFound) ||
+		errors.Is(err, os.ErrNotExist):
+		code = 127
+		startErr.Reason = pb.ExecStreamResponse_StartError_NOT_FOUND
+		startErr.Path = os.Getenv("PATH")
+
+	case status.Code(err) == codes.PermissionDenied ||
+		errors.Is(err, os.ErrPermission):
+		code = 126
+		startErr.Reason = pb.ExecStreamResponse_StartError_PERMISSION_DENIED
+	}
+
+	return &pb.EntrypointExecRequest_Exit{
+		Code:       code,
+		StartError: startErr,
+	}
+}
+
+// execCommandName returns the name of the command with the given args as
+// requested, for start errors.
+func execCommandName(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	return args[0]
+}
+
+// execError sends an error to the client. This terminates the session.
+func (ceb *CEB) execError(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	err error,
+) {
+	if err := client.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Error_{
+			Error: &pb.EntrypointExecRequest_Error{
+				Error: status.Convert(err).Proto(),
+			},
+		},
+	}); err != nil {
+		log.Warn("error sending error message", "err", err)
+	}
+}
+
+// execWarning sends a non-fatal warning message to the client.
+func (ceb *CEB) execWarning(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	msg string,
+) {
+	if err := client.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Warning_{
+			Warning: &pb.EntrypointExecRequest_Warning{
+				Message: msg,
+			},
+		},
+	}); err != nil {
+		log.Warn("error sending warning message", "err", err)
+	}
+}
+
+// execPong answers a ping from the client.
+func (ceb *CEB) execPong(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	ping *pb.ExecStreamRequest_Ping,
+) {
+	if err := client.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Pong{
+			Pong: &pb.ExecStreamResponse_Pong{
+				Id: ping.Id,
+			},
+		},
+	}); err != nil {
+		log.Warn("error sending pong message", "err", err)
+	}
+}
+
+// execTerminate stops the exec'd command. It sends SIGTERM (or SIGHUP for
+// PTY sessions, like a terminal hangup) to the command's process group and
+// waits up to grace for doneCh to close before sending SIGKILL.
+func execTerminate(
+	log hclog.Logger,
+	cmd *exec.Cmd,
+	pty bool,
+	grace time.Duration,
+	doneCh <-chan struct{},
+) {
+	if cmd.Process == nil {
+		return
+	}
+
+	sig := syscall.SIGTERM
+	if pty {
+		sig = syscall.SIGHUP
+	}
+
+	// A negative pid signals the entire process group.
+	pgid := -cmd.Process.Pid
+	log.Debug("sending termination signal", "signal", sig)
+	if err := syscall.Kill(pgid, sig); err != nil {
+		log.Warn("error sending termination signal", "signal", sig, "err", err)
+	}
+
+	select {
+	case <-doneCh:
+		return
+
+	case <-time.After(grace):
+	}
+
+	log.Warn("command did not exit within grace period, killing", "grace_period", grace)
+	if err := syscall.Kill(pgid, syscall.SIGKILL); err != nil {
+		log.Warn("error killing command", "err", err)
+	}
+}
+
+// execStream serializes sends on an entrypoint exec stream. gRPC streams
+// do not support concurrent calls to SendMsg.
+//
+// If newReplay is set, the session can be resumed: once sending fails or
+// detach is called, events are held in a replay buffer from newReplay
+// rather than sent, until attach gives us a new stream.
+type execStream struct {
+	pb.Waypoint_EntrypointExecStreamClient
+
+	lock      sync.Mutex
+	newReplay func() *execReplayBuffer
+	replay    *execReplayBuffer
+}
+
+func (s *execStream) Send(req *pb.EntrypointExecRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.send(req)
+}
+
+func (s *execStream) SendMsg(m interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if req, ok := m.(*pb.EntrypointExecRequest); ok {
+		return s.send(req)
+	}
+
+	return s.Waypoint_EntrypointExecStreamClient.SendMsg(m)
+}
+
+// send sends req, or holds it while detached. This must be called with
+// the lock held.
+func (s *execStream) send(req *pb.EntrypointExecRequest) error {
+	if s.replay != nil {
+		s.replay.Add(req)
+		return nil
+	}
+
+	err := s.Waypoint_EntrypointExecStreamClient.Send(req)
+	if err != nil && s.newReplay != nil {
+		s.replay = s.newReplay()
+		s.replay.Add(req)
+		return nil
+	}
+
+	return err
+}
+
+// detach holds the events sent from now on, if the session can be resumed.
+func (s *execStream) detach() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.replay == nil && s.newReplay != nil {
+		s.replay = s.newReplay()
+	}
+}
+
+// attach makes stream the one we send on. The events held since we
+// detached are sent first, followed by a Replayed event. If sending them
+// fails, we stay detached with the events that weren't sent.
+func (s *execStream) attach(stream pb.Waypoint_EntrypointExecStreamClient) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.Waypoint_EntrypointExecStreamClient = stream
+	var events []*pb.EntrypointExecRequest
+	var dropped int64
+	if s.replay != nil {
+		events, dropped = s.replay.Take()
+		s.replay = nil
+	}
+
+	events = append(events, &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Replayed{
+			Replayed: &pb.ExecStreamResponse_Replayed{DroppedBytes: dropped},
+		},
+	})
+	for i, req := range events {
+		if err := stream.Send(req); err != nil {
+			s.replay = s.newReplay()
+			for _, req := range events[i : len(events)-1] {
+				s.replay.Add(req)
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// detached returns true if we are holding events rather than sending them.
+func (s *execStream) detached() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.replay != nil
+}
+
+// release discards the events held, if any, once the session ends.
+func (s *execStream) release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.replay != nil {
+		s.replay.Release()
+	}
+}
+
+// execMessageOverhead is the room we leave in each message for everything
+// other than the output data itself.
+const execMessageOverhead = 1024
+
+// The bounds of the output chunk size a client can ask for. Smaller chunks
+// would be mostly message overhead, and larger ones hold output back for
+// too long to be worth it.
+const (
+	execOutputChunkMin = 512
+	execOutputChunkMax = 1024 * 1024
+)
+
+// execOutputChunkSize returns the output chunk size to use for the size n
+// asked for by the client, bounded to what we support. This returns zero
+// if n is zero, for our default of reading what io.Copy does and sending
+// messages as large as we can.
+func execOutputChunkSize(n uint32) int {
+	switch {
+	case n == 0:
+		return 0
+
+	case n < execOutputChunkMin:
+		return execOutputChunkMin
+
+	case n > execOutputChunkMax:
+		return execOutputChunkMax
+	}
+
+	return int(n)
+}
+
+// execCopyOutput copies the output of a command from r to w, reading up
+// to chunk bytes at a time, or as io.Copy does if chunk is zero.
+func execCopyOutput(w io.Writer, r io.Reader, chunk int) (int64, error) {
+	if chunk <= 0 {
+		return io.Copy(w, r)
+	}
+
+	// We hide any WriteTo of r since it would read with its own buffer
+	// rather than ours.
+	return io.CopyBuffer(w, struct{ io.Reader }{r}, make([]byte, chunk))
+}
+
+// execDiscardOnError writes to W until a write fails, after which writes
+// are discarded. Writes never fail, so a copy to it never stops early.
+type execDiscardOnError struct {
+	W io.Writer
+
+	failed bool
+}
+
+func (w *execDiscardOnError) Write(p []byte) (int, error) {
+	if !w.failed {
+		if _, err := w.W.Write(p); err != nil {
+			w.failed = true
+		}
+	}
+
+	return len(p), nil
+}
+
+// execOutputWriter returns a writer that sends output on the given channel.
+// Large writes are split across multiple messages so that no message
+// exceeds the maximum message size, or chunk bytes of output if chunk is
+// set. Each message is numbered so that the client can detect lost
+// messages.
 func (ceb *CEB) execOutputWriter(
 	client grpc.ClientStream,
 	channel pb.EntrypointExecRequest_Output_Channel,
+	chunk int,
 ) io.Writer {
+	size := ceb.maxMessageSize - execMessageOverhead
+	if size <= 0 {
+		size = ceb.maxMessageSize / 2
+	}
+	if chunk > 0 && chunk < size {
+		size = chunk
+	}
+
+	// The encoder is called once per message and the conn serializes
+	// writes, so this needs no lock.
+	var seq uint64
 	return &grpc_net_conn.Conn{
 		Stream:  client,
 		Request: &pb.EntrypointExecRequest{},
-		Encode: grpc_net_conn.SimpleEncoder(func(msg proto.Message) *[]byte {
+		Encode: grpc_net_conn.ChunkedEncoder(grpc_net_conn.SimpleEncoder(func(msg proto.Message) *[]byte {
 			req := msg.(*pb.EntrypointExecRequest)
 			if req.Event == nil {
 				req.Event = &pb.EntrypointExecRequest_Output_{
@@ -261,7 +1299,104 @@ func (ceb *CEB) execOutputWriter(
 				}
 			}
 
-			return &req.Event.(*pb.EntrypointExecRequest_Output_).Output.Data
-		}),
+			output := req.Event.(*pb.EntrypointExecRequest_Output_).Output
+			seq++
+			output.Sequence = seq
+			return &output.Data
+		}), size),
+	}
+}
+
+// execOutputPipes copies the stdout and stderr of a command from pipes
+// that we own to the original writers of the command.
+type execOutputPipes struct {
+	readers []*os.File
+	writers []*os.File
+	wg      sync.WaitGroup
+}
+
+// execPipeOutput replaces the stdout and stderr of cmd with pipes and
+// starts copying from them to the original writers, chunk bytes at a time
+// as execCopyOutput does. Started must be called once the command has
+// started.
+func execPipeOutput(cmd *exec.Cmd, chunk int) (*execOutputPipes, error) {
+	p := &execOutputPipes{}
+	for _, dst := range []*io.Writer{&cmd.Stdout, &cmd.Stderr} {
+		r, w, err := os.Pipe()
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		p.readers = append(p.readers, r)
+		p.writers = append(p.writers, w)
+
+		out := *dst
+		*dst = w
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			execCopyOutput(out, r, chunk)
+		}()
+	}
+
+	return p, nil
+}
+
+// Started closes our copies of the write side of the pipes so that we
+// see EOF once the command and anything it started have exited.
+func (p *execOutputPipes) Started() {
+	for _, w := range p.writers {
+		w.Close()
+	}
+}
+
+// Wait waits up to timeout for all output to be copied. If this times
+// out then the pipes are closed and any further output is discarded.
+// This returns false if it timed out.
+func (p *execOutputPipes) Wait(timeout time.Duration) bool {
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		p.wg.Wait()
+	}()
+
+	select {
+	case <-doneCh:
+		return true
+
+	case <-time.After(timeout):
+		p.Close()
+		<-doneCh
+		return false
 	}
 }
+
+// Close closes all the pipes.
+func (p *execOutputPipes) Close() {
+	for _, f := range p.readers {
+		f.Close()
+	}
+	for _, f := range p.writers {
+		f.Close()
+	}
+}
+
+// execLocale returns the locale a command with the environment env runs
+// with, which is the first of LC_ALL, LC_CTYPE and LANG that is set, or
+// "C" if none is. Later entries of env override earlier ones.
+func execLocale(env []string) string {
+	vars := map[string]string{}
+	for _, kv := range env {
+		if idx := strings.IndexByte(kv, '='); idx > 0 {
+			vars[kv[:idx]] = kv[idx+1:]
+		}
+	}
+
+	for _, k := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := vars[k]; v != "" {
+			return v
+		}
+	}
+
+	return "C"
+}
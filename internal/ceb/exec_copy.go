@@ -0,0 +1,234 @@
+package ceb
+
+import (
+	"bufio"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/pkg/tarcopy"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execCopyProgressInterval is the minimum time between progress events
+// of a file copy session.
+const execCopyProgressInterval = 250 * time.Millisecond
+
+// execCopyTo runs a file copy session. The input from the client is a tar
+// archive that we extract to the destination, sending progress as we go
+// and the result once the archive ends.
+func (ceb *CEB) execCopyTo(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	execConfig *pb.EntrypointConfig_Exec,
+) {
+	dest := execConfig.CopyTo.Path
+	log = log.With("dest", dest)
+	if execConfig.TargetContainer {
+		if !filepath.IsAbs(dest) {
+			ceb.execError(log, client, status.Errorf(codes.InvalidArgument,
+				"the destination must be an absolute path to copy to the application container"))
+			return
+		}
+
+		root, err := ceb.execTargetRoot()
+		if err != nil {
+			ceb.execError(log, client, err)
+			return
+		}
+
+		dest = filepath.Join(root, dest)
+	}
+
+	// When resuming, tell the client what we already have so that it
+	// only sends the rest.
+	if name := execConfig.CopyTo.ResumeName; name != "" {
+		partial, err := tarcopy.PartialFile(dest, name)
+		if err != nil {
+			ceb.execError(log, client, status.Errorf(codes.FailedPrecondition,
+				"error resuming copy: %s", err))
+			return
+		}
+
+		log.Info("resuming copy", "name", name, "size", partial.Size)
+		if err := client.Send(&pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_CopyPartial{
+				CopyPartial: &pb.ExecStreamResponse_CopyPartial{
+					Size:           partial.Size,
+					ChunkChecksums: partial.Chunks,
+				},
+			},
+		}); err != nil {
+			log.Warn("error sending partial copy", "err", err)
+			return
+		}
+	}
+
+	// Feed the input to the extraction. The archive marks its own end so
+	// the stream closing first means we didn't get all of it.
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		for {
+			resp, err := client.Recv()
+			if err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+
+				pw.CloseWithError(err)
+				return
+			}
+
+			if v, ok := resp.Event.(*pb.EntrypointExecResponse_Input); ok {
+				if _, err := pw.Write(v.Input); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	var last time.Time
+	progress := func(files, bytes int64) {
+		if time.Since(last) < execCopyProgressInterval {
+			return
+		}
+		last = time.Now()
+
+		if err := client.Send(&pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_CopyProgress{
+				CopyProgress: &pb.ExecStreamResponse_CopyProgress{
+					Files: files,
+					Bytes: bytes,
+				},
+			},
+		}); err != nil {
+			log.Warn("error sending copy progress", "err", err)
+		}
+	}
+
+	log.Info("extracting files")
+	result, err := tarcopy.Extract(pr, dest, &tarcopy.Options{Progress: progress})
+	if err != nil {
+		ceb.execError(log, client, status.Errorf(codes.Aborted,
+			"error reading files to copy: %s", err))
+		return
+	}
+
+	ceb.execCopyResult(log, client, result)
+}
+
+// execCopyFrom runs a file copy session that sends the files matching the
+// configured path to the client as a tar archive on stdout, followed by
+// the result.
+func (ceb *CEB) execCopyFrom(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	execConfig *pb.EntrypointConfig_Exec,
+) {
+	pattern := execConfig.CopyFrom.Path
+	log = log.With("path", pattern)
+
+	var root string
+	if execConfig.TargetContainer {
+		if !filepath.IsAbs(pattern) {
+			ceb.execError(log, client, status.Errorf(codes.InvalidArgument,
+				"the path must be absolute to copy from the application container"))
+			return
+		}
+
+		var err error
+		root, err = ceb.execTargetRoot()
+		if err != nil {
+			ceb.execError(log, client, err)
+			return
+		}
+	}
+
+	matches, err := filepath.Glob(filepath.Join(root, pattern))
+	if err != nil {
+		ceb.execError(log, client, status.Errorf(codes.InvalidArgument,
+			"invalid path pattern %q: %s", pattern, err))
+		return
+	}
+	if len(matches) == 0 {
+		ceb.execError(log, client, status.Errorf(codes.NotFound,
+			"no files match %q", pattern))
+		return
+	}
+
+	var opts tarcopy.Options
+	if resume := execConfig.CopyFrom.Resume; resume != nil {
+		if len(matches) != 1 {
+			ceb.execError(log, client, status.Errorf(codes.InvalidArgument,
+				"resuming a copy requires a single file, %d files match %q",
+				len(matches), pattern))
+			return
+		}
+
+		opts.Resume = &tarcopy.Partial{
+			Size:   resume.Size,
+			Chunks: resume.ChunkChecksums,
+		}
+	}
+
+	// The archive is buffered since tar writes headers in small pieces,
+	// and the output writer splits large writes across messages.
+	log.Info("sending files", "matches", len(matches))
+	w := bufio.NewWriterSize(
+		ceb.execOutputWriter(client, pb.EntrypointExecRequest_Output_STDOUT, 0), 32*1024)
+	result, err := tarcopy.Archive(w, matches, &opts)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		ceb.execError(log, client, status.Errorf(codes.Aborted,
+			"error sending files: %s", err))
+		return
+	}
+
+	ceb.execCopyResult(log, client, result)
+}
+
+// execCopyResult sends the result of a file copy session followed by the
+// exit. The exit code is 1 if any entry couldn't be copied.
+func (ceb *CEB) execCopyResult(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	result *tarcopy.Result,
+) {
+	copyResult := &pb.ExecStreamResponse_CopyResult{
+		Files:        result.Files,
+		Bytes:        result.Bytes,
+		Checksum:     result.Checksum,
+		ResumedBytes: result.Resumed,
+	}
+	for _, fileErr := range result.Errors {
+		copyResult.Errors = append(copyResult.Errors, &pb.ExecStreamResponse_CopyResult_FileError{
+			Path:    fileErr.Path,
+			Message: fileErr.Err.Error(),
+		})
+	}
+
+	code := int32(0)
+	if len(copyResult.Errors) > 0 {
+		code = 1
+	}
+
+	log.Info("copy complete", "files", result.Files, "errors", len(result.Errors))
+	for _, req := range []*pb.EntrypointExecRequest{
+		{Event: &pb.EntrypointExecRequest_CopyResult{CopyResult: copyResult}},
+		{Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{Code: code},
+		}},
+	} {
+		if err := client.Send(req); err != nil {
+			log.Warn("error sending copy result", "err", err)
+			return
+		}
+	}
+}
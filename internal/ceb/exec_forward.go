@@ -0,0 +1,275 @@
+package ceb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/pkg/tunnel"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execForwardDialTimeout is how long we wait to connect to the forwarded
+// port for each connection.
+const execForwardDialTimeout = 10 * time.Second
+
+// execPortForward runs a port forwarding session. For each connection the
+// client opens we connect to the port on localhost, trying both the IPv4
+// and IPv6 loopback addresses, or on the target address the client gave.
+// The data of all
+// the connections is carried as tunnel events until the stream closes.
+// When reversed, we listen on the port instead and open a connection to
+// the client for each connection we accept. When dynamic, each connection
+// goes to the address the client asks for, which the server has already
+// checked against its policy. For UDP, we relay the client's datagrams to
+// the port from a socket per peer instead.
+func (ceb *CEB) execPortForward(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	execConfig *pb.EntrypointConfig_Exec,
+) {
+	fwd := execConfig.PortForward
+	port := strconv.Itoa(int(fwd.Port))
+	addr := net.JoinHostPort("127.0.0.1", port)
+	log = log.With("port", fwd.Port, "target_addr", fwd.TargetAddr, "family", fwd.Family.String(),
+		"reverse", fwd.Reverse, "dynamic", fwd.Dynamic, "udp", fwd.Udp)
+
+	dialer := &execForwardDialer{Family: fwd.Family, Timeout: execForwardDialTimeout}
+
+	send := func(f *tunnel.Frame) error {
+		return client.Send(&pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_Tunnel{
+				Tunnel: tunnelFramePB(f),
+			},
+		})
+	}
+
+	mux := &tunnel.Mux{Logger: log, Send: send}
+	defer mux.Close()
+
+	handle := mux.Handle
+	switch {
+	case fwd.Udp:
+		packets := &tunnel.PacketMux{
+			Logger: log,
+			Send:   send,
+			Dial: func() (net.Conn, error) {
+				return dialer.Dial("udp", fwd.TargetAddr, port)
+			},
+		}
+		defer packets.Close()
+		handle = packets.Handle
+
+	case fwd.Reverse:
+		ln, err := net.Listen("tcp", addr)
+		if err != nil {
+			ceb.execError(log, client, status.Errorf(codes.FailedPrecondition,
+				"can't listen on port %d on the instance: %s", fwd.Port, err))
+			return
+		}
+
+		// Closing the listener when the client goes away ends accepting.
+		defer ln.Close()
+		go execForwardAccept(log, ln, mux)
+
+	case fwd.Dynamic:
+		mux.Dial = func(target string) (net.Conn, error) {
+			if target == "" {
+				return nil, errors.New("no address to connect to")
+			}
+
+			host, port, err := net.SplitHostPort(target)
+			if err != nil {
+				return nil, err
+			}
+
+			return dialer.Dial("tcp", host, port)
+		}
+
+	default:
+		mux.Dial = func(string) (net.Conn, error) {
+			return dialer.Dial("tcp", fwd.TargetAddr, port)
+		}
+	}
+
+	log.Info("forwarding connections")
+	for {
+		resp, err := client.Recv()
+		if err != nil {
+			if err != io.EOF {
+				log.Warn("error receiving from exec stream", "err", err)
+			}
+
+			log.Info("port forwarding ended")
+			return
+		}
+
+		if v, ok := resp.Event.(*pb.EntrypointExecResponse_Tunnel); ok {
+			handle(tunnelFrame(v.Tunnel))
+		}
+	}
+}
+
+// execForwardDialer connects the connections of a port forwarding session.
+// A host may have addresses of both families, and a service may only
+// listen on one of them, so each address is tried in turn until one
+// connects, those of the preferred family first.
+type execForwardDialer struct {
+	Family  pb.ExecStreamRequest_PortForward_Family
+	Timeout time.Duration
+
+	// Lookup, if set, resolves names instead of the default resolver.
+	Lookup func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// Dial connects to port on host. If host is empty, this is the loopback
+// address. If no address connects, the error is a *tunnel.DialError with
+// the error of each address tried.
+func (d *execForwardDialer) Dial(network, host, port string) (net.Conn, error) {
+	var dialErr tunnel.DialError
+	ips, err := d.addrs(host)
+	if err != nil {
+		dialErr.Add(net.JoinHostPort(host, port), err)
+		return nil, &dialErr
+	}
+
+	for _, ip := range ips {
+		addr := net.JoinHostPort(ip.String(), port)
+		conn, err := net.DialTimeout(network, addr, d.Timeout)
+		if err == nil {
+			return conn, nil
+		}
+
+		dialErr.Add(addr, err)
+	}
+
+	return nil, &dialErr
+}
+
+// addrs returns the addresses to try for host in order.
+func (d *execForwardDialer) addrs(host string) ([]net.IP, error) {
+	var ips []net.IP
+	switch {
+	case host == "":
+		ips = []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+
+	case net.ParseIP(host) != nil:
+		return []net.IP{net.ParseIP(host)}, nil
+
+	default:
+		lookup := d.Lookup
+		if lookup == nil {
+			lookup = net.DefaultResolver.LookupIPAddr
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), d.Timeout)
+		defer cancel()
+		addrs, err := lookup(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+
+	// IPv4 goes first unless IPv6 is preferred. Within each family we keep
+	// the resolver's order.
+	preferV6 := d.Family == pb.ExecStreamRequest_PortForward_IPV6
+	sort.SliceStable(ips, func(i, j int) bool {
+		iV6, jV6 := ips[i].To4() == nil, ips[j].To4() == nil
+		return iV6 != jV6 && iV6 == preferV6
+	})
+
+	return ips, nil
+}
+
+// execForwardAccept adds the connections accepted by ln to the tunnel
+// until ln or the tunnel is closed.
+func execForwardAccept(log hclog.Logger, ln net.Listener, mux *tunnel.Mux) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+
+		if err := mux.Add(conn, "", nil); err != nil {
+			log.Debug("error forwarding connection", "err", err)
+			return
+		}
+	}
+}
+
+// tunnelFrame converts a frame received on the exec stream.
+func tunnelFrame(v *pb.ExecStreamRequest_TunnelFrame) *tunnel.Frame {
+	return &tunnel.Frame{
+		ID:    v.ConnectionId,
+		Open:  v.Open,
+		Addr:  v.Address,
+		Data:  v.Data,
+		Close: v.Close,
+		Error: v.Error,
+		Peer:  v.Peer,
+
+		DialError: tunnelDialError(v.DialError),
+	}
+}
+
+// tunnelFramePB converts a frame to send on the exec stream.
+func tunnelFramePB(f *tunnel.Frame) *pb.ExecStreamRequest_TunnelFrame {
+	return &pb.ExecStreamRequest_TunnelFrame{
+		ConnectionId: f.ID,
+		Open:         f.Open,
+		Address:      f.Addr,
+		Data:         f.Data,
+		Close:        f.Close,
+		Error:        f.Error,
+		Peer:         f.Peer,
+		DialError:    tunnelDialErrorPB(f.DialError),
+	}
+}
+
+// tunnelDialError converts the dial error of a frame received on the exec
+// stream.
+func tunnelDialError(v *pb.ExecStreamRequest_DialError) *tunnel.DialError {
+	if v == nil {
+		return nil
+	}
+
+	result := &tunnel.DialError{}
+	for _, a := range v.Attempts {
+		result.Attempts = append(result.Attempts, tunnel.DialAttempt{
+			Addr: a.Address,
+			Err:  a.Error,
+		})
+	}
+
+	return result
+}
+
+// tunnelDialErrorPB converts the dial error of a frame to send on the exec
+// stream.
+func tunnelDialErrorPB(e *tunnel.DialError) *pb.ExecStreamRequest_DialError {
+	if e == nil {
+		return nil
+	}
+
+	result := &pb.ExecStreamRequest_DialError{}
+	for _, a := range e.Attempts {
+		result.Attempts = append(result.Attempts, &pb.ExecStreamRequest_DialError_Attempt{
+			Address: a.Addr,
+			Error:   a.Err,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,335 @@
+package ceb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execKube runs exec sessions in the other containers of our Kubernetes
+// pod through the exec subresource of the Kubernetes API, like kubectl
+// exec. This is how we reach containers whose namespaces we can't join.
+// The pod's service account must be allowed to get pods and to create
+// pods/exec in its namespace.
+type execKube struct {
+	Namespace string
+	Pod       string
+
+	// clientset and config are created from the in-cluster config when
+	// first needed. Tests may set clientset up front.
+	once      sync.Once
+	clientset kubernetes.Interface
+	config    *rest.Config
+	err       error
+}
+
+// client returns the Kubernetes client to use.
+func (k *execKube) client() (kubernetes.Interface, *rest.Config, error) {
+	k.once.Do(func() {
+		if k.clientset != nil {
+			return
+		}
+
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			k.err = status.Errorf(codes.FailedPrecondition,
+				"can't connect to the Kubernetes API to exec in another container: %s", err)
+			return
+		}
+
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			k.err = status.Errorf(codes.FailedPrecondition,
+				"can't connect to the Kubernetes API to exec in another container: %s", err)
+			return
+		}
+
+		k.clientset = clientset
+		k.config = config
+	})
+
+	return k.clientset, k.config, k.err
+}
+
+// Containers returns the names of the containers in our pod.
+func (k *execKube) Containers(ctx context.Context) ([]string, error) {
+	clientset, _, err := k.client()
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := clientset.CoreV1().Pods(k.Namespace).Get(ctx, k.Pod, metav1.GetOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"can't get pod %s/%s to exec in another container: %s", k.Namespace, k.Pod, err)
+	}
+
+	var result []string
+	for _, c := range pod.Spec.Containers {
+		result = append(result, c.Name)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// execKubeSession runs the command of an exec session in another container
+// of our pod. Input, window size changes, output and the exit are relayed
+// as they are for a local command so clients can't tell the difference,
+// except that signals, stats and limits aren't supported and a command is
+// only stopped by closing its stdin when the client goes away.
+func (ceb *CEB) execKubeSession(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	execConfig *pb.EntrypointConfig_Exec,
+) {
+	container := execConfig.Container
+	log = log.With("container", container)
+
+	k := ceb.execKube
+	if k == nil {
+		ceb.execError(log, client, status.Errorf(codes.FailedPrecondition,
+			"this instance can't exec in container %q, only in the container "+
+				"the entrypoint runs in", container))
+		return
+	}
+
+	if len(execConfig.Script) > 0 {
+		ceb.execError(log, client, status.Errorf(codes.InvalidArgument,
+			"scripts can't be run in container %q", container))
+		return
+	}
+
+	// Check the container exists first so that we can list the ones
+	// that do if it doesn't.
+	containers, err := k.Containers(ceb.context)
+	if err != nil {
+		ceb.execError(log, client, err)
+		return
+	}
+	found := false
+	for _, name := range containers {
+		found = found || name == container
+	}
+	if !found {
+		ceb.execError(log, client, status.Errorf(codes.NotFound,
+			"container %q not found, containers in this pod: %s",
+			container, strings.Join(containers, ", ")))
+		return
+	}
+
+	var name string
+	if len(execConfig.Args) > 0 {
+		name = execConfig.Args[0]
+	}
+
+	clientset, config, err := k.client()
+	if err != nil {
+		ceb.execError(log, client, err)
+		return
+	}
+
+	// The Kubernetes API can't set the environment of the command, so
+	// execConfig.Env isn't available to it.
+	tty := execConfig.Pty != nil && execConfig.Pty.Enable
+	stdin := !execConfig.NoStdin
+	req := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(k.Namespace).
+		Name(k.Pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   execConfig.Args,
+			Stdin:     stdin,
+			Stdout:    true,
+			Stderr:    !tty,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		ceb.execStartFailed(log, client, name, err)
+		return
+	}
+
+	// This context is cancelled when this exec session ends so that
+	// our helper goroutines exit.
+	ctx, cancel := context.WithCancel(ceb.context)
+	defer cancel()
+
+	stdinR, stdinW := io.Pipe()
+	defer stdinW.Close()
+
+	// The Kubernetes API reads the output, so only the messages we send
+	// follow the chunk size.
+	chunk := execOutputChunkSize(execConfig.OutputChunkSize)
+	opts := remotecommand.StreamOptions{
+		Stdout: ceb.execOutputWriter(client, pb.EntrypointExecRequest_Output_STDOUT, chunk),
+		Tty:    tty,
+	}
+	if stdin {
+		opts.Stdin = stdinR
+	}
+
+	var sizeQueue *execKubeSizeQueue
+	if tty {
+		sizeQueue = &execKubeSizeQueue{
+			ch:     make(chan *remotecommand.TerminalSize, 1),
+			doneCh: ctx.Done(),
+		}
+		if sz := execConfig.Pty.WindowSize; sz != nil {
+			sizeQueue.Push(sz)
+		}
+
+		opts.TerminalSizeQueue = sizeQueue
+	} else {
+		opts.Stderr = ceb.execOutputWriter(client, pb.EntrypointExecRequest_Output_STDERR, chunk)
+	}
+
+	log.Info("running exec command through the Kubernetes API", "pod", k.Pod)
+	cmdExitCh := make(chan error, 1)
+	go func() {
+		cmdExitCh <- executor.Stream(opts)
+	}()
+
+	// Start our receive data loop. The streamCloseCh is closed when we
+	// can no longer receive from the stream, which means the client is gone.
+	respCh := make(chan *pb.EntrypointExecResponse)
+	streamCloseCh := make(chan struct{})
+	go func() {
+		defer close(streamCloseCh)
+		for {
+			resp, err := client.Recv()
+			if err != nil {
+				if err != io.EOF {
+					log.Warn("error receiving from exec stream", "err", err)
+				}
+
+				return
+			}
+
+			select {
+			case respCh <- resp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-streamCloseCh:
+			// The client went away. We can't signal the command through
+			// the API, so we close its stdin which ends most interactive
+			// commands, and continue looping to report the exit if we can.
+			streamCloseCh = nil
+			log.Info("exec stream closed, closing stdin of command")
+			stdinW.Close()
+
+		case resp := <-respCh:
+			switch event := resp.Event.(type) {
+			case *pb.EntrypointExecResponse_Input:
+				if stdin {
+					stdinW.Write(event.Input)
+				}
+
+			case *pb.EntrypointExecResponse_InputEof:
+				if stdin {
+					stdinW.Close()
+				}
+
+			case *pb.EntrypointExecResponse_Signal:
+				ceb.execWarning(log, client, fmt.Sprintf(
+					"signals can't be sent to commands in container %q, ignoring %s",
+					container, event.Signal.Name))
+
+			case *pb.EntrypointExecResponse_Winch:
+				if sizeQueue != nil {
+					sizeQueue.Push(event.Winch)
+				}
+
+			case *pb.EntrypointExecResponse_Ping:
+				ceb.execPong(log, client, event.Ping)
+			}
+
+		case err := <-cmdExitCh:
+			exit := &pb.EntrypointExecRequest_Exit{}
+			if err != nil {
+				exitErr, ok := err.(utilexec.ExitError)
+				if !ok || !exitErr.Exited() {
+					ceb.execStartFailed(log, client, name, err)
+					return
+				}
+
+				exit.Code = int32(exitErr.ExitStatus())
+			}
+
+			log.Info("exec stream exited", "code", exit.Code)
+			if err := client.Send(&pb.EntrypointExecRequest{
+				Event: &pb.EntrypointExecRequest_Exit_{
+					Exit: exit,
+				},
+			}); err != nil {
+				log.Warn("error sending exit message", "err", err)
+			}
+
+			return
+		}
+	}
+}
+
+// execKubeSizeQueue passes window size changes to the Kubernetes API.
+// Only the latest size is kept if they aren't picked up in time.
+type execKubeSizeQueue struct {
+	ch     chan *remotecommand.TerminalSize
+	doneCh <-chan struct{}
+}
+
+// Next implements remotecommand.TerminalSizeQueue. This returns nil once
+// the session ends.
+func (q *execKubeSizeQueue) Next() *remotecommand.TerminalSize {
+	select {
+	case sz := <-q.ch:
+		return sz
+	case <-q.doneCh:
+		return nil
+	}
+}
+
+// Push queues a window size change, replacing any that is queued.
+func (q *execKubeSizeQueue) Push(sz *pb.ExecStreamRequest_WindowSize) {
+	v := &remotecommand.TerminalSize{
+		Width:  uint16(sz.Cols),
+		Height: uint16(sz.Rows),
+	}
+
+	for {
+		select {
+		case q.ch <- v:
+			return
+		default:
+		}
+
+		select {
+		case <-q.ch:
+		default:
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// +build !linux
+
+package ceb
+
+import (
+	"os/exec"
+
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execLimiter applies resource limits to an exec'd command. Limits are
+// only supported on Linux so this reports every limit as unsupported.
+type execLimiter struct{}
+
+func newExecLimiter(
+	log hclog.Logger,
+	index int64,
+	limits *pb.ExecStreamRequest_Limits,
+) (*execLimiter, []string) {
+	return nil, execLimitNames(limits)
+}
+
+func (l *execLimiter) Wrap(cmd *exec.Cmd) {}
+
+func (l *execLimiter) Close() {}
+
+// IsExecLimitsWrapper always returns false since the limits wrapper is only
+// used on Linux.
+func IsExecLimitsWrapper() bool { return false }
+
+// ExecLimitsWrapper is never run since IsExecLimitsWrapper returns false.
+func ExecLimitsWrapper() int { return 1 }
@@ -0,0 +1,237 @@
+package ceb
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/hashicorp/go-hclog"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// cgroupRoot is where the cgroup v2 unified hierarchy is mounted.
+const cgroupRoot = "/sys/fs/cgroup"
+
+// execLimitsWrapperName is the name the entrypoint starts itself with to
+// apply limits to an exec'd command before it runs. Niceness, memory and
+// the cgroup are inherited, so applying them once the command started
+// would let anything it forks or allocates first escape them.
+const execLimitsWrapperName = "waypoint-exec-limits"
+
+// execLimiter applies resource limits to an exec'd command. Niceness and
+// memory are applied with setpriority and setrlimit. CPU limits (and
+// additionally memory) require a transient cgroup v2 group which is only
+// available if the entrypoint can create one.
+type execLimiter struct {
+	log    hclog.Logger
+	limits *pb.ExecStreamRequest_Limits
+	cgroup string
+}
+
+// newExecLimiter prepares to apply the given limits. This returns the
+// names of any requested limits that can't be applied on this instance.
+// The returned limiter may be nil if no limits were requested.
+func newExecLimiter(
+	log hclog.Logger,
+	index int64,
+	limits *pb.ExecStreamRequest_Limits,
+) (*execLimiter, []string) {
+	if limits == nil {
+		return nil, nil
+	}
+
+	l := &execLimiter{log: log, limits: limits}
+	var cpuWeight, cpuMax bool
+	if limits.CpuShares > 0 || limits.CpuQuotaPercent > 0 || limits.MemoryBytes > 0 {
+		path, err := execCgroupCreate(index)
+		if err != nil {
+			log.Info("unable to create transient cgroup for exec", "err", err)
+		} else {
+			l.cgroup = path
+			cpuWeight = l.set("cpu.weight", limits.CpuShares > 0,
+				strconv.FormatInt(cpuSharesToWeight(limits.CpuShares), 10))
+
+			// The period is 100ms so the quota is 1ms per percent of a CPU.
+			cpuMax = l.set("cpu.max", limits.CpuQuotaPercent > 0,
+				fmt.Sprintf("%d 100000", int64(limits.CpuQuotaPercent)*1000))
+
+			// Memory is limited with setrlimit regardless, so this only
+			// matters for the processes that escape it.
+			l.set("memory.max", limits.MemoryBytes > 0,
+				strconv.FormatInt(limits.MemoryBytes, 10))
+		}
+	}
+
+	var unsupported []string
+	if limits.CpuShares > 0 && !cpuWeight {
+		unsupported = append(unsupported, "cpu_shares")
+	}
+	if limits.CpuQuotaPercent > 0 && !cpuMax {
+		unsupported = append(unsupported, "cpu_quota_percent")
+	}
+
+	return l, unsupported
+}
+
+// Wrap changes cmd, which isn't started yet, to start through the limits
+// wrapper so that the limits apply from its first instruction. See
+// ExecLimitsWrapper.
+func (l *execLimiter) Wrap(cmd *exec.Cmd) {
+	if l == nil || (l.limits.Nice == 0 && l.limits.MemoryBytes <= 0 && l.cgroup == "") {
+		return
+	}
+
+	args := []string{
+		execLimitsWrapperName,
+		strconv.Itoa(int(l.limits.Nice)),
+		strconv.FormatInt(l.limits.MemoryBytes, 10),
+		l.cgroup,
+		cmd.Path,
+	}
+	cmd.Args = append(args, cmd.Args...)
+	cmd.Path = "/proc/self/exe"
+}
+
+// Close removes the transient cgroup, if any. This should be called
+// after the process has exited.
+func (l *execLimiter) Close() {
+	if l == nil || l.cgroup == "" {
+		return
+	}
+
+	if err := os.Remove(l.cgroup); err != nil {
+		l.log.Warn("error removing transient cgroup", "path", l.cgroup, "err", err)
+	}
+}
+
+// set writes value to the control file name of our cgroup if ok is true.
+// This returns whether the control was set, logging why if it wasn't.
+func (l *execLimiter) set(name string, ok bool, value string) bool {
+	if !ok || !fileExists(filepath.Join(l.cgroup, name)) {
+		return false
+	}
+
+	path := filepath.Join(l.cgroup, name)
+	if err := ioutil.WriteFile(path, []byte(value), 0644); err != nil {
+		l.log.Warn("failed to set cgroup control", "name", name, "err", err)
+		return false
+	}
+
+	return true
+}
+
+// IsExecLimitsWrapper returns true if the entrypoint started this process
+// to apply limits to an exec'd command, in which case ExecLimitsWrapper
+// must run in place of the entrypoint.
+func IsExecLimitsWrapper() bool {
+	return len(os.Args) > 0 && os.Args[0] == execLimitsWrapperName
+}
+
+// ExecLimitsWrapper applies the limits that the process was started with
+// to itself and then execs the command, which inherits them. A limit that
+// fails to apply is reported on stderr and the command runs regardless.
+// This only returns, with the exit code to use, if the command can't be
+// executed.
+func ExecLimitsWrapper() int {
+	args := os.Args[1:]
+	if len(args) < 5 {
+		fmt.Fprintf(os.Stderr, "%s: not enough arguments\n", execLimitsWrapperName)
+		return 126
+	}
+	nice, memory, cgroup, path, argv := args[0], args[1], args[2], args[3], args[4:]
+
+	// Niceness is per thread on Linux and the command keeps that of the
+	// thread which execs it, so we have to stay on the same thread.
+	runtime.LockOSThread()
+
+	if v, _ := strconv.Atoi(nice); v != 0 {
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, 0, v); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: failed to set nice value: %s\n",
+				execLimitsWrapperName, err)
+		}
+	}
+
+	if v, _ := strconv.ParseInt(memory, 10, 64); v > 0 {
+		rlim := syscall.Rlimit{Cur: uint64(v), Max: uint64(v)}
+		if err := syscall.Setrlimit(syscall.RLIMIT_AS, &rlim); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: failed to set memory limit: %s\n",
+				execLimitsWrapperName, err)
+		}
+	}
+
+	if cgroup != "" {
+		// Writing 0 moves the writing process.
+		path := filepath.Join(cgroup, "cgroup.procs")
+		if err := ioutil.WriteFile(path, []byte("0"), 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: failed to join cgroup: %s\n",
+				execLimitsWrapperName, err)
+		}
+	}
+
+	err := syscall.Exec(path, argv, os.Environ())
+	fmt.Fprintf(os.Stderr, "%s: failed to execute %s: %s\n",
+		execLimitsWrapperName, path, err)
+	return 126
+}
+
+// execCgroupCreate creates a transient cgroup for the exec session with
+// the given index beneath the cgroup of the entrypoint.
+func execCgroupCreate(index int64) (string, error) {
+	// We only support the unified (v2) hierarchy.
+	if !fileExists(filepath.Join(cgroupRoot, "cgroup.controllers")) {
+		return "", fmt.Errorf("cgroup v2 is not available")
+	}
+
+	f, err := os.Open("/proc/self/cgroup")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var current string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "0::") {
+			current = strings.TrimPrefix(line, "0::")
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if current == "" {
+		return "", fmt.Errorf("unable to determine current cgroup")
+	}
+
+	path := filepath.Join(cgroupRoot, current, fmt.Sprintf("waypoint-exec-%d", index))
+	if err := os.Mkdir(path, 0755); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// cpuSharesToWeight converts Docker style CPU shares in the range
+// [2, 262144] to a cgroup v2 CPU weight in the range [1, 10000].
+func cpuSharesToWeight(shares int64) int64 {
+	if shares < 2 {
+		shares = 2
+	} else if shares > 262144 {
+		shares = 262144
+	}
+
+	return 1 + ((shares-2)*9999)/262142
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
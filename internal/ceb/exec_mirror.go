@@ -0,0 +1,176 @@
+package ceb
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execLogMirrorMaxLine is the longest line of exec output mirrored to the
+// logs. Longer lines are split so that output without newlines isn't held
+// without bound.
+const execLogMirrorMaxLine = 16 * 1024
+
+// execLogRate limits the rate of the lines of exec output mirrored to the
+// logs, for all sessions together, so that a session flooding its output
+// can't crowd the app's own lines out of the log buffer. This is a token
+// bucket holding up to a second of lines.
+type execLogRate struct {
+	lock   sync.Mutex
+	rate   int
+	tokens float64
+	last   time.Time
+}
+
+// allow takes a line from the budget, returning false if there is none.
+func (r *execLogRate) allow(now time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.last.IsZero() {
+		r.tokens = float64(r.rate)
+	} else {
+		r.tokens += now.Sub(r.last).Seconds() * float64(r.rate)
+		if max := float64(r.rate); r.tokens > max {
+			r.tokens = max
+		}
+	}
+	r.last = now
+
+	if r.tokens < 1 {
+		return false
+	}
+
+	r.tokens--
+	return true
+}
+
+// execLogMirror is a writer that pushes the lines of the output of an exec
+// session written to it to the log buffer, tagged with the session, so
+// that they appear in the logs of the app. Lines are scrubbed since the
+// logs are kept. Lines over the rate limit are dropped and counted, and a
+// line saying how many precedes the next line mirrored.
+type execLogMirror struct {
+	buf       *logBuffer
+	rate      *execLogRate
+	scrubber  *scrub.Scrubber
+	sessionId string
+	source    pb.LogBatch_Entry_Source
+
+	lock    sync.Mutex
+	partial []byte
+	dropped int
+	closed  bool
+}
+
+// execLogMirror returns the writer mirroring the output of session
+// sessionId written to the stream source to the logs. Close must be called
+// once the session ends.
+func (ceb *CEB) execLogMirror(
+	sessionId string,
+	source pb.LogBatch_Entry_Source,
+	scrubber *scrub.Scrubber,
+) *execLogMirror {
+	return &execLogMirror{
+		buf:       ceb.logLineBuffer(),
+		rate:      &ceb.execLogRate,
+		scrubber:  scrubber,
+		sessionId: sessionId,
+		source:    source,
+	}
+}
+
+// Write mirrors the complete lines of p, holding on to what follows the
+// last newline until the rest of its line is written. This never fails
+// since mirroring must not affect the session.
+func (m *execLogMirror) Write(p []byte) (int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.closed {
+		return len(p), nil
+	}
+
+	m.partial = append(m.partial, p...)
+	for {
+		i := bytes.IndexByte(m.partial, '\n')
+		if i < 0 {
+			if len(m.partial) < execLogMirrorMaxLine {
+				break
+			}
+
+			i = execLogMirrorMaxLine - 1
+		}
+
+		m.push(m.partial[:i+1])
+		m.partial = m.partial[i+1:]
+	}
+
+	// We don't keep the memory of a long write around.
+	if len(m.partial) == 0 {
+		m.partial = nil
+	}
+
+	return len(p), nil
+}
+
+// Close mirrors what is left of the last line and reports the lines that
+// were dropped since the last line mirrored.
+func (m *execLogMirror) Close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+
+	if len(m.partial) > 0 {
+		m.push(append(m.partial, '\n'))
+		m.partial = nil
+	}
+
+	if m.dropped > 0 {
+		m.pushDropped()
+	}
+
+	return nil
+}
+
+// push mirrors a line, which ends with a newline, if the rate allows.
+func (m *execLogMirror) push(line []byte) {
+	if !m.rate.allow(time.Now()) {
+		m.dropped++
+		return
+	}
+
+	if m.dropped > 0 {
+		m.pushDropped()
+	}
+
+	// A PTY ends lines with CRLF, which would show as garbage in the logs.
+	line = bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r"))
+	m.buf.Push(&pb.LogBatch_Entry{
+		Timestamp:     ptypes.TimestampNow(),
+		Line:          m.scrubber.String(string(line)) + "\n",
+		Source:        m.source,
+		ExecSessionId: m.sessionId,
+	})
+}
+
+// pushDropped mirrors a line saying how many lines were dropped, so that
+// the gap is visible in the logs, and resets the count.
+func (m *execLogMirror) pushDropped() {
+	m.buf.Push(&pb.LogBatch_Entry{
+		Timestamp: ptypes.TimestampNow(),
+		Line: fmt.Sprintf("[waypoint: %d lines of output not mirrored, "+
+			"over the rate limit]\n", m.dropped),
+		Source:        m.source,
+		ExecSessionId: m.sessionId,
+	})
+	m.dropped = 0
+}
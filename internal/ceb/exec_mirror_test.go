@@ -0,0 +1,104 @@
+package ceb
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestExecLogMirror(t *testing.T) {
+	newMirror := func(rate int) (*execLogMirror, *logBuffer) {
+		buf := newLogBuffer(100)
+		return &execLogMirror{
+			buf:       buf,
+			rate:      &execLogRate{rate: rate},
+			scrubber:  scrub.Default(),
+			sessionId: "s1",
+			source:    pb.LogBatch_Entry_STDOUT,
+		}, buf
+	}
+
+	t.Run("lines", func(t *testing.T) {
+		require := require.New(t)
+
+		m, buf := newMirror(100)
+		m.Write([]byte("hello\r\nwor"))
+		m.Write([]byte("ld\npart"))
+		require.Equal(2, buf.Len())
+		require.NoError(m.Close())
+
+		// Writes after the session ended are ignored.
+		m.Write([]byte("late\n"))
+
+		entries, _ := buf.Take(0, 0)
+		require.Equal([]string{"hello\n", "world\n", "part\n"}, testLines(entries))
+		for _, entry := range entries {
+			require.Equal("s1", entry.ExecSessionId)
+			require.Equal(pb.LogBatch_Entry_STDOUT, entry.Source)
+		}
+	})
+
+	t.Run("scrubbed", func(t *testing.T) {
+		require := require.New(t)
+
+		m, buf := newMirror(100)
+		m.scrubber = scrub.New([]*regexp.Regexp{regexp.MustCompile(`token=(\S+)`)}, nil)
+		m.Write([]byte("login token=abc123\n"))
+
+		entries, _ := buf.Take(0, 0)
+		require.Equal([]string{"login token=" + scrub.Redacted + "\n"}, testLines(entries))
+	})
+
+	t.Run("long line", func(t *testing.T) {
+		require := require.New(t)
+
+		m, buf := newMirror(100)
+		m.Write([]byte(strings.Repeat("x", execLogMirrorMaxLine+10)))
+		require.Equal(1, buf.Len())
+		require.Len(m.partial, 10)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		require := require.New(t)
+
+		// The budget starts with a second of lines, and refills slower
+		// than this test runs.
+		m, buf := newMirror(2)
+		m.Write([]byte("a\nb\nc\nd\n"))
+		require.NoError(m.Close())
+
+		entries, _ := buf.Take(0, 0)
+		require.Equal([]string{
+			"a\n",
+			"b\n",
+			"[waypoint: 2 lines of output not mirrored, over the rate limit]\n",
+		}, testLines(entries))
+	})
+}
+
+func TestExecLogRate(t *testing.T) {
+	require := require.New(t)
+
+	r := &execLogRate{rate: 2}
+	now := time.Now()
+	require.True(r.allow(now))
+	require.True(r.allow(now))
+	require.False(r.allow(now))
+
+	// Half a second is a line.
+	now = now.Add(500 * time.Millisecond)
+	require.True(r.allow(now))
+	require.False(r.allow(now))
+
+	// Idle time doesn't add up past a second of lines.
+	now = now.Add(time.Hour)
+	require.True(r.allow(now))
+	require.True(r.allow(now))
+	require.False(r.allow(now))
+}
@@ -0,0 +1,103 @@
+package ceb
+
+import (
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// execReapGroup kills any processes remaining in the process group pgid
+// and reaps those that were reparented to us. This should be called after
+// the group leader has exited and been waited on. We only wait on members
+// of the group so this never steals the exit status of other children.
+//
+// Processes that moved to another process group or session (for example
+// by daemonizing) aren't killed. Once they exit, execReaper reaps them.
+func execReapGroup(log hclog.Logger, pgid int, timeout time.Duration) {
+	if err := syscall.Kill(-pgid, syscall.SIGKILL); err == syscall.ESRCH {
+		// Nothing left in the group
+		return
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		var ws syscall.WaitStatus
+		pid, _ := syscall.Wait4(-pgid, &ws, syscall.WNOHANG, nil)
+		if pid > 0 {
+			log.Debug("reaped orphaned process", "pid", pid)
+			continue
+		}
+
+		// We have nothing to reap right now. If the group is empty we're
+		// done, otherwise processes are still exiting or being reparented.
+		if err := syscall.Kill(-pgid, 0); err == syscall.ESRCH {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			log.Warn("processes remain in exec process group after kill", "pgid", pgid)
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// execReaper reaps the processes that are reparented to the entrypoint
+// once it is a child subreaper, such as daemonized descendants of exec'd
+// commands, those that sessions allowing orphans leave behind and orphans
+// of the app. The processes we start ourselves are waited on by their
+// exec.Cmd, so they are started through the reaper and skipped until
+// they are done so that we never steal their exit status. The zero value
+// is ready to use.
+type execReaper struct {
+	lock sync.Mutex
+	pids map[int]struct{}
+}
+
+// Start starts cmd and skips its process until Done is called. This holds
+// the lock while starting so that the process can't be reaped before it
+// is tracked.
+func (r *execReaper) Start(cmd *exec.Cmd) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	if r.pids == nil {
+		r.pids = map[int]struct{}{}
+	}
+	r.pids[cmd.Process.Pid] = struct{}{}
+	return nil
+}
+
+// Done stops skipping the process of cmd. This must be called once Wait
+// on cmd returned.
+func (r *execReaper) Done(cmd *exec.Cmd) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.pids, cmd.Process.Pid)
+}
+
+// reap reaps those of the exited children pids that we didn't start
+// ourselves.
+func (r *execReaper) reap(log hclog.Logger, pids []int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, pid := range pids {
+		if _, ok := r.pids[pid]; ok {
+			continue
+		}
+
+		var ws syscall.WaitStatus
+		if n, _ := syscall.Wait4(pid, &ws, syscall.WNOHANG, nil); n > 0 {
+			log.Debug("reaped orphaned process", "pid", pid)
+		}
+	}
+}
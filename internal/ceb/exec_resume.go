@@ -0,0 +1,248 @@
+package ceb
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execReplayBudget caps the memory used by the replay buffers of all exec
+// sessions together.
+type execReplayBudget struct {
+	lock sync.Mutex
+	max  int64
+	used int64
+}
+
+// reserve takes n bytes from the budget, returning false if they aren't
+// available. If force is true, the bytes are taken even past the maximum.
+func (b *execReplayBudget) reserve(n int64, force bool) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if !force && b.used+n > b.max {
+		return false
+	}
+
+	b.used += n
+	return true
+}
+
+// release returns n bytes to the budget.
+func (b *execReplayBudget) release(n int64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.used -= n
+}
+
+// execReplayBuffer holds the events an exec session sends while it has no
+// stream, so that they can be replayed once the session is resumed. It
+// holds up to size bytes of events, also taken from a budget shared by all
+// sessions. Once either is used up, the oldest events are dropped to make
+// room and counted. Exits are never dropped since the client can't do
+// without them, and they are small.
+//
+// This isn't safe for concurrent use. An execStream serializes its use.
+type execReplayBuffer struct {
+	size    int64
+	budget  *execReplayBudget
+	events  []*pb.EntrypointExecRequest
+	sizes   []int64
+	used    int64
+	dropped int64
+}
+
+// newExecReplayBuffer returns a buffer holding up to size bytes of events.
+func newExecReplayBuffer(size int64, budget *execReplayBudget) *execReplayBuffer {
+	return &execReplayBuffer{size: size, budget: budget}
+}
+
+// Add adds a copy of the event, dropping older events if there isn't room.
+// If there still isn't room, the event itself is dropped.
+func (b *execReplayBuffer) Add(req *pb.EntrypointExecRequest) {
+	n := int64(proto.Size(req))
+	keep := execReplayKeep(req)
+	for !(b.used+n <= b.size && b.budget.reserve(n, false)) {
+		if !b.dropOldest() {
+			if !keep {
+				b.dropped += n
+				return
+			}
+
+			b.budget.reserve(n, true)
+			break
+		}
+	}
+
+	b.events = append(b.events, proto.Clone(req).(*pb.EntrypointExecRequest))
+	b.sizes = append(b.sizes, n)
+	b.used += n
+}
+
+// Take removes and returns the events held and the number of bytes of
+// events that were dropped.
+func (b *execReplayBuffer) Take() ([]*pb.EntrypointExecRequest, int64) {
+	events, dropped := b.events, b.dropped
+	b.Release()
+	return events, dropped
+}
+
+// Release discards the events held and returns their memory to the budget.
+func (b *execReplayBuffer) Release() {
+	b.budget.release(b.used)
+	b.events = nil
+	b.sizes = nil
+	b.used = 0
+	b.dropped = 0
+}
+
+// dropOldest drops the oldest event that may be dropped, returning false
+// if there is none.
+func (b *execReplayBuffer) dropOldest() bool {
+	for i, req := range b.events {
+		if execReplayKeep(req) {
+			continue
+		}
+
+		n := b.sizes[i]
+		b.events = append(b.events[:i], b.events[i+1:]...)
+		b.sizes = append(b.sizes[:i], b.sizes[i+1:]...)
+		b.used -= n
+		b.dropped += n
+		b.budget.release(n)
+		return true
+	}
+
+	return false
+}
+
+// execReplayKeep returns true for the events a replay buffer never drops.
+func execReplayKeep(req *pb.EntrypointExecRequest) bool {
+	switch req.Event.(type) {
+	case *pb.EntrypointExecRequest_Exit_, *pb.EntrypointExecRequest_CommandExit_:
+		return true
+	}
+
+	return false
+}
+
+// execResumable is an exec session whose stream dropped, waiting to be
+// resumed with a new stream.
+type execResumable struct {
+	token string
+
+	// resumeCh receives the stream to resume with. It is buffered so that
+	// sending never blocks, and only sent to once since the session is
+	// removed from CEB.execResumable first.
+	resumeCh chan *execResumeStream
+}
+
+// execResumeStream is the stream of an exec that resumes a session. The
+// session closes doneCh once it is done with the stream.
+type execResumeStream struct {
+	stream pb.Waypoint_EntrypointExecStreamClient
+	doneCh chan struct{}
+}
+
+// newExecResumeToken returns a random token that a resume of a session must
+// present, so that other sessions can't take it over.
+func newExecResumeToken() (string, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf[:]), nil
+}
+
+// execResumeWait registers the session with the given ID as waiting to be
+// resumed and returns the channel that receives the stream to resume with.
+func (ceb *CEB) execResumeWait(sessionId, token string) <-chan *execResumeStream {
+	r := &execResumable{
+		token:    token,
+		resumeCh: make(chan *execResumeStream, 1),
+	}
+
+	ceb.execResumeLock.Lock()
+	defer ceb.execResumeLock.Unlock()
+	if ceb.execResumable == nil {
+		ceb.execResumable = map[string]*execResumable{}
+	}
+	ceb.execResumable[sessionId] = r
+	return r.resumeCh
+}
+
+// execResumeStop stops the session with the given ID from waiting to be
+// resumed. This returns the stream of a resume that arrived meanwhile, if
+// any, which the session must take or close.
+func (ceb *CEB) execResumeStop(sessionId string, resumeCh <-chan *execResumeStream) *execResumeStream {
+	ceb.execResumeLock.Lock()
+	if r, ok := ceb.execResumable[sessionId]; ok && r.resumeCh == resumeCh {
+		delete(ceb.execResumable, sessionId)
+	}
+	ceb.execResumeLock.Unlock()
+
+	select {
+	case r := <-resumeCh:
+		return r
+	default:
+		return nil
+	}
+}
+
+// execResume hands the stream of an exec resuming another session to that
+// session if it is waiting to be resumed and the token matches, and waits
+// until the session is done with the stream.
+func (ceb *CEB) execResume(
+	log hclog.Logger,
+	client pb.Waypoint_EntrypointExecStreamClient,
+	execConfig *pb.EntrypointConfig_Exec,
+) {
+	if err := client.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Open_{
+			Open: &pb.EntrypointExecRequest_Open{
+				InstanceId: ceb.id,
+				Index:      execConfig.Index,
+			},
+		},
+	}); err != nil {
+		log.Warn("error opening exec stream", "err", err)
+		return
+	}
+
+	resume := execConfig.Resume
+	doneCh := make(chan struct{})
+
+	// We send while holding the lock so that a session that stops waiting
+	// always sees the stream. See execResumeStop.
+	ceb.execResumeLock.Lock()
+	r, ok := ceb.execResumable[resume.SessionId]
+	ok = ok && subtle.ConstantTimeCompare([]byte(r.token), []byte(resume.Token)) == 1
+	if ok {
+		delete(ceb.execResumable, resume.SessionId)
+		r.resumeCh <- &execResumeStream{stream: client, doneCh: doneCh}
+	}
+	ceb.execResumeLock.Unlock()
+
+	if !ok {
+		log.Warn("rejecting resume of an exec session that isn't waiting for one",
+			"resume_session_id", resume.SessionId)
+		ceb.execError(log, client, status.Errorf(codes.NotFound,
+			"exec session %q can't be resumed, it ended or wasn't resumed in time",
+			resume.SessionId))
+		return
+	}
+
+	log.Info("resuming exec session", "resume_session_id", resume.SessionId)
+	select {
+	case <-doneCh:
+	case <-ceb.context.Done():
+	}
+}
@@ -0,0 +1,370 @@
+package ceb
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestExecReplayBuffer(t *testing.T) {
+	output := func(data string) *pb.EntrypointExecRequest {
+		return &pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_Output_{
+				Output: &pb.EntrypointExecRequest_Output{Data: []byte(data)},
+			},
+		}
+	}
+	exit := &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{Code: 3},
+		},
+	}
+	size := int64(proto.Size(output("0123456789")))
+
+	t.Run("drops the oldest output", func(t *testing.T) {
+		require := require.New(t)
+
+		budget := &execReplayBudget{max: 1024}
+		b := newExecReplayBuffer(3*size, budget)
+		for _, v := range []string{"aaaaaaaaaa", "bbbbbbbbbb", "cccccccccc", "dddddddddd"} {
+			b.Add(output(v))
+		}
+		b.Add(exit)
+
+		events, dropped := b.Take()
+		require.Equal(2*size, dropped)
+		require.Len(events, 3)
+		require.Equal("cccccccccc", string(events[0].Event.(*pb.EntrypointExecRequest_Output_).Output.Data))
+		require.Equal("dddddddddd", string(events[1].Event.(*pb.EntrypointExecRequest_Output_).Output.Data))
+		require.True(proto.Equal(exit, events[2]))
+		require.Zero(budget.used)
+	})
+
+	t.Run("copies events", func(t *testing.T) {
+		require := require.New(t)
+
+		b := newExecReplayBuffer(1024, &execReplayBudget{max: 1024})
+		req := output("before")
+		b.Add(req)
+		req.Event.(*pb.EntrypointExecRequest_Output_).Output.Data = []byte("after")
+
+		events, _ := b.Take()
+		require.Equal("before", string(events[0].Event.(*pb.EntrypointExecRequest_Output_).Output.Data))
+	})
+
+	t.Run("budget shared by sessions", func(t *testing.T) {
+		require := require.New(t)
+
+		budget := &execReplayBudget{max: 3 * size}
+		b1 := newExecReplayBuffer(10*size, budget)
+		b2 := newExecReplayBuffer(10*size, budget)
+		for i := 0; i < 5; i++ {
+			b1.Add(output("0123456789"))
+			b2.Add(output("0123456789"))
+			require.True(budget.used <= budget.max)
+		}
+
+		// The second session only gets what the first leaves
+		events, dropped := b2.Take()
+		require.Len(events, 1)
+		require.Equal(4*size, dropped)
+		require.Equal(2*size, budget.used)
+
+		b1.Release()
+		require.Zero(budget.used)
+	})
+}
+
+func TestExecStream_detach(t *testing.T) {
+	require := require.New(t)
+
+	budget := &execReplayBudget{max: 1024}
+	s1 := newTestResumeStream()
+	stream := &execStream{
+		Waypoint_EntrypointExecStreamClient: s1,
+		newReplay:                           func() *execReplayBuffer { return newExecReplayBuffer(1024, budget) },
+	}
+
+	warning := func(msg string) *pb.EntrypointExecRequest {
+		return &pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_Warning_{
+				Warning: &pb.EntrypointExecRequest_Warning{Message: msg},
+			},
+		}
+	}
+
+	// Once sending fails, events are held rather than failing
+	require.NoError(stream.Send(warning("one")))
+	close(s1.dropCh)
+	require.NoError(stream.Send(warning("two")))
+	require.True(stream.detached())
+	require.NoError(stream.Send(warning("three")))
+
+	// Attaching replays them with a divider
+	s2 := newTestResumeStream()
+	require.NoError(stream.attach(s2))
+	require.False(stream.detached())
+	require.NoError(stream.Send(warning("four")))
+
+	var messages []string
+	for len(s2.sendCh) > 0 {
+		switch event := (<-s2.sendCh).Event.(type) {
+		case *pb.EntrypointExecRequest_Warning_:
+			messages = append(messages, event.Warning.Message)
+		case *pb.EntrypointExecRequest_Replayed:
+			messages = append(messages, "--")
+		}
+	}
+	require.Equal([]string{"two", "three", "--", "four"}, messages)
+	require.Zero(budget.used)
+}
+
+func TestExec_resume(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The command waits for us at each step
+	td, err := ioutil.TempDir("", "waypoint")
+	require.NoError(err)
+	defer os.RemoveAll(td)
+	path := filepath.Join(td, "done")
+
+	client := &testResumeClient{streams: make(chan *testResumeStream, 2)}
+	ceb := testResumeCEB(ctx, client, time.Minute)
+
+	s1 := newTestResumeStream()
+	defer s1.Close()
+	client.streams <- s1
+	go ceb.startExec(&pb.EntrypointConfig_Exec{
+		Index:     1,
+		SessionId: "s1",
+		Args: []string{"/bin/sh", "-c",
+			"echo before; sleep 0.5; echo during; touch " + path + "; read x; echo after; exit 3"},
+	})
+
+	open := s1.next(t).Event.(*pb.EntrypointExecRequest_Open_).Open
+	require.NotEmpty(open.ResumeToken)
+	require.Contains(testResumeOutput(t, s1, 1), "before")
+
+	// The stream drops, after which the command keeps running and its
+	// output is held
+	close(s1.dropCh)
+	require.Eventually(func() bool {
+		ceb.execResumeLock.Lock()
+		defer ceb.execResumeLock.Unlock()
+		return ceb.execResumable["s1"] != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(func() bool {
+		_, err := os.Stat(path)
+		ceb.execResumeBudget.lock.Lock()
+		defer ceb.execResumeBudget.lock.Unlock()
+		return err == nil && ceb.execResumeBudget.used > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Resuming with the wrong token fails
+	bad := newTestResumeStream()
+	defer bad.Close()
+	client.streams <- bad
+	ceb.startExec(&pb.EntrypointConfig_Exec{
+		Index:  2,
+		Resume: &pb.EntrypointConfig_Exec_Resume{SessionId: "s1", Token: "nope"},
+	})
+	bad.next(t)
+	errEvent, ok := bad.next(t).Event.(*pb.EntrypointExecRequest_Error_)
+	require.True(ok)
+	require.Equal(codes.NotFound, codes.Code(errEvent.Error.Error.Code))
+
+	// Resuming continues the session, delivering what it sent meanwhile
+	s2 := newTestResumeStream()
+	defer s2.Close()
+	client.streams <- s2
+	go ceb.startExec(&pb.EntrypointConfig_Exec{
+		Index:  3,
+		Resume: &pb.EntrypointConfig_Exec_Resume{SessionId: "s1", Token: open.ResumeToken},
+	})
+	require.IsType((*pb.EntrypointExecRequest_Open_)(nil), s2.next(t).Event)
+	require.Contains(testResumeOutput(t, s2, 1), "during")
+	require.IsType((*pb.EntrypointExecRequest_Replayed)(nil), s2.next(t).Event)
+
+	// Input reaches the command again
+	s2.recvCh <- &pb.EntrypointExecResponse{
+		Event: &pb.EntrypointExecResponse_Input{Input: []byte("x\n")},
+	}
+
+	var output bytes.Buffer
+	for {
+		req := s2.next(t)
+		if event, ok := req.Event.(*pb.EntrypointExecRequest_Output_); ok {
+			output.Write(event.Output.Data)
+			continue
+		}
+
+		exit, ok := req.Event.(*pb.EntrypointExecRequest_Exit_)
+		require.True(ok, "should be an exit")
+		require.Equal(int32(3), exit.Exit.Code)
+		break
+	}
+	require.Equal("after\n", output.String())
+}
+
+func TestExec_resumeGracePeriod(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &testResumeClient{streams: make(chan *testResumeStream, 2)}
+	ceb := testResumeCEB(ctx, client, 100*time.Millisecond)
+
+	s1 := newTestResumeStream()
+	defer s1.Close()
+	client.streams <- s1
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		ceb.startExec(&pb.EntrypointConfig_Exec{
+			Index:     1,
+			SessionId: "s1",
+			Args:      []string{"/bin/sh", "-c", "echo ready; sleep 60"},
+		})
+	}()
+
+	open := s1.next(t).Event.(*pb.EntrypointExecRequest_Open_).Open
+	testResumeOutput(t, s1, 1)
+	close(s1.dropCh)
+
+	// Without a resume, the command is stopped once the grace period ends
+	select {
+	case <-doneCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("session didn't end after its grace period")
+	}
+	require.Zero(ceb.execResumeBudget.used)
+
+	// It can't be resumed any more
+	s2 := newTestResumeStream()
+	defer s2.Close()
+	client.streams <- s2
+	ceb.startExec(&pb.EntrypointConfig_Exec{
+		Index:  2,
+		Resume: &pb.EntrypointConfig_Exec_Resume{SessionId: "s1", Token: open.ResumeToken},
+	})
+	s2.next(t)
+	require.IsType((*pb.EntrypointExecRequest_Error_)(nil), s2.next(t).Event)
+}
+
+// testResumeCEB returns a CEB for exec sessions on the streams of client,
+// resumable for grace.
+func testResumeCEB(ctx context.Context, client pb.WaypointClient, grace time.Duration) *CEB {
+	return &CEB{
+		id:      "i1",
+		logger:  hclog.L(),
+		context: ctx,
+		client:  client,
+
+		maxMessageSize: DefaultMaxMessageSize,
+
+		execResumeGrace:      grace,
+		execResumeBufferSize: DefaultExecResumeBufferSize,
+		execResumeBudget:     execReplayBudget{max: DefaultExecResumeMaxMemory},
+	}
+}
+
+// testResumeOutput returns the output of the next n output events on s.
+func testResumeOutput(t *testing.T, s *testResumeStream, n int) string {
+	var buf bytes.Buffer
+	for n > 0 {
+		if event, ok := s.next(t).Event.(*pb.EntrypointExecRequest_Output_); ok {
+			buf.Write(event.Output.Data)
+			n--
+		}
+	}
+
+	return buf.String()
+}
+
+// testResumeClient is a WaypointClient whose exec streams come from
+// streams.
+type testResumeClient struct {
+	pb.WaypointClient
+
+	streams chan *testResumeStream
+}
+
+func (c *testResumeClient) EntrypointExecStream(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (pb.Waypoint_EntrypointExecStreamClient, error) {
+	return <-c.streams, nil
+}
+
+// testResumeStream is an entrypoint exec stream that records what is sent
+// and receives from recvCh until dropCh is closed, after which it fails.
+type testResumeStream struct {
+	grpc.ClientStream
+
+	sendCh  chan *pb.EntrypointExecRequest
+	recvCh  chan *pb.EntrypointExecResponse
+	dropCh  chan struct{}
+	closeCh chan struct{}
+}
+
+func newTestResumeStream() *testResumeStream {
+	return &testResumeStream{
+		sendCh:  make(chan *pb.EntrypointExecRequest, 1000),
+		recvCh:  make(chan *pb.EntrypointExecResponse),
+		dropCh:  make(chan struct{}),
+		closeCh: make(chan struct{}),
+	}
+}
+
+func (s *testResumeStream) Send(req *pb.EntrypointExecRequest) error {
+	select {
+	case <-s.dropCh:
+		return status.Error(codes.Unavailable, "stream dropped")
+	default:
+	}
+
+	s.sendCh <- proto.Clone(req).(*pb.EntrypointExecRequest)
+	return nil
+}
+
+func (s *testResumeStream) Recv() (*pb.EntrypointExecResponse, error) {
+	select {
+	case resp := <-s.recvCh:
+		return resp, nil
+	case <-s.dropCh:
+		return nil, status.Error(codes.Unavailable, "stream dropped")
+	case <-s.closeCh:
+		return nil, status.Error(codes.Canceled, "test done")
+	}
+}
+
+func (s *testResumeStream) CloseSend() error { return nil }
+
+// Close unblocks Recv once the test is done.
+func (s *testResumeStream) Close() { close(s.closeCh) }
+
+// next returns the next event sent on the stream.
+func (s *testResumeStream) next(t *testing.T) *pb.EntrypointExecRequest {
+	select {
+	case req := <-s.sendCh:
+		return req
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for an exec event")
+		return nil
+	}
+}
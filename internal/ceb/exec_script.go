@@ -0,0 +1,72 @@
+package ceb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execDefaultInterpreter runs scripts that don't start with "#!" and
+// don't request an interpreter.
+const execDefaultInterpreter = "/bin/sh"
+
+// execScriptArgs writes the script of the exec config to a temporary file
+// and returns the arguments to run it along with a function that removes
+// the file. The config args are passed to the script.
+//
+// When running in the application container, the file is written below
+// that container's root so that the path is valid once we join its mount
+// namespace.
+func (ceb *CEB) execScriptArgs(execConfig *pb.EntrypointConfig_Exec) ([]string, func(), error) {
+	var root string
+	if execConfig.TargetContainer {
+		var err error
+		root, err = ceb.execTargetRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	f, err := ioutil.TempFile(filepath.Join(root, os.TempDir()), "waypoint-exec-")
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal,
+			"error creating file for script: %s", err)
+	}
+	cleanup := func() { os.Remove(f.Name()) }
+
+	_, err = f.Write(execConfig.Script)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0700)
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, status.Errorf(codes.Internal,
+			"error writing script: %s", err)
+	}
+
+	path := strings.TrimPrefix(f.Name(), root)
+
+	var args []string
+	switch {
+	case strings.TrimSpace(execConfig.Interpreter) != "":
+		args = append(strings.Fields(execConfig.Interpreter), path)
+
+	case bytes.HasPrefix(execConfig.Script, []byte("#!")):
+		args = []string{path}
+
+	default:
+		args = []string{execDefaultInterpreter, path}
+	}
+
+	return append(args, execConfig.Args...), cleanup, nil
+}
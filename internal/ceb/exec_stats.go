@@ -0,0 +1,21 @@
+// +build !linux
+
+package ceb
+
+import (
+	"errors"
+	"syscall"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// readExecStats is only supported on Linux.
+func readExecStats(pid int) (*pb.ExecStreamResponse_Stats, error) {
+	return nil, errors.New("exec stats are only supported on Linux")
+}
+
+// rusageMaxRSS returns the maximum resident set size in bytes. Darwin
+// reports this in bytes already.
+func rusageMaxRSS(ru *syscall.Rusage) int64 {
+	return ru.Maxrss
+}
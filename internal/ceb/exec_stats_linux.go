@@ -0,0 +1,65 @@
+package ceb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// clockTicks is the number of clock ticks per second used for CPU times
+// in /proc. This is USER_HZ which is 100 on all the platforms we support.
+const clockTicks = 100
+
+// readExecStats reads the resource usage of the process with the given
+// pid from /proc. CPU time includes children that have been waited on.
+func readExecStats(pid int) (*pb.ExecStreamResponse_Stats, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return nil, err
+	}
+
+	// The command name is in parentheses and may contain spaces, so we
+	// parse the fields after the closing parenthesis. The first field
+	// after it is the state, field 3 in proc(5).
+	idx := strings.LastIndexByte(string(data), ')')
+	if idx < 0 {
+		return nil, fmt.Errorf("malformed stat for pid %d", pid)
+	}
+	fields := strings.Fields(string(data[idx+1:]))
+	if len(fields) < 22 {
+		return nil, fmt.Errorf("malformed stat for pid %d", pid)
+	}
+
+	// utime, stime, cutime, cstime are fields 14 to 17 and rss (in pages)
+	// is field 24.
+	var ticks int64
+	for _, f := range fields[11:15] {
+		v, err := strconv.ParseInt(f, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		ticks += v
+	}
+
+	rss, err := strconv.ParseInt(fields[21], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExecStreamResponse_Stats{
+		CpuTimeMs: ticks * 1000 / clockTicks,
+		RssBytes:  rss * int64(os.Getpagesize()),
+	}, nil
+}
+
+// rusageMaxRSS returns the maximum resident set size in bytes. Linux
+// reports this in kilobytes.
+func rusageMaxRSS(ru *syscall.Rusage) int64 {
+	return ru.Maxrss * 1024
+}
@@ -0,0 +1,16 @@
+// +build !linux
+
+package ceb
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// execSetSubreaper is only supported on Linux. Elsewhere, orphaned
+// descendants are reparented to PID 1 as usual.
+func execSetSubreaper() error { return nil }
+
+// Run does nothing since orphans are never reparented to us.
+func (r *execReaper) Run(ctx context.Context, log hclog.Logger) {}
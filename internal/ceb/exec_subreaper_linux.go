@@ -0,0 +1,84 @@
+package ceb
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/hashicorp/go-hclog"
+	"golang.org/x/sys/unix"
+)
+
+// execSetSubreaper makes the entrypoint a child subreaper so that
+// descendants of exec'd commands are reparented to us rather than to
+// PID 1 when their parent exits. This lets us reap them on teardown.
+func execSetSubreaper() error {
+	return unix.Prctl(unix.PR_SET_CHILD_SUBREAPER, 1, 0, 0, 0)
+}
+
+// Run reaps the orphans reparented to us whenever a child exits, until
+// ctx is done. This should run once we are a child subreaper.
+func (r *execReaper) Run(ctx context.Context, log hclog.Logger) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGCHLD)
+	defer signal.Stop(ch)
+
+	for {
+		r.reap(log, execExitedChildren())
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// execExitedChildren returns the pids of our children that exited but
+// weren't waited on yet, the zombies.
+func execExitedChildren() []int {
+	dir, err := os.Open("/proc")
+	if err != nil {
+		return nil
+	}
+	defer dir.Close()
+
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return nil
+	}
+
+	self := os.Getpid()
+	var result []int
+	for _, name := range names {
+		pid, err := strconv.Atoi(name)
+		if err != nil {
+			continue
+		}
+
+		// The state and parent pid follow the command name, which is in
+		// parentheses and may contain anything.
+		data, err := ioutil.ReadFile(filepath.Join("/proc", name, "stat"))
+		if err != nil {
+			continue
+		}
+		i := bytes.LastIndexByte(data, ')')
+		if i < 0 {
+			continue
+		}
+		fields := bytes.Fields(data[i+1:])
+		if len(fields) < 2 || string(fields[0]) != "Z" {
+			continue
+		}
+		if ppid, _ := strconv.Atoi(string(fields[1])); ppid == self {
+			result = append(result, pid)
+		}
+	}
+
+	return result
+}
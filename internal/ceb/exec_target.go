@@ -0,0 +1,97 @@
+package ceb
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execTargetNamespaces are the namespaces of the application container
+// that we join when exec targets the application container.
+var execTargetNamespaces = []string{"mnt", "uts", "ipc", "net", "pid"}
+
+// execTargetArgs returns the arguments to run args within the namespaces
+// of the application container.
+//
+// Go can't join a mount namespace from a multi-threaded process, so we
+// use nsenter which calls setns on /proc/<pid>/ns/* itself before exec.
+// The command is looked up on the PATH within the application container.
+func (ceb *CEB) execTargetArgs(args []string) ([]string, error) {
+	pid := ceb.execTargetPid
+	if pid <= 0 {
+		return nil, errExecTargetPid
+	}
+
+	// Verify we can access the namespaces up front so that permission
+	// problems are reported clearly rather than as an nsenter failure.
+	for _, ns := range execTargetNamespaces {
+		path := fmt.Sprintf("/proc/%d/ns/%s", pid, ns)
+		if _, err := os.Readlink(path); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"can't join the %s namespace of the application container (pid %d): %s",
+				ns, pid, err)
+		}
+	}
+
+	nsenter, err := exec.LookPath("nsenter")
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"nsenter is required to exec in the application container: %s", err)
+	}
+
+	result := []string{
+		nsenter,
+		"--target", strconv.Itoa(pid),
+		"--mount", "--uts", "--ipc", "--net", "--pid",
+		"--root", "--wd",
+		"--",
+	}
+
+	return append(result, args...), nil
+}
+
+// execResolveContainer handles a session for a named container that we
+// can run the command in ourselves: our own container, or the application
+// container if we know its name and can join its namespaces. For these,
+// the returned config has Container cleared, and TargetContainer set for
+// the application container. Otherwise execConfig is returned as is.
+func (ceb *CEB) execResolveContainer(execConfig *pb.EntrypointConfig_Exec) *pb.EntrypointConfig_Exec {
+	name := execConfig.Container
+	switch {
+	case name == "":
+		return execConfig
+
+	case name == ceb.execContainer:
+		execConfig = proto.Clone(execConfig).(*pb.EntrypointConfig_Exec)
+		execConfig.Container = ""
+
+	case name == ceb.execTargetContainer && ceb.execTargetPid > 0:
+		execConfig = proto.Clone(execConfig).(*pb.EntrypointConfig_Exec)
+		execConfig.Container = ""
+		execConfig.TargetContainer = true
+	}
+
+	return execConfig
+}
+
+// execTargetRoot returns the path to the root filesystem of the
+// application container as seen from the entrypoint.
+func (ceb *CEB) execTargetRoot() (string, error) {
+	pid := ceb.execTargetPid
+	if pid <= 0 {
+		return "", errExecTargetPid
+	}
+
+	return fmt.Sprintf("/proc/%d/root", pid), nil
+}
+
+var errExecTargetPid = status.Errorf(codes.FailedPrecondition,
+	"this instance can't exec in the application container because "+
+		"the entrypoint wasn't configured with its PID (%s)", envExecTargetPid)
@@ -0,0 +1,112 @@
+package ceb
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// execTask is a task of our Nomad allocation that exec sessions can run
+// in.
+type execTask struct {
+	Name string
+
+	// Dir is the task directory of the task. This is empty for the task
+	// we run in, since the commands we start already have its environment
+	// and working directory.
+	Dir string
+}
+
+// parseExecTasks parses the tasks we can exec in other than our own. These
+// are comma-separated name=dir pairs where dir is the task's directory.
+func parseExecTasks(v string) ([]*execTask, error) {
+	var result []*execTask
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		idx := strings.Index(pair, "=")
+		if idx <= 0 || idx == len(pair)-1 {
+			return nil, fmt.Errorf("expected name=dir, got %q", pair)
+		}
+
+		dir := strings.TrimSpace(pair[idx+1:])
+		if !filepath.IsAbs(dir) {
+			return nil, fmt.Errorf("directory of task %q must be absolute", pair[:idx])
+		}
+
+		result = append(result, &execTask{
+			Name: strings.TrimSpace(pair[:idx]),
+			Dir:  dir,
+		})
+	}
+
+	return result, nil
+}
+
+// execTaskNames returns the sorted names of the tasks we can exec in.
+func (ceb *CEB) execTaskNames() []string {
+	var result []string
+	for _, t := range ceb.execTasks {
+		result = append(result, t.Name)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// execResolveTask returns the task an exec session requested with name
+// runs in. This is nil if we aren't running any tasks and none was
+// requested. If we run more than one, a task must be requested.
+func (ceb *CEB) execResolveTask(name string) (*execTask, error) {
+	if len(ceb.execTasks) == 0 {
+		if name != "" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"task %q requested but this instance isn't running in a Nomad allocation", name)
+		}
+
+		return nil, nil
+	}
+
+	if name == "" {
+		if len(ceb.execTasks) > 1 {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"allocation %s runs several tasks, a task must be chosen: %s",
+				ceb.allocId, strings.Join(ceb.execTaskNames(), ", "))
+		}
+
+		return ceb.execTasks[0], nil
+	}
+
+	for _, t := range ceb.execTasks {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound,
+		"task %q not found in allocation %s, tasks: %s",
+		name, ceb.allocId, strings.Join(ceb.execTaskNames(), ", "))
+}
+
+// Apply sets up cmd to run in the task's working directory with the
+// task's Nomad environment variables.
+func (t *execTask) Apply(cmd *exec.Cmd) {
+	if t == nil || t.Dir == "" {
+		return
+	}
+
+	cmd.Dir = t.Dir
+	cmd.Env = append(cmd.Env,
+		"NOMAD_TASK_NAME="+t.Name,
+		"NOMAD_TASK_DIR="+filepath.Join(t.Dir, "local"),
+		"NOMAD_SECRETS_DIR="+filepath.Join(t.Dir, "secrets"),
+	)
+}
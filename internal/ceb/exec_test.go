@@ -0,0 +1,1594 @@
+package ceb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/creack/pty"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/pkg/tunnel"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess"
+)
+
+func TestExec_streamCloseTerminates(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, path := testExecSignalHelper(t, ctx)
+
+	// Closing our side should result in the command being asked to exit
+	require.NoError(stream.CloseSend())
+	require.Eventually(func() bool {
+		data, err := ioutil.ReadFile(path)
+		return err == nil && string(data) == syscall.SIGTERM.String()
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExec_detachedStreamDrop(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Grace time.Duration
+	}{
+		{"not resumable", 0},
+		{"not resumed in time", 100 * time.Millisecond},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			td, err := ioutil.TempDir("", "waypoint")
+			require.NoError(err)
+			defer os.RemoveAll(td)
+			signaled := filepath.Join(td, "signaled")
+			done := filepath.Join(td, "done")
+
+			client := &testResumeClient{streams: make(chan *testResumeStream, 1)}
+			ceb := testResumeCEB(ctx, client, tt.Grace)
+
+			s1 := newTestResumeStream()
+			defer s1.Close()
+			client.streams <- s1
+			doneCh := make(chan struct{})
+			go func() {
+				defer close(doneCh)
+				ceb.startExec(&pb.EntrypointConfig_Exec{
+					Index:     1,
+					SessionId: "s1",
+					Detach:    true,
+					Args: []string{"/bin/sh", "-c", fmt.Sprintf(
+						"trap 'touch %s' TERM HUP; echo ready; sleep 0.5; "+
+							"head -c 1000000 /dev/zero; touch %s", signaled, done)},
+				})
+			}()
+
+			s1.next(t)
+			testResumeOutput(t, s1, 1)
+			close(s1.dropCh)
+
+			// The command runs to the end without being signaled, even
+			// though its output can't be sent.
+			select {
+			case <-doneCh:
+			case <-time.After(10 * time.Second):
+				t.Fatal("session didn't end")
+			}
+			_, err = os.Stat(done)
+			require.NoError(err)
+			_, err = os.Stat(signaled)
+			require.True(os.IsNotExist(err))
+		})
+	}
+}
+
+func TestExec_signal(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, path := testExecSignalHelper(t, ctx)
+	defer stream.CloseSend()
+
+	// An unknown signal should result in a warning and no exit
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Signal_{
+			Signal: &pb.ExecStreamRequest_Signal{Name: "SEGV"},
+		},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(err)
+	warning, ok := resp.Event.(*pb.ExecStreamResponse_Warning_)
+	require.True(ok)
+	require.Contains(warning.Warning.Message, "SEGV")
+
+	// A valid signal should be delivered
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Signal_{
+			Signal: &pb.ExecStreamRequest_Signal{Name: "usr1"},
+		},
+	}))
+	require.Eventually(func() bool {
+		data, err := ioutil.ReadFile(path)
+		return err == nil && string(data) == syscall.SIGUSR1.String()
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExec_ping(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, _ := testExecSignalHelper(t, ctx)
+	defer stream.CloseSend()
+
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Ping_{
+			Ping: &pb.ExecStreamRequest_Ping{Id: 7},
+		},
+	}))
+
+	for {
+		resp, err := stream.Recv()
+		require.NoError(err)
+		if event, ok := resp.Event.(*pb.ExecStreamResponse_Pong_); ok {
+			require.Equal(uint64(7), event.Pong.Id)
+			break
+		}
+	}
+}
+
+func TestExec_capabilities(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, attached := testExecStart(t, ctx, "print-limits", nil, &pb.ExecStreamRequest_Start{
+		ClientCapabilities: []string{"ping", "future"},
+	})
+	defer stream.CloseSend()
+	require.Equal([]string{"ping", "stdin_eof", "sequence"}, attached.InstanceCapabilities)
+}
+
+func TestExec_stdinEof(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+		Args: []string{"/bin/sh", "-c", "wc -c"},
+	})
+	defer stream.CloseSend()
+
+	// wc only exits once its stdin is closed
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Data: []byte("hello"), Eof: true},
+		},
+	}))
+
+	var output []byte
+	for {
+		resp, err := stream.Recv()
+		require.NoError(err)
+		if event, ok := resp.Event.(*pb.ExecStreamResponse_Output_); ok {
+			output = append(output, event.Output.Data...)
+			continue
+		}
+
+		exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_)
+		require.True(ok, "should be an exit")
+		require.Equal(int32(0), exit.Exit.Code)
+		break
+	}
+	require.Equal("5", strings.TrimSpace(string(output)))
+}
+
+func TestExec_sequence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sequence := []*pb.ExecStreamRequest_Command{
+		{Args: []string{"/bin/sh", "-c", "echo two; exit 3"}},
+		{Args: []string{"/bin/sh", "-c", "echo three"}},
+	}
+
+	// run returns the output of the session, the codes of the commands
+	// that exited and the final exit code.
+	run := func(t *testing.T, keepGoing bool) (string, []int32, int32) {
+		require := require.New(t)
+
+		stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+			Args:      []string{"/bin/sh", "-c", "echo one"},
+			Sequence:  sequence,
+			KeepGoing: keepGoing,
+		})
+		defer stream.CloseSend()
+
+		var output []byte
+		var codes []int32
+		for {
+			resp, err := stream.Recv()
+			require.NoError(err)
+			switch event := resp.Event.(type) {
+			case *pb.ExecStreamResponse_Output_:
+				output = append(output, event.Output.Data...)
+
+			case *pb.ExecStreamResponse_CommandExit_:
+				require.Equal(int32(len(codes)), event.CommandExit.Index)
+				codes = append(codes, event.CommandExit.Exit.Code)
+
+			case *pb.ExecStreamResponse_Exit_:
+				return string(output), codes, event.Exit.Code
+			}
+		}
+	}
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		output, codes, code := run(t, false)
+		require.Equal(t, "one\ntwo\n", output)
+		require.Equal(t, []int32{0, 3}, codes)
+		require.Equal(t, int32(3), code)
+	})
+
+	t.Run("keep going", func(t *testing.T) {
+		output, codes, code := run(t, true)
+		require.Equal(t, "one\ntwo\nthree\n", output)
+		require.Equal(t, []int32{0, 3, 0}, codes)
+		require.Equal(t, int32(0), code)
+	})
+}
+
+func TestExec_limits(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const memory = 8 << 30
+	stream, attached := testExecStart(t, ctx, "print-limits", nil, &pb.ExecStreamRequest_Start{
+		Limits: &pb.ExecStreamRequest_Limits{
+			MemoryBytes: memory,
+			Nice:        5,
+		},
+	})
+	defer stream.CloseSend()
+	require.NotEmpty(attached.InstanceId)
+	require.Empty(attached.UnsupportedLimits)
+
+	// Tell the helper to print its limits
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Data: []byte("\n")},
+		},
+	}))
+
+	var output bytes.Buffer
+	for !bytes.HasSuffix(output.Bytes(), []byte("\n")) {
+		resp, err := stream.Recv()
+		require.NoError(err)
+		if event, ok := resp.Event.(*pb.ExecStreamResponse_Output_); ok {
+			output.Write(event.Output.Data)
+		}
+	}
+	require.Equal(fmt.Sprintf("%d 5\n", memory), output.String())
+}
+
+func TestExec_startError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A file that exists but isn't executable
+	td, err := ioutil.TempDir("", "test")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+	noexec := filepath.Join(td, "noexec")
+	require.NoError(t, ioutil.WriteFile(noexec, []byte("#!/bin/sh\n"), 0644))
+
+	cases := []struct {
+		Name   string
+		Args   []string
+		Code   int32
+		Reason pb.ExecStreamResponse_StartError_Reason
+	}{
+		{
+			"not found on PATH",
+			[]string{"waypoint-test-no-such-command"},
+			127,
+			pb.ExecStreamResponse_StartError_NOT_FOUND,
+		},
+
+		{
+			"absolute path not found",
+			[]string{filepath.Join(td, "nope")},
+			127,
+			pb.ExecStreamResponse_StartError_NOT_FOUND,
+		},
+
+		{
+			"not executable",
+			[]string{noexec},
+			126,
+			pb.ExecStreamResponse_StartError_PERMISSION_DENIED,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+				Args: append([]string{}, tt.Args...),
+			})
+			defer stream.CloseSend()
+
+			resp, err := stream.Recv()
+			require.NoError(err)
+			exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_)
+			require.True(ok, "should be an exit")
+			require.Equal(tt.Code, exit.Exit.Code)
+			require.NotNil(exit.Exit.StartError)
+			require.Equal(tt.Reason, exit.Exit.StartError.Reason)
+			require.Equal(tt.Args[0], exit.Exit.StartError.Command)
+		})
+	}
+}
+
+func TestExec_pty(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, attached := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+		Args: []string{"/bin/sh", "-c", "test -t 1 && echo tty"},
+		Pty: &pb.ExecStreamRequest_PTY{
+			Enable:     true,
+			WindowSize: &pb.ExecStreamRequest_WindowSize{Rows: 24, Cols: 80},
+		},
+	})
+	defer stream.CloseSend()
+	require.False(attached.PtyUnavailable)
+	require.NotEmpty(attached.Locale)
+
+	resp, err := stream.Recv()
+	require.NoError(err)
+	output, ok := resp.Event.(*pb.ExecStreamResponse_Output_)
+	require.True(ok, "should be output")
+	require.Contains(string(output.Output.Data), "tty")
+}
+
+func TestExec_ptyUnavailable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Simulate an instance without PTY support
+	execPtyOpen = func() (*os.File, *os.File, error) {
+		return nil, nil, errors.New("no pty")
+	}
+	defer func() { execPtyOpen = pty.Open }()
+
+	ptyReq := func(require bool) *pb.ExecStreamRequest_Start {
+		return &pb.ExecStreamRequest_Start{
+			Pty: &pb.ExecStreamRequest_PTY{
+				Enable:     true,
+				WindowSize: &pb.ExecStreamRequest_WindowSize{Rows: 24, Cols: 80},
+				Require:    require,
+			},
+		}
+	}
+
+	t.Run("falls back", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, attached := testExecStart(t, ctx, "", nil, ptyReq(false))
+		defer stream.CloseSend()
+		require.True(attached.PtyUnavailable)
+
+		resp, err := stream.Recv()
+		require.NoError(err)
+		warning, ok := resp.Event.(*pb.ExecStreamResponse_Warning_)
+		require.True(ok, "should be a warning")
+		require.Contains(warning.Warning.Message, "PTY unavailable")
+	})
+
+	t.Run("required", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, attached := testExecStart(t, ctx, "", nil, ptyReq(true))
+		defer stream.CloseSend()
+		require.True(attached.PtyUnavailable)
+
+		resp, err := stream.Recv()
+		require.NoError(err)
+		exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_)
+		require.True(ok, "should be an exit")
+		require.Equal(int32(1), exit.Exit.Code)
+		require.NotNil(exit.Exit.StartError)
+		require.Contains(exit.Exit.StartError.Message, "PTY is required")
+	})
+}
+
+func TestExec_targetContainer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("not configured", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+			TargetContainer: true,
+		})
+		defer stream.CloseSend()
+
+		resp, err := stream.Recv()
+		require.NoError(err)
+		exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_)
+		require.True(ok, "should be an exit")
+		require.Equal(int32(1), exit.Exit.Code)
+		require.NotNil(exit.Exit.StartError)
+		require.Contains(exit.Exit.StartError.Message, envExecTargetPid)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		if _, err := exec.LookPath("nsenter"); err != nil || os.Geteuid() != 0 {
+			t.Skip("requires nsenter and root")
+		}
+
+		require := require.New(t)
+
+		// We target ourselves since we have no other container to join.
+		stream, _ := testExecStart(t, ctx, "", map[string]string{
+			envExecTargetPid: strconv.Itoa(os.Getpid()),
+		}, &pb.ExecStreamRequest_Start{
+			Args:            []string{"sh", "-c", "echo hello"},
+			TargetContainer: true,
+		})
+		defer stream.CloseSend()
+
+		resp, err := stream.Recv()
+		require.NoError(err)
+		output, ok := resp.Event.(*pb.ExecStreamResponse_Output_)
+		require.True(ok, "should be output")
+		require.Equal("hello\n", string(output.Output.Data))
+	})
+}
+
+func TestExec_container(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("own container", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, _ := testExecStart(t, ctx, "", map[string]string{
+			envK8SContainerName: "app",
+		}, &pb.ExecStreamRequest_Start{
+			Args:      []string{"/bin/sh", "-c", "echo hello"},
+			Container: "app",
+		})
+		defer stream.CloseSend()
+
+		resp, err := stream.Recv()
+		require.NoError(err)
+		output, ok := resp.Event.(*pb.ExecStreamResponse_Output_)
+		require.True(ok, "should be output")
+		require.Equal("hello\n", string(output.Output.Data))
+	})
+
+	t.Run("not in a pod", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+			Args:      []string{"/bin/sh"},
+			Container: "envoy",
+		})
+		defer stream.CloseSend()
+
+		_, err := stream.Recv()
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+		require.Contains(err.Error(), "envoy")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		require := require.New(t)
+
+		client, ceb := testExecCEB(t, ctx, "", nil)
+		ceb.execKube = &execKube{
+			Namespace: "default",
+			Pod:       "web",
+			clientset: fake.NewSimpleClientset(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "fluentd"}, {Name: "app"}, {Name: "envoy"},
+					},
+				},
+			}),
+		}
+
+		stream, _ := testExecSession(t, ctx, client, ceb, &pb.ExecStreamRequest_Start{
+			Args:      []string{"/bin/sh"},
+			Container: "db",
+		})
+		defer stream.CloseSend()
+
+		_, err := stream.Recv()
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+		require.Contains(err.Error(), "containers in this pod: app, envoy, fluentd")
+	})
+}
+
+func TestExec_task(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "waypoint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	env := map[string]string{
+		envNomadAllocId:  "8c5cb3e4",
+		envNomadTaskName: "web",
+		envExecTasks:     "logger=" + dir,
+	}
+
+	t.Run("other task", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, attached := testExecStart(t, ctx, "", env, &pb.ExecStreamRequest_Start{
+			Args: []string{"/bin/sh", "-c", "pwd; echo $NOMAD_TASK_NAME"},
+			Task: "logger",
+		})
+		defer stream.CloseSend()
+		require.Equal("8c5cb3e4", attached.AllocationId)
+		require.Equal("logger", attached.Task)
+
+		var output bytes.Buffer
+		for {
+			resp, err := stream.Recv()
+			require.NoError(err)
+			if _, ok := resp.Event.(*pb.ExecStreamResponse_Exit_); ok {
+				break
+			}
+
+			if v, ok := resp.Event.(*pb.ExecStreamResponse_Output_); ok {
+				output.Write(v.Output.Data)
+			}
+		}
+
+		realDir, err := filepath.EvalSymlinks(dir)
+		require.NoError(err)
+		require.Equal(realDir+"\nlogger\n", output.String())
+	})
+
+	t.Run("no task chosen", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, _ := testExecStart(t, ctx, "", env, &pb.ExecStreamRequest_Start{
+			Args: []string{"/bin/sh"},
+		})
+		defer stream.CloseSend()
+
+		_, err := stream.Recv()
+		require.Error(err)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+		require.Contains(err.Error(), "logger, web")
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		require := require.New(t)
+
+		stream, _ := testExecStart(t, ctx, "", env, &pb.ExecStreamRequest_Start{
+			Args: []string{"/bin/sh"},
+			Task: "db",
+		})
+		defer stream.CloseSend()
+
+		_, err := stream.Recv()
+		require.Error(err)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func TestExec_injectEnv(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	output := func(t *testing.T, start *pb.ExecStreamRequest_Start) string {
+		require := require.New(t)
+
+		stream, _ := testExecSession(t, ctx, client, ceb, start)
+		defer stream.CloseSend()
+
+		var output bytes.Buffer
+		for {
+			resp, err := stream.Recv()
+			require.NoError(err)
+			if _, ok := resp.Event.(*pb.ExecStreamResponse_Exit_); ok {
+				break
+			}
+
+			if v, ok := resp.Event.(*pb.ExecStreamResponse_Output_); ok {
+				output.Write(v.Output.Data)
+			}
+		}
+
+		return output.String()
+	}
+
+	// The deployment ID is already in our own environment so we check
+	// the instance ID.
+	args := []string{"/bin/sh", "-c", "echo $WAYPOINT_INSTANCE_ID"}
+
+	t.Run("injected by default", func(t *testing.T) {
+		require.Equal(t, ceb.id+"\n", output(t, &pb.ExecStreamRequest_Start{
+			Args: args,
+		}))
+	})
+
+	t.Run("opt out", func(t *testing.T) {
+		require.Equal(t, "\n", output(t, &pb.ExecStreamRequest_Start{
+			Args:        args,
+			NoInjectEnv: true,
+		}))
+	})
+
+	t.Run("client env", func(t *testing.T) {
+		for _, noInject := range []bool{false, true} {
+			require.Equal(t, "C.UTF-8\n", output(t, &pb.ExecStreamRequest_Start{
+				Args:        []string{"/bin/sh", "-c", "echo $LANG"},
+				Env:         []string{"LANG=C.UTF-8"},
+				NoInjectEnv: noInject,
+			}))
+		}
+	})
+}
+
+func TestExecLocale(t *testing.T) {
+	cases := []struct {
+		Name string
+		Env  []string
+		Want string
+	}{
+		{"none", []string{"PATH=/bin"}, "C"},
+		{"lang", []string{"LANG=en_US.UTF-8"}, "en_US.UTF-8"},
+		{"lc_ctype over lang", []string{"LANG=C", "LC_CTYPE=C.UTF-8"}, "C.UTF-8"},
+		{"lc_all over all", []string{"LC_ALL=POSIX", "LC_CTYPE=C.UTF-8"}, "POSIX"},
+		{"empty is unset", []string{"LC_ALL=", "LANG=C.UTF-8"}, "C.UTF-8"},
+		{"later wins", []string{"LANG=C.UTF-8", "LANG=C"}, "C"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Want, execLocale(tt.Env))
+		})
+	}
+}
+
+func TestExec_maxSessions(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, ceb := testExecCEB(t, ctx, "", map[string]string{
+		envExecMaxSessions: "1",
+	})
+
+	// The first session runs
+	stream, _ := testExecSession(t, ctx, client, ceb, nil)
+	defer stream.CloseSend()
+
+	// The second session is rejected
+	stream2, _ := testExecSession(t, ctx, client, ceb, nil)
+	defer stream2.CloseSend()
+	_, err := stream2.Recv()
+	require.Error(err)
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+
+	// Once the first session ends, we can start another
+	require.NoError(stream.CloseSend())
+	require.Eventually(func() bool {
+		return atomic.LoadInt32(&ceb.execCount) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+	stream3, _ := testExecSession(t, ctx, client, ceb, &pb.ExecStreamRequest_Start{
+		Args: []string{"/bin/sh", "-c", "echo hello"},
+	})
+	defer stream3.CloseSend()
+	resp, err := stream3.Recv()
+	require.NoError(err)
+	require.IsType((*pb.ExecStreamResponse_Output_)(nil), resp.Event)
+}
+
+func TestExec_stats(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+		StatsInterval: "1s",
+	})
+	defer stream.CloseSend()
+
+	resp, err := stream.Recv()
+	require.NoError(err)
+	stats, ok := resp.Event.(*pb.ExecStreamResponse_Stats_)
+	require.True(ok, "should be stats")
+	require.True(stats.Stats.RssBytes > 0)
+}
+
+func TestExec_orphans(t *testing.T) {
+	// run starts a command that leaves a sleeper running in the background
+	// with our stdout and returns its pid once the command has exited.
+	run := func(t *testing.T, policy pb.ExecStreamRequest_OrphanPolicy) int {
+		require := require.New(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+			Args:         []string{"sh", "-c", "sleep 300 & echo $!"},
+			OrphanPolicy: policy,
+		})
+		defer stream.CloseSend()
+
+		var output bytes.Buffer
+		for {
+			resp, err := stream.Recv()
+			require.NoError(err)
+
+			if event, ok := resp.Event.(*pb.ExecStreamResponse_Output_); ok {
+				output.Write(event.Output.Data)
+				continue
+			}
+
+			exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_)
+			require.True(ok, "should be exit")
+			require.Equal(int32(0), exit.Exit.Code)
+			break
+		}
+
+		pid, err := strconv.Atoi(strings.TrimSpace(output.String()))
+		require.NoError(err)
+		return pid
+	}
+
+	t.Run("kills the process group by default", func(t *testing.T) {
+		pid := run(t, pb.ExecStreamRequest_KILL_GROUP)
+		require.Eventually(t, func() bool {
+			return syscall.Kill(pid, 0) == syscall.ESRCH
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("leaves orphans running if allowed", func(t *testing.T) {
+		pid := run(t, pb.ExecStreamRequest_ORPHAN_ALLOWED)
+		defer func() {
+			syscall.Kill(pid, syscall.SIGKILL)
+			var ws syscall.WaitStatus
+			syscall.Wait4(pid, &ws, 0, nil)
+		}()
+
+		require.NoError(t, syscall.Kill(pid, 0))
+	})
+}
+
+func TestExec_exitSignal(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+		Args: []string{"sh", "-c", "kill -KILL $$"},
+	})
+	defer stream.CloseSend()
+
+	resp, err := stream.Recv()
+	require.NoError(err)
+	exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_)
+	require.True(ok, "should be exit")
+	require.Equal(int32(128+9), exit.Exit.Code)
+	require.Equal("SIGKILL", exit.Exit.Signal)
+	require.False(exit.Exit.CoreDumped)
+	require.NotNil(exit.Exit.Usage)
+}
+
+func TestExec_noStdin(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// cat only exits once it sees EOF on stdin
+	stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+		Args:    []string{"cat"},
+		NoStdin: true,
+	})
+	defer stream.CloseSend()
+
+	resp, err := stream.Recv()
+	require.NoError(err)
+	exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_)
+	require.True(ok, "should be exit")
+	require.Equal(int32(0), exit.Exit.Code)
+}
+
+func TestExec_script(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The script reads from stdin to verify it is still available
+	stream, _ := testExecStart(t, ctx, "", nil, &pb.ExecStreamRequest_Start{
+		Args:   []string{"hello"},
+		Script: []byte("echo \"$0\"\nread line\necho \"$1 $line\"\n"),
+	})
+	defer stream.CloseSend()
+
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Data: []byte("world\n")},
+		},
+	}))
+
+	var output bytes.Buffer
+	for {
+		resp, err := stream.Recv()
+		require.NoError(err)
+		if exit, ok := resp.Event.(*pb.ExecStreamResponse_Exit_); ok {
+			require.Equal(int32(0), exit.Exit.Code)
+			break
+		}
+
+		if v, ok := resp.Event.(*pb.ExecStreamResponse_Output_); ok {
+			output.Write(v.Output.Data)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(lines, 2)
+	require.Equal("hello world", lines[1])
+
+	// The script is removed once it exits
+	require.Eventually(func() bool {
+		_, err := os.Stat(lines[0])
+		return os.IsNotExist(err)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExec_copyTo(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	defer os.RemoveAll(src)
+	require.NoError(os.Mkdir(filepath.Join(src, "dir"), 0755))
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "dir", "a.txt"), []byte("hello"), 0600))
+
+	dest, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	defer os.RemoveAll(dest)
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	execClient := &execclient.Client{
+		Logger:       hclog.L(),
+		UI:           terminal.NonInteractiveUI(ctx),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: ceb.DeploymentId(),
+	}
+	require.NoError(execClient.CopyTo(ctx, filepath.Join(src, "dir"), filepath.Join(dest, "copy")))
+
+	data, err := ioutil.ReadFile(filepath.Join(dest, "copy", "a.txt"))
+	require.NoError(err)
+	require.Equal("hello", string(data))
+
+	fi, err := os.Stat(filepath.Join(dest, "copy", "a.txt"))
+	require.NoError(err)
+	require.Equal(os.FileMode(0600), fi.Mode().Perm())
+
+	// Copying the directory over a file fails for every entry
+	require.NoError(ioutil.WriteFile(filepath.Join(dest, "file"), nil, 0644))
+	err = execClient.CopyTo(ctx, filepath.Join(src, "dir"), filepath.Join(dest, "file"))
+	var copyErr *execclient.CopyError
+	require.True(errors.As(err, &copyErr))
+	require.Len(copyErr.Errors, 2)
+}
+
+func TestExec_copyFrom(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A file larger than a single message along with one we don't match
+	large := bytes.Repeat([]byte("0123456789abcdef"), 6*1024*1024/16)
+	src, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	defer os.RemoveAll(src)
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "a.log"), []byte("hello"), 0644))
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "b.log"), large, 0644))
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "c.txt"), nil, 0644))
+
+	dest, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	defer os.RemoveAll(dest)
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	execClient := &execclient.Client{
+		Logger:       hclog.L(),
+		UI:           terminal.NonInteractiveUI(ctx),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: ceb.DeploymentId(),
+	}
+	require.NoError(execClient.CopyFrom(ctx, filepath.Join(src, "*.log"), dest))
+
+	data, err := ioutil.ReadFile(filepath.Join(dest, "b.log"))
+	require.NoError(err)
+	require.Equal(large, data)
+	require.FileExists(filepath.Join(dest, "a.log"))
+	_, err = os.Stat(filepath.Join(dest, "c.txt"))
+	require.True(os.IsNotExist(err))
+
+	// Nothing matching is an error
+	err = execClient.CopyFrom(ctx, filepath.Join(src, "*.nope"), dest)
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestExec_copyResume(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	large := bytes.Repeat([]byte("0123456789abcdef"), 3*1024*1024/16)
+	src, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	defer os.RemoveAll(src)
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "big"), large, 0644))
+
+	// A previous copy was interrupted part way through
+	dest, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	defer os.RemoveAll(dest)
+	require.NoError(ioutil.WriteFile(filepath.Join(dest, "big"), large[:2*1024*1024+100], 0644))
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	execClient := &execclient.Client{
+		Logger:       hclog.L(),
+		UI:           terminal.NonInteractiveUI(ctx),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: ceb.DeploymentId(),
+		Resume:       true,
+	}
+	require.NoError(execClient.CopyFrom(ctx, filepath.Join(src, "big"), dest))
+
+	data, err := ioutil.ReadFile(filepath.Join(dest, "big"))
+	require.NoError(err)
+	require.Equal(large, data)
+
+	// And the same going the other way
+	require.NoError(os.Truncate(filepath.Join(src, "big"), 1024*1024+7))
+	require.NoError(execClient.CopyTo(ctx, filepath.Join(dest, "big"), src))
+
+	data, err = ioutil.ReadFile(filepath.Join(src, "big"))
+	require.NoError(err)
+	require.Equal(large, data)
+}
+
+func TestExec_portForward(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A service on the instance that only listens on localhost
+	service, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer service.Close()
+	go func() {
+		for {
+			conn, err := service.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	execClient := &execclient.Client{
+		Logger:       hclog.L(),
+		UI:           terminal.NonInteractiveUI(ctx),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: ceb.DeploymentId(),
+	}
+
+	fwdCtx, fwdCancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- execClient.PortForward(fwdCtx, ln, service.Addr().(*net.TCPAddr).Port)
+	}()
+
+	// Two connections at once over the same session
+	var conns []net.Conn
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		require.NoError(err)
+		defer conn.Close()
+		conns = append(conns, conn)
+	}
+	for i, conn := range conns {
+		msg := fmt.Sprintf("hello %d", i)
+		_, err := conn.Write([]byte(msg))
+		require.NoError(err)
+
+		buf := make([]byte, len(msg))
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(err)
+		require.Equal(msg, string(buf))
+	}
+
+	// Interrupting ends forwarding without an error
+	fwdCancel()
+	select {
+	case err := <-errCh:
+		require.NoError(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("port forwarding didn't end")
+	}
+}
+
+func TestExec_portForwardReverse(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A service on "our" side that the instance connects back to
+	local, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer local.Close()
+	go func() {
+		for {
+			conn, err := local.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	// Find a free port for the instance to listen on
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	execClient := &execclient.Client{
+		Logger:       hclog.L(),
+		UI:           terminal.NonInteractiveUI(ctx),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: ceb.DeploymentId(),
+	}
+
+	// While the port is taken, forwarding fails
+	err = execClient.PortForwardReverse(ctx, port, local.Addr().String())
+	require.Error(err)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	ln.Close()
+
+	fwdCtx, fwdCancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- execClient.PortForwardReverse(fwdCtx, port, local.Addr().String())
+	}()
+
+	var conn net.Conn
+	require.Eventually(func() bool {
+		conn, err = net.Dial("tcp", ln.Addr().String())
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(err)
+	require.Equal("hello", string(buf))
+
+	// The instance stops listening once we disconnect
+	fwdCancel()
+	require.NoError(<-errCh)
+	require.Eventually(func() bool {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		if err == nil {
+			conn.Close()
+		}
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExec_portForwardUDP(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A UDP service on the instance that echoes datagrams
+	service, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	defer service.Close()
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := service.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			service.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	execClient := &execclient.Client{
+		Logger:       hclog.L(),
+		UI:           terminal.NonInteractiveUI(ctx),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: ceb.DeploymentId(),
+	}
+
+	fwdCtx, fwdCancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- execClient.PortForwardUDP(fwdCtx, pc, service.LocalAddr().(*net.UDPAddr).Port)
+	}()
+
+	// Two peers each get their own replies. Datagrams sent before the
+	// instance attaches are lost so we retry until we get a reply.
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("udp", pc.LocalAddr().String())
+		require.NoError(err)
+		defer conn.Close()
+
+		msg := fmt.Sprintf("hello %d", i)
+		require.Eventually(func() bool {
+			if _, err := conn.Write([]byte(msg)); err != nil {
+				return false
+			}
+
+			conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+			buf := make([]byte, 64)
+			n, err := conn.Read(buf)
+			return err == nil && string(buf[:n]) == msg
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	fwdCancel()
+	select {
+	case err := <-errCh:
+		require.NoError(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("port forwarding didn't end")
+	}
+}
+
+func TestExec_portForwardSOCKS(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A service on the instance that only listens on localhost
+	service, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer service.Close()
+	go func() {
+		for {
+			conn, err := service.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	// A port that nothing listens on
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+
+	client, ceb := testExecCEB(t, ctx, "", nil)
+	execClient := &execclient.Client{
+		Logger:       hclog.L(),
+		UI:           terminal.NonInteractiveUI(ctx),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: ceb.DeploymentId(),
+	}
+
+	fwdCtx, fwdCancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- execClient.PortForwardSOCKS(fwdCtx, ln)
+	}()
+
+	// socksConnect sends a CONNECT to 127.0.0.1 on port and returns the
+	// reply code.
+	socksConnect := func(port int) (net.Conn, byte) {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		require.NoError(err)
+
+		_, err = conn.Write([]byte{5, 1, 0})
+		require.NoError(err)
+		_, err = io.ReadFull(conn, make([]byte, 2))
+		require.NoError(err)
+
+		_, err = conn.Write([]byte{5, 1, 0, 1, 127, 0, 0, 1, byte(port >> 8), byte(port)})
+		require.NoError(err)
+		reply := make([]byte, 10)
+		_, err = io.ReadFull(conn, reply)
+		require.NoError(err)
+		return conn, reply[1]
+	}
+
+	conn, code := socksConnect(service.Addr().(*net.TCPAddr).Port)
+	defer conn.Close()
+	require.Equal(byte(0), code)
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(err)
+	require.Equal("hello", string(buf))
+
+	// A refused connection is reported to the SOCKS client
+	refused, code := socksConnect(closedPort)
+	defer refused.Close()
+	require.Equal(byte(5), code)
+
+	fwdCancel()
+	select {
+	case err := <-errCh:
+		require.NoError(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("port forwarding didn't end")
+	}
+}
+
+func TestExecForwardDialer(t *testing.T) {
+	t.Run("falls back to the other family", func(t *testing.T) {
+		require := require.New(t)
+
+		// A service that only listens on ::1, like "localhost" resolving
+		// to IPv6 only.
+		service, err := net.Listen("tcp", "[::1]:0")
+		if err != nil {
+			t.Skip("IPv6 loopback isn't available")
+		}
+		defer service.Close()
+		port := strconv.Itoa(service.Addr().(*net.TCPAddr).Port)
+
+		d := &execForwardDialer{Timeout: 5 * time.Second}
+		conn, err := d.Dial("tcp", "", port)
+		require.NoError(err)
+		conn.Close()
+
+		// With only 127.0.0.1 as the target, the error says what was tried
+		_, err = d.Dial("tcp", "127.0.0.1", port)
+		var dialErr *tunnel.DialError
+		require.True(errors.As(err, &dialErr))
+		require.Len(dialErr.Attempts, 1)
+		require.Equal(net.JoinHostPort("127.0.0.1", port), dialErr.Attempts[0].Addr)
+	})
+
+	t.Run("order", func(t *testing.T) {
+		lookup := func(context.Context, string) ([]net.IPAddr, error) {
+			return []net.IPAddr{
+				{IP: net.ParseIP("::1")},
+				{IP: net.ParseIP("10.0.0.1")},
+				{IP: net.ParseIP("fd00::1")},
+				{IP: net.ParseIP("10.0.0.2")},
+			}, nil
+		}
+
+		cases := []struct {
+			Family   pb.ExecStreamRequest_PortForward_Family
+			Host     string
+			Expected []string
+		}{
+			{pb.ExecStreamRequest_PortForward_ANY, "", []string{"127.0.0.1", "::1"}},
+			{pb.ExecStreamRequest_PortForward_IPV6, "", []string{"::1", "127.0.0.1"}},
+			{pb.ExecStreamRequest_PortForward_IPV6, "10.1.1.1", []string{"10.1.1.1"}},
+			{pb.ExecStreamRequest_PortForward_IPV4, "db",
+				[]string{"10.0.0.1", "10.0.0.2", "::1", "fd00::1"}},
+			{pb.ExecStreamRequest_PortForward_IPV6, "db",
+				[]string{"::1", "fd00::1", "10.0.0.1", "10.0.0.2"}},
+		}
+
+		for _, tt := range cases {
+			d := &execForwardDialer{Family: tt.Family, Timeout: time.Second, Lookup: lookup}
+			ips, err := d.addrs(tt.Host)
+			require.NoError(t, err)
+
+			var actual []string
+			for _, ip := range ips {
+				actual = append(actual, ip.String())
+			}
+			require.Equal(t, tt.Expected, actual, "%s %q", tt.Family, tt.Host)
+		}
+	})
+}
+
+// testExecSignalHelper starts a CEB and an exec session running the
+// "write-file-on-signal" helper. This returns once the helper is running.
+func testExecSignalHelper(
+	t *testing.T,
+	ctx context.Context,
+) (pb.Waypoint_StartExecStreamClient, string) {
+	require := require.New(t)
+
+	// Create a temporary directory for our test
+	td, err := ioutil.TempDir("", "test")
+	require.NoError(err)
+	t.Cleanup(func() { os.RemoveAll(td) })
+	path := filepath.Join(td, "hello")
+
+	stream, _ := testExecStart(t, ctx, "write-file-on-signal", map[string]string{
+		"HELPER_PATH": path,
+	}, nil)
+
+	// Wait for the helper to tell us it is ready
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.IsType((*pb.ExecStreamResponse_Output_)(nil), resp.Event)
+
+	return stream, path
+}
+
+func TestExec_outputChunked(t *testing.T) {
+	require := require.New(t)
+
+	const max = 64 * 1024
+	ceb := &CEB{maxMessageSize: max}
+	stream := &testMaxMsgStream{max: max}
+	w := ceb.execOutputWriter(stream, pb.EntrypointExecRequest_Output_STDOUT, 0)
+
+	// A single write that is far larger than the max message size
+	data := bytes.Repeat([]byte("0123456789abcdef"), 20*1024*1024/16)
+	n, err := w.Write(data)
+	require.NoError(err)
+	require.Equal(len(data), n)
+	require.True(stream.count > len(data)/max)
+	require.Equal(data, stream.data.Bytes())
+}
+
+func TestExec_outputChunkSize(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(0, execOutputChunkSize(0))
+	require.Equal(execOutputChunkMin, execOutputChunkSize(1))
+	require.Equal(4096, execOutputChunkSize(4096))
+	require.Equal(execOutputChunkMax, execOutputChunkSize(1<<30))
+
+	const max = 64 * 1024
+	ceb := &CEB{maxMessageSize: max}
+	stream := &testMaxMsgStream{max: max}
+	w := ceb.execOutputWriter(stream, pb.EntrypointExecRequest_Output_STDOUT, 1024)
+
+	// Reads of 4KB are each sent as four messages of 1KB.
+	data := bytes.Repeat([]byte("0123456789abcdef"), 10*1024/16)
+	n, err := execCopyOutput(w, bytes.NewReader(data), 4096)
+	require.NoError(err)
+	require.Equal(int64(len(data)), n)
+	require.Equal(10, stream.count)
+	require.Equal(data, stream.data.Bytes())
+}
+
+// BenchmarkExecOutput_chunkSize measures copying the output of a command
+// to the stream with each output chunk size, marshaling each message as
+// a stand in for its cost on the stream. Smaller chunks cost more per
+// byte here but are sent sooner, which this doesn't measure; weigh the
+// two for the link with:
+//
+//	go test -run XXX -bench BenchmarkExecOutput -benchmem ./internal/ceb
+func BenchmarkExecOutput_chunkSize(b *testing.B) {
+	const size = 1024 * 1024
+	data := bytes.Repeat([]byte("x"), size)
+
+	for _, chunk := range []int{0, 512, 4 * 1024, 32 * 1024, 256 * 1024, execOutputChunkMax} {
+		name := fmt.Sprintf("%dB", chunk)
+		if chunk == 0 {
+			name = "default"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			ceb := &CEB{maxMessageSize: DefaultMaxMessageSize}
+			w := ceb.execOutputWriter(testMarshalStream{}, pb.EntrypointExecRequest_Output_STDOUT, chunk)
+
+			b.SetBytes(size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := execCopyOutput(w, bytes.NewReader(data), chunk); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// testExecStart starts a CEB running the given helper and then starts
+// an exec session with the given start event. See testExecSession.
+func testExecStart(
+	t *testing.T,
+	ctx context.Context,
+	helper string,
+	env map[string]string,
+	start *pb.ExecStreamRequest_Start,
+) (pb.Waypoint_StartExecStreamClient, *pb.ExecStreamResponse_Attached) {
+	client, ceb := testExecCEB(t, ctx, helper, env)
+	return testExecSession(t, ctx, client, ceb, start)
+}
+
+// testExecCEB starts a CEB running the given helper and waits for it
+// to register.
+func testExecCEB(
+	t *testing.T,
+	ctx context.Context,
+	helper string,
+	env map[string]string,
+) (pb.WaypointClient, *CEB) {
+	require := require.New(t)
+
+	// Start the CEB
+	client := singleprocess.TestServer(t)
+	ceb := testRun(t, ctx, &testRunOpts{
+		Client:    client,
+		Helper:    helper,
+		HelperEnv: env,
+	})
+
+	// Wait for registration
+	require.Eventually(func() bool {
+		resp, err := client.ListInstances(ctx, &pb.ListInstancesRequest{
+			Scope: &pb.ListInstancesRequest_DeploymentId{
+				DeploymentId: ceb.DeploymentId(),
+			},
+		})
+		require.NoError(err)
+		return len(resp.Instances) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return client, ceb
+}
+
+// testExecSession starts an exec session with the given start event. If
+// the start event has no args then the exec runs the same helper as the
+// CEB. This returns once the instance has attached to the exec session.
+func testExecSession(
+	t *testing.T,
+	ctx context.Context,
+	client pb.WaypointClient,
+	ceb *CEB,
+	start *pb.ExecStreamRequest_Start,
+) (pb.Waypoint_StartExecStreamClient, *pb.ExecStreamResponse_Attached) {
+	require := require.New(t)
+
+	if start == nil {
+		start = &pb.ExecStreamRequest_Start{}
+	}
+	start.DeploymentId = ceb.DeploymentId()
+	if len(start.Args) == 0 {
+		start.Args = []string{testExec}
+	}
+
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: start,
+		},
+	}))
+
+	// Wait for open and then for the instance to attach
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.IsType((*pb.ExecStreamResponse_Open_)(nil), resp.Event)
+	resp, err = stream.Recv()
+	require.NoError(err)
+	attached, ok := resp.Event.(*pb.ExecStreamResponse_Attached_)
+	require.True(ok, "should be attached")
+
+	return stream, attached.Attached
+}
+
+// testMarshalStream is a grpc.ClientStream that marshals the messages it
+// is sent and then discards them.
+type testMarshalStream struct {
+	grpc.ClientStream
+}
+
+func (testMarshalStream) SendMsg(m interface{}) error {
+	_, err := proto.Marshal(m.(proto.Message))
+	return err
+}
+
+// testMaxMsgStream is a grpc.ClientStream that fails any message larger
+// than max and records the output data of the messages it is sent.
+type testMaxMsgStream struct {
+	grpc.ClientStream
+
+	max   int
+	count int
+	data  bytes.Buffer
+}
+
+func (s *testMaxMsgStream) SendMsg(m interface{}) error {
+	req := m.(*pb.EntrypointExecRequest)
+	if size := proto.Size(req); size > s.max {
+		return status.Errorf(codes.ResourceExhausted,
+			"message larger than max (%d vs. %d)", size, s.max)
+	}
+
+	s.count++
+	output := req.Event.(*pb.EntrypointExecRequest_Output_).Output
+	if output.Sequence != uint64(s.count) {
+		return fmt.Errorf("message %d has sequence %d", s.count, output.Sequence)
+	}
+
+	s.data.Write(output.Data)
+	return nil
+}
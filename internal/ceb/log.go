@@ -3,8 +3,10 @@ package ceb
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-hclog"
@@ -18,102 +20,202 @@ import (
 func (ceb *CEB) initLogStream(ctx context.Context, cfg *config) error {
 	log := ceb.logger.Named("log")
 
-	r, w, err := os.Pipe()
+	// We use a pipe for each of stdout and stderr so that we can tag each
+	// line with the stream it was written to.
+	stdoutR, stdoutW, err := os.Pipe()
 	if err != nil {
 		return err
 	}
+	stderrR, stderrW, err := os.Pipe()
+	if err != nil {
+		stdoutR.Close()
+		stdoutW.Close()
+		return err
+	}
 
 	// Set our output for the command. We use a multiwriter so that we
 	// can always send the out/err back to the normal channels so that
 	// users can see it.
-	ceb.childCmd.Stdout = io.MultiWriter(w, ceb.childCmd.Stdout)
-	ceb.childCmd.Stderr = io.MultiWriter(w, ceb.childCmd.Stderr)
+	ceb.childCmd.Stdout = io.MultiWriter(stdoutW, ceb.childCmd.Stdout)
+	ceb.childCmd.Stderr = io.MultiWriter(stderrW, ceb.childCmd.Stderr)
 
-	// We need to start a goroutine to read from our pipe. If we don't
-	// read from the pipe the child command will get a SIGPIPE and could
+	// We need to start goroutines to read from our pipes. If we don't
+	// read from a pipe the child command will get a SIGPIPE and could
 	// exit/crash if it doesn't handle it. So even if we don't have a
-	// connection to the server, we need to be draining the pipe.
-	entryCh := make(chan *pb.LogBatch_Entry, 30)
-	go func() {
-		defer r.Close()
-		br := bufio.NewReader(r)
-		for {
-			line, err := br.ReadString('\n')
-			if err != nil {
-				log.Error("error reading logs", "error", err)
-				return
-			}
+	// connection to the server, we need to be draining the pipes.
+	//
+	// The lines are buffered until they're sent. If the buffer fills up,
+	// such as while we're disconnected, the oldest lines are dropped.
+	buf := ceb.logLineBuffer()
+	go ceb.readLogPipe(log, stdoutR, pb.LogBatch_Entry_STDOUT, buf)
+	go ceb.readLogPipe(log, stderrR, pb.LogBatch_Entry_STDERR, buf)
 
-			if log.IsTrace() {
-				log.Trace("sending line", "line", line[:len(line)-1])
-			}
+	// Start up our server stream. We do this in a goroutine cause we don't
+	// want to block the child command startup on it.
+	go ceb.runLogStreamSender(log, ctx, buf)
 
-			entry := &pb.LogBatch_Entry{
-				Timestamp: ptypes.TimestampNow(),
-				Line:      line,
-			}
+	return nil
+}
 
-			// Send the entry. We never block here because blocking the
-			// pipe is worse. The channel is buffered to help with this.
-			select {
-			case entryCh <- entry:
-			default:
-			}
+// logLineBuffer returns the buffer of log lines waiting to be sent,
+// creating it the first time.
+func (ceb *CEB) logLineBuffer() *logBuffer {
+	ceb.logLinesOnce.Do(func() {
+		ceb.logLines = newLogBuffer(ceb.logBufferSize)
+	})
+
+	return ceb.logLines
+}
+
+// readLogPipe reads the lines written to r and pushes them to buf tagged
+// with source until r is closed.
+func (ceb *CEB) readLogPipe(
+	log hclog.Logger,
+	r io.ReadCloser,
+	source pb.LogBatch_Entry_Source,
+	buf *logBuffer,
+) {
+	defer r.Close()
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			log.Error("error reading logs", "error", err, "source", source.String())
+			return
 		}
-	}()
 
-	// Start up our server stream. We do this in a goroutine cause we don't
-	// want to block the child command startup on it.
-	go ceb.initLogStreamSender(log, ctx, entryCh)
+		if log.IsTrace() {
+			log.Trace("sending line", "line", line[:len(line)-1], "source", source.String())
+		}
 
-	return nil
+		// This never blocks because blocking the pipe is worse than
+		// dropping lines.
+		buf.Push(&pb.LogBatch_Entry{
+			Timestamp: ptypes.TimestampNow(),
+			Line:      line,
+			Source:    source,
+		})
+	}
+}
+
+// logSender sends batches of log entries to the server. This is
+// implemented by the log stream client and replaced in tests.
+type logSender interface {
+	Send(*pb.EntrypointLogBatch) error
 }
 
-func (ceb *CEB) initLogStreamSender(
+// runLogStreamSender sends the entries in buf to the server until ctx is
+// done, reconnecting if the server goes away. Entries keep being buffered
+// while we're disconnected.
+func (ceb *CEB) runLogStreamSender(
 	log hclog.Logger,
 	ctx context.Context,
-	entryCh <-chan *pb.LogBatch_Entry,
-) error {
-	// Open our log stream
-	log.Debug("connecting to log stream")
-	client, err := ceb.client.EntrypointLogStream(ctx, grpc.WaitForReady(true))
-	if err != nil {
-		return status.Errorf(codes.Aborted,
-			"failed to open a log stream: %s", err)
+	buf *logBuffer,
+) {
+	var pending *pb.EntrypointLogBatch
+	for {
+		// Open our log stream
+		log.Debug("connecting to log stream")
+		client, err := ceb.client.EntrypointLogStream(ctx, grpc.WaitForReady(true))
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Warn("failed to open a log stream", "error", err)
+			}
+			return
+		}
+		ceb.cleanup(func() { client.CloseAndRecv() })
+		log.Trace("log stream connected")
+
+		pending, err = ceb.sendLogs(ctx, client, buf, pending)
+		if err == io.EOF || status.Code(err) == codes.Unavailable {
+			log.Error("log stream disconnected from server, attempting reconnect")
+			continue
+		}
+		if err != nil && ctx.Err() == nil {
+			log.Warn("error sending logs", "error", err)
+		}
+
+		return
+	}
+}
+
+// sendLogs sends batches of the entries in buf to client until ctx is done
+// or sending fails. If pending is set, it is sent first. If sending fails,
+// the batch that failed is returned so that it can be sent again once we
+// reconnect.
+func (ceb *CEB) sendLogs(
+	ctx context.Context,
+	client logSender,
+	buf *logBuffer,
+	pending *pb.EntrypointLogBatch,
+) (*pb.EntrypointLogBatch, error) {
+	for {
+		if pending == nil {
+			var err error
+			pending, err = ceb.nextLogBatch(ctx, buf)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := client.Send(pending); err != nil {
+			return pending, err
+		}
+
+		pending = nil
+	}
+}
+
+// nextLogBatch waits for entries in buf and returns the next batch to
+// send. A batch is sent once it has logBatchSize entries, or once
+// logFlushInterval has passed since we started waiting for it to fill.
+//
+// If entries were dropped because the buffer was full, the batch starts
+// with a line saying how many so that the gap is visible in the logs.
+func (ceb *CEB) nextLogBatch(ctx context.Context, buf *logBuffer) (*pb.EntrypointLogBatch, error) {
+	for buf.Len() == 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-buf.Notify():
+		}
 	}
-	ceb.cleanup(func() { client.CloseAndRecv() })
-	log.Trace("log stream connected")
-
-	// NOTE(mitchellh): Lots of improvements we can make here one day:
-	//   - we can coalesce entryCh receives to send less log updates
-	//   - during reconnect we can buffer entryCh receives
-	go func() {
-		for {
-			var entry *pb.LogBatch_Entry
+
+	if ceb.logFlushInterval > 0 && buf.Len() < ceb.logBatchSize {
+		timer := time.NewTimer(ceb.logFlushInterval)
+		defer timer.Stop()
+
+	WAIT:
+		for buf.Len() < ceb.logBatchSize {
 			select {
 			case <-ctx.Done():
-				return
+				return nil, ctx.Err()
 
-			case entry = <-entryCh:
-			}
+			case <-timer.C:
+				break WAIT
 
-			err := client.Send(&pb.EntrypointLogBatch{
-				InstanceId: ceb.id,
-				Lines:      []*pb.LogBatch_Entry{entry},
-			})
-			if err == io.EOF || status.Code(err) == codes.Unavailable {
-				log.Error("log stream disconnected from server, attempting reconnect")
-				err = ceb.initLogStreamSender(log, ctx, entryCh)
-				if err == nil {
-					return
-				}
-			}
-			if err != nil {
-				log.Warn("error sending logs", "error", err)
-				return
+			case <-buf.Notify():
 			}
 		}
-	}()
+	}
 
-	return nil
+	// We leave half of the message for the overhead of each entry.
+	entries, dropped := buf.Take(ceb.logBatchSize, ceb.maxMessageSize/2)
+	if dropped > 0 {
+		ts := ptypes.TimestampNow()
+		if len(entries) > 0 {
+			ts = entries[0].Timestamp
+		}
+
+		entries = append([]*pb.LogBatch_Entry{{
+			Timestamp: ts,
+			Line:      fmt.Sprintf("[waypoint: dropped %d log entries]\n", dropped),
+		}}, entries...)
+	}
+
+	return &pb.EntrypointLogBatch{
+		InstanceId: ceb.id,
+		Lines:      entries,
+	}, nil
 }
@@ -0,0 +1,105 @@
+package ceb
+
+import (
+	"sync"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// logBuffer is a bounded ring buffer of log entries waiting to be sent to
+// the server. Pushing never blocks: once the buffer is full, the oldest
+// entry is dropped to make room and counted so that the drop can be
+// reported with the next batch taken.
+type logBuffer struct {
+	lock    sync.Mutex
+	entries []*pb.LogBatch_Entry
+	head    int
+	len     int
+	dropped uint64
+
+	// notifyCh receives a value when entries are pushed. It is buffered
+	// so that pushing doesn't block on a receiver.
+	notifyCh chan struct{}
+}
+
+// newLogBuffer returns a buffer holding up to size entries.
+func newLogBuffer(size int) *logBuffer {
+	if size < 1 {
+		size = 1
+	}
+
+	return &logBuffer{
+		entries:  make([]*pb.LogBatch_Entry, size),
+		notifyCh: make(chan struct{}, 1),
+	}
+}
+
+// Push adds an entry, dropping the oldest entry if the buffer is full.
+func (b *logBuffer) Push(entry *pb.LogBatch_Entry) {
+	b.lock.Lock()
+	if b.len == len(b.entries) {
+		b.entries[b.head] = nil
+		b.head = (b.head + 1) % len(b.entries)
+		b.len--
+		b.dropped++
+	}
+
+	b.entries[(b.head+b.len)%len(b.entries)] = entry
+	b.len++
+	b.lock.Unlock()
+
+	select {
+	case b.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Notify returns a channel that receives a value after entries are
+// pushed. Only one value is buffered, so after receiving from it callers
+// should check Len rather than assume a single entry was pushed.
+func (b *logBuffer) Notify() <-chan struct{} {
+	return b.notifyCh
+}
+
+// Len returns the number of entries in the buffer.
+func (b *logBuffer) Len() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.len
+}
+
+// Take removes and returns the oldest entries, up to max entries and
+// maxBytes bytes of lines. At least one entry is returned if the buffer
+// isn't empty, even if it is larger than maxBytes. maxBytes of zero or
+// less doesn't limit the size.
+//
+// This also returns the number of entries dropped since the last call
+// and resets it.
+func (b *logBuffer) Take(max, maxBytes int) ([]*pb.LogBatch_Entry, uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	n := b.len
+	if max > 0 && n > max {
+		n = max
+	}
+
+	result := make([]*pb.LogBatch_Entry, 0, n)
+	size := 0
+	for len(result) < n {
+		entry := b.entries[b.head]
+		size += len(entry.Line)
+		if maxBytes > 0 && size > maxBytes && len(result) > 0 {
+			break
+		}
+
+		result = append(result, entry)
+		b.entries[b.head] = nil
+		b.head = (b.head + 1) % len(b.entries)
+		b.len--
+	}
+
+	dropped := b.dropped
+	b.dropped = 0
+	return result, dropped
+}
@@ -0,0 +1,116 @@
+package ceb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestLogBuffer(t *testing.T) {
+	t.Run("take in order", func(t *testing.T) {
+		require := require.New(t)
+
+		buf := newLogBuffer(5)
+		testPushLines(buf, "a", "b", "c")
+		require.Equal(3, buf.Len())
+
+		entries, dropped := buf.Take(2, 0)
+		require.Equal([]string{"a", "b"}, testLines(entries))
+		require.Zero(dropped)
+
+		entries, dropped = buf.Take(2, 0)
+		require.Equal([]string{"c"}, testLines(entries))
+		require.Zero(dropped)
+		require.Zero(buf.Len())
+	})
+
+	t.Run("drops the oldest entries", func(t *testing.T) {
+		require := require.New(t)
+
+		buf := newLogBuffer(3)
+		testPushLines(buf, "a", "b", "c", "d", "e")
+		require.Equal(3, buf.Len())
+
+		entries, dropped := buf.Take(10, 0)
+		require.Equal([]string{"c", "d", "e"}, testLines(entries))
+		require.Equal(uint64(2), dropped)
+
+		// The count is reset once taken
+		testPushLines(buf, "f")
+		entries, dropped = buf.Take(10, 0)
+		require.Equal([]string{"f"}, testLines(entries))
+		require.Zero(dropped)
+	})
+
+	t.Run("counts drops across takes", func(t *testing.T) {
+		require := require.New(t)
+
+		buf := newLogBuffer(2)
+		for i := 0; i < 1000; i++ {
+			testPushLines(buf, fmt.Sprint(i))
+		}
+
+		entries, dropped := buf.Take(1, 0)
+		require.Equal([]string{"998"}, testLines(entries))
+		require.Equal(uint64(998), dropped)
+
+		entries, dropped = buf.Take(1, 0)
+		require.Equal([]string{"999"}, testLines(entries))
+		require.Zero(dropped)
+	})
+
+	t.Run("limits bytes", func(t *testing.T) {
+		require := require.New(t)
+
+		buf := newLogBuffer(5)
+		testPushLines(buf, "aaaa", "bbbb", "cccc")
+
+		entries, _ := buf.Take(0, 9)
+		require.Equal([]string{"aaaa", "bbbb"}, testLines(entries))
+
+		// A single entry over the limit is still taken
+		entries, _ = buf.Take(0, 2)
+		require.Equal([]string{"cccc"}, testLines(entries))
+	})
+
+	t.Run("notifies", func(t *testing.T) {
+		require := require.New(t)
+
+		buf := newLogBuffer(5)
+		testPushLines(buf, "a", "b")
+
+		select {
+		case <-buf.Notify():
+		default:
+			t.Fatal("should notify")
+		}
+
+		select {
+		case <-buf.Notify():
+			t.Fatal("should notify once")
+		default:
+		}
+
+		require.Equal(2, buf.Len())
+	})
+}
+
+// testPushLines pushes an entry for each line.
+func testPushLines(buf *logBuffer, lines ...string) {
+	for _, line := range lines {
+		buf.Push(&pb.LogBatch_Entry{Line: line})
+	}
+}
+
+// testLines returns the lines of entries.
+func testLines(entries []*pb.LogBatch_Entry) []string {
+	var result []string
+	for _, entry := range entries {
+		result = append(result, entry.Line)
+	}
+
+	return result
+}
@@ -2,9 +2,13 @@ package ceb
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc"
 
 	"github.com/hashicorp/waypoint/internal/server"
@@ -59,6 +63,57 @@ func TestLogs_basic(t *testing.T) {
 	}, 1*time.Second, 50*time.Millisecond)
 }
 
+func TestLogs_source(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start up the server
+	impl := singleprocess.TestImpl(t)
+	client := server.TestServer(t, impl, server.TestWithContext(ctx))
+
+	// Start the CEB
+	ceb := testRun(t, context.Background(), &testRunOpts{
+		Client: client,
+		Helper: "logs-stdout-stderr",
+	})
+
+	// We should get registered
+	require.Eventually(func() bool {
+		resp, err := client.ListInstances(ctx, &pb.ListInstancesRequest{
+			Scope: &pb.ListInstancesRequest_DeploymentId{
+				DeploymentId: ceb.DeploymentId(),
+			},
+		})
+		require.NoError(err)
+		return len(resp.Instances) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Get the log stream
+	stream, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+		Scope: &pb.GetLogStreamRequest_DeploymentId{
+			DeploymentId: ceb.DeploymentId(),
+		},
+	})
+	require.NoError(err)
+
+	// Each line is tagged with the stream it was written to
+	sources := map[string]pb.LogBatch_Entry_Source{}
+	require.Eventually(func() bool {
+		batch, err := stream.Recv()
+		require.NoError(err)
+		for _, entry := range batch.Lines {
+			sources[entry.Line] = entry.Source
+		}
+
+		return len(sources) == 2
+	}, 2*time.Second, 50*time.Millisecond)
+	require.Equal(map[string]pb.LogBatch_Entry_Source{
+		"out\n": pb.LogBatch_Entry_STDOUT,
+		"err\n": pb.LogBatch_Entry_STDERR,
+	}, sources)
+}
+
 func TestLogs_reconnect(t *testing.T) {
 	require := require.New(t)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -140,3 +195,165 @@ func TestLogs_reconnect(t *testing.T) {
 		return len(batch.Lines) > 0
 	}, 1*time.Second, 50*time.Millisecond)
 }
+
+func TestLogs_batch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("full batches are sent right away", func(t *testing.T) {
+		require := require.New(t)
+
+		ceb := &CEB{id: "i1", logBatchSize: 2, logFlushInterval: time.Hour}
+		buf := newLogBuffer(10)
+		testPushLines(buf, "a", "b", "c")
+
+		batch, err := ceb.nextLogBatch(ctx, buf)
+		require.NoError(err)
+		require.Equal("i1", batch.InstanceId)
+		require.Equal([]string{"a", "b"}, testLines(batch.Lines))
+	})
+
+	t.Run("partial batches are sent after the interval", func(t *testing.T) {
+		require := require.New(t)
+
+		ceb := &CEB{logBatchSize: 10, logFlushInterval: 50 * time.Millisecond}
+		buf := newLogBuffer(10)
+		testPushLines(buf, "a")
+
+		start := time.Now()
+		batch, err := ceb.nextLogBatch(ctx, buf)
+		require.NoError(err)
+		require.Equal([]string{"a"}, testLines(batch.Lines))
+		require.True(time.Since(start) >= 50*time.Millisecond)
+	})
+
+	t.Run("waits for entries", func(t *testing.T) {
+		require := require.New(t)
+
+		ceb := &CEB{logBatchSize: 10}
+		buf := newLogBuffer(10)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			testPushLines(buf, "a")
+		}()
+
+		batch, err := ceb.nextLogBatch(ctx, buf)
+		require.NoError(err)
+		require.Equal([]string{"a"}, testLines(batch.Lines))
+	})
+
+	t.Run("reports dropped entries", func(t *testing.T) {
+		require := require.New(t)
+
+		ceb := &CEB{logBatchSize: 10}
+		buf := newLogBuffer(2)
+		testPushLines(buf, "a", "b", "c", "d", "e")
+
+		batch, err := ceb.nextLogBatch(ctx, buf)
+		require.NoError(err)
+		require.Equal([]string{
+			"[waypoint: dropped 3 log entries]\n", "d", "e",
+		}, testLines(batch.Lines))
+	})
+
+	t.Run("resends the batch that failed", func(t *testing.T) {
+		require := require.New(t)
+
+		ceb := &CEB{logBatchSize: 10}
+		buf := newLogBuffer(10)
+		testPushLines(buf, "a")
+
+		client := &testLogSender{err: io.EOF}
+		pending, err := ceb.sendLogs(ctx, client, buf, nil)
+		require.Equal(io.EOF, err)
+		require.Equal([]string{"a"}, testLines(pending.Lines))
+
+		sendCtx, sendCancel := context.WithCancel(ctx)
+		client = &testLogSender{
+			onSend: func(*pb.EntrypointLogBatch) { sendCancel() },
+		}
+		_, err = ceb.sendLogs(sendCtx, client, buf, pending)
+		require.Equal(context.Canceled, err)
+		require.Len(client.batches, 1)
+		require.Equal([]string{"a"}, testLines(client.batches[0].Lines))
+	})
+}
+
+// The log benchmarks send lines through the buffer to a fake sender that
+// marshals each batch, which stands in for the cost of each message on
+// the stream. Compare the lines/s of the batch sizes with:
+//
+//	go test -run XXX -bench BenchmarkLogs -benchmem ./internal/ceb
+func BenchmarkLogs_send(b *testing.B) {
+	for _, size := range []int{1, 16, DefaultLogBatchSize} {
+		b.Run(fmt.Sprintf("batch-%d", size), func(b *testing.B) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// We don't wait for batches to fill so that the last one
+			// isn't delayed.
+			ceb := &CEB{
+				id:             "i1",
+				logBatchSize:   size,
+				maxMessageSize: DefaultMaxMessageSize,
+			}
+
+			buf := newLogBuffer(b.N)
+			line := strings.Repeat("x", 99) + "\n"
+
+			var sent int
+			client := &testLogSender{
+				marshal: true,
+				onSend: func(batch *pb.EntrypointLogBatch) {
+					sent += len(batch.Lines)
+					if sent >= b.N {
+						cancel()
+					}
+				},
+			}
+
+			b.ResetTimer()
+			start := time.Now()
+			go func() {
+				for i := 0; i < b.N; i++ {
+					buf.Push(&pb.LogBatch_Entry{Line: line})
+				}
+			}()
+
+			_, err := ceb.sendLogs(ctx, client, buf, nil)
+			if err != context.Canceled {
+				b.Fatal(err)
+			}
+
+			b.ReportMetric(float64(sent)/time.Since(start).Seconds(), "lines/s")
+		})
+	}
+}
+
+// testLogSender records the batches sent to it, or fails with err.
+type testLogSender struct {
+	err     error
+	marshal bool
+	onSend  func(*pb.EntrypointLogBatch)
+	batches []*pb.EntrypointLogBatch
+}
+
+func (s *testLogSender) Send(batch *pb.EntrypointLogBatch) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	if s.marshal {
+		if _, err := proto.Marshal(batch); err != nil {
+			return err
+		}
+	} else {
+		s.batches = append(s.batches, batch)
+	}
+
+	if s.onSend != nil {
+		s.onSend(batch)
+	}
+
+	return nil
+}
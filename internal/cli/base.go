@@ -4,14 +4,14 @@ import (
 	"context"
 	"errors"
 	"io"
-	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
-	"github.com/adrg/xdg"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/hcl/v2"
+	"google.golang.org/grpc"
 
 	"github.com/hashicorp/waypoint/internal/clicontext"
 	clientpkg "github.com/hashicorp/waypoint/internal/client"
@@ -91,6 +91,13 @@ type baseCommand struct {
 	// flagConnection contains manual flag-based connection info.
 	flagConnection clicontext.Config
 
+	// flagDialTimeout is how long to wait to connect to the server, if set.
+	flagDialTimeout time.Duration
+
+	// dialOptions are additional gRPC options for connecting to the
+	// server, set with WithDialOptions.
+	dialOptions []grpc.DialOption
+
 	// args that were present after parsing flags
 	args []string
 
@@ -137,6 +144,7 @@ func (c *baseCommand) Init(opts ...Option) error {
 	}
 
 	c.ui = ui
+	c.dialOptions = baseCfg.DialOptions
 
 	// Parse flags
 	if err := baseCfg.Flags.Parse(baseCfg.Args); err != nil {
@@ -153,18 +161,8 @@ func (c *baseCommand) Init(opts ...Option) error {
 	// With the flags we now know what workspace we're targeting
 	c.refWorkspace = &pb.Ref_Workspace{Workspace: c.flagWorkspace}
 
-	// Setup our base config path
-	homeConfigPath, err := xdg.ConfigFile("waypoint/.ignore")
-	if err != nil {
-		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
-		return err
-	}
-	homeConfigPath = filepath.Dir(homeConfigPath)
-	c.Log.Debug("home configuration directory", "path", homeConfigPath)
-
 	// Setup our base directory for context management
-	contextStorage, err := clicontext.NewStorage(
-		clicontext.WithDir(filepath.Join(homeConfigPath, "context")))
+	contextStorage, err := c.initContextStorage()
 	if err != nil {
 		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 		return err
@@ -317,6 +315,16 @@ func (c *baseCommand) DoApp(ctx context.Context, f func(context.Context, *client
 	return finalErr
 }
 
+// serverAddr returns the address of the server we're connected to, or
+// an empty string if we're using a local server.
+func (c *baseCommand) serverAddr() string {
+	if c.clientContext == nil {
+		return ""
+	}
+
+	return c.clientContext.Server.Address
+}
+
 // logError logs an error and outputs it to the UI.
 func (c *baseCommand) logError(log hclog.Logger, prefix string, err error) {
 	if err == ErrSentinel {
@@ -360,6 +368,14 @@ func (c *baseCommand) flagSet(bit flagSetBit, f func(*flag.Sets)) *flag.Sets {
 			Default: "default",
 			Usage:   "Workspace to operate in.",
 		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:   "server-dial-timeout",
+			Target: &c.flagDialTimeout,
+			Usage: "How long to wait to connect to the server, such as \"30s\". " +
+				"The server is reached through the proxy given by the HTTPS_PROXY " +
+				"and NO_PROXY environment variables, if set. Defaults to 5 seconds.",
+		})
 	}
 
 	if bit&flagSetOperation != 0 {
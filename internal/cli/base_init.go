@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/adrg/xdg"
 	"github.com/hashicorp/hcl/v2/hclsimple"
+	"google.golang.org/grpc"
 
 	"github.com/hashicorp/waypoint/internal/clicontext"
 	clientpkg "github.com/hashicorp/waypoint/internal/client"
@@ -65,21 +67,54 @@ func (c *baseCommand) initConfigLoad(path string) (*configpkg.Config, error) {
 	return &cfg, nil
 }
 
-// initClient initializes the client.
-func (c *baseCommand) initClient() (*clientpkg.Project, error) {
+// initContextStorage returns the storage for CLI contexts, which lives
+// in our home configuration directory.
+func (c *baseCommand) initContextStorage() (*clicontext.Storage, error) {
+	homeConfigPath, err := xdg.ConfigFile("waypoint/.ignore")
+	if err != nil {
+		return nil, err
+	}
+	homeConfigPath = filepath.Dir(homeConfigPath)
+	c.Log.Debug("home configuration directory", "path", homeConfigPath)
+
+	return clicontext.NewStorage(
+		clicontext.WithDir(filepath.Join(homeConfigPath, "context")))
+}
+
+// initConnectOpts returns the options to connect to the server from our
+// flags, the current context, and the environment, in that order.
+func (c *baseCommand) initConnectOpts() []serverclient.ConnectOption {
 	// We use our flag-based connection info if the user set an addr.
 	var flagConnection *clicontext.Config
 	if v := c.flagConnection; v.Server.Address != "" {
 		flagConnection = &v
 	}
 
-	// Get the context we'll use.
-	var err error
-	connectOpts := []serverclient.ConnectOption{
+	opts := []serverclient.ConnectOption{
 		serverclient.FromContextConfig(flagConnection),
 		serverclient.FromContext(c.contextStorage, ""),
 		serverclient.FromEnv(),
+
+		// The server may only be reachable through a proxy. gRPC honors
+		// the proxy environment by itself only without a custom dialer,
+		// so we set ours explicitly. A dialer from WithDialOptions comes
+		// after and replaces it.
+		serverclient.DialOptions(
+			grpc.WithContextDialer(serverclient.ProxyDialer(nil))),
+		serverclient.DialOptions(c.dialOptions...),
+	}
+	if c.flagDialTimeout > 0 {
+		opts = append(opts, serverclient.Timeout(c.flagDialTimeout))
 	}
+
+	return opts
+}
+
+// initClient initializes the client.
+func (c *baseCommand) initClient() (*clientpkg.Project, error) {
+	// Get the context we'll use.
+	var err error
+	connectOpts := c.initConnectOpts()
 	c.clientContext, err = serverclient.ContextConfig(connectOpts...)
 	if err != nil {
 		return nil, err
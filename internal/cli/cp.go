@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+type CopyCommand struct {
+	*baseCommand
+
+	flagDeployment     string
+	flagInstance       string
+	flagContainer      bool
+	flagFollowSymlinks bool
+	flagResume         bool
+}
+
+func (c *CopyCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 2 {
+		c.ui.Output("A source and a destination are required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	src, dst := parseCopyPath(c.args[0]), parseCopyPath(c.args[1])
+	if src.Remote == dst.Remote {
+		c.ui.Output("One of the source or destination must be a path on the "+
+			"instance, such as \"app:/tmp/file\".", terminal.WithErrorStyle())
+		return 1
+	}
+
+	remote := dst
+	if src.Remote {
+		remote = src
+	}
+
+	appName, err := c.copyApp(remote)
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	app := c.project.App(appName)
+	client := c.project.Client()
+	deployment, err := execResolveDeployment(
+		c.Ctx, client, app.Ref(), c.project.WorkspaceRef(), c.flagDeployment)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	execClient := &execclient.Client{
+		Logger:          c.Log,
+		UI:              c.ui,
+		Context:         c.Ctx,
+		Client:          client,
+		ServerAddr:      c.serverAddr(),
+		DeploymentId:    deployment.Id,
+		DeploymentSeq:   deployment.Sequence,
+		InstanceId:      c.flagInstance,
+		TargetContainer: c.flagContainer,
+		FollowSymlinks:  c.flagFollowSymlinks,
+		Resume:          c.flagResume,
+	}
+
+	if src.Remote {
+		err = execClient.CopyFrom(c.Ctx, src.Path, dst.Path)
+	} else {
+		err = execClient.CopyTo(c.Ctx, src.Path, dst.Path)
+	}
+	var copyErr *execclient.CopyError
+	if errors.As(err, &copyErr) {
+		c.ui.Output("Some files couldn't be copied:", terminal.WithErrorStyle())
+		for _, fileErr := range copyErr.Errors {
+			c.ui.Output("  %s", fileErr, terminal.WithErrorStyle())
+		}
+
+		return 1
+	}
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return 0
+}
+
+// copyApp returns the name of the app to copy to or from. This is the app
+// in the path if it has one, otherwise the -app flag or the only app.
+func (c *CopyCommand) copyApp(p copyPath) (string, error) {
+	switch {
+	case p.App != "":
+		return p.App, nil
+
+	case c.flagApp != "":
+		return c.flagApp, nil
+
+	case c.cfg != nil && len(c.cfg.Apps) == 1:
+		return c.cfg.Apps[0].Name, nil
+
+	default:
+		return "", errors.New(errAppModeSingle)
+	}
+}
+
+// copyPath is a source or destination of cp.
+type copyPath struct {
+	// Remote is true if this is a path on the instance. App is the app
+	// given with the path, if any.
+	Remote bool
+	App    string
+	Path   string
+}
+
+// parseCopyPath parses a cp argument. Paths on an instance are written as
+// "app:path" or ":path" for the default app. Like "docker cp", a colon
+// after a slash is part of a local path, so "./a:b" is local.
+func parseCopyPath(v string) copyPath {
+	idx := strings.Index(v, ":")
+	if idx < 0 || strings.ContainsAny(v[:idx], `/\`) {
+		return copyPath{Path: v}
+	}
+
+	return copyPath{Remote: true, App: v[:idx], Path: v[idx+1:]}
+}
+
+func (c *CopyCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "deployment",
+			Target: &c.flagDeployment,
+			Usage: "Deployment to copy to or from: \"latest\" (the default), a sequence " +
+				"number such as \"12\" or \"v12\", or a deployment ID.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "instance",
+			Target: &c.flagInstance,
+			Usage:  "ID of the instance of the deployment to copy to or from.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "container",
+			Target: &c.flagContainer,
+			Usage: "Use the filesystem of the application container. This " +
+				"is only needed if the entrypoint runs in a separate (sidecar) container.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "follow-symlinks",
+			Target: &c.flagFollowSymlinks,
+			Usage: "When copying to an instance, copy the files that local " +
+				"symlinks point to rather than the symlinks themselves.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "resume",
+			Target: &c.flagResume,
+			Usage: "Continue an interrupted copy of a single file rather than " +
+				"starting over. The part already at the destination is verified " +
+				"and only the rest is copied.",
+		})
+	})
+}
+
+func (c *CopyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *CopyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *CopyCommand) Synopsis() string {
+	return "Copy files to or from a running application instance"
+}
+
+func (c *CopyCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint cp [options] SOURCE [APP]:DEST
+       waypoint cp [options] [APP]:SOURCE DEST
+
+  Copy files and directories to or from a running application instance.
+
+  Paths on the instance are prefixed with the app name and a colon, such
+  as "web:/tmp/debug". The app name can be left out if the project has a
+  single app. If DEST is an existing directory, SOURCE is copied into it.
+  Otherwise SOURCE is created as DEST.
+
+  When copying from an instance, SOURCE may be a glob pattern such as
+  "web:/var/log/*.log". The pattern is expanded on the instance, and if it
+  matches more than one file, DEST must be an existing directory.
+
+  File modes are preserved. If some files can't be copied, the rest are
+  still copied and the ones that failed are listed.
+
+  If copying a large file is interrupted, run the same command again with
+  -resume to continue where it left off. The partial file at the
+  destination is compared in 1 MiB chunks and copying continues after the
+  last chunk that matches, or starts over if none do. The whole file is
+  checksummed when the copy completes.
+
+` + c.Flags().Help())
+}
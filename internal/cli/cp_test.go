@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCopyPath(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected copyPath
+	}{
+		{"./local", copyPath{Path: "./local"}},
+		{"web:/tmp/x", copyPath{Remote: true, App: "web", Path: "/tmp/x"}},
+		{":/tmp/x", copyPath{Remote: true, Path: "/tmp/x"}},
+		{"./a:b", copyPath{Path: "./a:b"}},
+		{"/abs/a:b", copyPath{Path: "/abs/a:b"}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Input, func(t *testing.T) {
+			require.Equal(t, tt.Expected, parseCopyPath(tt.Input))
+		})
+	}
+}
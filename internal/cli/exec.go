@@ -2,20 +2,90 @@ package cli
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/posener/complete"
+	sshterm "golang.org/x/crypto/ssh/terminal"
 
 	clientpkg "github.com/hashicorp/waypoint/internal/client"
 	"github.com/hashicorp/waypoint/internal/clierrors"
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/pkg/signalcontext"
 	"github.com/hashicorp/waypoint/internal/server/execclient"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 )
 
+// The exit codes of exec when the session fails rather than the command
+// exiting. See the help for their meaning.
+const (
+	execExitClosed    = 252
+	execExitMaxOutput = 253
+	execExitFailed    = 254
+	execExitCanceled  = 255
+)
+
 type ExecCommand struct {
 	*baseCommand
+
+	flagLimitMemory string
+	flagNice        int
+	flagRequirePTY  bool
+	flagContainer   string
+	flagStats       bool
+	flagSummary     bool
+	flagLatency     bool
+	flagSpill       string
+	flagMaxOutput   string
+	flagMaxAction   string
+	flagStrict      bool
+	flagInteractive bool
+	flagTTY         bool
+	flagForce       bool
+	flagIT          bool
+	flagDeployment  string
+	flagLabel       string
+	flagInstance    string
+	flagDetach      bool
+	flagYes         bool
+	flagStdoutFile  string
+	flagStderrFile  string
+	flagAppend      bool
+	flagNoMirror    bool
+	flagScript      string
+	flagInterpreter string
+	flagAll         bool
+	flagTask        string
+	flagHistory     bool
+	flagRerun       int
+	flagExactDeploy bool
+	flagNoInjectEnv bool
+	flagDebugBundle string
+
+	flagConnectTimeout time.Duration
+	flagStdinFile      string
+
+	flagRelease string
+
+	flagSetLocale bool
+	flagQuiet     bool
+
+	flagSequence  bool
+	flagKeepGoing bool
+
+	flagColorStderr bool
+	flagLogOutput   bool
+
+	flagReadChunkSize   string
+	flagOutputChunkSize string
+
+	flagGracePeriod time.Duration
 }
 
 func (c *ExecCommand) Run(args []string) int {
@@ -32,58 +102,1076 @@ func (c *ExecCommand) Run(args []string) int {
 
 	args = flagSet.Args()
 
+	if c.flagHistory {
+		if err := c.showHistory(); err != nil {
+			c.ui.Output(err.Error(), terminal.WithErrorStyle())
+			return 1
+		}
+
+		return 0
+	}
+
+	// With -rerun, we run the command of a history entry against the
+	// latest deployment of its app and workspace, or the same deployment
+	// with -exact-deployment.
+	workspaceRef := c.project.WorkspaceRef()
+	if c.flagRerun != 0 {
+		entry, err := c.rerunEntry(args)
+		if err != nil {
+			c.ui.Output(err.Error(), terminal.WithErrorStyle())
+			return 1
+		}
+
+		args = entry.Args
+		if c.flagScript == "" {
+			c.flagScript = entry.Script
+		}
+		if c.flagExactDeploy {
+			c.flagDeployment = fmt.Sprintf("v%d", entry.DeploymentSeq)
+		}
+		c.refApp = &pb.Ref_Application{
+			Project:     entry.Project,
+			Application: entry.App,
+		}
+		workspaceRef = &pb.Ref_Workspace{Workspace: entry.Workspace}
+	} else if c.flagExactDeploy {
+		c.ui.Output("-exact-deployment can only be used with -rerun.", terminal.WithErrorStyle())
+		return 1
+	}
+
+	limits, err := c.limits()
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagRelease != "" && (c.flagDeployment != "" || c.flagRerun != 0) {
+		c.ui.Output("-release can't be used with -deployment or -rerun.",
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagLabel != "" && c.flagInstance != "" {
+		c.ui.Output("Only one of -label and -instance can be set.", terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagAll && (c.flagInstance != "" || c.flagDetach ||
+		c.flagStdoutFile != "" || c.flagStderrFile != "") {
+		c.ui.Output("-all can't be used with -instance, -detach, -stdout-file "+
+			"or -stderr-file.", terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagStdinFile != "" && (c.flagAll || c.flagDetach || c.flagTTY) {
+		c.ui.Output("-stdin-file can't be used with -all, -detach or -t.",
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagAll && c.flagDebugBundle != "" {
+		c.ui.Output("-debug-bundle can't be used with -all.", terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagSequence && (len(args) == 0 || c.flagScript != "" || c.flagAll || c.flagDetach) {
+		c.ui.Output("-sequence needs at least one command and can't be used with "+
+			"-script, -all or -detach.", terminal.WithErrorStyle())
+		return 1
+	}
+	if c.flagKeepGoing && !c.flagSequence {
+		c.ui.Output("-keep-going can only be used with -sequence.", terminal.WithErrorStyle())
+		return 1
+	}
+	sequence := c.sequence(args)
+	if sequence != nil {
+		args = sequence[0]
+	}
+
+	var selector labelSelector
+	if v := c.flagLabel; v != "" {
+		selector, err = parseLabelSelector(v)
+		if err != nil {
+			c.ui.Output(err.Error(), terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	script, err := c.script()
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	var spill uint64
+	if v := c.flagSpill; v != "" {
+		spill, err = humanize.ParseBytes(v)
+		if err != nil {
+			c.ui.Output(fmt.Sprintf("invalid value for %s %q: %s",
+				execFlagSource(flagSet, "spill"), v, err),
+				terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	var maxOutput uint64
+	if v := c.flagMaxOutput; v != "" {
+		maxOutput, err = humanize.ParseBytes(v)
+		if err != nil {
+			c.ui.Output(fmt.Sprintf("invalid value for %s %q: %s",
+				execFlagSource(flagSet, "max-output"), v, err),
+				terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	readChunk, err := c.chunkSize(flagSet, "read-chunk-size", c.flagReadChunkSize)
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	outputChunk, err := c.chunkSize(flagSet, "output-chunk-size", c.flagOutputChunkSize)
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	sinks, err := c.sinks()
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+	for _, s := range sinks {
+		defer s.Close()
+	}
+
+	stdinFile, stdinSize, err := c.stdinFile()
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+	if stdinFile != nil {
+		defer stdinFile.Close()
+	}
+
 	var exitCode int
+	failCode := 1
 	client := c.project.Client()
-	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
-		// Get the latest deployment
-		resp, err := client.ListDeployments(ctx, &pb.ListDeploymentsRequest{
-			Application: app.Ref(),
-			Order: &pb.OperationOrder{
-				Limit: 1,
-				Order: pb.OperationOrder_COMPLETE_TIME,
-				Desc:  true,
-			},
-			PhysicalState: pb.Operation_CREATED,
-		})
+	err = c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		var deployment *pb.Deployment
+		if c.flagRelease != "" {
+			var release *pb.Release
+			release, deployment, err = execResolveRelease(
+				ctx, client, app.Ref(), workspaceRef, c.flagRelease)
+			if err == nil {
+				app.UI.Output("Release v%d is backed by deployment v%d",
+					release.Sequence, deployment.Sequence, terminal.WithInfoStyle())
+			}
+		} else {
+			deployment, err = execResolveDeployment(
+				ctx, client, app.Ref(), workspaceRef, c.flagDeployment)
+		}
 		if err != nil {
 			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 			return ErrSentinel
 		}
-		if len(resp.Deployments) == 0 {
-			app.UI.Output("No successful deployments found.", terminal.WithErrorStyle())
+
+		instanceIds := []string{c.flagInstance}
+		task := c.flagTask
+		switch {
+		case c.flagAll:
+			instanceIds, err = execResolveInstances(ctx, client, deployment.Id, selector)
+
+		case strings.HasPrefix(c.flagInstance, execAllocPrefix):
+			alloc := strings.TrimPrefix(c.flagInstance, execAllocPrefix)
+			instanceIds[0], task, err = execResolveAllocation(
+				ctx, client, deployment.Id, alloc, task)
+
+		case task != "" && c.flagInstance == "" && len(selector) == 0:
+			instanceIds[0], task, err = execResolveAllocation(
+				ctx, client, deployment.Id, "", task)
+
+		case len(selector) > 0:
+			instanceIds[0], err = execResolveInstance(ctx, client, deployment.Id, selector)
+		}
+		if err != nil {
+			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 			return ErrSentinel
 		}
 
-		client := &execclient.Client{
-			Logger:        c.Log,
-			UI:            c.ui,
-			Context:       ctx,
-			Client:        client,
-			DeploymentId:  resp.Deployments[0].Id,
-			DeploymentSeq: resp.Deployments[0].Sequence,
-			Args:          args,
-			Stdin:         os.Stdin,
-			Stdout:        os.Stdout,
-			Stderr:        os.Stderr,
+		stdinTerminal := sshterm.IsTerminal(int(os.Stdin.Fd()))
+
+		// Show what the server knows about the health of our targets. If
+		// one looks unhealthy, we warn and ask for -force interactively.
+		now := time.Now()
+		for _, h := range execResolveHealth(ctx, client, deployment, instanceIds) {
+			if h.Healthy() {
+				app.UI.Output(h.Summary(now), terminal.WithInfoStyle())
+				continue
+			}
+
+			app.UI.Output(h.Summary(now), terminal.WithWarningStyle())
+			if stdinTerminal && app.UI.Interactive() && !c.flagForce {
+				app.UI.Output("The target looks unhealthy. Pass -force to exec anyway.",
+					terminal.WithErrorStyle())
+				return ErrSentinel
+			}
 		}
+		var clients []*execclient.Client
+		for _, instanceId := range instanceIds {
+			client := &execclient.Client{
+				Logger:        c.Log,
+				UI:            c.ui,
+				Context:       ctx,
+				Client:        client,
+				ServerAddr:    c.serverAddr(),
+				DeploymentId:  deployment.Id,
+				DeploymentSeq: deployment.Sequence,
+				InstanceId:    instanceId,
+				Args:          args,
+				Stdin:         os.Stdin,
+				Stdout:        os.Stdout,
+				Stderr:        os.Stderr,
+				Limits:        limits,
+				RequirePTY:    c.flagRequirePTY,
+				Container:     c.flagContainer,
+				Task:          task,
+				NoInjectEnv:   c.flagNoInjectEnv,
+				Env:           c.env(),
+				Quiet:         c.flagQuiet,
+				Summary:       c.flagSummary,
+				Detach:        c.flagDetach,
+				OrphanPolicy:  c.orphanPolicy(),
+				Sinks:         sinks,
+				NoMirror:      c.flagNoMirror,
+				ColorStderr:   c.flagColorStderr,
+				Script:        script,
+				Interpreter:   c.flagInterpreter,
+				Strict:        c.flagStrict,
+				KeepGoing:     c.flagKeepGoing,
+				MirrorToLogs:  c.flagLogOutput,
 
-		exitCode, err = client.Run()
-		if err != nil {
+				SpillThreshold: int64(spill),
+				ConnectTimeout: c.flagConnectTimeout,
+				MaxOutput:      int64(maxOutput),
+				MaxOutputAbort: c.flagMaxAction == "abort",
+
+				ReadChunkSize:   readChunk,
+				OutputChunkSize: outputChunk,
+			}
+			if len(sequence) > 1 {
+				client.Sequence = sequence[1:]
+			}
+			if c.flagStats {
+				client.StatsInterval = 5 * time.Second
+			}
+			if c.flagLatency {
+				client.PingInterval = 2 * time.Second
+				client.LatencyTitle = fmt.Sprintf("%s v%d",
+					app.Ref().Application, deployment.Sequence)
+			}
+
+			// We record a debug bundle if one was requested, or if we can
+			// offer to write one should the session fail.
+			if !c.flagAll && (c.flagDebugBundle != "" || (stdinTerminal && app.UI.Interactive())) {
+				client.DebugBundle = execclient.NewDebugBundle()
+				client.DebugBundle.Conn = c.project.Conn()
+			}
+
+			if err := c.ttyOptions(client, stdinTerminal); err != nil {
+				app.UI.Output(err.Error(), terminal.WithErrorStyle())
+				return ErrSentinel
+			}
+
+			// The file is data rather than typed, so it gets no PTY.
+			if stdinFile != nil {
+				client.Stdin = stdinFile
+				client.StdinSize = stdinSize
+				client.CloseStdin = true
+				client.DisablePTY = true
+			}
+
+			clients = append(clients, client)
+		}
+
+		appName := app.Ref().Application
+		if err := c.confirmProtected(
+			app.UI,
+			c.execLabels(appName),
+			appName,
+			workspaceRef.Workspace,
+			deployment.Sequence,
+			stdinTerminal,
+		); err != nil {
+			app.UI.Output(err.Error(), terminal.WithErrorStyle())
+			return ErrSentinel
+		}
+
+		history := func(code int) *execHistoryEntry {
+			historyArgs, redacted := execHistoryArgs(args)
+			return &execHistoryEntry{
+				Time:          time.Now(),
+				Project:       app.Ref().Project,
+				App:           appName,
+				Workspace:     workspaceRef.Workspace,
+				DeploymentSeq: deployment.Sequence,
+				Args:          historyArgs,
+				Redacted:      redacted,
+				Script:        c.flagScript,
+				ExitCode:      code,
+			}
+		}
+
+		if c.flagAll {
+			// The first interrupt asks the commands to exit and the second
+			// ends them.
+			broadcastCtx, drainCh, stopDrain := signalcontext.WithGracefulInterrupt(ctx)
+			defer stopDrain()
+
+			broadcast := &execclient.Broadcast{
+				Logger:  c.Log,
+				UI:      c.ui,
+				Context: broadcastCtx,
+				Stdin:   os.Stdin,
+				Stdout:  os.Stdout,
+				Clients: clients,
+
+				Drain:        drainCh,
+				DrainTimeout: c.flagGracePeriod,
+			}
+
+			exitCode, err = broadcast.Run()
+			if errors.Is(err, execclient.ErrShutdownForced) {
+				app.UI.Output("Sessions were ended before their commands exited.",
+					terminal.WithWarningStyle())
+				failCode = execExitCanceled
+				return ErrSentinel
+			}
+			if err != nil {
+				app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+				return ErrSentinel
+			}
+
+			c.recordHistory(history(exitCode))
+			return nil
+		}
+
+		// We note when the session opened and where the command ran to
+		// show how it exited.
+		var opened bool
+		var openedAt time.Time
+		var attachedId string
+		clients[0].Hooks.Open = func(string) {
+			opened = true
+			openedAt = time.Now()
+		}
+		clients[0].Hooks.Attached = func(instanceId string) { attachedId = instanceId }
+
+		// We collect the exit of each command of a sequence for the summary.
+		var sequenceExits []*pb.ExecStreamResponse_Exit
+		clients[0].Hooks.CommandExit = func(index int, exit *pb.ExecStreamResponse_Exit) {
+			sequenceExits = append(sequenceExits, exit)
+		}
+
+		// An unsuccessful exit of the command isn't an error of ours, we
+		// just exit with the same code. Otherwise the session failed or was
+		// canceled, which scripts can tell apart by our exit code.
+		exitCode, err = clients[0].Run()
+
+		// If the session never opened, a newer deployment may have
+		// superseded ours while we waited, draining its instances. We
+		// switch to it once if we were targeting the latest deployment,
+		// but never switch a deployment that was asked for, including
+		// through -release since the newer deployment isn't released.
+		var newer *pb.Deployment
+		if execWaitTimedOut(err, opened) {
+			newer, _ = execNewerDeployment(ctx, client, app.Ref(), workspaceRef, deployment)
+		}
+		if newer != nil && execLatest(c.flagDeployment) && c.flagRelease == "" &&
+			instanceIds[0] == "" {
+			app.UI.Output("Deployment v%d was superseded by v%d while waiting, retrying with v%d",
+				deployment.Sequence, newer.Sequence, newer.Sequence, terminal.WithWarningStyle())
+			deployment = newer
+			clients[0].DeploymentId = newer.Id
+			clients[0].DeploymentSeq = newer.Sequence
+			clients[0].Attempt, clients[0].Attempts = 2, 2
+			sequenceExits = nil
+			exitCode, err = clients[0].Run()
+			newer = nil
+		}
+
+		var exitErr *execclient.ExitError
+		canceled := errors.Is(err, context.Canceled)
+		outputExceeded := errors.Is(err, execclient.ErrMaxOutput)
+		var closedErr *execclient.ClosedError
+		closed := errors.As(err, &closedErr)
+		failed := err != nil && !errors.As(err, &exitErr) && !canceled && !outputExceeded &&
+			!closed
+		if closed {
+			app.UI.Output("The session was ended by the server: %s", closedErr.Message,
+				terminal.WithErrorStyle())
+		}
+		if failed {
 			app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+			if newer != nil {
+				app.UI.Output("Deployment v%d is newer than v%d, which was asked for, "+
+					"and may have replaced its instances. Use \"-deployment v%d\" to exec into it.",
+					newer.Sequence, deployment.Sequence, newer.Sequence, terminal.WithInfoStyle())
+			}
+		}
+		c.writeDebugBundle(app.UI, clients[0].DebugBundle, failed, stdinTerminal)
+		if sequence != nil {
+			c.sequenceSummary(app.UI, sequence, sequenceExits)
+		}
+		if (err == nil || exitErr != nil) && !c.flagDetach {
+			c.showExit(app.UI, os.Stderr, sshterm.IsTerminal(int(os.Stdout.Fd())),
+				exitErr, time.Since(openedAt), attachedId)
+		}
+		switch {
+		case canceled:
+			failCode = execExitCanceled
+			return ErrSentinel
+
+		case outputExceeded:
+			failCode = execExitMaxOutput
 			return ErrSentinel
+
+		case closed:
+			failCode = execExitClosed
+			return ErrSentinel
+
+		case failed:
+			failCode = execExitFailed
+			return ErrSentinel
+		}
+
+		// Detached sessions have no exit code yet so they aren't recorded.
+		if !c.flagDetach {
+			c.recordHistory(history(exitCode))
 		}
 
 		return nil
 	})
 	if err != nil {
-		return 1
+		return failCode
 	}
 
 	return exitCode
 }
 
+// orphanPolicy returns what the instance does with the processes that the
+// command leaves running in the background. A detached command keeps them
+// since it is usually started to leave something running.
+func (c *ExecCommand) orphanPolicy() pb.ExecStreamRequest_OrphanPolicy {
+	if c.flagDetach {
+		return pb.ExecStreamRequest_ORPHAN_ALLOWED
+	}
+
+	return pb.ExecStreamRequest_KILL_GROUP
+}
+
+// limits returns the resource limits requested by our flags, or nil if
+// no limits were requested.
+func (c *ExecCommand) limits() (*pb.ExecStreamRequest_Limits, error) {
+	if c.flagLimitMemory == "" && c.flagNice == 0 {
+		return nil, nil
+	}
+
+	var limits pb.ExecStreamRequest_Limits
+	if v := c.flagLimitMemory; v != "" {
+		n, err := humanize.ParseBytes(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for -limit-memory %q: %s", v, err)
+		}
+
+		limits.MemoryBytes = int64(n)
+	}
+
+	if c.flagNice < 0 {
+		return nil, fmt.Errorf("invalid value for -nice %d: must not be negative", c.flagNice)
+	}
+
+	limits.Nice = int32(c.flagNice)
+	return &limits, nil
+}
+
+// chunkSize parses v, the value of the chunk size flag name, such as
+// "4KB". This returns zero, for the default, if v is empty.
+func (c *ExecCommand) chunkSize(sets *flag.Sets, name, v string) (int, error) {
+	if v == "" {
+		return 0, nil
+	}
+
+	n, err := humanize.ParseBytes(v)
+	if err == nil {
+		// Anything over the maximum is out of range however large, and
+		// this keeps it from overflowing.
+		if n > execclient.MaxChunkSize {
+			n = execclient.MaxChunkSize + 1
+		}
+
+		err = execclient.CheckChunkSize(int(n))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s %q: %s",
+			execFlagSource(sets, name), v, err)
+	}
+
+	return int(n), nil
+}
+
+// script reads the script requested by -script, if any.
+func (c *ExecCommand) script() ([]byte, error) {
+	if c.flagScript == "" {
+		if c.flagInterpreter != "" {
+			return nil, fmt.Errorf("-interpreter can only be used with -script")
+		}
+
+		return nil, nil
+	}
+
+	// Check the size first so we don't read a huge file by mistake.
+	fi, err := os.Stat(c.flagScript)
+	if err != nil {
+		return nil, fmt.Errorf("error reading -script: %s", err)
+	}
+	if fi.IsDir() {
+		return nil, fmt.Errorf("-script %q is a directory", c.flagScript)
+	}
+	if fi.Size() > execclient.MaxScriptSize {
+		return nil, fmt.Errorf("-script %q is %d bytes, the maximum size is %d bytes",
+			c.flagScript, fi.Size(), execclient.MaxScriptSize)
+	}
+
+	script, err := ioutil.ReadFile(c.flagScript)
+	if err != nil {
+		return nil, fmt.Errorf("error reading -script: %s", err)
+	}
+
+	return script, nil
+}
+
+// env returns the environment variables to set for the command.
+func (c *ExecCommand) env() []string {
+	if !c.flagSetLocale {
+		return nil
+	}
+
+	return []string{"LANG=C.UTF-8", "LC_ALL=C.UTF-8"}
+}
+
+// sequence returns the commands to run for -sequence, each argument being
+// a command line run with /bin/sh, or nil without -sequence.
+func (c *ExecCommand) sequence(args []string) [][]string {
+	if !c.flagSequence {
+		return nil
+	}
+
+	result := make([][]string, len(args))
+	for i, arg := range args {
+		result[i] = []string{"/bin/sh", "-c", arg}
+	}
+	return result
+}
+
+// sequenceSummary shows a table of how each command of a -sequence exited.
+// Commands that didn't run, such as after a failure without -keep-going,
+// are shown as skipped.
+func (c *ExecCommand) sequenceSummary(
+	ui terminal.UI,
+	sequence [][]string,
+	exits []*pb.ExecStreamResponse_Exit,
+) {
+	tbl := terminal.NewTable("#", "Command", "Exit")
+	for i, args := range sequence {
+		exit := "skipped"
+		var colors []string
+		if i < len(exits) {
+			exit = fmt.Sprintf("%d", exits[i].Code)
+			if exits[i].Code != 0 {
+				colors = []string{"", "", terminal.Red}
+			}
+		}
+
+		tbl.Rich([]string{fmt.Sprintf("%d", i+1), args[len(args)-1], exit}, colors)
+	}
+
+	ui.Table(tbl)
+}
+
+// stdinFile opens the file requested by -stdin-file, if any, and returns
+// it with its size.
+func (c *ExecCommand) stdinFile() (*os.File, int64, error) {
+	if c.flagStdinFile == "" {
+		return nil, 0, nil
+	}
+
+	f, err := os.Open(c.flagStdinFile)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error opening -stdin-file: %s", err)
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, fmt.Errorf("error opening -stdin-file: %s", err)
+	}
+	if fi.IsDir() {
+		f.Close()
+		return nil, 0, fmt.Errorf("-stdin-file %q is a directory", c.flagStdinFile)
+	}
+
+	return f, fi.Size(), nil
+}
+
+// sinks opens the files requested by -stdout-file and -stderr-file.
+func (c *ExecCommand) sinks() ([]*execclient.Sink, error) {
+	var result []*execclient.Sink
+	for _, f := range []struct {
+		Flag    string
+		Path    string
+		Channel pb.ExecStreamResponse_Output_Channel
+	}{
+		{"-stdout-file", c.flagStdoutFile, pb.ExecStreamResponse_Output_STDOUT},
+		{"-stderr-file", c.flagStderrFile, pb.ExecStreamResponse_Output_STDERR},
+	} {
+		if f.Path == "" {
+			continue
+		}
+
+		s, err := execclient.FileSink(f.Channel, f.Path, c.flagAppend)
+		if err != nil {
+			for _, s := range result {
+				s.Close()
+			}
+
+			return nil, fmt.Errorf("error opening %s %q: %s", f.Flag, f.Path, err)
+		}
+
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// ttyOptions sets the PTY and stdin options of client from the -i and
+// -t flags. If neither flag is set, the client decides automatically.
+// stdinTerminal is true if stdin is a terminal.
+func (c *ExecCommand) ttyOptions(client *execclient.Client, stdinTerminal bool) error {
+	if c.flagDetach && c.flagInteractive && c.flagTTY {
+		return fmt.Errorf("-detach can't be used with both -i and -t, use " +
+			"\"waypoint exec attach\" to interact with the session once it is running")
+	}
+
+	if !c.flagInteractive && !c.flagTTY {
+		// Detached sessions get neither unless they're asked for since
+		// nothing is connected to them yet.
+		if c.flagDetach {
+			client.DisablePTY = true
+			client.ReadOnly = true
+		}
+
+		return nil
+	}
+
+	if c.flagTTY && !stdinTerminal && !c.flagForce {
+		return fmt.Errorf("-t requires stdin to be a terminal, use -force to allocate a PTY anyway")
+	}
+
+	client.ForcePTY = c.flagTTY
+	client.DisablePTY = !c.flagTTY
+	client.ReadOnly = !c.flagInteractive
+	return nil
+}
+
 func (c *ExecCommand) Flags() *flag.Sets {
-	return c.flagSet(0, nil)
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "deployment",
+			Target: &c.flagDeployment,
+			Usage: "Deployment to exec into: \"latest\" (the default), a sequence " +
+				"number such as \"12\" or \"v12\", or a deployment ID.",
+			Completion: c.predictDeployments(),
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "release",
+			Target: &c.flagRelease,
+			Usage: "Exec into the deployment backing a release rather than choosing " +
+				"a deployment: \"latest\" for what is live, a sequence number such as " +
+				"\"3\" or \"v3\", or a release ID. This can't be used with -deployment.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "label",
+			Target: &c.flagLabel,
+			Usage: "Only exec into an instance with matching labels. This is a " +
+				"comma-separated list of key=value and key!=value terms, such as " +
+				"\"role=primary,zone!=us-east-1a\".",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "instance",
+			Target: &c.flagInstance,
+			Usage: "ID of the instance of the deployment to exec into. For Nomad, " +
+				"this may be \"alloc:ID\" to exec into the instance in the allocation " +
+				"with this ID, or a unique prefix of it.",
+			Completion: c.predictInstances(),
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "task",
+			Target: &c.flagTask,
+			Usage: "For Nomad, the task of the allocation to run the command in. " +
+				"This is required if the allocation has several tasks.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "all",
+			Target: &c.flagAll,
+			Usage: "Run the command on every instance of the deployment at once, " +
+				"or every instance matching -label. Output is shown prefixed " +
+				"with the instance ID and input is sent to all of them.",
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:    "grace-period",
+			Target:  &c.flagGracePeriod,
+			EnvVar:  execEnvVars["grace-period"],
+			Default: 10 * time.Second,
+			Usage: "With -all, how long the commands have to exit after an " +
+				"interrupt. The first interrupt sends them SIGINT, and they are " +
+				"ended once this passes or on a second interrupt, in which case " +
+				"we exit with code 255.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "interactive",
+			Aliases: []string{"i"},
+			Target:  &c.flagInteractive,
+			Usage: "Send stdin to the command. If -t is set without -i, the " +
+				"command gets no input.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "tty",
+			Aliases: []string{"t"},
+			Target:  &c.flagTTY,
+			Usage: "Allocate a PTY for the command. This requires stdin to be a " +
+				"terminal unless -force is set. If -i is set without -t, no PTY " +
+				"is allocated. If neither -i nor -t is set, stdin is sent and a PTY " +
+				"is allocated only if stdout is a terminal.",
+		})
+
+		// Go flags can't be combined so we accept the common "-it" directly.
+		f.BoolVar(&flag.BoolVar{
+			Name:   "it",
+			Target: &c.flagIT,
+			Hidden: true,
+			Usage:  "Shorthand for -i -t.",
+			SetHook: func(v bool) {
+				c.flagInteractive = v
+				c.flagTTY = v
+			},
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "force",
+			Target: &c.flagForce,
+			Usage: "Allocate a PTY with -t even if stdin is not a terminal, and " +
+				"exec into an instance that looks unhealthy.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "limit-memory",
+			Target: &c.flagLimitMemory,
+			Usage:  "Limit the memory the command may use, such as \"512MB\".",
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:   "nice",
+			Target: &c.flagNice,
+			Usage: "Niceness to run the command with, from 0 to 19. Higher values " +
+				"lower the priority.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "require-pty",
+			Target: &c.flagRequirePTY,
+			Usage: "Fail if the instance can't allocate a PTY instead of " +
+				"continuing without one.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "container",
+			Target: &c.flagContainer,
+			Usage: "Run the command in the named container of the instance, such " +
+				"as another container in its Kubernetes pod. By default, the " +
+				"command runs in the container the entrypoint runs in.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "set-locale",
+			Target: &c.flagSetLocale,
+			EnvVar: execEnvVars["set-locale"],
+			Usage: "Run the command with LANG and LC_ALL set to C.UTF-8 so that it " +
+				"can show non-ASCII characters, for images without a UTF-8 locale.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "quiet",
+			Target: &c.flagQuiet,
+			EnvVar: execEnvVars["quiet"],
+			Usage: "Don't show warnings that are only advice, such as that the " +
+				"locale of the command can't show non-ASCII characters, nor the " +
+				"line saying that the command failed once the session ends.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "no-inject-env",
+			Target: &c.flagNoInjectEnv,
+			Usage: "Don't set the WAYPOINT_DEPLOYMENT_ID, WAYPOINT_DEPLOYMENT_SEQ, " +
+				"WAYPOINT_APP, WAYPOINT_WORKSPACE and WAYPOINT_INSTANCE_ID " +
+				"environment variables for the command.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "debug-bundle",
+			Target: &c.flagDebugBundle,
+			Usage: "Write a debug bundle to attach to bug reports to this zip file " +
+				"once the session ends. It has the types and sizes of the messages " +
+				"of the session, the target, versions, logs and facts about the " +
+				"environment, but never the data of the session or tokens. When " +
+				"interactive, a bundle is offered if the session fails.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "stats",
+			Target: &c.flagStats,
+			EnvVar: execEnvVars["stats"],
+			Usage: "Periodically show the CPU and memory usage of the command. " +
+				"With a terminal this is shown in the window title, otherwise on stderr.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "latency",
+			Target: &c.flagLatency,
+			EnvVar: execEnvVars["latency"],
+			Usage: "Measure the round trip time to the instance. With a terminal " +
+				"this is shown in the window title, and -summary includes it.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "spill",
+			Target: &c.flagSpill,
+			EnvVar: execEnvVars["spill"],
+			Usage: "Once this much output, such as \"10MB\", is waiting to be " +
+				"shown, write further output to a temporary file instead so that " +
+				"the session stays responsive. Press Enter and type ~o to resume " +
+				"the live view, or ~p to pause and unpause it. The path of the " +
+				"file is shown when the session ends.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "max-output",
+			Target: &c.flagMaxOutput,
+			EnvVar: execEnvVars["max-output"],
+			Usage: "Stop showing the output of the command once it wrote this " +
+				"much, such as \"100MB\", counting stdout and stderr together " +
+				"but not terminal escape sequences such as colors. A notice at " +
+				"the end says how much output there was in total.",
+		})
+
+		f.EnumSingleVar(&flag.EnumSingleVar{
+			Name:    "max-output-action",
+			Target:  &c.flagMaxAction,
+			EnvVar:  execEnvVars["max-output-action"],
+			Values:  []string{"truncate", "abort"},
+			Default: "truncate",
+			Usage: "What to do once -max-output is exceeded. \"truncate\" drops " +
+				"the rest of the output while the command keeps running, and " +
+				"\"abort\" sends the command SIGTERM and ends the session with " +
+				"exit code 253.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "read-chunk-size",
+			Target: &c.flagReadChunkSize,
+			EnvVar: execEnvVars["read-chunk-size"],
+			Usage: "The most input, such as \"4KB\", read and sent to the " +
+				"command at a time. Larger chunks are faster over fast links, " +
+				"while smaller ones keep sessions over slow links interactive. " +
+				"Defaults to 32KB, and must be between 512B and 1MB.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "output-chunk-size",
+			Target: &c.flagOutputChunkSize,
+			EnvVar: execEnvVars["output-chunk-size"],
+			Usage: "The most output of the command, such as \"4KB\", the " +
+				"instance reads and sends at a time, like -read-chunk-size. " +
+				"Defaults to what the instance uses, and must be between 512B " +
+				"and 1MB. Instances that don't support this use their default.",
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:    "connect-timeout",
+			Target:  &c.flagConnectTimeout,
+			EnvVar:  execEnvVars["connect-timeout"],
+			Default: 60 * time.Second,
+			Usage: "How long to wait for the session to open. This doesn't " +
+				"limit how long the session runs once it opens. Zero waits forever. " +
+				"If a newer deployment replaced the latest one meanwhile, the " +
+				"session is retried once with it unless -deployment was given.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "strict",
+			Target: &c.flagStrict,
+			EnvVar: execEnvVars["strict"],
+			Usage: "End the session with an error if output from the instance is " +
+				"lost or arrives out of order. Otherwise this is only reported by " +
+				"-summary.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "detach",
+			Target: &c.flagDetach,
+			Usage: "Start the command and exit once it is running, leaving it " +
+				"running in the background. This prints the session ID to use " +
+				"with \"waypoint exec attach\". A bounded amount of its output " +
+				"is kept by the server. With -i, its stdin is left open for " +
+				"clients that attach; with -t, it gets a PTY. Processes it " +
+				"starts in the background keep running after it exits.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "yes",
+			Target: &c.flagYes,
+			Usage: "Don't ask for confirmation when the workspace is protected. " +
+				"This is required to exec into a protected workspace when stdin " +
+				"isn't a terminal.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "stdout-file",
+			Target: &c.flagStdoutFile,
+			Usage: "Also write the command's stdout to this file. With a PTY, " +
+				"stdout and stderr are combined and both go to this file.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "stderr-file",
+			Target: &c.flagStderrFile,
+			Usage:  "Also write the command's stderr to this file.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "append",
+			Target: &c.flagAppend,
+			Usage: "Append to the files given by -stdout-file and -stderr-file " +
+				"rather than replacing their contents.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "no-mirror",
+			Target: &c.flagNoMirror,
+			Usage: "Don't write output that goes to -stdout-file or -stderr-file " +
+				"to the terminal as well.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "log-output",
+			Target: &c.flagLogOutput,
+			Usage: "Also write the output of the command to the logs of the app, " +
+				"so that it is kept with them, such as for audited maintenance. " +
+				"\"waypoint logs\" marks these lines with [exec] and leaves them " +
+				"out with -no-exec. Output beyond a rate set by the instance isn't " +
+				"written to the logs.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "color-stderr",
+			Target: &c.flagColorStderr,
+			EnvVar: execEnvVars["color-stderr"],
+			Usage: "Color the stderr of the command red when stdout and stderr " +
+				"go to the same place, such as the terminal.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "script",
+			Target: &c.flagScript,
+			Usage: "Path to a local script to run on the instance. The script " +
+				"doesn't need to exist in the image. Any arguments are passed " +
+				"to the script, and stdin is available to it as usual.",
+			Completion: complete.PredictFiles("*"),
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "sequence",
+			Target: &c.flagSequence,
+			Usage: "Run each argument as a command line with /bin/sh, one after " +
+				"another in the same session on the same instance, such as " +
+				"\"waypoint exec -sequence 'make migrate' 'make seed'\". This " +
+				"stops at the first command that exits with a non-zero code.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "keep-going",
+			Target: &c.flagKeepGoing,
+			Usage: "With -sequence, run the remaining commands even after one " +
+				"exits with a non-zero code.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "stdin-file",
+			Target: &c.flagStdinFile,
+			Usage: "Send this local file as the stdin of the command, such as a " +
+				"database dump. Progress is shown while it is sent, and the " +
+				"command sees the end of its input once all of it is sent. " +
+				"If reading the file fails, the command is stopped.",
+			Completion: complete.PredictFiles("*"),
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "interpreter",
+			Target: &c.flagInterpreter,
+			Usage: "Command line used to run -script, such as \"python3 -u\". " +
+				"By default, a script starting with \"#!\" is executed directly " +
+				"and any other script is run with /bin/sh.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "summary",
+			Target: &c.flagSummary,
+			EnvVar: execEnvVars["summary"],
+			Usage: "Show how the command exited and the CPU time and memory " +
+				"it used once it exits. At a terminal, a successful exit is also " +
+				"shown with a check mark like failures are with a cross.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "history",
+			Target: &c.flagHistory,
+			Usage: "List the commands recently run with exec, most recent first, " +
+				"with the numbers to pass to -rerun.",
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:   "rerun",
+			Target: &c.flagRerun,
+			Usage: "Run the command with this number in -history again, in the " +
+				"same app and workspace, on the latest deployment.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "exact-deployment",
+			Target: &c.flagExactDeploy,
+			Usage:  "With -rerun, run the command on the same deployment as before.",
+		})
+	})
 }
 
 func (c *ExecCommand) AutocompleteArgs() complete.Predictor {
@@ -91,7 +1179,10 @@ func (c *ExecCommand) AutocompleteArgs() complete.Predictor {
 }
 
 func (c *ExecCommand) AutocompleteFlags() complete.Flags {
-	return c.Flags().Completions()
+	result := c.Flags().Completions()
+	result["-app"] = c.predictApps()
+	result["-workspace"] = c.predictWorkspaces()
+	return result
 }
 
 func (c *ExecCommand) Synopsis() string {
@@ -104,5 +1195,67 @@ Usage: waypoint exec [options]
 
   Execute a command in the context of a running application instance.
 
+  The -i and -t flags behave like they do for "docker exec":
+
+    (neither)  stdin is sent, with a PTY only if stdout is a terminal
+    -i         stdin is sent without a PTY
+    -t         a PTY is allocated but no stdin is sent
+    -i -t      stdin is sent with a PTY
+
+  With -script, a local script is sent to the instance and run there in
+  place of a command. Any arguments are passed to the script.
+
+  With -sequence, each argument is a command line run with /bin/sh, one
+  after another in the same session on the same instance. The sequence
+  stops at the first command that exits with a non-zero code unless
+  -keep-going is passed. A table of how each command exited is shown at
+  the end, and the exit code is that of the last command that ran.
+
+  With -container, the command runs in another container of the
+  instance. On Kubernetes, this is any container in the pod, such as a
+  sidecar. If the entrypoint can't run the command there itself, it runs
+  it through the Kubernetes API, which requires the pod's service account
+  to be allowed to get pods and create pods/exec. Signals can't be sent
+  to such commands.
+
+  On Nomad, each instance is in an allocation. Use "-instance alloc:ID"
+  to exec into a specific allocation, where ID may be a unique prefix of
+  the allocation ID. If the allocation has several tasks, choose the one
+  to run the command in with -task.
+
+  With -all, the command runs on every instance at once, such as with
+  "waypoint exec -all -it bash". Each line of output is prefixed with the
+  instance it came from and what you type is sent to every instance.
+  After a newline, type "~f" to switch between sending input to all
+  instances and to each single instance in turn, or "~." to end all the
+  sessions. If an instance's command exits early the others continue,
+  and the exit code is the highest of all of them. Ctrl-C sends SIGINT to
+  all the commands and waits up to -grace-period for them to exit. A
+  second Ctrl-C ends the sessions at once, with exit code 255.
+
+  The exit code is that of the command. If the session fails rather than
+  the command exiting, such as when the connection to the server is lost
+  or the server breaks the exec protocol, the exit code is 254. If the
+  session is canceled, such as with Ctrl-C when stdin isn't sent or with
+  the "~." escape, the exit code is 255. If the session is ended because
+  the output exceeded -max-output with "-max-output-action abort", the
+  exit code is 253. If the server ends the session, such as when the
+  deployment is destroyed, the reason is shown and the exit code is 252.
+  Other errors, such as an unknown deployment, exit with code 1.
+
+  The session ID is shown once connected. Someone else can follow the
+  session read-only with "waypoint exec watch SESSION-ID", and you are
+  told when they start and stop watching.
+
+  Each command run is recorded in a local history of the last 100
+  commands. "waypoint exec -history" lists them and "waypoint exec
+  -rerun N" runs number N again. Only the command and where it ran are
+  recorded, not its input or output. Secrets in the command, such as the
+  value of --password, are redacted and such commands can't be rerun.
+
+  Workspaces listed in the "waypoint/protected-workspaces" label of the
+  project or app (separated by commas) are protected. Exec into them asks
+  you to type the app name to confirm unless -yes is passed.
+
 ` + c.Flags().Help())
 }
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"errors"
+	"os"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+type ExecAttachCommand struct {
+	*baseCommand
+
+	flagSummary bool
+}
+
+func (c *ExecAttachCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single session ID is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	client := &execclient.Client{
+		Logger:     c.Log,
+		UI:         c.ui,
+		Context:    c.Ctx,
+		Client:     c.project.Client(),
+		ServerAddr: c.serverAddr(),
+		SessionId:  c.args[0],
+		Stdin:      os.Stdin,
+		Stdout:     os.Stdout,
+		Stderr:     os.Stderr,
+		Summary:    c.flagSummary,
+	}
+
+	// As with exec, an unsuccessful exit of the command isn't an error of
+	// ours, we just exit with the same code.
+	exitCode, err := client.Run()
+	var exitErr *execclient.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return exitCode
+}
+
+func (c *ExecAttachCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.BoolVar(&flag.BoolVar{
+			Name:   "summary",
+			Target: &c.flagSummary,
+			Usage: "Show how the command exited and the CPU time and memory " +
+				"it used once it exits.",
+		})
+	})
+}
+
+func (c *ExecAttachCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ExecAttachCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExecAttachCommand) Synopsis() string {
+	return "Attach to a detached exec session"
+}
+
+func (c *ExecAttachCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint exec attach [options] SESSION-ID
+
+  Attach to an exec session started with "waypoint exec -detach".
+
+  The output the session produced since it was started is shown first,
+  followed by its live output. If the session was started with -i, your
+  stdin is sent to it, and if it was started with -t, it uses a PTY.
+
+  If the session already exited, this shows its output and exits with
+  the same exit code.
+
+` + c.Flags().Help())
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+// writeDebugBundle writes the debug bundle of a session, if one was
+// recorded, to the -debug-bundle path. Without -debug-bundle, we offer to
+// write one if the session failed and we can ask.
+func (c *ExecCommand) writeDebugBundle(
+	ui terminal.UI,
+	bundle *execclient.DebugBundle,
+	failed bool,
+	stdinTerminal bool,
+) {
+	if bundle == nil {
+		return
+	}
+
+	path := c.flagDebugBundle
+	if path == "" {
+		if !failed || !stdinTerminal || !ui.Interactive() {
+			return
+		}
+
+		result, err := ui.Input(&terminal.Input{
+			Prompt: "To write a debug bundle for a bug report, enter a path for " +
+				"the zip file, or leave it empty to skip: ",
+			Style: terminal.InfoStyle,
+		})
+		if err != nil {
+			c.Log.Warn("error asking for a debug bundle path", "err", err)
+			return
+		}
+
+		path = strings.TrimSpace(result)
+		if path == "" {
+			return
+		}
+	}
+
+	if err := bundle.Write(path); err != nil {
+		ui.Output("Error writing the debug bundle: %s", err, terminal.WithErrorStyle())
+		return
+	}
+
+	ui.Output("Wrote a debug bundle to %s", path, terminal.WithSuccessStyle())
+}
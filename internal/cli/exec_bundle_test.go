@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+func TestExecCommandWriteDebugBundle(t *testing.T) {
+	td, err := ioutil.TempDir("", "waypoint")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+
+	c := &ExecCommand{baseCommand: &baseCommand{Log: hclog.NewNullLogger()}}
+
+	t.Run("written to the flag path", func(t *testing.T) {
+		path := filepath.Join(td, "flag.zip")
+		c.flagDebugBundle = path
+		defer func() { c.flagDebugBundle = "" }()
+
+		ui := &testOutputUI{}
+		c.writeDebugBundle(ui, execclient.NewDebugBundle(), false, false)
+		require.FileExists(t, path)
+		require.Empty(t, ui.prompt)
+	})
+
+	t.Run("offered when the session fails", func(t *testing.T) {
+		path := filepath.Join(td, "prompt.zip")
+		ui := &testOutputUI{testInputUI: testInputUI{interactive: true, result: path + "\n"}}
+		c.writeDebugBundle(ui, execclient.NewDebugBundle(), true, true)
+		require.FileExists(t, path)
+		require.Contains(t, ui.prompt, "debug bundle")
+	})
+
+	t.Run("skipped with an empty answer", func(t *testing.T) {
+		ui := &testOutputUI{testInputUI: testInputUI{interactive: true}}
+		c.writeDebugBundle(ui, execclient.NewDebugBundle(), true, true)
+		require.NotEmpty(t, ui.prompt)
+		require.Empty(t, ui.output)
+	})
+
+	t.Run("not offered when the session succeeds", func(t *testing.T) {
+		ui := &testOutputUI{testInputUI: testInputUI{interactive: true}}
+		c.writeDebugBundle(ui, execclient.NewDebugBundle(), false, true)
+		require.Empty(t, ui.prompt)
+	})
+
+	t.Run("not offered without a terminal", func(t *testing.T) {
+		ui := &testOutputUI{testInputUI: testInputUI{interactive: true}}
+		c.writeDebugBundle(ui, execclient.NewDebugBundle(), true, false)
+		require.Empty(t, ui.prompt)
+	})
+}
+
+// testOutputUI is a testInputUI that records its output.
+type testOutputUI struct {
+	testInputUI
+
+	output []string
+}
+
+func (ui *testOutputUI) Output(msg string, raw ...interface{}) {
+	ui.output = append(ui.output, msg)
+}
@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/posener/complete"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/serverclient"
+)
+
+// execCompleteTimeout is the most time we spend talking to the server
+// to complete a flag. Completion runs in the user's shell so it must never
+// block for long; on a timeout we complete nothing.
+const execCompleteTimeout = 2 * time.Second
+
+// execPredictor returns a predictor that calls f with a connection to the
+// server. Any error or timeout results in no predictions, since there is no
+// way to report it to the user from within their shell.
+func (c *ExecCommand) execPredictor(
+	f func(context.Context, pb.WaypointClient) ([]string, error),
+) complete.Predictor {
+	return complete.PredictFunc(func(args complete.Args) []string {
+		ctx, cancel := context.WithTimeout(context.Background(), execCompleteTimeout)
+		defer cancel()
+
+		resultCh := make(chan []string, 1)
+		go func() {
+			result, err := c.execPredict(ctx, args, f)
+			if err != nil {
+				c.Log.Debug("error completing exec flag", "error", err)
+			}
+
+			resultCh <- result
+		}()
+
+		select {
+		case result := <-resultCh:
+			return result
+
+		case <-ctx.Done():
+			c.Log.Debug("timeout completing exec flag")
+			return nil
+		}
+	})
+}
+
+// execPredict sets up what we need from the partial command line being
+// completed and calls f.
+func (c *ExecCommand) execPredict(
+	ctx context.Context,
+	args complete.Args,
+	f func(context.Context, pb.WaypointClient) ([]string, error),
+) ([]string, error) {
+	// Parse what we have so far so that flags such as -app and -deployment
+	// narrow down the predictions. The line is incomplete so we expect
+	// errors here and ignore them.
+	c.Flags().Parse(args.Completed)
+
+	storage, err := c.initContextStorage()
+	if err != nil {
+		return nil, err
+	}
+	c.contextStorage = storage
+
+	conn, err := serverclient.Connect(ctx,
+		append(c.initConnectOpts(), serverclient.Timeout(execCompleteTimeout))...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return f(ctx, pb.NewWaypointClient(conn))
+}
+
+// execCompleteApp returns the app that the command being completed
+// targets from the configuration and the -app flag.
+func (c *ExecCommand) execCompleteApp() (*pb.Ref_Application, error) {
+	cfg, err := c.initConfig(true)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("no configuration found")
+	}
+
+	app := c.flagApp
+	if app == "" {
+		if len(cfg.Apps) != 1 {
+			return nil, fmt.Errorf("no single app target")
+		}
+
+		app = cfg.Apps[0].Name
+	}
+
+	return &pb.Ref_Application{Project: cfg.Project, Application: app}, nil
+}
+
+// predictApps predicts the applications of the current project.
+func (c *ExecCommand) predictApps() complete.Predictor {
+	return c.execPredictor(func(ctx context.Context, client pb.WaypointClient) ([]string, error) {
+		cfg, err := c.initConfig(true)
+		if err != nil {
+			return nil, err
+		}
+		if cfg == nil {
+			return nil, fmt.Errorf("no configuration found")
+		}
+
+		resp, err := client.GetProject(ctx, &pb.GetProjectRequest{
+			Project: &pb.Ref_Project{Project: cfg.Project},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var result []string
+		for _, app := range resp.Project.Applications {
+			result = append(result, app.Name)
+		}
+
+		return result, nil
+	})
+}
+
+// predictWorkspaces predicts the workspaces known to the server.
+func (c *ExecCommand) predictWorkspaces() complete.Predictor {
+	return c.execPredictor(func(ctx context.Context, client pb.WaypointClient) ([]string, error) {
+		resp, err := client.ListWorkspaces(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var result []string
+		for _, ws := range resp.Workspaces {
+			result = append(result, ws.Name)
+		}
+
+		return result, nil
+	})
+}
+
+// predictDeployments predicts the sequence numbers of the running
+// deployments of the targeted app, newest first, along with "latest".
+func (c *ExecCommand) predictDeployments() complete.Predictor {
+	return c.execPredictor(func(ctx context.Context, client pb.WaypointClient) ([]string, error) {
+		app, err := c.execCompleteApp()
+		if err != nil {
+			return nil, err
+		}
+
+		deployments, err := execListDeployments(ctx, client, app,
+			&pb.Ref_Workspace{Workspace: c.flagWorkspace})
+		if err != nil {
+			return nil, err
+		}
+
+		result := []string{"latest"}
+		for _, d := range deployments {
+			result = append(result, fmt.Sprintf("v%d", d.Sequence))
+		}
+
+		return result, nil
+	})
+}
+
+// predictInstances predicts the IDs of the instances of the deployment
+// selected with -deployment, or the latest deployment.
+func (c *ExecCommand) predictInstances() complete.Predictor {
+	return c.execPredictor(func(ctx context.Context, client pb.WaypointClient) ([]string, error) {
+		app, err := c.execCompleteApp()
+		if err != nil {
+			return nil, err
+		}
+
+		deployment, err := execResolveDeployment(ctx, client, app,
+			&pb.Ref_Workspace{Workspace: c.flagWorkspace}, c.flagDeployment)
+		if err != nil {
+			return nil, err
+		}
+
+		instances, err := execListInstances(ctx, client, deployment.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []string
+		for _, inst := range instances {
+			result = append(result, inst.Id)
+		}
+
+		return result, nil
+	})
+}
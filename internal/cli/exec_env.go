@@ -0,0 +1,53 @@
+package cli
+
+import (
+	stdflag "flag"
+	"os"
+
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+// execEnvVars are the environment variables giving defaults for the flags
+// of exec, by flag name. This lets people set their preferences once, such
+// as in their shell profile, rather than on every exec. A flag given on the
+// command line always takes precedence over its environment variable.
+//
+// Only flags that are preferences belong here. Flags choosing what to run
+// or where, or skipping confirmations such as -yes, must be given
+// explicitly every time.
+var execEnvVars = map[string]string{
+	"connect-timeout":   "WAYPOINT_EXEC_CONNECT_TIMEOUT",
+	"grace-period":      "WAYPOINT_EXEC_GRACE_PERIOD",
+	"quiet":             "WAYPOINT_EXEC_QUIET",
+	"summary":           "WAYPOINT_EXEC_SUMMARY",
+	"stats":             "WAYPOINT_EXEC_STATS",
+	"latency":           "WAYPOINT_EXEC_LATENCY",
+	"strict":            "WAYPOINT_EXEC_STRICT",
+	"set-locale":        "WAYPOINT_EXEC_SET_LOCALE",
+	"color-stderr":      "WAYPOINT_EXEC_COLOR_STDERR",
+	"spill":             "WAYPOINT_EXEC_SPILL",
+	"max-output":        "WAYPOINT_EXEC_MAX_OUTPUT",
+	"max-output-action": "WAYPOINT_EXEC_MAX_OUTPUT_ACTION",
+	"read-chunk-size":   "WAYPOINT_EXEC_READ_CHUNK_SIZE",
+	"output-chunk-size": "WAYPOINT_EXEC_OUTPUT_CHUNK_SIZE",
+}
+
+// execFlagSource returns how the value of the flag name was given, for
+// errors about it: the environment variable if the value came from it, or
+// the flag otherwise.
+func execFlagSource(sets *flag.Sets, name string) string {
+	given := false
+	sets.Visit(func(f *stdflag.Flag) {
+		if f.Name == name {
+			given = true
+		}
+	})
+
+	if env, ok := execEnvVars[name]; ok && !given {
+		if _, ok := os.LookupEnv(env); ok {
+			return env
+		}
+	}
+
+	return "-" + name
+}
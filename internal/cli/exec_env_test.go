@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+)
+
+func TestExecEnvVars(t *testing.T) {
+	c := &ExecCommand{baseCommand: &baseCommand{}}
+
+	// Every entry is for a flag of exec, which reads it.
+	found := map[string]string{}
+	c.Flags().VisitSets(func(_ string, set *flag.Set) {
+		set.VisitVars(func(v *flag.VarFlag) {
+			if v.EnvVar != "" {
+				found[v.Name] = v.EnvVar
+			}
+		})
+	})
+	require.Equal(t, execEnvVars, found)
+}
+
+func TestExecCommand_envDefaults(t *testing.T) {
+	setenv := func(t *testing.T, key, value string) {
+		require.NoError(t, os.Setenv(key, value))
+		t.Cleanup(func() { os.Unsetenv(key) })
+	}
+
+	t.Run("env", func(t *testing.T) {
+		require := require.New(t)
+		setenv(t, "WAYPOINT_EXEC_CONNECT_TIMEOUT", "5s")
+		setenv(t, "WAYPOINT_EXEC_QUIET", "true")
+		setenv(t, "WAYPOINT_EXEC_MAX_OUTPUT_ACTION", "abort")
+
+		c := &ExecCommand{baseCommand: &baseCommand{}}
+		sets := c.Flags()
+		require.NoError(sets.Parse(nil))
+		require.Equal(5*time.Second, c.flagConnectTimeout)
+		require.True(c.flagQuiet)
+		require.Equal("abort", c.flagMaxAction)
+	})
+
+	t.Run("flags win", func(t *testing.T) {
+		require := require.New(t)
+		setenv(t, "WAYPOINT_EXEC_CONNECT_TIMEOUT", "5s")
+		setenv(t, "WAYPOINT_EXEC_QUIET", "true")
+		setenv(t, "WAYPOINT_EXEC_SPILL", "1MB")
+
+		c := &ExecCommand{baseCommand: &baseCommand{}}
+		sets := c.Flags()
+		require.NoError(sets.Parse([]string{
+			"-connect-timeout=30s", "-quiet=false", "-spill", "10MB"}))
+		require.Equal(30*time.Second, c.flagConnectTimeout)
+		require.False(c.flagQuiet)
+		require.Equal("10MB", c.flagSpill)
+		require.Equal("-spill", execFlagSource(sets, "spill"))
+	})
+
+	t.Run("invalid env", func(t *testing.T) {
+		require := require.New(t)
+		setenv(t, "WAYPOINT_EXEC_CONNECT_TIMEOUT", "soon")
+
+		c := &ExecCommand{baseCommand: &baseCommand{}}
+		err := c.Flags().Parse(nil)
+		require.Error(err)
+		require.Contains(err.Error(), "WAYPOINT_EXEC_CONNECT_TIMEOUT")
+
+		// The flag makes the variable irrelevant.
+		c = &ExecCommand{baseCommand: &baseCommand{}}
+		require.NoError(c.Flags().Parse([]string{"-connect-timeout", "5s"}))
+		require.Equal(5*time.Second, c.flagConnectTimeout)
+	})
+
+	t.Run("invalid size from env", func(t *testing.T) {
+		setenv(t, "WAYPOINT_EXEC_MAX_OUTPUT", "lots")
+
+		c := &ExecCommand{baseCommand: &baseCommand{}}
+		sets := c.Flags()
+		require.NoError(t, sets.Parse(nil))
+		require.Equal(t, "WAYPOINT_EXEC_MAX_OUTPUT", execFlagSource(sets, "max-output"))
+	})
+}
+
+func TestExecCommandChunkSize(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Value    string
+		Expected int
+		Err      bool
+	}{
+		{"empty", "", 0, false},
+		{"size", "4KB", 4000, false},
+		{"bytes", "512", 512, false},
+		{"too small", "100B", 0, true},
+		{"too large", "1GB", 0, true},
+		{"overflow", "100EB", 0, true},
+		{"invalid", "lots", 0, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &ExecCommand{baseCommand: &baseCommand{}}
+			sets := c.Flags()
+			n, err := c.chunkSize(sets, "read-chunk-size", tt.Value)
+			if tt.Err {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "-read-chunk-size")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.Expected, n)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+// showExit shows how the command of a session exited once the session
+// ends, so that a failure isn't missed when the prompt returns: with a red
+// cross if it failed, or a green check if it succeeded and -summary is set.
+// This is only for people at a terminal, so nothing is shown with -quiet
+// or if stdout isn't a terminal. The line goes to w, which is stderr, and
+// is shown after the terminal left raw mode so it isn't garbled.
+func (c *ExecCommand) showExit(
+	ui terminal.UI,
+	w io.Writer,
+	stdoutTerminal bool,
+	exitErr *execclient.ExitError,
+	elapsed time.Duration,
+	instanceId string,
+) {
+	if c.flagQuiet || !stdoutTerminal || !ui.Interactive() {
+		return
+	}
+
+	style := terminal.WithErrorStyle()
+	if exitErr == nil {
+		if !c.flagSummary {
+			return
+		}
+
+		style = terminal.WithSuccessStyle()
+	}
+
+	ui.Output(execExitMessage(exitErr, elapsed, instanceId), style, terminal.WithWriter(w))
+}
+
+// execExitMessage returns the line showExit shows, such as "✗ command
+// exited with code 2 after 1m32s on instance abc123".
+func execExitMessage(
+	exitErr *execclient.ExitError,
+	elapsed time.Duration,
+	instanceId string,
+) string {
+	msg := "✓ command exited with code 0"
+	if exitErr != nil {
+		msg = "✗ " + exitErr.Error()
+	}
+
+	// Sub-second runs keep their milliseconds, longer ones are rounded to
+	// the second since that's as precise as anyone reads them.
+	if elapsed >= time.Second {
+		elapsed = elapsed.Round(time.Second)
+	} else {
+		elapsed = elapsed.Round(time.Millisecond)
+	}
+	msg += fmt.Sprintf(" after %s", elapsed)
+
+	if instanceId != "" {
+		msg += " on instance " + instanceId
+	}
+
+	return msg
+}
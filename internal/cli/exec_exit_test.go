@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+func TestExecExitMessage(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Err        *execclient.ExitError
+		Elapsed    time.Duration
+		InstanceId string
+		Expected   string
+	}{
+		{
+			"failed",
+			&execclient.ExitError{Code: 2},
+			92*time.Second + 300*time.Millisecond,
+			"abc123",
+			"✗ command exited with code 2 after 1m32s on instance abc123",
+		},
+		{
+			"signal",
+			&execclient.ExitError{Code: 137, Signal: "SIGKILL"},
+			3 * time.Second,
+			"abc123",
+			"✗ command terminated by signal SIGKILL after 3s on instance abc123",
+		},
+		{
+			"success within a second",
+			nil,
+			123456 * time.Microsecond,
+			"abc123",
+			"✓ command exited with code 0 after 123ms on instance abc123",
+		},
+		{
+			"unknown instance",
+			&execclient.ExitError{Code: 1},
+			time.Second,
+			"",
+			"✗ command exited with code 1 after 1s",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, execExitMessage(tt.Err, tt.Elapsed, tt.InstanceId))
+		})
+	}
+}
+
+func TestExecCommandShowExit(t *testing.T) {
+	failure := &execclient.ExitError{Code: 2}
+
+	cases := []struct {
+		Name        string
+		Quiet       bool
+		Summary     bool
+		Terminal    bool
+		Interactive bool
+		Err         *execclient.ExitError
+		Shown       bool
+	}{
+		{"failure", false, false, true, true, failure, true},
+		{"success", false, false, true, true, nil, false},
+		{"success with summary", false, true, true, true, nil, true},
+		{"quiet", true, true, true, true, failure, false},
+		{"stdout not a terminal", false, true, false, true, failure, false},
+		{"not interactive", false, true, true, false, failure, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &ExecCommand{flagQuiet: tt.Quiet, flagSummary: tt.Summary}
+			ui := &testOutputUI{testInputUI: testInputUI{interactive: tt.Interactive}}
+			c.showExit(ui, ioutil.Discard, tt.Terminal, tt.Err, time.Second, "i1")
+
+			if !tt.Shown {
+				require.Empty(t, ui.output)
+				return
+			}
+
+			require.Len(t, ui.output, 1)
+			require.Contains(t, ui.output[0], "after 1s on instance i1")
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/adrg/xdg"
+	"github.com/dustin/go-humanize"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
+)
+
+// execHistoryMax is the number of exec runs kept in the history. Older
+// runs are dropped.
+const execHistoryMax = 100
+
+// execHistoryEntry is a single exec run in the history. Only the command
+// and where it ran are kept, never its input or output. Secrets in the
+// command are redacted, in which case Redacted is set since the command
+// can't be run again. See execHistoryArgs.
+type execHistoryEntry struct {
+	Time          time.Time `json:"time"`
+	Project       string    `json:"project"`
+	App           string    `json:"app"`
+	Workspace     string    `json:"workspace"`
+	DeploymentSeq uint64    `json:"deployment_seq"`
+	Args          []string  `json:"args"`
+	Redacted      bool      `json:"redacted,omitempty"`
+	Script        string    `json:"script,omitempty"`
+	ExitCode      int       `json:"exit_code"`
+}
+
+// execHistoryArgs returns args as they are recorded in the history, with
+// any secrets redacted, and whether any were.
+func execHistoryArgs(args []string) ([]string, bool) {
+	result := scrub.Default().Args(args)
+	for i := range args {
+		if result[i] != args[i] {
+			return result, true
+		}
+	}
+
+	return result, false
+}
+
+// execHistoryPath returns the path of the history file, which lives in
+// our home configuration directory.
+func execHistoryPath() (string, error) {
+	return xdg.ConfigFile(filepath.Join("waypoint", "exec-history.json"))
+}
+
+// loadExecHistory reads the history at path, oldest first. A history that
+// doesn't exist yet is empty.
+func loadExecHistory(path string) ([]*execHistoryEntry, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*execHistoryEntry
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("error reading exec history %s: %s", path, err)
+	}
+
+	return result, nil
+}
+
+// appendExecHistory adds entry to the history at path, dropping the
+// oldest entries beyond execHistoryMax.
+func appendExecHistory(path string, entry *execHistoryEntry) error {
+	entries, err := loadExecHistory(path)
+	if err != nil {
+		return err
+	}
+
+	entries = append(entries, entry)
+	if len(entries) > execHistoryMax {
+		entries = entries[len(entries)-execHistoryMax:]
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write and rename so that concurrent runs never see a partial file.
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// execHistoryIndex returns the entry with the given index, where 1 is the
+// most recent run, as shown by -history.
+func execHistoryIndex(entries []*execHistoryEntry, idx int) (*execHistoryEntry, error) {
+	if idx < 1 || idx > len(entries) {
+		if len(entries) == 0 {
+			return nil, fmt.Errorf("The exec history is empty.")
+		}
+
+		return nil, fmt.Errorf(
+			"There is no exec history entry %d, entries are numbered 1 to %d.",
+			idx, len(entries))
+	}
+
+	return entries[len(entries)-idx], nil
+}
+
+// showHistory shows the history, most recent first.
+func (c *ExecCommand) showHistory() error {
+	path, err := execHistoryPath()
+	if err != nil {
+		return err
+	}
+
+	entries, err := loadExecHistory(path)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		c.ui.Output("No exec history.")
+		return nil
+	}
+
+	tbl := terminal.NewTable("#", "App", "Workspace", "Deployment", "Command", "Exit", "Ran")
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+
+		command := strings.Join(e.Args, " ")
+		if e.Script != "" {
+			command = strings.TrimSpace("-script " + e.Script + " " + command)
+		}
+
+		tbl.Rich([]string{
+			fmt.Sprintf("%d", len(entries)-i),
+			e.App,
+			e.Workspace,
+			fmt.Sprintf("v%d", e.DeploymentSeq),
+			command,
+			fmt.Sprintf("%d", e.ExitCode),
+			humanize.Time(e.Time),
+		}, nil)
+	}
+
+	c.ui.Table(tbl)
+	return nil
+}
+
+// rerunEntry returns the history entry to run again for -rerun. The
+// entry must be from the project we're in.
+func (c *ExecCommand) rerunEntry(args []string) (*execHistoryEntry, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("-rerun can't be used with a command.")
+	}
+
+	path, err := execHistoryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := loadExecHistory(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := execHistoryIndex(entries, c.flagRerun)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.refProject != nil && entry.Project != c.refProject.Project {
+		return nil, fmt.Errorf(
+			"Exec history entry %d ran in project %q, run -rerun from that project.",
+			c.flagRerun, entry.Project)
+	}
+
+	if entry.Redacted {
+		return nil, fmt.Errorf(
+			"Exec history entry %d had secrets redacted from its command, so it "+
+				"can't be run again. Run the command directly instead.",
+			c.flagRerun)
+	}
+
+	return entry, nil
+}
+
+// recordHistory adds a run to the history. Errors are only logged since
+// they shouldn't affect the result of the command that ran.
+func (c *ExecCommand) recordHistory(entry *execHistoryEntry) {
+	path, err := execHistoryPath()
+	if err == nil {
+		err = appendExecHistory(path, entry)
+	}
+	if err != nil {
+		c.Log.Warn("error recording exec history", "err", err)
+	}
+}
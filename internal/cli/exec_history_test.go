@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
+)
+
+func TestExecHistory(t *testing.T) {
+	td, err := ioutil.TempDir("", "waypoint")
+	require.NoError(t, err)
+	defer os.RemoveAll(td)
+	path := filepath.Join(td, "exec-history.json")
+
+	// A missing history is empty.
+	entries, err := loadExecHistory(path)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	_, err = execHistoryIndex(entries, 1)
+	require.Error(t, err)
+
+	for i := 0; i < execHistoryMax+5; i++ {
+		require.NoError(t, appendExecHistory(path, &execHistoryEntry{
+			App:           "web",
+			Workspace:     "default",
+			DeploymentSeq: 3,
+			Args:          []string{"echo", fmt.Sprintf("%d", i)},
+		}))
+	}
+
+	// Only the most recent entries are kept.
+	entries, err = loadExecHistory(path)
+	require.NoError(t, err)
+	require.Len(t, entries, execHistoryMax)
+	require.Equal(t, []string{"echo", "5"}, entries[0].Args)
+
+	entry, err := execHistoryIndex(entries, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"echo", fmt.Sprintf("%d", execHistoryMax+4)}, entry.Args)
+
+	entry, err = execHistoryIndex(entries, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"echo", fmt.Sprintf("%d", execHistoryMax+2)}, entry.Args)
+
+	_, err = execHistoryIndex(entries, execHistoryMax+1)
+	require.Error(t, err)
+	_, err = execHistoryIndex(entries, 0)
+	require.Error(t, err)
+}
+
+func TestExecHistoryArgs(t *testing.T) {
+	args, redacted := execHistoryArgs([]string{"ls", "-la"})
+	require.Equal(t, []string{"ls", "-la"}, args)
+	require.False(t, redacted)
+
+	args, redacted = execHistoryArgs([]string{"psql", "--password=hunter2", "db"})
+	require.Equal(t, []string{"psql", "--password=" + scrub.Redacted, "db"}, args)
+	require.True(t, redacted)
+}
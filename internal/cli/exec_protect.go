@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+)
+
+// execProtectedLabel is the project or app label listing the workspaces
+// that are protected, separated by commas. Exec into a protected
+// workspace requires confirmation. App labels override project labels.
+const execProtectedLabel = "waypoint/protected-workspaces"
+
+// isProtectedWorkspace returns true if the labels mark the workspace as
+// protected. Workspace names are compared case-insensitively.
+func isProtectedWorkspace(labels map[string]string, ws string) bool {
+	for _, v := range strings.Split(labels[execProtectedLabel], ",") {
+		if v = strings.TrimSpace(v); v != "" && strings.EqualFold(v, ws) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// execLabels returns the labels of the app from our configuration, with
+// the project labels as defaults.
+func (c *ExecCommand) execLabels(appName string) map[string]string {
+	result := map[string]string{}
+	if c.cfg == nil {
+		return result
+	}
+
+	for k, v := range c.cfg.Labels {
+		result[k] = v
+	}
+	if appCfg, ok := c.cfg.AppConfig(appName); ok {
+		for k, v := range appCfg.Labels {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// confirmProtected asks the user to confirm exec into a protected
+// workspace by typing the app name. This returns an error if the user
+// doesn't confirm or if we can't ask because stdin isn't a terminal.
+// -yes skips this.
+func (c *ExecCommand) confirmProtected(
+	ui terminal.UI,
+	labels map[string]string,
+	appName, ws string,
+	seq uint64,
+	stdinTerminal bool,
+) error {
+	if c.flagYes || !isProtectedWorkspace(labels, ws) {
+		return nil
+	}
+
+	if !stdinTerminal || !ui.Interactive() {
+		return fmt.Errorf(
+			"Workspace %q is protected and confirmation can't be requested "+
+				"since stdin isn't a terminal. Pass -yes to exec anyway.", ws)
+	}
+
+	result, err := ui.Input(&terminal.Input{
+		Prompt: fmt.Sprintf(
+			"You are about to exec into %s (%s v%d). Type the app name to continue: ",
+			strings.ToUpper(ws), appName, seq),
+		Style: terminal.WarningStyle,
+	})
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(result) != appName {
+		return fmt.Errorf("The app name didn't match, not executing.")
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+)
+
+func TestIsProtectedWorkspace(t *testing.T) {
+	labels := map[string]string{execProtectedLabel: "production, Staging"}
+
+	require.True(t, isProtectedWorkspace(labels, "production"))
+	require.True(t, isProtectedWorkspace(labels, "staging"))
+	require.False(t, isProtectedWorkspace(labels, "default"))
+	require.False(t, isProtectedWorkspace(nil, "production"))
+}
+
+func TestExecCommand_confirmProtected(t *testing.T) {
+	labels := map[string]string{execProtectedLabel: "production"}
+
+	t.Run("unprotected", func(t *testing.T) {
+		c := &ExecCommand{}
+		require.NoError(t, c.confirmProtected(
+			&testInputUI{}, labels, "myapp", "default", 12, false))
+	})
+
+	t.Run("matching app name", func(t *testing.T) {
+		c := &ExecCommand{}
+		ui := &testInputUI{interactive: true, result: "myapp"}
+		require.NoError(t, c.confirmProtected(ui, labels, "myapp", "production", 12, true))
+		require.Contains(t, ui.prompt, "PRODUCTION (myapp v12)")
+	})
+
+	t.Run("wrong app name", func(t *testing.T) {
+		c := &ExecCommand{}
+		ui := &testInputUI{interactive: true, result: "other"}
+		require.Error(t, c.confirmProtected(ui, labels, "myapp", "production", 12, true))
+	})
+
+	t.Run("stdin not a terminal", func(t *testing.T) {
+		c := &ExecCommand{}
+		ui := &testInputUI{interactive: true, result: "myapp"}
+		err := c.confirmProtected(ui, labels, "myapp", "production", 12, false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "-yes")
+		require.Empty(t, ui.prompt)
+	})
+
+	t.Run("yes", func(t *testing.T) {
+		c := &ExecCommand{flagYes: true}
+		ui := &testInputUI{}
+		require.NoError(t, c.confirmProtected(ui, labels, "myapp", "production", 12, false))
+		require.Empty(t, ui.prompt)
+	})
+}
+
+// testInputUI is a UI that answers Input with a fixed result.
+type testInputUI struct {
+	terminal.UI
+
+	interactive bool
+	result      string
+	prompt      string
+}
+
+func (ui *testInputUI) Interactive() bool { return ui.interactive }
+
+func (ui *testInputUI) Input(input *terminal.Input) (string, error) {
+	ui.prompt = input.Prompt
+	return ui.result, nil
+}
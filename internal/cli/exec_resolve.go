@@ -0,0 +1,677 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execResolveDeployment resolves the deployment to exec into for the
+// given app and workspace. value may be empty or "latest" for the latest
+// deployment, a sequence number such as "12" or "v12", or a deployment ID.
+// The deployment must have at least one instance.
+func execResolveDeployment(
+	ctx context.Context,
+	client pb.WaypointClient,
+	app *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+	value string,
+) (*pb.Deployment, error) {
+	deployments, err := execListDeployments(ctx, client, app, ws)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployments) == 0 {
+		return nil, fmt.Errorf("No successful deployments found.")
+	}
+
+	deployment, err := findDeployment(deployments, value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the deployment has instances we can exec into.
+	instResp, err := client.ListInstances(ctx, &pb.ListInstancesRequest{
+		Scope: &pb.ListInstancesRequest_Application_{
+			Application: &pb.ListInstancesRequest_Application{
+				Application: app,
+				Workspace:   ws,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	withInstances := map[string]struct{}{}
+	for _, inst := range instResp.Instances {
+		withInstances[inst.DeploymentId] = struct{}{}
+	}
+	if _, ok := withInstances[deployment.Id]; !ok {
+		var available []*pb.Deployment
+		for _, d := range deployments {
+			if _, ok := withInstances[d.Id]; ok {
+				available = append(available, d)
+			}
+		}
+
+		return nil, fmt.Errorf(
+			"Deployment v%d has no running instances. Deployments with instances: %s",
+			deployment.Sequence, formatDeploymentSeqs(available))
+	}
+
+	return deployment, nil
+}
+
+// execResolveRelease resolves the deployment backing a release of the
+// given app and workspace, so that we can exec into what is live. value
+// may be "latest" for the latest release, a sequence number such as "3"
+// or "v3", or a release ID. The deployment must have at least one
+// instance, the same as execResolveDeployment.
+func execResolveRelease(
+	ctx context.Context,
+	client pb.WaypointClient,
+	app *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+	value string,
+) (*pb.Release, *pb.Deployment, error) {
+	var release *pb.Release
+	var err error
+	if execLatest(value) {
+		release, err = client.GetLatestRelease(ctx, &pb.GetLatestReleaseRequest{
+			Application: app,
+			Workspace:   ws,
+		})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, fmt.Errorf("No releases found.")
+		}
+	} else {
+		ref := &pb.Ref_Operation{Target: &pb.Ref_Operation_Id{Id: value}}
+		if seq, ok := parseDeploymentSeq(value); ok {
+			ref.Target = &pb.Ref_Operation_Sequence{
+				Sequence: &pb.Ref_OperationSeq{Application: app, Number: seq},
+			}
+		}
+
+		release, err = client.GetRelease(ctx, &pb.GetReleaseRequest{Ref: ref})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, fmt.Errorf("Release %q not found.", value)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deployment *pb.Deployment
+	if release.DeploymentId != "" {
+		deployment, err = client.GetDeployment(ctx, &pb.GetDeploymentRequest{
+			Ref: &pb.Ref_Operation{
+				Target: &pb.Ref_Operation_Id{Id: release.DeploymentId},
+			},
+		})
+		if status.Code(err) == codes.NotFound {
+			deployment, err = nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := execCheckReleaseDeployment(release, deployment); err != nil {
+		return nil, nil, err
+	}
+
+	deployment, err = execResolveDeployment(ctx, client, app, ws, deployment.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return release, deployment, nil
+}
+
+// execCheckReleaseDeployment returns an error if the deployment backing
+// release, or nil if the server no longer has it, can't be exec'd into
+// because it is gone.
+func execCheckReleaseDeployment(release *pb.Release, deployment *pb.Deployment) error {
+	switch {
+	case release.DeploymentId == "":
+		return fmt.Errorf("Release v%d isn't backed by a deployment.", release.Sequence)
+
+	case deployment == nil:
+		return fmt.Errorf(
+			"Release v%d is backed by deployment %s, which no longer exists. "+
+				"Use -deployment to exec into another deployment.",
+			release.Sequence, release.DeploymentId)
+
+	case deployment.State == pb.Operation_DESTROYED:
+		return fmt.Errorf(
+			"Release v%d is backed by deployment v%d, which has been destroyed. "+
+				"Use -deployment to exec into another deployment.",
+			release.Sequence, deployment.Sequence)
+	}
+
+	return nil
+}
+
+// execNewerDeployment returns the latest deployment of the app if it is
+// newer than deployment and has instances, or nil if there is none. This
+// is used when a session of deployment never opened since a newer
+// deployment may have superseded it, draining its instances.
+func execNewerDeployment(
+	ctx context.Context,
+	client pb.WaypointClient,
+	app *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+	deployment *pb.Deployment,
+) (*pb.Deployment, error) {
+	latest, err := execResolveDeployment(ctx, client, app, ws, "latest")
+	if err != nil {
+		return nil, err
+	}
+	if latest.Sequence <= deployment.Sequence {
+		return nil, nil
+	}
+
+	return latest, nil
+}
+
+// execLatest returns true if the deployment value refers to the latest
+// deployment rather than a specific one.
+func execLatest(value string) bool {
+	return value == "" || value == "latest"
+}
+
+// execWaitTimedOut returns true if err ended a session that never opened
+// because it timed out waiting, such as for an instance to be assigned.
+func execWaitTimedOut(err error, opened bool) bool {
+	return !opened && status.Code(err) == codes.DeadlineExceeded
+}
+
+// findDeployment returns the deployment that value refers to: empty or
+// "latest" for the first of deployments, a sequence number such as "12"
+// or "v12", or a deployment ID.
+func findDeployment(deployments []*pb.Deployment, value string) (*pb.Deployment, error) {
+	if execLatest(value) {
+		return deployments[0], nil
+	}
+
+	// We only treat the value as a sequence number if it looks like
+	// one. Otherwise, or if no sequence matches, it is an ID.
+	if seq, ok := parseDeploymentSeq(value); ok {
+		for _, d := range deployments {
+			if d.Sequence == seq {
+				return d, nil
+			}
+		}
+	}
+
+	for _, d := range deployments {
+		if d.Id == value {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"Deployment %q not found. Available deployments: %s",
+		value, formatDeploymentSeqs(deployments))
+}
+
+// execListDeployments returns the deployments of the app that are
+// running, newest first.
+func execListDeployments(
+	ctx context.Context,
+	client pb.WaypointClient,
+	app *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+) ([]*pb.Deployment, error) {
+	resp, err := client.ListDeployments(ctx, &pb.ListDeploymentsRequest{
+		Application:   app,
+		Workspace:     ws,
+		PhysicalState: pb.Operation_CREATED,
+		Order: &pb.OperationOrder{
+			Order: pb.OperationOrder_COMPLETE_TIME,
+			Desc:  true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Deployments, nil
+}
+
+// execListInstances returns the instances of the deployment.
+func execListInstances(
+	ctx context.Context,
+	client pb.WaypointClient,
+	deploymentId string,
+) ([]*pb.Instance, error) {
+	resp, err := client.ListInstances(ctx, &pb.ListInstancesRequest{
+		Scope: &pb.ListInstancesRequest_DeploymentId{
+			DeploymentId: deploymentId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Instances, nil
+}
+
+// parseDeploymentSeq parses a deployment sequence number such as "12" or
+// "v12". This returns false if the value isn't a sequence number.
+func parseDeploymentSeq(v string) (uint64, bool) {
+	v = strings.TrimPrefix(v, "v")
+	if v == "" {
+		return 0, false
+	}
+
+	for _, r := range v {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+
+	seq, err := strconv.ParseUint(v, 10, 64)
+	return seq, err == nil
+}
+
+// formatDeploymentSeqs returns the sequence numbers of the deployments
+// for use in error messages.
+func formatDeploymentSeqs(ds []*pb.Deployment) string {
+	if len(ds) == 0 {
+		return "none"
+	}
+
+	seqs := make([]string, len(ds))
+	for i, d := range ds {
+		seqs[i] = fmt.Sprintf("v%d", d.Sequence)
+	}
+
+	return strings.Join(seqs, ", ")
+}
+
+// labelSelector selects instances by their labels. Every term must match.
+type labelSelector []labelTerm
+
+// labelTerm is a single "key=value" or "key!=value" requirement. A "!="
+// term matches if the label is missing or has a different value.
+type labelTerm struct {
+	Key   string
+	Value string
+	Not   bool
+}
+
+// parseLabelSelector parses a comma-separated list of "key=value" and
+// "key!=value" terms.
+func parseLabelSelector(v string) (labelSelector, error) {
+	var result labelSelector
+	for _, raw := range strings.Split(v, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		var term labelTerm
+		idx := strings.Index(raw, "=")
+		if idx > 0 && raw[idx-1] == '!' {
+			term.Not = true
+			term.Key = raw[:idx-1]
+		} else if idx > 0 {
+			term.Key = raw[:idx]
+		} else {
+			return nil, fmt.Errorf(
+				"Invalid label selector term %q, expected key=value or key!=value.", raw)
+		}
+
+		term.Key = strings.TrimSpace(term.Key)
+		term.Value = strings.TrimSpace(raw[idx+1:])
+		if term.Key == "" {
+			return nil, fmt.Errorf("Invalid label selector term %q, the key is empty.", raw)
+		}
+
+		result = append(result, term)
+	}
+
+	return result, nil
+}
+
+// Matches returns true if the labels match every term of the selector.
+func (s labelSelector) Matches(labels map[string]string) bool {
+	for _, term := range s {
+		v, ok := labels[term.Key]
+		if term.Not {
+			if ok && v == term.Value {
+				return false
+			}
+		} else if !ok || v != term.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// execResolveInstance returns the ID of an instance of the deployment
+// whose labels match the selector.
+func execResolveInstance(
+	ctx context.Context,
+	client pb.WaypointClient,
+	deploymentId string,
+	sel labelSelector,
+) (string, error) {
+	instances, err := execListInstances(ctx, client, deploymentId)
+	if err != nil {
+		return "", err
+	}
+
+	seen := map[string]struct{}{}
+	var existing []string
+	for _, inst := range instances {
+		if sel.Matches(inst.Labels) {
+			return inst.Id, nil
+		}
+
+		for k, v := range inst.Labels {
+			label := k + "=" + v
+			if _, ok := seen[label]; !ok {
+				seen[label] = struct{}{}
+				existing = append(existing, label)
+			}
+		}
+	}
+
+	available := "none"
+	if len(existing) > 0 {
+		sort.Strings(existing)
+		available = strings.Join(existing, ", ")
+	}
+
+	return "", fmt.Errorf(
+		"No instances match the label selector. Labels on the instances "+
+			"of this deployment: %s", available)
+}
+
+// execResolveInstances returns the IDs of all the instances of the
+// deployment whose labels match the selector. An empty selector matches
+// every instance.
+func execResolveInstances(
+	ctx context.Context,
+	client pb.WaypointClient,
+	deploymentId string,
+	sel labelSelector,
+) ([]string, error) {
+	instances, err := execListInstances(ctx, client, deploymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, inst := range instances {
+		if sel.Matches(inst.Labels) {
+			result = append(result, inst.Id)
+		}
+	}
+
+	if len(result) == 0 {
+		if len(sel) > 0 {
+			return nil, fmt.Errorf("No instances match the label selector.")
+		}
+
+		return nil, fmt.Errorf("The deployment has no running instances.")
+	}
+
+	return result, nil
+}
+
+// execAllocPrefix is the prefix of an -instance value that is a Nomad
+// allocation ID rather than an instance ID.
+const execAllocPrefix = "alloc:"
+
+// execResolveAllocation returns the ID of the instance of the deployment
+// in the Nomad allocation alloc that runs task, along with the task to
+// request. alloc may be a prefix of the allocation ID, or empty for any
+// allocation. task may be empty if the allocation only has one task.
+func execResolveAllocation(
+	ctx context.Context,
+	client pb.WaypointClient,
+	deploymentId string,
+	alloc string,
+	task string,
+) (string, string, error) {
+	instances, err := execListInstances(ctx, client, deploymentId)
+	if err != nil {
+		return "", "", err
+	}
+
+	return execSelectAllocation(instances, alloc, task)
+}
+
+// execSelectAllocation selects the instance for execResolveAllocation.
+func execSelectAllocation(instances []*pb.Instance, alloc, task string) (string, string, error) {
+	var matched []*pb.Instance
+	allocs := map[string]struct{}{}
+	for _, inst := range instances {
+		if inst.AllocationId == "" || !strings.HasPrefix(inst.AllocationId, alloc) {
+			continue
+		}
+
+		matched = append(matched, inst)
+		allocs[inst.AllocationId] = struct{}{}
+	}
+
+	if len(matched) == 0 {
+		if alloc == "" {
+			return "", "", fmt.Errorf(
+				"The deployment has no instances in Nomad allocations, so -task can't be used.")
+		}
+
+		return "", "", fmt.Errorf("No instances of the deployment are in allocation %q.", alloc)
+	}
+
+	// An allocation ID prefix must be unambiguous, like the Nomad CLI.
+	if alloc != "" && len(allocs) > 1 {
+		var ids []string
+		for id := range allocs {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		return "", "", fmt.Errorf(
+			"Allocation %q is ambiguous, it matches: %s", alloc, strings.Join(ids, ", "))
+	}
+
+	// Gather the tasks so that we can list them if one must be chosen.
+	seen := map[string]struct{}{}
+	var tasks []string
+	for _, inst := range matched {
+		for _, t := range inst.Tasks {
+			if _, ok := seen[t]; !ok {
+				seen[t] = struct{}{}
+				tasks = append(tasks, t)
+			}
+		}
+	}
+	sort.Strings(tasks)
+
+	if task == "" {
+		if len(tasks) > 1 {
+			return "", "", fmt.Errorf(
+				"The allocation has several tasks, choose one with -task: %s",
+				strings.Join(tasks, ", "))
+		}
+
+		return matched[0].Id, "", nil
+	}
+
+	for _, inst := range matched {
+		for _, t := range inst.Tasks {
+			if t == task {
+				return inst.Id, task, nil
+			}
+		}
+	}
+
+	available := "none"
+	if len(tasks) > 0 {
+		available = strings.Join(tasks, ", ")
+	}
+
+	return "", "", fmt.Errorf("Task %q not found. Tasks: %s", task, available)
+}
+
+// execRecentRestart is how soon after registering an instance counts as
+// having restarted, if it registered well after its deployment completed.
+const execRecentRestart = time.Minute
+
+// execHealth is what the server knows about the health of an instance we
+// are about to exec into, or of its deployment if the server chooses the
+// instance.
+type execHealth struct {
+	// Name is what the health is for, such as "instance abc123".
+	Name string
+
+	// State is READY, or why the target is unhealthy.
+	State string
+
+	// Since is when the target became ready, if known.
+	Since time.Time
+
+	// Reason explains an unhealthy state.
+	Reason string
+}
+
+// Healthy returns true if nothing suggests the target is unhealthy.
+func (h *execHealth) Healthy() bool {
+	return h.State == "READY"
+}
+
+// Summary returns a one line summary of the health as of now.
+func (h *execHealth) Summary(now time.Time) string {
+	result := h.Name + ": " + h.State
+	if h.Healthy() && !h.Since.IsZero() {
+		result += " for " + formatUptime(now.Sub(h.Since))
+	}
+	if h.Reason != "" {
+		result += ", " + h.Reason
+	}
+
+	return result
+}
+
+// execResolveHealth returns the health of the instances we'll exec into.
+// An empty instance ID means the server chooses the instance, so the
+// health is for the whole deployment. This returns nil if the health
+// can't be determined since it shouldn't stop us from trying to exec.
+func execResolveHealth(
+	ctx context.Context,
+	client pb.WaypointClient,
+	deployment *pb.Deployment,
+	instanceIds []string,
+) []*execHealth {
+	instances, err := execListInstances(ctx, client, deployment.Id)
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	var result []*execHealth
+	for _, id := range instanceIds {
+		result = append(result, execInstanceHealth(deployment, instances, id, now))
+	}
+
+	return result
+}
+
+// execInstanceHealth joins what we know about the deployment and its
+// instances into the health of the instance with the given ID, or of the
+// deployment if the ID is empty.
+func execInstanceHealth(
+	deployment *pb.Deployment,
+	instances []*pb.Instance,
+	id string,
+	now time.Time,
+) *execHealth {
+	var result execHealth
+
+	// A failed deployment makes all of its instances suspect.
+	if st := deployment.Status; st != nil && st.State == pb.Status_ERROR {
+		result.State = "FAILED"
+		result.Reason = fmt.Sprintf("deployment v%d failed", deployment.Sequence)
+		if st.Error != nil && st.Error.Message != "" {
+			result.Reason += ": " + st.Error.Message
+		}
+	}
+
+	if id == "" {
+		result.Name = fmt.Sprintf("deployment v%d", deployment.Sequence)
+		if result.State == "" {
+			result.State = "READY"
+			result.Reason = fmt.Sprintf("%d instance(s)", len(instances))
+		}
+
+		return &result
+	}
+
+	result.Name = "instance " + id
+	var inst *pb.Instance
+	for _, v := range instances {
+		if v.Id == id {
+			inst = v
+			break
+		}
+	}
+	if inst == nil {
+		result.State = "DISCONNECTED"
+		result.Reason = "the instance isn't connected to the server"
+		return &result
+	}
+	if result.State != "" {
+		return &result
+	}
+
+	result.State = "READY"
+	if inst.RegisteredAt == nil {
+		// Older servers don't tell us when the instance registered.
+		return &result
+	}
+
+	registered, err := ptypes.Timestamp(inst.RegisteredAt)
+	if err != nil {
+		return &result
+	}
+	result.Since = registered
+
+	// Instances register again when they restart, so one that registered
+	// recently but long after its deployment completed has restarted.
+	var completed time.Time
+	if st := deployment.Status; st != nil && st.CompleteTime != nil {
+		completed, _ = ptypes.Timestamp(st.CompleteTime)
+	}
+	if uptime := now.Sub(registered); uptime < execRecentRestart &&
+		!completed.IsZero() && registered.Sub(completed) > execRecentRestart {
+		result.State = "RESTARTED"
+		result.Reason = fmt.Sprintf(
+			"the instance restarted %s ago and may be crash-looping", formatUptime(uptime))
+	}
+
+	return &result
+}
+
+// formatUptime formats a duration such as "2h14m" or "42s".
+func formatUptime(d time.Duration) string {
+	if d < time.Minute {
+		return d.Truncate(time.Second).String()
+	}
+
+	return strings.TrimSuffix(d.Truncate(time.Minute).String(), "0s")
+}
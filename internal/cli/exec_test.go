@@ -0,0 +1,392 @@
+package cli
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hashicorp/go-hclog"
+	"github.com/posener/complete"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/serverclient"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+func TestExecCommand_ttyOptions(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Interactive bool
+		TTY         bool
+		Force       bool
+		Terminal    bool
+		ForcePTY    bool
+		DisablePTY  bool
+		ReadOnly    bool
+		Err         bool
+	}{
+		{
+			"neither flag",
+			false, false, false, true,
+			false, false, false,
+			false,
+		},
+		{
+			"interactive only",
+			true, false, false, true,
+			false, true, false,
+			false,
+		},
+		{
+			"tty only",
+			false, true, false, true,
+			true, false, true,
+			false,
+		},
+		{
+			"interactive and tty",
+			true, true, false, true,
+			true, false, false,
+			false,
+		},
+		{
+			"tty without a terminal",
+			true, true, false, false,
+			false, false, false,
+			true,
+		},
+		{
+			"tty without a terminal forced",
+			true, true, true, false,
+			true, false, false,
+			false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			c := &ExecCommand{
+				flagInteractive: tt.Interactive,
+				flagTTY:         tt.TTY,
+				flagForce:       tt.Force,
+			}
+
+			var client execclient.Client
+			err := c.ttyOptions(&client, tt.Terminal)
+			if tt.Err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			require.Equal(tt.ForcePTY, client.ForcePTY)
+			require.Equal(tt.DisablePTY, client.DisablePTY)
+			require.Equal(tt.ReadOnly, client.ReadOnly)
+		})
+	}
+}
+
+func TestExecCommand_ttyOptionsDetach(t *testing.T) {
+	require := require.New(t)
+
+	// Detaching on its own has no PTY or stdin
+	c := &ExecCommand{flagDetach: true}
+	var client execclient.Client
+	require.NoError(c.ttyOptions(&client, true))
+	require.True(client.DisablePTY)
+	require.True(client.ReadOnly)
+
+	// Stdin can be kept open for attaching clients
+	client = execclient.Client{}
+	c = &ExecCommand{flagDetach: true, flagInteractive: true}
+	require.NoError(c.ttyOptions(&client, true))
+	require.True(client.DisablePTY)
+	require.False(client.ReadOnly)
+
+	// But not an interactive PTY
+	c = &ExecCommand{flagDetach: true, flagInteractive: true, flagTTY: true}
+	require.Error(c.ttyOptions(&client, true))
+}
+
+func TestExecCommand_orphanPolicy(t *testing.T) {
+	require := require.New(t)
+
+	// Background processes are killed unless the command is detached
+	c := &ExecCommand{}
+	require.Equal(pb.ExecStreamRequest_KILL_GROUP, c.orphanPolicy())
+
+	c = &ExecCommand{flagDetach: true}
+	require.Equal(pb.ExecStreamRequest_ORPHAN_ALLOWED, c.orphanPolicy())
+}
+
+func TestParseDeploymentSeq(t *testing.T) {
+	cases := []struct {
+		Input string
+		Seq   uint64
+		Ok    bool
+	}{
+		{"12", 12, true},
+		{"v12", 12, true},
+		{"v", 0, false},
+		{"", 0, false},
+		{"01EXAMPLEID", 0, false},
+		{"12a", 0, false},
+		{"-1", 0, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Input, func(t *testing.T) {
+			seq, ok := parseDeploymentSeq(tt.Input)
+			require.Equal(t, tt.Ok, ok)
+			require.Equal(t, tt.Seq, seq)
+		})
+	}
+}
+
+func TestExecWaitTimedOut(t *testing.T) {
+	require := require.New(t)
+
+	timeout := status.Error(codes.DeadlineExceeded, "timed out after 1m0s waiting for the session to open")
+	require.True(execWaitTimedOut(timeout, false))
+	require.True(execWaitTimedOut(&execclient.SessionError{SessionId: "s1", Err: timeout}, false))
+
+	// Once the session opened, the deployment was fine
+	require.False(execWaitTimedOut(timeout, true))
+	require.False(execWaitTimedOut(status.Error(codes.Unavailable, "down"), false))
+	require.False(execWaitTimedOut(nil, false))
+}
+
+func TestExecCheckReleaseDeployment(t *testing.T) {
+	release := &pb.Release{Sequence: 3, DeploymentId: "d1"}
+
+	t.Run("running", func(t *testing.T) {
+		require.NoError(t, execCheckReleaseDeployment(release,
+			&pb.Deployment{Id: "d1", Sequence: 12, State: pb.Operation_CREATED}))
+	})
+
+	t.Run("destroyed", func(t *testing.T) {
+		err := execCheckReleaseDeployment(release,
+			&pb.Deployment{Id: "d1", Sequence: 12, State: pb.Operation_DESTROYED})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "deployment v12, which has been destroyed")
+	})
+
+	t.Run("gone", func(t *testing.T) {
+		err := execCheckReleaseDeployment(release, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "deployment d1, which no longer exists")
+	})
+
+	t.Run("no deployment", func(t *testing.T) {
+		err := execCheckReleaseDeployment(&pb.Release{Sequence: 3}, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "isn't backed by a deployment")
+	})
+}
+
+func TestLabelSelector(t *testing.T) {
+	labels := map[string]string{"role": "primary", "zone": "a"}
+
+	cases := []struct {
+		Selector string
+		Match    bool
+		Err      bool
+	}{
+		{"role=primary", true, false},
+		{"role=replica", false, false},
+		{"role=primary,zone=a", true, false},
+		{"role=primary, zone!=a", false, false},
+		{"zone!=b", true, false},
+		{"missing!=x", true, false},
+		{"missing=x", false, false},
+		{"role", false, true},
+		{"=primary", false, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Selector, func(t *testing.T) {
+			sel, err := parseLabelSelector(tt.Selector)
+			if tt.Err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.Match, sel.Matches(labels))
+		})
+	}
+}
+
+func TestExecSelectAllocation(t *testing.T) {
+	instances := []*pb.Instance{
+		{Id: "a", AllocationId: "8c5cb3e4-aaaa", Tasks: []string{"web"}},
+		{Id: "b", AllocationId: "8c5cb3e4-aaaa", Tasks: []string{"logger"}},
+		{Id: "c", AllocationId: "19f2d7c1-bbbb", Tasks: []string{"web"}},
+		{Id: "d", AllocationId: "19f3a001-cccc", Tasks: []string{"web", "envoy"}},
+		{Id: "e"},
+	}
+
+	cases := []struct {
+		Name     string
+		Alloc    string
+		Task     string
+		Instance string
+		Err      string
+	}{
+		{"single task", "19f2", "", "c", ""},
+		{"task chosen", "8c5cb3e4", "logger", "b", ""},
+		{"task of a shared instance", "19f3", "envoy", "d", ""},
+		{"any allocation", "", "logger", "b", ""},
+		{"several tasks", "8c5c", "", "", "choose one with -task: logger, web"},
+		{"unknown task", "8c5c", "db", "", "Tasks: logger, web"},
+		{"ambiguous", "19f", "", "", "ambiguous"},
+		{"not found", "ffff", "", "", "No instances"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			id, task, err := execSelectAllocation(instances, tt.Alloc, tt.Task)
+			if tt.Err != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Err)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tt.Instance, id)
+			require.Equal(tt.Task, task)
+		})
+	}
+}
+
+func TestExecInstanceHealth(t *testing.T) {
+	now := time.Now()
+	ts := func(d time.Duration) *timestamp.Timestamp {
+		v, err := ptypes.TimestampProto(now.Add(-d))
+		require.NoError(t, err)
+		return v
+	}
+
+	deployment := &pb.Deployment{
+		Sequence: 3,
+		Status: &pb.Status{
+			State:        pb.Status_SUCCESS,
+			CompleteTime: ts(3 * time.Hour),
+		},
+	}
+	failed := &pb.Deployment{
+		Sequence: 4,
+		Status: &pb.Status{
+			State: pb.Status_ERROR,
+			Error: &rpcstatus.Status{Message: "image not found"},
+		},
+	}
+	instances := []*pb.Instance{
+		{Id: "A", RegisteredAt: ts(2*time.Hour + 14*time.Minute + 5*time.Second)},
+		{Id: "B", RegisteredAt: ts(20 * time.Second)},
+		{Id: "C"},
+	}
+
+	cases := []struct {
+		Name       string
+		Deployment *pb.Deployment
+		Instance   string
+		Healthy    bool
+		Summary    string
+	}{
+		{
+			"ready",
+			deployment, "A",
+			true,
+			"instance A: READY for 2h14m",
+		},
+		{
+			"restarted",
+			deployment, "B",
+			false,
+			"instance B: RESTARTED, the instance restarted 20s ago and may be crash-looping",
+		},
+		{
+			"no registration time",
+			deployment, "C",
+			true,
+			"instance C: READY",
+		},
+		{
+			"disconnected",
+			deployment, "D",
+			false,
+			"instance D: DISCONNECTED, the instance isn't connected to the server",
+		},
+		{
+			"deployment",
+			deployment, "",
+			true,
+			"deployment v3: READY, 3 instance(s)",
+		},
+		{
+			"failed deployment",
+			failed, "A",
+			false,
+			"instance A: FAILED, deployment v4 failed: image not found",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			h := execInstanceHealth(tt.Deployment, instances, tt.Instance, now)
+			require.Equal(t, tt.Healthy, h.Healthy())
+			require.Equal(t, tt.Summary, h.Summary(now))
+		})
+	}
+}
+
+func TestExecCommand_predictorTimeout(t *testing.T) {
+	require := require.New(t)
+
+	// A server that accepts connections but never responds
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	td, err := ioutil.TempDir("", "waypoint-exec")
+	require.NoError(err)
+	defer os.RemoveAll(td)
+
+	defer os.Setenv("XDG_CONFIG_HOME", os.Getenv("XDG_CONFIG_HOME"))
+	defer os.Setenv(serverclient.EnvServerAddr, os.Getenv(serverclient.EnvServerAddr))
+	os.Setenv("XDG_CONFIG_HOME", td)
+	os.Setenv(serverclient.EnvServerAddr, ln.Addr().String())
+
+	c := &ExecCommand{baseCommand: &baseCommand{Log: hclog.NewNullLogger()}}
+	p := c.execPredictor(func(ctx context.Context, client pb.WaypointClient) ([]string, error) {
+		<-ctx.Done()
+		return []string{"unreachable"}, nil
+	})
+
+	start := time.Now()
+	require.Nil(p.Predict(complete.Args{}))
+	require.True(time.Since(start) < execCompleteTimeout+time.Second)
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"errors"
+	"os"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+)
+
+type ExecWatchCommand struct {
+	*baseCommand
+}
+
+func (c *ExecWatchCommand) Run(args []string) int {
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(c.Flags()),
+		WithNoConfig(),
+	); err != nil {
+		return 1
+	}
+
+	if len(c.args) != 1 {
+		c.ui.Output("A single session ID is required.\n\n"+c.Help(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	client := &execclient.Client{
+		Logger:     c.Log,
+		UI:         c.ui,
+		Context:    c.Ctx,
+		Client:     c.project.Client(),
+		ServerAddr: c.serverAddr(),
+		SessionId:  c.args[0],
+		Watch:      true,
+		Stdin:      os.Stdin,
+		Stdout:     os.Stdout,
+		Stderr:     os.Stderr,
+	}
+
+	// As with exec, we exit with the exit code of the watched command.
+	exitCode, err := client.Run()
+	var exitErr *execclient.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return exitCode
+}
+
+func (c *ExecWatchCommand) Flags() *flag.Sets {
+	return c.flagSet(0, nil)
+}
+
+func (c *ExecWatchCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ExecWatchCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExecWatchCommand) Synopsis() string {
+	return "Watch another user's exec session without sending input"
+}
+
+func (c *ExecWatchCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint exec watch [options] SESSION-ID
+
+  Watch an exec session that someone else is running, such as to follow
+  along while they debug. The session ID is shown when "waypoint exec"
+  connects.
+
+  The recent output of the session is shown first, followed by its live
+  output. Nothing you type is sent to the session. The user running the
+  session is told when you start and stop watching.
+
+  Watching ends when the session exits, with the same exit code, or when
+  you interrupt it. This doesn't affect the session.
+
+` + c.Flags().Help())
+}
@@ -2,22 +2,64 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"strings"
+	"time"
 
-	"github.com/fatih/color"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/posener/complete"
 
 	clientpkg "github.com/hashicorp/waypoint/internal/client"
 	"github.com/hashicorp/waypoint/internal/clierrors"
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/logviewer"
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 )
 
 type LogsCommand struct {
 	*baseCommand
-}
 
-var headerColor = color.New(color.FgCyan)
+	flagSince    string
+	flagUntil    string
+	flagTail     int
+	flagNoFollow bool
+	flagNoPrefix bool
+
+	flagTimestamps bool
+	flagTimeFormat string
+	flagTimeZone   string
+
+	flagGrep      []string
+	flagGrepRegex []string
+	flagInvert    bool
+	flagStderr    bool
+	flagStdout    bool
+	flagNoExec    bool
+
+	flagParseJSON bool
+	flagFields    []string
+	flagLevel     string
+
+	flagOutput string
+
+	flagOutputFile string
+	flagMaxSize    string
+	flagMaxFiles   int
+	flagQuiet      bool
+
+	flagInstances  []string
+	flagDeployment string
+
+	flagJob    string
+	flagRunner string
+
+	flagReorderWindow time.Duration
+}
 
 func (c *LogsCommand) Run(args []string) int {
 	// Initialize. If we fail, we just exit since Init handles the UI.
@@ -29,55 +71,238 @@ func (c *LogsCommand) Run(args []string) int {
 		return 1
 	}
 
-	err := c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
-		lv, err := app.Logs(ctx)
+	now := time.Now()
+	req := pb.GetLogStreamRequest{
+		LimitBacklog: int32(c.flagTail),
+		NoFollow:     c.flagNoFollow,
+		InstanceIds:  c.flagInstances,
+	}
+	for _, f := range []struct {
+		name   string
+		value  string
+		target **timestamp.Timestamp
+	}{
+		{"since", c.flagSince, &req.Since},
+		{"until", c.flagUntil, &req.Until},
+	} {
+		if f.value == "" {
+			continue
+		}
+
+		ts, err := parseLogsTime(f.value, now)
 		if err != nil {
-			if !clierrors.IsCanceled(err) {
-				app.UI.Output("Error reading logs: %s", err, terminal.WithErrorStyle())
-			}
-			return ErrSentinel
+			c.ui.Output("Invalid -%s value: %s", f.name, err, terminal.WithErrorStyle())
+			return 1
 		}
 
-		for {
-			batch, err := lv.NextLogBatch(ctx)
+		*f.target = ts
+	}
+
+	if c.flagStderr && c.flagStdout {
+		c.ui.Output("-stderr-only and -stdout-only can't be used together",
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	if len(c.flagGrep) > 0 || len(c.flagGrepRegex) > 0 || c.flagStderr || c.flagStdout ||
+		c.flagNoExec {
+		req.Filter = &pb.GetLogStreamRequest_Filter{
+			Contains: c.flagGrep,
+			Regexps:  c.flagGrepRegex,
+			Invert:   c.flagInvert,
+			NoExec:   c.flagNoExec,
+		}
+
+		switch {
+		case c.flagStderr:
+			req.Filter.Source = pb.LogBatch_Entry_STDERR
+
+		case c.flagStdout:
+			req.Filter.Source = pb.LogBatch_Entry_STDOUT
+		}
+
+		// Validate the filter here so that we can show a nicer error.
+		if _, err := logviewer.NewFilter(req.Filter); err != nil {
+			c.ui.Output("Invalid -grep-regex value: %s", err, terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	if !validLogTimeFormat(c.flagTimeFormat) {
+		c.ui.Output("Invalid -time-format value: %q isn't a Go time layout, "+
+			"rfc3339, unix or relative", c.flagTimeFormat, terminal.WithErrorStyle())
+		return 1
+	}
+
+	location, err := parseLogsTimeZone(c.flagTimeZone)
+	if err != nil {
+		c.ui.Output("Invalid -time-zone value: %s", err, terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Setting the fields or the level implies parsing JSON.
+	var jsonFormatter *logviewer.JSONFormatter
+	if c.flagParseJSON || len(c.flagFields) > 0 || c.flagLevel != "" {
+		if c.flagLevel != "" && !logviewer.ValidJSONLevel(c.flagLevel) {
+			c.ui.Output("Invalid -level value: %q isn't a known log level", c.flagLevel,
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		jsonFormatter = &logviewer.JSONFormatter{
+			Fields: c.flagFields,
+			Level:  c.flagLevel,
+		}
+	}
+
+	var file *logFile
+	if c.flagOutputFile != "" {
+		maxSize, err := humanize.ParseBytes(c.flagMaxSize)
+		if err != nil {
+			c.ui.Output("Invalid -max-size value: %s", err, terminal.WithErrorStyle())
+			return 1
+		}
+
+		file = &logFile{
+			Path:     c.flagOutputFile,
+			MaxSize:  int64(maxSize),
+			MaxFiles: c.flagMaxFiles,
+			OnError: func(err error) {
+				c.ui.Output("Error writing logs to %s: %s", c.flagOutputFile, err,
+					terminal.WithWarningStyle())
+			},
+		}
+		defer file.Close()
+	} else if c.flagQuiet {
+		c.ui.Output("-quiet requires -output-file", terminal.WithErrorStyle())
+		return 1
+	}
+
+	printer := &logPrinter{
+		NoColor:  c.flagPlain || os.Getenv("NO_COLOR") != "",
+		NoPrefix: c.flagNoPrefix,
+
+		// With a single instance or job there's no need to show it
+		NoInstance: len(c.flagInstances) == 1 || c.flagJob != "",
+
+		NoTimestamp: !c.flagTimestamps,
+		TimeFormat:  c.flagTimeFormat,
+		Location:    location,
+	}
+	if !c.flagQuiet {
+		printer.Output = func(line string) { c.ui.Output(line) }
+	}
+	if file != nil {
+		printer.Plain = file.WriteLine
+	}
+	if jsonFormatter != nil {
+		printer.Format = jsonFormatter.Format
+	}
+
+	client := c.project.Client()
+	if c.flagJob != "" || c.flagRunner != "" {
+		return c.runJobs(c.Ctx, client, req.Since, printer, file)
+	}
+
+	workspaceRef := c.project.WorkspaceRef()
+	err = c.DoApp(c.Ctx, func(ctx context.Context, app *clientpkg.App) error {
+		// With -deployment we follow a single deployment rather than the
+		// whole app. With "any", the stream ends once the deployment has no
+		// instances left so that we can move on to the newest deployment.
+		follow := c.flagDeployment == "any" && !req.NoFollow
+		if c.flagDeployment != "" {
+			deployment, err := logsResolveDeployment(
+				ctx, client, app.Ref(), workspaceRef, c.flagDeployment)
 			if err != nil {
-				if !clierrors.IsCanceled(err) {
-					app.UI.Output("Error reading logs: %s", err, terminal.WithErrorStyle())
-				}
+				app.UI.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
 				return ErrSentinel
 			}
 
-			if len(batch) == 0 {
-				break
+			req.Scope = &pb.GetLogStreamRequest_DeploymentId{
+				DeploymentId: deployment.Id,
 			}
+			req.EndWhenEmpty = follow
+		}
 
-			for _, event := range batch {
-				event.Message = strings.TrimSuffix(event.Message, "\n")
+		lv, err := app.Logs(ctx, &req)
+		if err != nil {
+			if !clierrors.IsCanceled(err) {
+				app.UI.Output("Error reading logs: %s", err, terminal.WithErrorStyle())
+			}
+			return ErrSentinel
+		}
 
-				// We use this format rather than regular RFC3339Nano because we use .0
-				// instead of .9, which preserves the spacing so the output is always
-				// lined up
-				ts := event.Timestamp.Format("2006-01-02T15:04:05.000Z07:00")
-				short := event.Partition
-				if len(short) > 6 {
-					short = short[len(short)-6:]
-				}
+		// Notices, such as that we're reconnecting, are shown in order
+		// with the events.
+		itemCh := make(chan *logsItem)
+		notify := c.logsNotify(ctx, itemCh)
 
-				header := headerColor.Sprintf("%s %s: ", ts, short)
-				if strings.IndexByte(event.Message, '\n') != -1 {
-					parts := strings.Split(event.Message, "\n")
+		lv.OnReconnect = func(err error, wait time.Duration) {
+			notify(&logsItem{
+				notice: fmt.Sprintf("Log stream interrupted: %s. Reconnecting in %s...",
+					clierrors.Humanize(err), wait),
+				options: []interface{}{terminal.WithWarningStyle()},
+			})
+		}
+
+		if follow {
+			wait := logsNextMinWait
+			lv.Next = func(ctx context.Context, prev *pb.GetLogStreamRequest) (*pb.GetLogStreamRequest, error) {
+				for {
+					if req.Until != nil {
+						until, err := ptypes.Timestamp(req.Until)
+						if err == nil && !time.Now().Before(until) {
+							return nil, nil
+						}
+					}
+
+					deployment, err := logsResolveDeployment(
+						ctx, client, app.Ref(), workspaceRef, "any")
+					if err == nil && deployment.Id != prev.GetDeploymentId() {
+						wait = logsNextMinWait
+						notify(&logsItem{
+							notice: fmt.Sprintf("==> Following deployment v%d", deployment.Sequence),
+						})
+
+						next := proto.Clone(&req).(*pb.GetLogStreamRequest)
+						next.Scope = &pb.GetLogStreamRequest_DeploymentId{
+							DeploymentId: deployment.Id,
+						}
+						return next, nil
+					}
 
-					for _, part := range parts {
-						m := header + part
-						c.ui.Output(m)
+					// There's no newer deployment yet, or we couldn't list
+					// them. We wait and then follow the same deployment again
+					// in case its instances came back.
+					select {
+					case <-time.After(wait):
+					case <-ctx.Done():
+						return nil, ctx.Err()
+					}
+
+					wait *= 2
+					if wait > logsNextMaxWait {
+						wait = logsNextMaxWait
+					}
+
+					if err == nil {
+						return prev, nil
 					}
-				} else {
-					m := header + event.Message
-					c.ui.Output(m)
 				}
 			}
 		}
 
+		if c.flagOutput != "text" {
+			printer.Encode = c.logsEncode(lv.Deployment)
+		}
+
+		if err := c.printLogs(ctx, lv, printer, file, itemCh); err != nil {
+			if !clierrors.IsCanceled(err) {
+				app.UI.Output("Error reading logs: %s", err, terminal.WithErrorStyle())
+			}
+			return ErrSentinel
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -87,8 +312,413 @@ func (c *LogsCommand) Run(args []string) int {
 	return 0
 }
 
+// printLogs prints the events of lv until it ends, ordering them with a
+// logMerger. Notices sent to itemCh are shown between the events, and
+// itemCh is closed once lv ends. This returns the error lv failed with.
+func (c *LogsCommand) printLogs(
+	ctx context.Context,
+	lv logsViewer,
+	printer *logPrinter,
+	file *logFile,
+	itemCh chan *logsItem,
+) error {
+	merger := &logMerger{Window: c.flagReorderWindow}
+	printEvents := func(events []*logMergeEvent) {
+		for _, e := range events {
+			printer.Print(e)
+		}
+	}
+
+	// Read the batches in the background so that we can release the
+	// events held to be ordered while the stream is quiet.
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(itemCh)
+		for {
+			batch, err := lv.NextEvents(ctx)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			if len(batch) == 0 {
+				return
+			}
+
+			select {
+			case itemCh <- &logsItem{batch: batch}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		var readyCh <-chan time.Time
+		if next, ok := merger.Next(); ok {
+			readyCh = time.After(time.Until(next))
+		}
+
+		select {
+		case item, ok := <-itemCh:
+			if !ok {
+				printEvents(merger.Flush())
+				printer.Flush()
+
+				select {
+				case err := <-errCh:
+					return err
+
+				default:
+					return nil
+				}
+			}
+
+			if item.notice != "" {
+				printEvents(merger.Flush())
+				printer.Flush()
+				c.ui.Output(item.notice, item.options...)
+				if file != nil {
+					file.WriteLine(item.notice)
+				}
+				continue
+			}
+
+			merger.Add(item.batch, time.Now())
+
+		case <-readyCh:
+		}
+
+		printEvents(merger.Release(time.Now()))
+	}
+}
+
+// logsNotify returns a function that sends notices to itemCh to be shown
+// by printLogs.
+func (c *LogsCommand) logsNotify(ctx context.Context, itemCh chan<- *logsItem) func(*logsItem) {
+	return func(item *logsItem) {
+		// Notices would corrupt the machine-readable formats. Each record
+		// has its deployment or job so switching is still visible.
+		if c.flagOutput != "text" {
+			return
+		}
+
+		select {
+		case itemCh <- item:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// logsEncode returns the logPrinter.Encode function for -output.
+// deployment, if set, returns the deployment of an instance.
+func (c *LogsCommand) logsEncode(
+	deployment func(instanceId string) (string, uint64),
+) func(logviewer.Event, string) string {
+	return func(event logviewer.Event, line string) string {
+		r := &logRecord{
+			Timestamp:     event.Timestamp,
+			Partition:     event.Partition,
+			Message:       line,
+			Stream:        logStream(event.Source),
+			ExecSessionId: event.ExecSessionId,
+		}
+		if deployment != nil {
+			r.InstanceId = event.Partition
+			r.DeploymentId, r.DeploymentSequence = deployment(event.Partition)
+		}
+
+		if c.flagOutput == "logfmt" {
+			return r.Logfmt()
+		}
+
+		return r.JSON()
+	}
+}
+
 func (c *LogsCommand) Flags() *flag.Sets {
-	return c.flagSet(0, nil)
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "since",
+			Target: &c.flagSince,
+			Usage: "Only show logs since this time. This is either a duration " +
+				"before now, such as 1h, or an RFC3339 timestamp.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "until",
+			Target: &c.flagUntil,
+			Usage: "Only show logs until this time, in the same format as -since. " +
+				"If this is in the past the command exits once the logs are shown.",
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:   "tail",
+			Target: &c.flagTail,
+			Usage: "Show at most this many of the latest lines of each instance " +
+				"before following new logs. A negative value shows all buffered " +
+				"lines. Defaults to 100, or to all lines with -since.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "no-follow",
+			Target: &c.flagNoFollow,
+			Usage:  "Exit once the existing logs are shown rather than following new logs.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "instance",
+			Target: &c.flagInstances,
+			Usage: "Only show the logs of this instance. This is the instance ID " +
+				"or its end, such as the short ID shown before log lines. This " +
+				"can be specified multiple times.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "deployment",
+			Target: &c.flagDeployment,
+			Usage: "Only show the logs of this deployment. This is a sequence " +
+				"number such as v12, a deployment ID or \"latest\". \"any\" " +
+				"follows the newest deployment, moving on to the next one when " +
+				"it has no instances left.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "job",
+			Target: &c.flagJob,
+			Usage: "Show the output of this job, such as a build on a remote " +
+				"runner, rather than the logs of the app. This follows the " +
+				"output until the job completes.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "runner",
+			Target: &c.flagRunner,
+			Usage: "Show the output of the latest job of this runner, and then " +
+				"of each job it runs after that.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "no-prefix",
+			Target: &c.flagNoPrefix,
+			Usage:  "Show only the log lines, without the timestamp and instance of each.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:    "timestamps",
+			Target:  &c.flagTimestamps,
+			Default: true,
+			Usage:   "Show the timestamp of each log line.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "time-format",
+			Target: &c.flagTimeFormat,
+			Usage: "The format of timestamps. This is a Go time layout such as " +
+				"15:04:05, rfc3339, unix for seconds since the epoch or relative " +
+				"for the age of each line such as 3m ago. Defaults to RFC3339 " +
+				"with milliseconds.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "time-zone",
+			Target:  &c.flagTimeZone,
+			Default: "utc",
+			Usage: "The time zone to show timestamps in. This is utc, local or " +
+				"an IANA time zone name such as Europe/Berlin.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "grep",
+			Target: &c.flagGrep,
+			Usage: "Only show log lines that contain this text. This can be " +
+				"specified multiple times and lines must match every filter.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "grep-regex",
+			Target: &c.flagGrepRegex,
+			Usage: "Only show log lines that match this regular expression. This " +
+				"can be specified multiple times and lines must match every filter.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "invert",
+			Target: &c.flagInvert,
+			Usage:  "Only show log lines that don't match -grep and -grep-regex.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "stderr-only",
+			Target: &c.flagStderr,
+			Usage: "Only show the log lines the app wrote to stderr. Lines from " +
+				"entrypoints that don't report their stream aren't shown.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "stdout-only",
+			Target: &c.flagStdout,
+			Usage:  "Only show the log lines the app wrote to stdout.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "no-exec",
+			Target: &c.flagNoExec,
+			Usage: "Don't show the output of exec sessions mirrored to the logs " +
+				"with 'waypoint exec -log-output'.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "parse-json",
+			Target: &c.flagParseJSON,
+			Usage: "Show log lines that are JSON objects as the time, level and " +
+				"message followed by the other fields as key=value pairs.",
+		})
+
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "field",
+			Target: &c.flagFields,
+			Usage: "Show only this field of JSON log lines after the message. " +
+				"Nested fields are separated by dots, such as http.status. This " +
+				"can be specified multiple times and implies -parse-json.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "level",
+			Target: &c.flagLevel,
+			Usage: "Hide JSON log lines with a level below this, such as warn. " +
+				"This implies -parse-json.",
+		})
+
+		f.EnumSingleVar(&flag.EnumSingleVar{
+			Name:    "output",
+			Target:  &c.flagOutput,
+			Values:  []string{"text", "json", "logfmt"},
+			Default: "text",
+			Usage: "The format of the output. json and logfmt show each log line " +
+				"as a record with its timestamp, instance and deployment, " +
+				"without colors.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "output-file",
+			Target: &c.flagOutputFile,
+			Usage: "Also write the logs to this file, without colors. The file " +
+				"is appended to and rotated once it reaches -max-size.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "max-size",
+			Target:  &c.flagMaxSize,
+			Default: "100MB",
+			Usage: "The size at which -output-file is rotated, such as 10MB. " +
+				"Zero never rotates it.",
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:    "max-files",
+			Target:  &c.flagMaxFiles,
+			Default: 5,
+			Usage: "How many rotated files of -output-file to keep. These are " +
+				"named with a .1, .2 and so on suffix, newest first.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "quiet",
+			Target: &c.flagQuiet,
+			Usage:  "Only write the logs to -output-file rather than showing them too.",
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:    "reorder-window",
+			Target:  &c.flagReorderWindow,
+			Default: 2 * time.Second,
+			Usage: "How long to hold each line to order the lines of all " +
+				"instances by timestamp. Zero shows lines as they arrive.",
+		})
+	})
+}
+
+var (
+	// logsNextMinWait and logsNextMaxWait bound the wait between checks
+	// for a newer deployment with -deployment=any.
+	logsNextMinWait = time.Second
+	logsNextMaxWait = 30 * time.Second
+)
+
+// logsViewer is implemented by the log viewers that printLogs reads from.
+// Unlike component.LogViewer, these know the stream of each line.
+type logsViewer interface {
+	NextEvents(ctx context.Context) ([]logviewer.Event, error)
+}
+
+// logsItem is an item read from the log stream: either a batch of events
+// or a notice to show between them.
+type logsItem struct {
+	batch []logviewer.Event
+
+	notice  string
+	options []interface{}
+}
+
+// logsResolveDeployment resolves the value of -deployment. "any" and
+// "latest" are the newest deployment, otherwise the value is a sequence
+// number or a deployment ID.
+func logsResolveDeployment(
+	ctx context.Context,
+	client pb.WaypointClient,
+	app *pb.Ref_Application,
+	ws *pb.Ref_Workspace,
+	value string,
+) (*pb.Deployment, error) {
+	deployments, err := execListDeployments(ctx, client, app, ws)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployments) == 0 {
+		return nil, fmt.Errorf("No successful deployments found.")
+	}
+
+	if value == "any" {
+		value = "latest"
+	}
+
+	return findDeployment(deployments, value)
+}
+
+// parseLogsTime parses the value of -since or -until: either a duration
+// before now or an RFC3339 timestamp.
+func parseLogsTime(v string, now time.Time) (*timestamp.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		d, derr := time.ParseDuration(v)
+		if derr != nil {
+			return nil, fmt.Errorf(
+				"%q must be a duration such as 1h or an RFC3339 timestamp", v)
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("%q must not be a negative duration", v)
+		}
+
+		t = now.Add(-d)
+	}
+
+	return ptypes.TimestampProto(t)
+}
+
+// parseLogsTimeZone parses the value of -time-zone.
+func parseLogsTimeZone(v string) (*time.Location, error) {
+	switch strings.ToLower(v) {
+	case "", "utc":
+		return time.UTC, nil
+
+	case "local":
+		return time.Local, nil
+
+	default:
+		return time.LoadLocation(v)
+	}
 }
 
 func (c *LogsCommand) AutocompleteArgs() complete.Predictor {
@@ -114,7 +744,76 @@ Usage: waypoint logs [options]
 
   The six character text after the date on a log line is the last six
   characters of the instance ID. This can be used to trace any logs back
-  to a specific deployment or filter out certain log messages.
+  to a specific deployment or filter out certain log messages, or with
+  -instance to only show the logs of that instance. Each instance is
+  given its own color unless -plain is set, NO_COLOR is set or the
+  output isn't a terminal.
+
+  Lines are shown in timestamp order across instances, holding each line
+  for -reorder-window to put the lines around it in order. If the clocks
+  of the instances differ by more than that, a line can be shown after
+  newer lines; it is then marked with a "~" after its timestamp.
+
+  The -grep and -grep-regex flags only show the lines that contain the
+  text or match the regular expression. They can be repeated, and a line
+  must match all of them. -invert shows the lines that don't match
+  instead. Filters apply to the log line itself, not to the timestamp or
+  instance shown before it.
+
+  Timestamps are shown in UTC as RFC3339 with milliseconds by default.
+  Use -time-format for another format, such as -time-format=relative to
+  show the age of each line when it was received, -time-zone to show
+  them in another time zone, or -timestamps=false to hide them. These
+  apply to -output-file too.
+
+  Lines the app wrote to stderr are shown in red, or marked with "E"
+  before the line without colors. Use -stderr-only or -stdout-only to
+  only show the lines of one stream. Lines from older entrypoints that
+  don't report their stream aren't marked.
+
+  The output of exec sessions run with -log-output is mirrored to the
+  logs and marked with "[exec]" before the line. Use -no-exec to hide it.
+
+  Use -parse-json for apps that log JSON objects. Each one is shown as
+  its time, level and message followed by its other fields, or only the
+  fields chosen with -field. -level hides the lines with a lower level.
+  Lines that aren't JSON are shown unchanged.
+
+  Use -output=json or -output=logfmt to ship logs to other systems. Each
+  log line is then a record with the fields timestamp, instance_id,
+  partition, deployment_id, deployment_sequence, message, stream and
+  exec_session_id, which is set for the output of exec sessions.
+
+  Use -output-file to also write the logs to a file, such as to capture
+  an issue over days. The file is rotated once it reaches -max-size and
+  -max-files rotated files are kept. Failing to write the file shows a
+  warning but the logs are still followed. With -quiet the logs are only
+  written to the file.
+
+  The -since and -until flags limit the logs to a time range. Each takes
+  either a duration before now, such as "30m", or an RFC3339 timestamp.
+  If -until is in the past, the command exits once the logs in the range
+  are shown; otherwise it follows new logs until that time.
+
+  By default the logs of all the app's deployments are shown. Use
+  -deployment to show the logs of one deployment, or -deployment=any to
+  follow the newest deployment: when it has no instances left, such as
+  after a redeploy, the logs of the newest deployment are shown next
+  after a "==> Following deployment" line.
+
+  If the connection to the server is lost, such as while it restarts,
+  the command reconnects and resumes where it left off without showing
+  lines again. It only exits on its own with -no-follow or -until.
+
+  Use -job to show the output of a job instead, such as a build run by a
+  remote runner, whether it's still running or has completed. The output
+  is followed until the job completes, with each step shown on a line
+  starting with "==>". -runner shows the output of the latest job of a
+  runner and then of each job the runner is assigned after it.
+
+  Use -tail to choose how many of the latest lines of each instance are
+  shown first, and -no-follow to exit once they are shown rather than
+  following new logs, such as to pipe the logs into another command.
 
 ` + c.Flags().Help())
 }
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+)
+
+// logFile writes log lines to a file, rotating it once it reaches
+// MaxSize. The rotated files are renamed to Path.1, Path.2 and so on,
+// newest first, and only MaxFiles of them are kept.
+//
+// A line is never split across files: if it doesn't fit in the current
+// file, the file is rotated before the line is written.
+//
+// Errors don't stop the writing. They are reported to OnError, once until
+// a write succeeds again, and the file is reopened for the next line.
+type logFile struct {
+	// Path is the path of the file to write.
+	Path string
+
+	// MaxSize is the size in bytes after which the file is rotated. Zero
+	// never rotates the file.
+	MaxSize int64
+
+	// MaxFiles is how many rotated files are kept.
+	MaxFiles int
+
+	// OnError, if set, is called when writing fails.
+	OnError func(error)
+
+	f      *os.File
+	size   int64
+	failed bool
+}
+
+// WriteLine writes a line, adding its newline.
+func (l *logFile) WriteLine(line string) {
+	if err := l.writeLine(line + "\n"); err != nil {
+		if l.f != nil {
+			l.f.Close()
+			l.f = nil
+		}
+
+		if !l.failed && l.OnError != nil {
+			l.OnError(err)
+		}
+		l.failed = true
+		return
+	}
+
+	l.failed = false
+}
+
+func (l *logFile) writeLine(line string) error {
+	if l.f == nil {
+		if err := l.open(); err != nil {
+			return err
+		}
+	}
+
+	if l.MaxSize > 0 && l.size > 0 && l.size+int64(len(line)) > l.MaxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.f.WriteString(line)
+	l.size += int64(n)
+	return err
+}
+
+// Close closes the file.
+func (l *logFile) Close() error {
+	if l.f == nil {
+		return nil
+	}
+
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
+
+// open opens the file to append to it.
+func (l *logFile) open() error {
+	f, err := os.OpenFile(l.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	l.f = f
+	l.size = fi.Size()
+	return nil
+}
+
+// rotate moves the current file to Path.1, shifting the older files
+// along and replacing the oldest, and then opens a new file.
+func (l *logFile) rotate() error {
+	if err := l.f.Close(); err != nil {
+		return err
+	}
+	l.f = nil
+
+	if l.MaxFiles > 0 {
+		for i := l.MaxFiles - 1; i >= 1; i-- {
+			err := os.Rename(l.rotatedPath(i), l.rotatedPath(i+1))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := os.Rename(l.Path, l.rotatedPath(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(l.Path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return l.open()
+}
+
+// rotatedPath returns the path of the nth newest rotated file.
+func (l *logFile) rotatedPath(n int) string {
+	return fmt.Sprintf("%s.%d", l.Path, n)
+}
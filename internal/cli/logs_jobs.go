@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/logviewer"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+)
+
+// runJobs shows the output of the job given with -job, or of the jobs of
+// the runner given with -runner, the same way as the logs of instances.
+func (c *LogsCommand) runJobs(
+	ctx context.Context,
+	client pb.WaypointClient,
+	since *timestamp.Timestamp,
+	printer *logPrinter,
+	file *logFile,
+) int {
+	if c.flagJob != "" && c.flagRunner != "" {
+		c.ui.Output("-job and -runner can't be used together", terminal.WithErrorStyle())
+		return 1
+	}
+
+	req := &pb.GetJobStreamRequest{
+		Since:        since,
+		LimitBacklog: int32(c.flagTail),
+		NoFollow:     c.flagNoFollow,
+	}
+
+	itemCh := make(chan *logsItem)
+	notify := c.logsNotify(ctx, itemCh)
+
+	var last *pb.Job
+	lv := &logsJobsViewer{
+		Next: func(ctx context.Context, prev *logviewer.JobViewer) (*logviewer.JobViewer, error) {
+			if prev != nil {
+				if complete := prev.Complete(); complete != nil && complete.Error != nil {
+					notify(&logsItem{
+						notice: fmt.Sprintf("Job %s failed: %s",
+							prev.Partition, status.FromProto(complete.Error).Message()),
+						options: []interface{}{terminal.WithWarningStyle()},
+					})
+				}
+
+				if c.flagJob != "" || req.NoFollow {
+					return nil, nil
+				}
+			}
+
+			jobId := c.flagJob
+			if c.flagRunner != "" {
+				job, err := waitRunnerJob(ctx, client, c.flagRunner, last, !req.NoFollow)
+				if err != nil || job == nil {
+					return nil, err
+				}
+
+				last = job
+				jobId = job.Id
+				notify(&logsItem{notice: fmt.Sprintf("==> Job %s", job.Id)})
+			}
+
+			jobReq := proto.Clone(req).(*pb.GetJobStreamRequest)
+			jobReq.JobId = jobId
+			stream, err := client.GetJobStream(ctx, jobReq)
+			if err != nil {
+				return nil, err
+			}
+
+			return &logviewer.JobViewer{Stream: stream, Partition: jobId}, nil
+		},
+	}
+
+	if c.flagOutput != "text" {
+		printer.Encode = c.logsEncode(nil)
+	}
+
+	if err := c.printLogs(ctx, lv, printer, file, itemCh); err != nil {
+		if !clierrors.IsCanceled(err) {
+			c.ui.Output("Error reading job output: %s", clierrors.Humanize(err),
+				terminal.WithErrorStyle())
+		}
+		return 1
+	}
+
+	return 0
+}
+
+// logsJobsViewer implements logsViewer over the output of a
+// sequence of jobs, moving on to the next job once the output of one
+// ends.
+type logsJobsViewer struct {
+	// Next returns the viewer of the next job to show, or nil once there
+	// are no more. prev is the viewer of the previous job, if any.
+	Next func(ctx context.Context, prev *logviewer.JobViewer) (*logviewer.JobViewer, error)
+
+	current *logviewer.JobViewer
+	prev    *logviewer.JobViewer
+}
+
+// NextEvents implements logsViewer
+func (v *logsJobsViewer) NextEvents(ctx context.Context) ([]logviewer.Event, error) {
+	for {
+		if v.current == nil {
+			next, err := v.Next(ctx, v.prev)
+			if err != nil || next == nil {
+				return nil, err
+			}
+
+			v.current = next
+		}
+
+		batch, err := v.current.NextEvents(ctx)
+		if err != nil || len(batch) > 0 {
+			return batch, err
+		}
+
+		v.prev, v.current = v.current, nil
+	}
+}
+
+// waitRunnerJob returns the job of the runner to show after the job
+// after, or its latest job if after is nil. If follow is true, this waits
+// for the runner to be assigned a job rather than returning nil.
+func waitRunnerJob(
+	ctx context.Context,
+	client pb.WaypointClient,
+	runnerId string,
+	after *pb.Job,
+	follow bool,
+) (*pb.Job, error) {
+	wait := logsNextMinWait
+	for {
+		resp, err := client.XListJobs(ctx, &pb.ListJobsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		if job := nextRunnerJob(resp.Jobs, runnerId, after); job != nil {
+			return job, nil
+		}
+
+		if !follow {
+			if after == nil {
+				return nil, fmt.Errorf("No jobs found for runner %q.", runnerId)
+			}
+
+			return nil, nil
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		wait *= 2
+		if wait > logsNextMaxWait {
+			wait = logsNextMaxWait
+		}
+	}
+}
+
+// nextRunnerJob returns the job that was assigned to the runner next
+// after the job after, or the latest job assigned to it if after is nil.
+func nextRunnerJob(jobs []*pb.Job, runnerId string, after *pb.Job) *pb.Job {
+	var assigned []*pb.Job
+	for _, job := range jobs {
+		if job.AssignedRunner.GetId() == runnerId && job.AssignTime != nil {
+			assigned = append(assigned, job)
+		}
+	}
+	if len(assigned) == 0 {
+		return nil
+	}
+
+	assignTime := func(job *pb.Job) time.Time {
+		t, _ := ptypes.Timestamp(job.AssignTime)
+		return t
+	}
+	sort.SliceStable(assigned, func(i, j int) bool {
+		return assignTime(assigned[i]).Before(assignTime(assigned[j]))
+	})
+
+	if after == nil {
+		return assigned[len(assigned)-1]
+	}
+
+	for _, job := range assigned {
+		if job.Id != after.Id && assignTime(job).After(assignTime(after)) {
+			return job
+		}
+	}
+
+	return nil
+}
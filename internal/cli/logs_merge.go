@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/waypoint/internal/server/logviewer"
+)
+
+// logMerger orders the log events of several instances by timestamp. The
+// events arrive in the order the instances sent them, so a later line of
+// one instance can arrive before an earlier line of another. Each event is
+// held for Window after it arrives so that the events around it can be
+// put in order, and is then released along with every held event that is
+// older than it. An instance that is quiet can't hold up the others.
+//
+// The events of one instance always stay in the order they arrived. If
+// the clocks of the instances are further apart than Window, an event can
+// be released after a newer one; it is then marked as skewed.
+type logMerger struct {
+	// Window is how long events are held to be put in order. Zero
+	// releases events as they arrive.
+	Window time.Duration
+
+	held []*logMergeEvent
+	last time.Time
+
+	// instanceLast is the sort key of the latest event of each instance.
+	instanceLast map[string]time.Time
+}
+
+// logMergeEvent is an event held by a logMerger.
+type logMergeEvent struct {
+	Event logviewer.Event
+
+	// Skewed is true if the event is released after a newer event.
+	Skewed bool
+
+	key     time.Time
+	arrived time.Time
+}
+
+// Add holds events that arrived at now.
+func (m *logMerger) Add(events []logviewer.Event, now time.Time) {
+	if m.instanceLast == nil {
+		m.instanceLast = make(map[string]time.Time)
+	}
+
+	for _, event := range events {
+		// An event never sorts before an earlier event of its instance.
+		key := event.Timestamp
+		if last := m.instanceLast[event.Partition]; key.Before(last) {
+			key = last
+		}
+		m.instanceLast[event.Partition] = key
+
+		// Insert after every held event that isn't newer to keep the
+		// order of arrival between events with the same timestamp.
+		i := sort.Search(len(m.held), func(i int) bool {
+			return m.held[i].key.After(key)
+		})
+		m.held = append(m.held, nil)
+		copy(m.held[i+1:], m.held[i:])
+		m.held[i] = &logMergeEvent{Event: event, key: key, arrived: now}
+	}
+}
+
+// Release returns the events that are ready at now, in order.
+func (m *logMerger) Release(now time.Time) []*logMergeEvent {
+	n := 0
+	for i, e := range m.held {
+		if !e.arrived.Add(m.Window).After(now) {
+			n = i + 1
+		}
+	}
+
+	return m.release(n)
+}
+
+// Flush returns all the held events, in order.
+func (m *logMerger) Flush() []*logMergeEvent {
+	return m.release(len(m.held))
+}
+
+// Next returns when the next held event is ready, if any are held.
+func (m *logMerger) Next() (time.Time, bool) {
+	var next time.Time
+	for _, e := range m.held {
+		if ready := e.arrived.Add(m.Window); next.IsZero() || ready.Before(next) {
+			next = ready
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+// release returns the first n held events.
+func (m *logMerger) release(n int) []*logMergeEvent {
+	result := m.held[:n:n]
+	m.held = m.held[n:]
+	for _, e := range result {
+		if e.key.Before(m.last) {
+			e.Skewed = true
+		} else {
+			m.last = e.key
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// logRecord is a log line in the machine-readable output formats of the
+// logs command. These formats are relied upon by other systems, so the
+// fields and their order must stay the same. New fields go at the end.
+type logRecord struct {
+	Timestamp          time.Time `json:"timestamp"`
+	InstanceId         string    `json:"instance_id"`
+	Partition          string    `json:"partition"`
+	DeploymentId       string    `json:"deployment_id"`
+	DeploymentSequence uint64    `json:"deployment_sequence"`
+	Message            string    `json:"message"`
+	Stream             string    `json:"stream"`
+	ExecSessionId      string    `json:"exec_session_id"`
+}
+
+// JSON returns the record as a JSON object on a single line.
+func (r *logRecord) JSON() string {
+	v := *r
+	v.Timestamp = v.Timestamp.UTC()
+
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(&v); err != nil {
+		// This can't happen since every field can be encoded.
+		panic(err)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// Logfmt returns the record as logfmt key=value pairs.
+func (r *logRecord) Logfmt() string {
+	pairs := []struct {
+		key   string
+		value string
+	}{
+		{"timestamp", r.Timestamp.UTC().Format(time.RFC3339Nano)},
+		{"instance_id", r.InstanceId},
+		{"partition", r.Partition},
+		{"deployment_id", r.DeploymentId},
+		{"deployment_sequence", strconv.FormatUint(r.DeploymentSequence, 10)},
+		{"message", r.Message},
+		{"stream", r.Stream},
+		{"exec_session_id", r.ExecSessionId},
+	}
+
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = p.key + "=" + logfmtValue(p.value)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// logStream returns the stream field of a record for a line from source.
+// This is empty if the source isn't known.
+func logStream(source pb.LogBatch_Entry_Source) string {
+	switch source {
+	case pb.LogBatch_Entry_STDOUT:
+		return "stdout"
+
+	case pb.LogBatch_Entry_STDERR:
+		return "stderr"
+
+	default:
+		return ""
+	}
+}
+
+// logfmtValue quotes v if it is empty or contains anything that would
+// make it ambiguous unquoted.
+func logfmtValue(v string) string {
+	if v == "" {
+		return `""`
+	}
+
+	for _, r := range v {
+		if r <= ' ' || r == '=' || r == '"' || r == '\\' || r == 0x7f {
+			return strconv.Quote(v)
+		}
+	}
+
+	return v
+}
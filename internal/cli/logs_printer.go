@@ -0,0 +1,309 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/logviewer"
+)
+
+// logPrefixColors are the colors of the instance prefixes, given out in
+// the order the instances are first seen.
+var logPrefixColors = []color.Attribute{
+	color.FgCyan,
+	color.FgGreen,
+	color.FgYellow,
+	color.FgMagenta,
+	color.FgBlue,
+	color.FgRed,
+}
+
+// logStderrColor is the color of lines written to stderr.
+var logStderrColor = color.New(color.FgRed)
+
+// logPrinter prints the log events of several instances merged into one
+// stream. Each line is prefixed with its timestamp and a short identifier
+// of its instance, colored the same for each instance and aligned.
+//
+// An event that doesn't end in a newline is held until the rest of its
+// line arrives from the same instance so that lines from other instances
+// can't be printed in the middle of it. Call Flush at the end of the
+// stream to print any held lines.
+//
+// Lines of skewed events, printed after newer lines, are marked with a
+// "~" after their timestamp. Lines written to stderr are shown in red, or
+// marked with "E " without colors. Lines of unknown source aren't marked.
+// Lines of exec sessions mirrored to the logs are marked with "[exec] ".
+//
+// The prefix is also what formats timestamps, so that every output of
+// the logs command shows them the same way.
+type logPrinter struct {
+	// Output is called with each line to print, without its newline.
+	Output func(string)
+
+	// Plain, if set, is called with each line too but never colored,
+	// such as to write it to a file.
+	Plain func(string)
+
+	// NoColor disables the colors of the prefixes.
+	NoColor bool
+
+	// NoPrefix prints only the log lines, without timestamps or instances.
+	NoPrefix bool
+
+	// NoInstance leaves the instance out of the prefix, such as when
+	// there is only one.
+	NoInstance bool
+
+	// NoTimestamp leaves the timestamp out of the prefix.
+	NoTimestamp bool
+
+	// TimeFormat is the format of timestamps. This is a Go time layout or
+	// one of "rfc3339", "unix" or "relative", which shows the age of each
+	// line when it was received, such as "3m ago". This defaults to
+	// RFC3339 with milliseconds.
+	TimeFormat string
+
+	// Location is the time zone timestamps are shown in. This defaults
+	// to UTC.
+	Location *time.Location
+
+	// Format, if set, is called with each line before it is printed, and
+	// the line is skipped if it returns false.
+	Format func(string) (string, bool)
+
+	// Encode, if set, returns each line to print in place of the prefix
+	// and line, such as for a machine-readable format. Colors and
+	// prefixes aren't used.
+	Encode func(event logviewer.Event, line string) string
+
+	width   int
+	colors  map[string]*color.Color
+	partial map[string]*logMergeEvent
+}
+
+// Print prints an event released by a logMerger. Skewed marks its lines
+// as printed out of order.
+func (p *logPrinter) Print(e *logMergeEvent) {
+	event, skewed, arrived := e.Event, e.Skewed, e.arrived
+	if held, ok := p.partial[event.Partition]; ok {
+		delete(p.partial, event.Partition)
+		event.Timestamp = held.Event.Timestamp
+		event.Message = held.Event.Message + event.Message
+		skewed = skewed || held.Skewed
+		arrived = held.arrived
+	}
+
+	lines := strings.Split(event.Message, "\n")
+
+	// The last part is what follows the final newline. If it isn't empty
+	// the line isn't complete yet.
+	if last := lines[len(lines)-1]; last != "" {
+		if p.partial == nil {
+			p.partial = make(map[string]*logMergeEvent)
+		}
+
+		held := &logMergeEvent{Event: event, Skewed: skewed, arrived: arrived}
+		held.Event.Message = last
+		p.partial[event.Partition] = held
+	}
+
+	for _, line := range lines[:len(lines)-1] {
+		p.print(event, skewed, arrived, line)
+	}
+}
+
+// Flush prints the lines held waiting for the rest of their line.
+func (p *logPrinter) Flush() {
+	keys := make([]string, 0, len(p.partial))
+	for k := range p.partial {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		held := p.partial[k]
+		p.print(held.Event, held.Skewed, held.arrived, held.Event.Message)
+	}
+
+	p.partial = nil
+}
+
+// print prints a line of event, which arrived at the given time.
+func (p *logPrinter) print(event logviewer.Event, skewed bool, arrived time.Time, line string) {
+	if p.Format != nil {
+		var ok bool
+		if line, ok = p.Format(line); !ok {
+			return
+		}
+	}
+
+	if p.Encode != nil {
+		line = p.Encode(event, line)
+		p.output(line, line)
+		return
+	}
+
+	prefix := p.prefix(event, skewed, arrived)
+	colored := prefix
+	if prefix != "" && !p.NoColor && !p.NoInstance {
+		colored = p.color(event.Partition).Sprint(prefix)
+	}
+
+	// Output of exec sessions mirrored to the logs is marked so it isn't
+	// mistaken for the app's own.
+	if event.ExecSessionId != "" {
+		line = "[exec] " + line
+	}
+
+	plainLine, coloredLine := line, line
+	if event.Source == pb.LogBatch_Entry_STDERR {
+		plainLine = "E " + line
+		coloredLine = plainLine
+		if !p.NoColor {
+			coloredLine = logStderrColor.Sprint(line)
+		}
+	}
+
+	p.output(colored+coloredLine, prefix+plainLine)
+}
+
+// output calls Output and Plain, if they're set, with a line.
+func (p *logPrinter) output(line, plain string) {
+	if p.Output != nil {
+		p.Output(line)
+	}
+	if p.Plain != nil {
+		p.Plain(plain)
+	}
+}
+
+// prefix returns the prefix of the lines of event, without colors.
+func (p *logPrinter) prefix(event logviewer.Event, skewed bool, arrived time.Time) string {
+	if p.NoPrefix {
+		return ""
+	}
+
+	var ts string
+	if !p.NoTimestamp {
+		ts = p.timestamp(event.Timestamp, arrived)
+	}
+
+	if p.NoInstance {
+		if ts == "" {
+			return ""
+		}
+
+		return ts + ": "
+	}
+
+	short := event.Partition
+	if len(short) > 6 {
+		short = short[len(short)-6:]
+	}
+	if len(short) > p.width {
+		p.width = len(short)
+	}
+
+	// The skew marker is about the order of timestamps so it is only
+	// shown with them.
+	if ts == "" {
+		return fmt.Sprintf("%-*s: ", p.width, short)
+	}
+
+	sep := " "
+	if skewed {
+		sep = "~"
+	}
+
+	return fmt.Sprintf("%s%s%-*s: ", ts, sep, p.width, short)
+}
+
+// timestamp formats the timestamp of an event that arrived at the given
+// time.
+func (p *logPrinter) timestamp(ts, arrived time.Time) string {
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	ts = ts.In(loc)
+
+	switch p.TimeFormat {
+	case "rfc3339":
+		return ts.Format(time.RFC3339)
+
+	case "":
+		// We use this format rather than regular RFC3339Nano because we use
+		// .0 instead of .9, which preserves the spacing so the output is
+		// always lined up
+		return ts.Format("2006-01-02T15:04:05.000Z07:00")
+
+	case "unix":
+		return fmt.Sprintf("%d.%03d", ts.Unix(), ts.Nanosecond()/int(time.Millisecond))
+
+	case "relative":
+		// The age is from when the line was received rather than printed
+		// so that holding it to order it doesn't change it.
+		if arrived.IsZero() {
+			arrived = time.Now()
+		}
+
+		return fmt.Sprintf("%8s", formatLogAge(arrived.Sub(ts)))
+
+	default:
+		return ts.Format(p.TimeFormat)
+	}
+}
+
+// formatLogAge formats the age of a line in its largest unit, such as
+// "3m ago". Lines from the future, because of clock skew, are "0s ago".
+func formatLogAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		if d < 0 {
+			d = 0
+		}
+		return fmt.Sprintf("%ds ago", d/time.Second)
+
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", d/time.Minute)
+
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", d/time.Hour)
+
+	default:
+		return fmt.Sprintf("%dd ago", d/(24*time.Hour))
+	}
+}
+
+// validLogTimeFormat returns true if v is a value of
+// logPrinter.TimeFormat. A Go layout must have at least one element that
+// formats part of the time, which catches misspelled shorthands.
+func validLogTimeFormat(v string) bool {
+	switch v {
+	case "", "rfc3339", "unix", "relative":
+		return true
+	}
+
+	return time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC).Format(v) != v
+}
+
+// color returns the color of the prefix of an instance.
+func (p *logPrinter) color(instance string) *color.Color {
+	c, ok := p.colors[instance]
+	if !ok {
+		if p.colors == nil {
+			p.colors = make(map[string]*color.Color)
+		}
+
+		c = color.New(logPrefixColors[len(p.colors)%len(logPrefixColors)])
+		p.colors[instance] = c
+	}
+
+	return c
+}
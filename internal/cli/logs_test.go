@@ -0,0 +1,570 @@
+package cli
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/logviewer"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+func TestParseLogsTime(t *testing.T) {
+	now := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Value    string
+		Expected time.Time
+		Err      bool
+	}{
+		{"1h", now.Add(-time.Hour), false},
+		{"90s", now.Add(-90 * time.Second), false},
+		{"2020-10-14T08:30:00Z", time.Date(2020, 10, 14, 8, 30, 0, 0, time.UTC), false},
+		{"2020-10-14T08:30:00+02:00", time.Date(2020, 10, 14, 6, 30, 0, 0, time.UTC), false},
+		{"-1h", time.Time{}, true},
+		{"yesterday", time.Time{}, true},
+		{"2020-10-14", time.Time{}, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Value, func(t *testing.T) {
+			require := require.New(t)
+
+			ts, err := parseLogsTime(tt.Value, now)
+			if tt.Err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			actual, err := ptypes.Timestamp(ts)
+			require.NoError(err)
+			require.True(tt.Expected.Equal(actual), actual.String())
+		})
+	}
+}
+
+func TestLogPrinter(t *testing.T) {
+	ts := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+	event := func(instance, msg string) *logMergeEvent {
+		return &logMergeEvent{
+			Event: logviewer.Event{
+				LogEvent: component.LogEvent{Partition: instance, Timestamp: ts, Message: msg},
+			},
+		}
+	}
+
+	t.Run("prefixes and alignment", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Output:  func(line string) { lines = append(lines, line) },
+			NoColor: true,
+		}
+
+		p.Print(event("01EMZ4ABCDEF", "one\n"))
+		p.Print(event("web-2", "two\nthree\n"))
+		p.Print(event("x", "four\n"))
+		p.Flush()
+
+		require.Equal(t, []string{
+			"2020-10-15T12:00:00.000Z ABCDEF: one",
+			"2020-10-15T12:00:00.000Z web-2 : two",
+			"2020-10-15T12:00:00.000Z web-2 : three",
+			"2020-10-15T12:00:00.000Z x     : four",
+		}, lines)
+	})
+
+	t.Run("no instance", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Output:     func(line string) { lines = append(lines, line) },
+			NoColor:    true,
+			NoInstance: true,
+		}
+
+		p.Print(event("web-1", "one\n"))
+		require.Equal(t, []string{"2020-10-15T12:00:00.000Z: one"}, lines)
+	})
+
+	t.Run("partial lines", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Output:   func(line string) { lines = append(lines, line) },
+			NoPrefix: true,
+		}
+
+		p.Print(event("a", "hello "))
+		p.Print(event("b", "other\n"))
+		p.Print(event("a", "world\nand"))
+		p.Print(event("b", "unfinished"))
+		require.Equal(t, []string{"other", "hello world"}, lines)
+
+		p.Flush()
+		require.Equal(t, []string{"other", "hello world", "and", "unfinished"}, lines)
+	})
+
+	t.Run("partial lines keep their first timestamp", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Output:  func(line string) { lines = append(lines, line) },
+			NoColor: true,
+		}
+
+		p.Print(event("a", "hello "))
+		later := event("a", "world\n")
+		later.Event.Timestamp = ts.Add(time.Second)
+		p.Print(later)
+		require.Equal(t, []string{"2020-10-15T12:00:00.000Z a: hello world"}, lines)
+	})
+
+	t.Run("format", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Output:   func(line string) { lines = append(lines, line) },
+			NoPrefix: true,
+			Format: func(line string) (string, bool) {
+				return strings.ToUpper(line), line != "hidden"
+			},
+		}
+
+		p.Print(event("a", "one\nhidden\ntw"))
+		p.Print(event("a", "o\n"))
+		require.Equal(t, []string{"ONE", "TWO"}, lines)
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Plain: func(line string) { lines = append(lines, line) },
+		}
+
+		p.Print(event("web-1", "one\n"))
+		require.Equal(t, []string{"2020-10-15T12:00:00.000Z web-1: one"}, lines)
+	})
+
+	t.Run("time formats", func(t *testing.T) {
+		est, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		cases := []struct {
+			Name     string
+			Printer  logPrinter
+			Expected string
+		}{
+			{"default", logPrinter{}, "2020-10-15T12:00:00.000Z: one"},
+			{"no timestamp", logPrinter{NoTimestamp: true}, "one"},
+			{"rfc3339", logPrinter{TimeFormat: "rfc3339"}, "2020-10-15T12:00:00Z: one"},
+			{"unix", logPrinter{TimeFormat: "unix"}, "1602763200.000: one"},
+			{"layout", logPrinter{TimeFormat: "15:04:05"}, "12:00:00: one"},
+			{"relative", logPrinter{TimeFormat: "relative"}, "  3m ago: one"},
+			{"time zone", logPrinter{Location: est}, "2020-10-15T08:00:00.000-04:00: one"},
+		}
+
+		for _, tt := range cases {
+			t.Run(tt.Name, func(t *testing.T) {
+				var lines []string
+				p := tt.Printer
+				p.Output = func(line string) { lines = append(lines, line) }
+				p.NoColor = true
+				p.NoInstance = true
+
+				// The age of relative timestamps is from when the line
+				// arrived, not when it is printed.
+				e := event("web-1", "one\n")
+				e.arrived = ts.Add(3*time.Minute + 20*time.Second)
+				p.Print(e)
+				require.Equal(t, []string{tt.Expected}, lines)
+			})
+		}
+	})
+
+	t.Run("no timestamp with instances", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Output:      func(line string) { lines = append(lines, line) },
+			NoColor:     true,
+			NoTimestamp: true,
+		}
+
+		p.Print(event("web-1", "one\n"))
+		p.Print(event("web-22", "two\n"))
+		require.Equal(t, []string{"web-1: one", "web-22: two"}, lines)
+	})
+
+	t.Run("stderr", func(t *testing.T) {
+		var lines, plain []string
+		p := &logPrinter{
+			Output:     func(line string) { lines = append(lines, line) },
+			Plain:      func(line string) { plain = append(plain, line) },
+			NoColor:    true,
+			NoInstance: true,
+		}
+
+		out := event("web-1", "out\n")
+		out.Event.Source = pb.LogBatch_Entry_STDOUT
+		err := event("web-1", "err\n")
+		err.Event.Source = pb.LogBatch_Entry_STDERR
+		p.Print(out)
+		p.Print(err)
+		p.Print(event("web-1", "unknown\n"))
+
+		expected := []string{
+			"2020-10-15T12:00:00.000Z: out",
+			"2020-10-15T12:00:00.000Z: E err",
+			"2020-10-15T12:00:00.000Z: unknown",
+		}
+		require.Equal(t, expected, lines)
+		require.Equal(t, expected, plain)
+	})
+
+	t.Run("exec", func(t *testing.T) {
+		var lines []string
+		p := &logPrinter{
+			Output:     func(line string) { lines = append(lines, line) },
+			NoColor:    true,
+			NoInstance: true,
+		}
+
+		out := event("web-1", "migrated\n")
+		out.Event.ExecSessionId = "s1"
+		err := event("web-1", "warning\n")
+		err.Event.ExecSessionId = "s1"
+		err.Event.Source = pb.LogBatch_Entry_STDERR
+		p.Print(out)
+		p.Print(err)
+
+		require.Equal(t, []string{
+			"2020-10-15T12:00:00.000Z: [exec] migrated",
+			"2020-10-15T12:00:00.000Z: E [exec] warning",
+		}, lines)
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		p := &logPrinter{}
+		a := p.color("a")
+		b := p.color("b")
+		require.True(t, a == p.color("a"))
+		require.False(t, a == b)
+	})
+}
+
+func TestFormatLogAge(t *testing.T) {
+	cases := map[time.Duration]string{
+		-time.Second:                   "0s ago",
+		1500 * time.Millisecond:        "1s ago",
+		59 * time.Second:               "59s ago",
+		3*time.Minute + 59*time.Second: "3m ago",
+		5 * time.Hour:                  "5h ago",
+		50 * time.Hour:                 "2d ago",
+	}
+
+	for d, expected := range cases {
+		require.Equal(t, expected, formatLogAge(d), d.String())
+	}
+}
+
+func TestValidLogTimeFormat(t *testing.T) {
+	for _, v := range []string{"", "rfc3339", "unix", "relative", "15:04:05", time.Kitchen} {
+		require.True(t, validLogTimeFormat(v), v)
+	}
+
+	for _, v := range []string{"iso", "Unix", "relativ"} {
+		require.False(t, validLogTimeFormat(v), v)
+	}
+}
+
+func TestLogMerger(t *testing.T) {
+	start := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+	event := func(instance string, offset time.Duration, msg string) logviewer.Event {
+		return logviewer.Event{
+			LogEvent: component.LogEvent{
+				Partition: instance,
+				Timestamp: start.Add(offset),
+				Message:   msg,
+			},
+		}
+	}
+	messages := func(events []*logMergeEvent) []string {
+		var result []string
+		for _, e := range events {
+			msg := e.Event.Message
+			if e.Skewed {
+				msg = "~" + msg
+			}
+
+			result = append(result, msg)
+		}
+
+		return result
+	}
+
+	t.Run("orders across instances", func(t *testing.T) {
+		require := require.New(t)
+
+		m := &logMerger{Window: 2 * time.Second}
+		m.Add([]logviewer.Event{
+			event("a", 0, "a1"),
+			event("a", 3*time.Second, "a2"),
+		}, start)
+		m.Add([]logviewer.Event{
+			event("b", time.Second, "b1"),
+		}, start.Add(time.Second))
+		require.Empty(m.Release(start.Add(time.Second)))
+
+		next, ok := m.Next()
+		require.True(ok)
+		require.Equal(start.Add(2*time.Second), next)
+
+		// The events of a are ready, and with them the older event of b
+		require.Equal([]string{"a1", "b1", "a2"}, messages(m.Release(next)))
+		_, ok = m.Next()
+		require.False(ok)
+	})
+
+	t.Run("a quiet instance doesn't hold up others", func(t *testing.T) {
+		require := require.New(t)
+
+		m := &logMerger{Window: 2 * time.Second}
+		m.Add([]logviewer.Event{event("a", 0, "a1")}, start)
+		m.Add([]logviewer.Event{event("b", 10*time.Second, "b1")}, start.Add(time.Second))
+		require.Equal([]string{"a1"}, messages(m.Release(start.Add(2*time.Second))))
+		require.Equal([]string{"b1"}, messages(m.Release(start.Add(3*time.Second))))
+	})
+
+	t.Run("an instance keeps its own order", func(t *testing.T) {
+		require := require.New(t)
+
+		m := &logMerger{Window: time.Second}
+		m.Add([]logviewer.Event{
+			event("a", 5*time.Second, "a1"),
+			event("a", time.Second, "a2"),
+			event("b", 3*time.Second, "b1"),
+		}, start)
+		require.Equal([]string{"b1", "a1", "a2"}, messages(m.Flush()))
+	})
+
+	t.Run("skew beyond the window", func(t *testing.T) {
+		require := require.New(t)
+
+		m := &logMerger{Window: time.Second}
+		m.Add([]logviewer.Event{event("a", 10*time.Second, "a1")}, start)
+		require.Equal([]string{"a1"}, messages(m.Release(start.Add(time.Second))))
+
+		// b's clock is behind, so its event is older than one shown
+		m.Add([]logviewer.Event{event("b", 5*time.Second, "b1")}, start.Add(time.Second))
+		m.Add([]logviewer.Event{event("a", 11*time.Second, "a2")}, start.Add(time.Second))
+		require.Equal([]string{"~b1", "a2"}, messages(m.Flush()))
+	})
+
+	t.Run("no window", func(t *testing.T) {
+		require := require.New(t)
+
+		m := &logMerger{}
+		m.Add([]logviewer.Event{
+			event("a", time.Second, "a1"),
+			event("b", 0, "b1"),
+		}, start)
+		require.Equal([]string{"b1", "a1"}, messages(m.Release(start)))
+	})
+}
+
+func TestLogFile(t *testing.T) {
+	t.Run("rotation", func(t *testing.T) {
+		require := require.New(t)
+
+		dir, err := ioutil.TempDir("", "waypoint-logs")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "app.log")
+		f := &logFile{
+			Path:     path,
+			MaxSize:  10,
+			MaxFiles: 2,
+			OnError:  func(err error) { t.Fatal(err) },
+		}
+		defer f.Close()
+
+		// Each line is 6 bytes so only one fits in each file and a line
+		// is never split at the rotation.
+		for _, line := range []string{"line1", "line2", "line3", "line4"} {
+			f.WriteLine(line)
+		}
+
+		for name, expected := range map[string]string{
+			"app.log":   "line4\n",
+			"app.log.1": "line3\n",
+			"app.log.2": "line2\n",
+		} {
+			data, err := ioutil.ReadFile(filepath.Join(dir, name))
+			require.NoError(err)
+			require.Equal(expected, string(data), name)
+		}
+
+		_, err = os.Stat(path + ".3")
+		require.True(os.IsNotExist(err))
+	})
+
+	t.Run("appends to an existing file", func(t *testing.T) {
+		require := require.New(t)
+
+		dir, err := ioutil.TempDir("", "waypoint-logs")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "app.log")
+		require.NoError(ioutil.WriteFile(path, []byte("old\n"), 0644))
+
+		f := &logFile{Path: path}
+		f.WriteLine("new")
+		require.NoError(f.Close())
+
+		data, err := ioutil.ReadFile(path)
+		require.NoError(err)
+		require.Equal("old\nnew\n", string(data))
+	})
+
+	t.Run("errors are reported once", func(t *testing.T) {
+		require := require.New(t)
+
+		dir, err := ioutil.TempDir("", "waypoint-logs")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+
+		var errs []error
+		f := &logFile{
+			Path:    filepath.Join(dir, "missing", "app.log"),
+			OnError: func(err error) { errs = append(errs, err) },
+		}
+
+		f.WriteLine("one")
+		f.WriteLine("two")
+		require.Len(errs, 1)
+
+		// Once the file can be written, later errors are reported again.
+		require.NoError(os.Mkdir(filepath.Join(dir, "missing"), 0755))
+		f.WriteLine("three")
+		require.NoError(f.Close())
+
+		data, err := ioutil.ReadFile(f.Path)
+		require.NoError(err)
+		require.Equal("three\n", string(data))
+	})
+}
+
+func TestNextRunnerJob(t *testing.T) {
+	start := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+	job := func(id, runner string, assigned int) *pb.Job {
+		ts, err := ptypes.TimestampProto(start.Add(time.Duration(assigned) * time.Minute))
+		require.NoError(t, err)
+		return &pb.Job{
+			Id:             id,
+			AssignedRunner: &pb.Ref_RunnerId{Id: runner},
+			AssignTime:     ts,
+		}
+	}
+
+	jobs := []*pb.Job{
+		job("b", "r1", 2),
+		job("other", "r2", 3),
+		job("a", "r1", 1),
+		job("c", "r1", 4),
+		{Id: "queued"},
+	}
+
+	require.Equal(t, "c", nextRunnerJob(jobs, "r1", nil).Id)
+	require.Equal(t, "b", nextRunnerJob(jobs, "r1", jobs[2]).Id)
+	require.Equal(t, "c", nextRunnerJob(jobs, "r1", jobs[0]).Id)
+	require.Nil(t, nextRunnerJob(jobs, "r1", jobs[3]))
+	require.Nil(t, nextRunnerJob(jobs, "r3", nil))
+}
+
+func TestLogRecord(t *testing.T) {
+	ts := time.Date(2020, 10, 15, 12, 0, 0, 123456789, time.FixedZone("PDT", -7*60*60))
+	records := []*logRecord{
+		{
+			Timestamp:          ts,
+			InstanceId:         "01EMZ4V6MJBQAS1B5Q1W4SJGKT",
+			Partition:          "01EMZ4V6MJBQAS1B5Q1W4SJGKT",
+			DeploymentId:       "01EMZ4T9X3QCRGV3S3J2VN6A2M",
+			DeploymentSequence: 3,
+			Message:            "listening on :8080",
+			Stream:             "stdout",
+		},
+		{
+			Timestamp:          ts.Add(time.Second),
+			InstanceId:         "web-1",
+			Partition:          "web-1",
+			DeploymentId:       "01EMZ4T9X3QCRGV3S3J2VN6A2M",
+			DeploymentSequence: 3,
+			Message:            `GET /search?q=a&b=<c> "quoted" \ tab	end`,
+			Stream:             "stderr",
+		},
+		{
+			Timestamp:  ts.Add(2 * time.Second),
+			InstanceId: "web-2",
+			Partition:  "web-2",
+			Message:    "",
+		},
+		{
+			Timestamp:          ts.Add(3 * time.Second),
+			InstanceId:         "web-2",
+			Partition:          "web-2",
+			DeploymentId:       "01EMZ4T9X3QCRGV3S3J2VN6A2M",
+			DeploymentSequence: 12,
+			Message:            "héllo wörld ✓",
+		},
+		{
+			Timestamp:          ts.Add(4 * time.Second),
+			InstanceId:         "web-1",
+			Partition:          "web-1",
+			DeploymentId:       "01EMZ4T9X3QCRGV3S3J2VN6A2M",
+			DeploymentSequence: 3,
+			Message:            "migrated 3 tables",
+			Stream:             "stdout",
+			ExecSessionId:      "01EN0B3XJ6Y2T7ZP0G1F2H3K4M",
+		},
+	}
+
+	for _, tt := range []struct {
+		Name   string
+		Format func(*logRecord) string
+	}{
+		{"json", (*logRecord).JSON},
+		{"logfmt", (*logRecord).Logfmt},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			var out strings.Builder
+			for _, r := range records {
+				out.WriteString(tt.Format(r) + "\n")
+			}
+
+			testGolden(t, filepath.Join("testdata", "logs", "output."+tt.Name+".golden"), out.String())
+		})
+	}
+}
+
+// testGolden compares got with the golden file at path. If the test is
+// run with -update, the golden file is written instead.
+func testGolden(t *testing.T, path, got string) {
+	t.Helper()
+
+	if *updateGolden {
+		require.NoError(t, ioutil.WriteFile(path, []byte(got), 0644))
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	require.NoError(t, err, "run the test with -update to create %s", path)
+	require.Equal(t, string(want), got, "run the test with -update to update %s", path)
+}
@@ -155,6 +155,26 @@ func Commands(
 				baseCommand: baseCommand,
 			}, nil
 		},
+		"exec attach": func() (cli.Command, error) {
+			return &ExecAttachCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"exec watch": func() (cli.Command, error) {
+			return &ExecWatchCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"cp": func() (cli.Command, error) {
+			return &CopyCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
+		"port-forward": func() (cli.Command, error) {
+			return &PortForwardCommand{
+				baseCommand: baseCommand,
+			}, nil
+		},
 		"config": func() (cli.Command, error) {
 			return &helpCommand{
 				SynopsisText: helpText["config"][0],
@@ -455,7 +475,9 @@ func logger(args []string) ([]string, hclog.Logger, io.Writer, error) {
 		color = hclog.AutoColor
 	}
 
-	logger := hclog.New(&hclog.LoggerOptions{
+	// This is an intercept logger so that exec can record the debug logs
+	// of a session for a debug bundle whatever the level is.
+	logger := hclog.NewInterceptLogger(&hclog.LoggerOptions{
 		Name:   app,
 		Level:  level,
 		Color:  color,
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"google.golang.org/grpc"
+
 	"github.com/hashicorp/waypoint/internal/pkg/flag"
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
 )
@@ -65,6 +67,15 @@ func WithUI(ui terminal.UI) Option {
 	}
 }
 
+// WithDialOptions sets additional gRPC options for connecting to the
+// server, such as a custom dialer. A custom dialer should be wrapped with
+// serverclient.ProxyDialer to keep honoring the proxy environment.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *baseConfig) {
+		c.DialOptions = append(c.DialOptions, opts...)
+	}
+}
+
 type baseConfig struct {
 	Args              []string
 	Flags             *flag.Sets
@@ -73,4 +84,5 @@ type baseConfig struct {
 	Client            bool
 	AppTargetRequired bool
 	UI                terminal.UI
+	DialOptions       []grpc.DialOption
 }
@@ -0,0 +1,538 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/posener/complete"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/clierrors"
+	"github.com/hashicorp/waypoint/internal/pkg/flag"
+	"github.com/hashicorp/waypoint/internal/pkg/signalcontext"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+type PortForwardCommand struct {
+	*baseCommand
+
+	flagDeployment string
+	flagInstance   string
+	flagAddress    string
+	flagReverse    bool
+	flagSOCKS      int
+	flagUDP        bool
+	flagTargetAddr string
+	flagFamily     string
+	flagStatus     time.Duration
+	flagGrace      time.Duration
+}
+
+func (c *PortForwardCommand) Run(args []string) int {
+	flagSet := c.Flags()
+
+	// Initialize. If we fail, we just exit since Init handles the UI.
+	if err := c.Init(
+		WithArgs(args),
+		WithFlags(flagSet),
+	); err != nil {
+		return 1
+	}
+
+	if c.flagUDP && (c.flagReverse || c.flagSOCKS != 0) {
+		c.ui.Output("-udp can't be used with -reverse or -socks.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	if c.flagTargetAddr != "" {
+		if c.flagReverse || c.flagSOCKS != 0 {
+			c.ui.Output("-target-addr can't be used with -reverse or -socks.\n\n"+c.Help(),
+				terminal.WithErrorStyle())
+			return 1
+		}
+		if net.ParseIP(c.flagTargetAddr) == nil {
+			c.ui.Output(fmt.Sprintf("invalid target address %q: must be an IP address",
+				c.flagTargetAddr), terminal.WithErrorStyle())
+			return 1
+		}
+	}
+
+	var appArg, spec string
+	switch {
+	case c.flagSOCKS != 0:
+		if c.flagReverse {
+			c.ui.Output("-socks can't be used with -reverse.\n\n"+c.Help(),
+				terminal.WithErrorStyle())
+			return 1
+		}
+		if c.flagSOCKS < 0 || c.flagSOCKS > 65535 {
+			c.ui.Output(fmt.Sprintf("invalid SOCKS port %d", c.flagSOCKS),
+				terminal.WithErrorStyle())
+			return 1
+		}
+		if len(c.args) > 1 {
+			c.ui.Output("-socks takes only an app argument.\n\n"+c.Help(),
+				terminal.WithErrorStyle())
+			return 1
+		}
+		if len(c.args) == 1 {
+			appArg = c.args[0]
+		}
+
+	case len(c.args) == 1:
+		spec = c.args[0]
+
+	case len(c.args) == 2:
+		appArg, spec = c.args[0], c.args[1]
+
+	default:
+		c.ui.Output("A port to forward is required.\n\n"+c.Help(),
+			terminal.WithErrorStyle())
+		return 1
+	}
+
+	var mapping portMapping
+	var reverse reverseMapping
+	var err error
+	switch {
+	case c.flagSOCKS != 0:
+		mapping.Local = c.flagSOCKS
+	case c.flagReverse:
+		reverse, err = parseReverseMapping(spec)
+	default:
+		mapping, err = parsePortMapping(spec)
+	}
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	appName, err := c.forwardApp(appArg)
+	if err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	app := c.project.App(appName)
+	client := c.project.Client()
+	deployment, err := execResolveDeployment(
+		c.Ctx, client, app.Ref(), c.project.WorkspaceRef(), c.flagDeployment)
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// Port names are resolved now so that a wrong name fails before we
+	// start listening.
+	if err := mapping.Resolve(deployment); err != nil {
+		c.ui.Output(err.Error(), terminal.WithErrorStyle())
+		return 1
+	}
+
+	// The first interrupt stops accepting connections and waits for those
+	// open to close, and the second ends them.
+	ctx, drainCh, stopDrain := signalcontext.WithGracefulInterrupt(c.Ctx)
+	defer stopDrain()
+
+	execClient := &execclient.Client{
+		Logger:        c.Log,
+		UI:            c.ui,
+		Context:       ctx,
+		Client:        client,
+		ServerAddr:    c.serverAddr(),
+		DeploymentId:  deployment.Id,
+		DeploymentSeq: deployment.Sequence,
+		InstanceId:    c.flagInstance,
+		Stderr:        os.Stderr,
+
+		ForwardTargetAddr:     c.flagTargetAddr,
+		ForwardFamily:         forwardFamily(c.flagFamily),
+		ForwardStatusInterval: c.flagStatus,
+		Drain:                 drainCh,
+		DrainTimeout:          c.flagGrace,
+	}
+
+	listenAddr := net.JoinHostPort(c.flagAddress, strconv.Itoa(mapping.Local))
+	switch {
+	case c.flagReverse:
+		err = execClient.PortForwardReverse(ctx, reverse.Remote, reverse.Local)
+
+	case c.flagUDP:
+		var pc net.PacketConn
+		pc, err = net.ListenPacket("udp", listenAddr)
+		if err != nil {
+			c.ui.Output(fmt.Sprintf("Error listening for datagrams: %s", err),
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		err = execClient.PortForwardUDP(ctx, pc, mapping.Remote)
+
+	default:
+		var ln net.Listener
+		ln, err = net.Listen("tcp", listenAddr)
+		if err != nil {
+			c.ui.Output(fmt.Sprintf("Error listening for connections: %s", err),
+				terminal.WithErrorStyle())
+			return 1
+		}
+
+		if c.flagSOCKS != 0 {
+			err = execClient.PortForwardSOCKS(ctx, ln)
+		} else {
+			err = execClient.PortForward(ctx, ln, mapping.Remote)
+		}
+	}
+	if errors.Is(err, execclient.ErrShutdownForced) {
+		c.ui.Output("Forwarding ended before all connections closed.",
+			terminal.WithWarningStyle())
+		return 1
+	}
+	if err != nil {
+		c.ui.Output(clierrors.Humanize(err), terminal.WithErrorStyle())
+		return 1
+	}
+
+	return 0
+}
+
+// forwardApp returns the name of the app to forward to. This is the app
+// argument if there is one, otherwise the -app flag or the only app.
+func (c *PortForwardCommand) forwardApp(arg string) (string, error) {
+	switch {
+	case arg != "":
+		return arg, nil
+
+	case c.flagApp != "":
+		return c.flagApp, nil
+
+	case c.cfg != nil && len(c.cfg.Apps) == 1:
+		return c.cfg.Apps[0].Name, nil
+
+	default:
+		return "", errors.New(errAppModeSingle)
+	}
+}
+
+// forwardFamily returns the address family for the -family flag.
+func forwardFamily(v string) pb.ExecStreamRequest_PortForward_Family {
+	switch v {
+	case "ipv4":
+		return pb.ExecStreamRequest_PortForward_IPV4
+	case "ipv6":
+		return pb.ExecStreamRequest_PortForward_IPV6
+	default:
+		return pb.ExecStreamRequest_PortForward_ANY
+	}
+}
+
+// portMapping is a local port and the port on the instance to forward
+// its connections to.
+type portMapping struct {
+	Local  int
+	Remote int
+
+	// RemoteName, if set, is the name of the remote port, such as "http",
+	// which Resolve turns into Remote. SameLocal is true if there was no
+	// LOCAL, so that the same port is used locally once it is resolved.
+	RemoteName string
+	SameLocal  bool
+}
+
+// parsePortMapping parses a port-forward argument. This is "LOCAL:REMOTE"
+// or a single port to use the same port locally. LOCAL may be empty or 0
+// to listen on any free port. REMOTE may be the name of a port of the
+// deployment instead of a number.
+func parsePortMapping(v string) (portMapping, error) {
+	local, remote := v, v
+	if idx := strings.Index(v, ":"); idx >= 0 {
+		local, remote = v[:idx], v[idx+1:]
+	}
+	if local == "" {
+		local = "0"
+	}
+
+	var result portMapping
+	if remote != "" && !portNameStart(remote[0]) {
+		port, err := strconv.Atoi(remote)
+		if err != nil || port <= 0 || port > 65535 {
+			return result, fmt.Errorf("invalid remote port %q", remote)
+		}
+		result.Remote = port
+	} else if validPortName(remote) {
+		result.RemoteName = remote
+		if local == remote {
+			result.SameLocal = true
+			return result, nil
+		}
+	} else {
+		return result, fmt.Errorf("invalid remote port %q", remote)
+	}
+
+	var err error
+	result.Local, err = strconv.Atoi(local)
+	if err != nil || result.Local < 0 || result.Local > 65535 {
+		return result, fmt.Errorf("invalid local port %q", local)
+	}
+
+	return result, nil
+}
+
+// Resolve resolves RemoteName to a port using the named ports of the
+// deployment. The error lists the names that are known.
+func (m *portMapping) Resolve(deployment *pb.Deployment) error {
+	if m.RemoteName == "" {
+		return nil
+	}
+
+	port, ok := deployment.Ports[m.RemoteName]
+	if !ok {
+		if len(deployment.Ports) == 0 {
+			return fmt.Errorf(
+				"Deployment v%d has no named ports, so port %q can't be resolved. "+
+					"Use a port number instead.", deployment.Sequence, m.RemoteName)
+		}
+
+		return fmt.Errorf("Deployment v%d has no port named %q. Known ports: %s",
+			deployment.Sequence, m.RemoteName, formatPortNames(deployment.Ports))
+	}
+
+	m.Remote = int(port)
+	if m.SameLocal {
+		m.Local = m.Remote
+	}
+
+	return nil
+}
+
+// formatPortNames lists named ports as "http (3000), metrics (9090)".
+func formatPortNames(ports map[string]int32) string {
+	names := make([]string, 0, len(ports))
+	for name := range ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s (%d)", name, ports[name])
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// validPortName returns true if v is a valid port name. Like Kubernetes
+// port names, this is lowercase letters, digits and dashes starting with a
+// letter.
+func validPortName(v string) bool {
+	if v == "" || !portNameStart(v[0]) {
+		return false
+	}
+
+	for _, c := range v {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// portNameStart returns true if c can start a port name, which is how we
+// tell names from port numbers.
+func portNameStart(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// reverseMapping is a port on the instance and the local address to
+// forward its connections to.
+type reverseMapping struct {
+	Remote int
+	Local  string
+}
+
+// parseReverseMapping parses a port-forward argument with -reverse. This
+// is "REMOTE:HOST:PORT", "REMOTE:PORT" to use localhost, or a single port
+// to use the same port on localhost.
+func parseReverseMapping(v string) (reverseMapping, error) {
+	remote, local := v, v
+	if idx := strings.Index(v, ":"); idx >= 0 {
+		remote, local = v[:idx], v[idx+1:]
+	}
+
+	var result reverseMapping
+	port, err := strconv.Atoi(remote)
+	if err != nil || port <= 0 || port > 65535 {
+		return result, fmt.Errorf("invalid remote port %q", remote)
+	}
+	result.Remote = port
+
+	host, localPort := "localhost", local
+	if h, p, err := net.SplitHostPort(local); err == nil {
+		host, localPort = h, p
+	}
+	port, err = strconv.Atoi(localPort)
+	if err != nil || port <= 0 || port > 65535 {
+		return result, fmt.Errorf("invalid local port %q", localPort)
+	}
+	result.Local = net.JoinHostPort(host, localPort)
+
+	return result, nil
+}
+
+func (c *PortForwardCommand) Flags() *flag.Sets {
+	return c.flagSet(0, func(set *flag.Sets) {
+		f := set.NewSet("Command Options")
+		f.StringVar(&flag.StringVar{
+			Name:   "deployment",
+			Target: &c.flagDeployment,
+			Usage: "Deployment to forward to: \"latest\" (the default), a sequence " +
+				"number such as \"12\" or \"v12\", or a deployment ID.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "instance",
+			Target: &c.flagInstance,
+			Usage:  "ID of the instance of the deployment to forward to.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:    "address",
+			Target:  &c.flagAddress,
+			Default: "127.0.0.1",
+			Usage: "Local address to listen on. Use \"0.0.0.0\" to accept " +
+				"connections from other machines. This isn't used with -reverse.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "reverse",
+			Target: &c.flagReverse,
+			Usage: "Forward connections from the instance to this machine instead. " +
+				"The argument is then REMOTE:[HOST:]PORT.",
+		})
+
+		f.IntVar(&flag.IntVar{
+			Name:   "socks",
+			Target: &c.flagSOCKS,
+			Usage: "Run a SOCKS5 proxy on this local port that connects through " +
+				"the instance. No port argument is given with this.",
+		})
+
+		f.BoolVar(&flag.BoolVar{
+			Name:   "udp",
+			Target: &c.flagUDP,
+			Usage:  "Forward UDP datagrams instead of TCP connections.",
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:    "grace-period",
+			Target:  &c.flagGrace,
+			Default: 10 * time.Second,
+			Usage: "How long open connections have to close after an interrupt. " +
+				"The first interrupt stops accepting connections, and the rest " +
+				"are closed once this passes or on a second interrupt, in which " +
+				"case the exit code is 1.",
+		})
+
+		f.DurationVar(&flag.DurationVar{
+			Name:   "status-interval",
+			Target: &c.flagStatus,
+			Usage: "Show the open connections, the totals so far and the last " +
+				"error at this interval, such as \"30s\". The status is also " +
+				"shown when the process receives SIGUSR1.",
+		})
+
+		f.StringVar(&flag.StringVar{
+			Name:   "target-addr",
+			Target: &c.flagTargetAddr,
+			Usage: "IP address on the instance to connect to instead of the " +
+				"loopback address, such as \"::1\". Only this address is tried. " +
+				"This can't be used with -reverse or -socks.",
+		})
+
+		f.EnumSingleVar(&flag.EnumSingleVar{
+			Name:   "family",
+			Target: &c.flagFamily,
+			Values: []string{"ipv4", "ipv6"},
+			Usage: "Address family the instance tries first when connecting to " +
+				"the loopback address, or to a name with -socks. The other family " +
+				"is tried if that fails. IPv4 is tried first by default.",
+		})
+	})
+}
+
+func (c *PortForwardCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *PortForwardCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PortForwardCommand) Synopsis() string {
+	return "Forward local ports to a running application instance"
+}
+
+func (c *PortForwardCommand) Help() string {
+	return formatHelp(`
+Usage: waypoint port-forward [options] [APP] [LOCAL:]REMOTE
+       waypoint port-forward -reverse [options] [APP] REMOTE:[HOST:]PORT
+       waypoint port-forward -socks PORT [options] [APP]
+       waypoint port-forward -udp [options] [APP] [LOCAL:]REMOTE
+
+  Forward connections to a local port to a port on an application instance.
+
+  This listens on LOCAL and, for each connection, connects to REMOTE on
+  localhost inside the instance. This reaches services that only listen on
+  localhost without exposing them. If LOCAL is left out, the same port is
+  used locally. Use ":REMOTE" to listen on any free local port.
+
+  REMOTE may also be the name of a port of the deployment, such as "http",
+  if the platform reports named ports. On Kubernetes these are the named
+  container ports. For example, "8080:http" listens on 8080 and forwards
+  to the port named "http", and "http" alone uses the same port locally.
+
+  The instance tries 127.0.0.1 and then ::1, since a service may only
+  listen on one of them. Use -family to try ::1 first, or -target-addr to
+  connect to exactly one address. If the instance can't connect, the
+  connection is closed and the error for each address tried is shown.
+
+  With -reverse, connections go the other way so that the instance can
+  reach a service on this machine, such as a local mock or a debugger. The
+  argument is then REMOTE:[HOST:]PORT. The instance listens on REMOTE on
+  127.0.0.1, and each connection to it is forwarded to HOST:PORT from
+  this machine. HOST defaults to localhost. This fails if REMOTE is
+  already in use on the instance.
+
+  With -socks, a SOCKS5 proxy listens on PORT and each connection through
+  it is made from the instance, so tools that support SOCKS can reach any
+  address the instance can. Only CONNECT without authentication is
+  supported. The server may restrict which addresses can be reached with
+  its -port-forward-allow and -port-forward-deny options.
+
+  With -udp, UDP datagrams sent to LOCAL are forwarded to REMOTE instead,
+  such as for statsd or DNS, and replies come back to their sender. This
+  is as unreliable as UDP itself: datagrams that can't be delivered are
+  dropped and never retried, and datagrams over 32 KiB are dropped. The
+  instance keeps a socket per sender until it has been idle for two
+  minutes.
+
+  The app name can be left out if the project has a single app. Many
+  connections can be forwarded at once. Forwarding continues until
+  interrupted, and a summary of the connections is shown at the end. On
+  the first interrupt, no more connections are accepted and those open
+  have up to -grace-period to close. A second interrupt closes them at
+  once and exits with code 1.
+
+  To see how forwarding is going, use -status-interval or send the
+  process SIGUSR1. Status and errors are written to stderr.
+
+` + c.Flags().Help())
+}
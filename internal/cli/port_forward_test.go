@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestParsePortMapping(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected portMapping
+		Err      bool
+	}{
+		{"8080:5432", portMapping{Local: 8080, Remote: 5432}, false},
+		{"5432", portMapping{Local: 5432, Remote: 5432}, false},
+		{":5432", portMapping{Local: 0, Remote: 5432}, false},
+		{"0:5432", portMapping{Local: 0, Remote: 5432}, false},
+		{"8080:", portMapping{}, true},
+		{"8080:0", portMapping{}, true},
+		{"x:5432", portMapping{}, true},
+		{"8080:70000", portMapping{}, true},
+		{"8080:http", portMapping{Local: 8080, RemoteName: "http"}, false},
+		{"http", portMapping{RemoteName: "http", SameLocal: true}, false},
+		{":grpc-web", portMapping{Local: 0, RemoteName: "grpc-web"}, false},
+		{"8080:HTTP", portMapping{}, true},
+		{"8080:http_1", portMapping{}, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Input, func(t *testing.T) {
+			actual, err := parsePortMapping(tt.Input)
+			if tt.Err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.Expected, actual)
+		})
+	}
+}
+
+func TestPortMappingResolve(t *testing.T) {
+	require := require.New(t)
+
+	deployment := &pb.Deployment{
+		Sequence: 3,
+		Ports:    map[string]int32{"http": 3000, "metrics": 9090},
+	}
+
+	m := portMapping{RemoteName: "http", SameLocal: true}
+	require.NoError(m.Resolve(deployment))
+	require.Equal(portMapping{Local: 3000, Remote: 3000, RemoteName: "http", SameLocal: true}, m)
+
+	m = portMapping{Local: 8080, RemoteName: "metrics"}
+	require.NoError(m.Resolve(deployment))
+	require.Equal(8080, m.Local)
+	require.Equal(9090, m.Remote)
+
+	// Unknown names list the known ones
+	m = portMapping{RemoteName: "grpc"}
+	err := m.Resolve(deployment)
+	require.Error(err)
+	require.Contains(err.Error(), "http (3000), metrics (9090)")
+
+	err = m.Resolve(&pb.Deployment{Sequence: 3})
+	require.Error(err)
+	require.Contains(err.Error(), "no named ports")
+
+	// Numbers are left alone
+	m = portMapping{Local: 1, Remote: 2}
+	require.NoError(m.Resolve(&pb.Deployment{}))
+	require.Equal(portMapping{Local: 1, Remote: 2}, m)
+}
+
+func TestParseReverseMapping(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected reverseMapping
+		Err      bool
+	}{
+		{"9000:localhost:9000", reverseMapping{Remote: 9000, Local: "localhost:9000"}, false},
+		{"9000:9001", reverseMapping{Remote: 9000, Local: "localhost:9001"}, false},
+		{"9000", reverseMapping{Remote: 9000, Local: "localhost:9000"}, false},
+		{"9000:[::1]:9001", reverseMapping{Remote: 9000, Local: "[::1]:9001"}, false},
+		{"9000:10.0.0.1:80", reverseMapping{Remote: 9000, Local: "10.0.0.1:80"}, false},
+		{"0:9000", reverseMapping{}, true},
+		{"9000:localhost:x", reverseMapping{}, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Input, func(t *testing.T) {
+			actual, err := parseReverseMapping(tt.Input)
+			if tt.Err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.Expected, actual)
+		})
+	}
+}
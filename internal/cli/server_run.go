@@ -296,6 +296,9 @@ func (c *ServerRunCommand) Flags() *flag.Sets {
 		if c.config.URL == nil {
 			c.config.URL = &config.URL{}
 		}
+		if c.config.PortForward == nil {
+			c.config.PortForward = &config.PortForward{}
+		}
 
 		f := set.NewSet("Command Options")
 		f.StringVar(&flag.StringVar{
@@ -396,6 +399,20 @@ func (c *ServerRunCommand) Flags() *flag.Sets {
 			Usage:   "Do not verify the TLS certificate presented by the server.",
 			Default: false,
 		})
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "port-forward-allow",
+			Target: &c.config.PortForward.Allow,
+			Usage: "Address that dynamic port forwarding (port-forward -socks) may\n" +
+				"connect to, as HOST[:PORT]. HOST may be a glob or a CIDR. If any are\n" +
+				"set, only matching addresses are allowed. Can be repeated.",
+		})
+		f.StringSliceVar(&flag.StringSliceVar{
+			Name:   "port-forward-deny",
+			Target: &c.config.PortForward.Deny,
+			Usage: "Address that dynamic port forwarding may not connect to, in the\n" +
+				"same form as -port-forward-allow. This takes precedence over allowed\n" +
+				"addresses. Can be repeated.",
+		})
 		f.BoolVar(&flag.BoolVar{
 			Name:    "accept-tos",
 			Target:  &c.flagAcceptTOS,
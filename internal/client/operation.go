@@ -5,7 +5,6 @@ import (
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/logviewer"
-	"github.com/hashicorp/waypoint-plugin-sdk/component"
 )
 
 func (c *Project) Validate(ctx context.Context, op *pb.Job_ValidateOp) (*pb.Job_ValidateResult, error) {
@@ -159,23 +158,47 @@ func (c *App) Release(ctx context.Context, op *pb.Job_ReleaseOp) (*pb.Job_Releas
 	return result.Release, nil
 }
 
-func (a *App) Logs(ctx context.Context) (component.LogViewer, error) {
+// Logs streams the logs of the app's deployments. req may be nil or set
+// options such as a time range. If it has no scope, it is scoped to this
+// app. The viewer reconnects if the stream fails, such as when the server
+// restarts.
+func (a *App) Logs(ctx context.Context, req *pb.GetLogStreamRequest) (*logviewer.Viewer, error) {
 	log := a.project.logger.Named("logs")
 
-	// First we attempt to query the server for logs for this deployment.
-	log.Info("requesting log stream")
-	client, err := a.project.client.GetLogStream(ctx, &pb.GetLogStreamRequest{
-		Scope: &pb.GetLogStreamRequest_Application_{
+	if req == nil {
+		req = &pb.GetLogStreamRequest{}
+	}
+	if req.Scope == nil {
+		req.Scope = &pb.GetLogStreamRequest_Application_{
 			Application: &pb.GetLogStreamRequest_Application{
 				Application: a.Ref(),
 				Workspace:   a.project.WorkspaceRef(),
 			},
-		},
-	})
+		}
+	}
+
+	// First we attempt to query the server for logs for this deployment.
+	log.Info("requesting log stream")
+	client, err := a.project.client.GetLogStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// We filter the entries again in case the server doesn't support
+	// filtering them.
+	filter, err := logviewer.NewFilter(req.Filter)
 	if err != nil {
 		return nil, err
 	}
 
 	// Build our log viewer
-	return &logviewer.Viewer{Stream: client}, nil
+	return &logviewer.Viewer{
+		Stream:  client,
+		Filter:  filter,
+		Request: req,
+		Reconnect: func(ctx context.Context, req *pb.GetLogStreamRequest) (pb.Waypoint_GetLogStreamClient, error) {
+			log.Info("reconnecting log stream")
+			return a.project.client.GetLogStream(ctx, req)
+		},
+	}, nil
 }
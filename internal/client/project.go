@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/serverclient"
@@ -18,6 +19,7 @@ type Project struct {
 	UI terminal.UI
 
 	client              pb.WaypointClient
+	conn                *grpc.ClientConn
 	logger              hclog.Logger
 	project             *pb.Ref_Project
 	workspace           *pb.Ref_Workspace
@@ -62,6 +64,7 @@ func New(ctx context.Context, opts ...Option) (*Project, error) {
 		if err != nil {
 			return nil, err
 		}
+		client.conn = conn
 		client.client = pb.NewWaypointClient(conn)
 	}
 
@@ -88,6 +91,12 @@ func (c *Project) Client() pb.WaypointClient {
 	return c.client
 }
 
+// Conn returns the connection to the server, or nil if the API client
+// was provided with WithClient.
+func (c *Project) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
 // WorkspaceRef returns the application reference that this client is using.
 func (c *Project) WorkspaceRef() *pb.Ref_Workspace {
 	return c.workspace
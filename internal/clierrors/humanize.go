@@ -1,6 +1,8 @@
 package clierrors
 
 import (
+	"errors"
+
 	"github.com/mitchellh/go-wordwrap"
 	"google.golang.org/grpc/status"
 )
@@ -14,6 +16,13 @@ func Humanize(err error) string {
 		return "operation canceled"
 	}
 
+	// Errors that explain themselves to users are shown as they are even
+	// if they also carry a status.
+	var human interface{ HumanError() string }
+	if errors.As(err, &human) {
+		return wordwrap.WrapString(human.HumanError(), 80)
+	}
+
 	v := err.Error()
 	if s, ok := status.FromError(err); ok {
 		v = s.Message()
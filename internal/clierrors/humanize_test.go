@@ -0,0 +1,28 @@
+package clierrors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHumanize(t *testing.T) {
+	t.Run("status", func(t *testing.T) {
+		require.Equal(t, "oops", Humanize(status.Error(codes.Internal, "oops")))
+	})
+
+	t.Run("human error", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", &testHumanError{})
+		require.Equal(t, "explained", Humanize(err))
+	})
+}
+
+// testHumanError is an error with a status and a message for users.
+type testHumanError struct{}
+
+func (e *testHumanError) Error() string              { return "error" }
+func (e *testHumanError) HumanError() string         { return "explained" }
+func (e *testHumanError) GRPCStatus() *status.Status { return status.New(codes.Internal, "status") }
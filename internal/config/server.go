@@ -16,6 +16,21 @@ type ServerConfig struct {
 
 	// CEBConfig configures the entrypoint binary for deployments
 	CEBConfig *CEBConfig `hcl:"entrypoint_config,block"`
+
+	// PortForward restricts where dynamic port forwarding can connect.
+	PortForward *PortForward `hcl:"port_forward,block"`
+}
+
+// PortForward is the policy for dynamic port forwarding sessions, which
+// connect to addresses chosen by the client. Patterns are "HOST[:PORT]"
+// where HOST is a glob such as "*.internal" or a CIDR such as
+// "10.0.0.0/8", and PORT is a port or "*". A deny pattern always wins.
+// If there are allow patterns, an address must match one of them. CIDR
+// patterns only match addresses given as IPs since the instance resolves
+// names, so an allow list is the safer way to restrict access.
+type PortForward struct {
+	Allow []string `hcl:"allow,optional"`
+	Deny  []string `hcl:"deny,optional"`
 }
 
 // CEBConfig is specific configuration for the entrypoint binaries
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-argmapper"
 	"github.com/hashicorp/go-hclog"
@@ -135,12 +136,12 @@ func (op *deployOperation) Upsert(
 	return resp.Deployment, nil
 }
 
-func (op *deployOperation) Do(ctx context.Context, log hclog.Logger, app *App, _ proto.Message) (interface{}, error) {
+func (op *deployOperation) Do(ctx context.Context, log hclog.Logger, app *App, msg proto.Message) (interface{}, error) {
 	dconfig := *op.DeploymentConfig
 	dconfig.Id = op.id
 	dconfig.EntrypointInviteToken = op.cebToken
 
-	return app.callDynamicFunc(ctx,
+	result, err := app.callDynamicFunc(ctx,
 		log,
 		(*component.Deployment)(nil),
 		app.Platform,
@@ -148,6 +149,43 @@ func (op *deployOperation) Do(ctx context.Context, log hclog.Logger, app *App, _
 		argNamedAny("artifact", op.Push.Artifact.Artifact),
 		argmapper.Typed(&dconfig),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.(*pb.Deployment).Ports = deploymentPorts(log, result)
+	return result, nil
+}
+
+// deploymentPorts returns the named ports of the deployment value a
+// platform returned, if it has a ports field mapping names to ports. The
+// value may come from a plugin as an Any, which we decode if we know its
+// type.
+func deploymentPorts(log hclog.Logger, result interface{}) map[string]int32 {
+	type ports interface {
+		GetPorts() map[string]int32
+	}
+
+	if v, ok := result.(ports); ok {
+		return v.GetPorts()
+	}
+
+	v, err := component.ProtoAny(result)
+	if err != nil || v == nil {
+		return nil
+	}
+
+	var decoded ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(v, &decoded); err != nil {
+		log.Trace("can't decode deployment value for its ports", "type", v.TypeUrl, "err", err)
+		return nil
+	}
+
+	if v, ok := decoded.Message.(ports); ok {
+		return v.GetPorts()
+	}
+
+	return nil
 }
 
 func (op *deployOperation) StatusPtr(msg proto.Message) **pb.Status {
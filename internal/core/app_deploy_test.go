@@ -0,0 +1,28 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestDeploymentPorts(t *testing.T) {
+	require := require.New(t)
+
+	ports := map[string]int32{"http": 3000, "metrics": 9090}
+	value := &pb.Deployment{Ports: ports}
+	require.Equal(ports, deploymentPorts(hclog.L(), value))
+
+	// Values from plugins arrive encoded
+	encoded, err := ptypes.MarshalAny(value)
+	require.NoError(err)
+	require.Equal(ports, deploymentPorts(hclog.L(), encoded))
+
+	// Values without ports
+	require.Nil(deploymentPorts(hclog.L(), &pb.Ref_Application{}))
+	require.Nil(deploymentPorts(hclog.L(), nil))
+}
@@ -0,0 +1,218 @@
+// Package escapewatcher handles the escape sequences typed in the input
+// of an interactive session, like those of ssh. A sequence is a "~" typed
+// at the start of a line followed by a character, such as "~." to end the
+// session.
+package escapewatcher
+
+import (
+	"io"
+	"sync"
+)
+
+// MaxLine is the maximum length of a line read after a sequence for a
+// Handler with Line set. Longer lines are cut off.
+const MaxLine = 32
+
+// Handler handles an escape sequence.
+type Handler struct {
+	// Func is called once the sequence is typed.
+	Func func()
+
+	// Forward, if true, forwards the character after the "~" as typed.
+	// Otherwise it isn't forwarded. The "~" itself is always forwarded
+	// since we can't know yet whether a sequence follows.
+	Forward bool
+
+	// Line, if set, reads a line typed after the sequence and calls Line
+	// with it rather than Func, unless it is empty. Prompt is echoed to
+	// the Watcher's Echo first, and what is typed is echoed after it. The
+	// line isn't forwarded, and Ctrl-C or escape abandon it.
+	Prompt string
+	Line   func(string)
+}
+
+// Watcher reads from an io.Reader, calling the handlers of the escape
+// sequences typed and returning what is to be forwarded.
+//
+// A Watcher can be stacked with other readers. The reader it wraps may
+// split the input anywhere, since a sequence is followed across reads, and
+// may return data along with an error such as io.EOF. The reader wrapping
+// it, such as one that stops reading once a context is done, may stop
+// calling Read at any time. Read must not be called concurrently, but
+// SetEnabled may be called at any time.
+type Watcher struct {
+	// Echo is where prompts and the lines typed after them are echoed,
+	// such as the terminal in raw mode. If nil, nothing is echoed.
+	Echo io.Writer
+
+	// CarriageReturn, if true, also takes a carriage return to end a line,
+	// as Enter sends with the terminal in raw mode. Callers set this
+	// whenever they put the terminal in raw mode, so that sequences work
+	// after Enter as they do without it.
+	CarriageReturn bool
+
+	input    io.Reader
+	handlers map[byte]Handler
+
+	lock     sync.Mutex
+	disabled bool
+	state    int
+	line     []byte
+	lineFunc func(string)
+}
+
+const (
+	stateNormal = iota
+	stateNewline
+	stateTilde
+	stateLine
+)
+
+// New returns a Watcher reading from r. handlers are the escape sequences
+// it handles, keyed by the character typed after the "~".
+func New(r io.Reader, handlers map[byte]Handler) *Watcher {
+	return &Watcher{input: r, handlers: handlers}
+}
+
+// SetEnabled turns the handling of escape sequences on or off, such as
+// off while the input is binary data. While off, everything read is
+// forwarded as is. Once turned on again, sequences are seen after the next
+// newline.
+func (w *Watcher) SetEnabled(v bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.disabled = !v
+	w.state = stateNormal
+	w.line = nil
+	w.lineFunc = nil
+}
+
+func (w *Watcher) Read(b []byte) (int, error) {
+	// A reader may return its last bytes along with an error such as
+	// io.EOF, so we always look at what was read before returning it.
+	n, err := w.input.Read(b)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.disabled {
+		return n, err
+	}
+
+	// out is where we write the bytes that should be forwarded. Anything
+	// typed at a prompt is consumed here and not forwarded.
+	out := 0
+	for i := 0; i < n; i++ {
+		r := b[i]
+		if w.disabled {
+			// A handler turned us off, so the rest is forwarded as is.
+			out += copy(b[out:], b[i:n])
+			break
+		}
+
+		if w.handle(r) {
+			b[out] = r
+			out++
+		}
+	}
+
+	return out, err
+}
+
+// handle handles a single byte read and returns whether to forward it.
+// This must be called with the lock held.
+func (w *Watcher) handle(r byte) bool {
+	switch w.state {
+	case stateNewline:
+		if r == '~' {
+			w.state = stateTilde
+			return true
+		}
+
+	case stateTilde:
+		h, ok := w.handlers[r]
+		if !ok {
+			break
+		}
+
+		// Another sequence can't follow right away, so typing the
+		// character again doesn't call the handler again.
+		w.state = stateNormal
+		if h.Line != nil {
+			w.state = stateLine
+			w.lineFunc = h.Line
+			w.echo("\r\n" + h.Prompt)
+			return false
+		}
+
+		if h.Func != nil {
+			w.call(h.Func)
+		}
+		return h.Forward
+
+	case stateLine:
+		w.readLine(r)
+		return false
+	}
+
+	w.next(r)
+	return true
+}
+
+// next updates the state for a byte that isn't part of a sequence.
+func (w *Watcher) next(r byte) {
+	w.state = stateNormal
+	if r == '\n' || (r == '\r' && w.CarriageReturn) {
+		w.state = stateNewline
+	}
+}
+
+// readLine handles a single byte typed at a prompt.
+func (w *Watcher) readLine(r byte) {
+	switch r {
+	case '\r', '\n':
+		line, f := string(w.line), w.lineFunc
+		w.line = nil
+		w.lineFunc = nil
+		w.echo("\r\n")
+
+		// Enter behaves like a newline so the next sequence works right
+		// away.
+		w.state = stateNewline
+		if line != "" {
+			w.call(func() { f(line) })
+		}
+
+	case 0x03, 0x1b:
+		// Ctrl-C or escape abandons the line.
+		w.line = nil
+		w.lineFunc = nil
+		w.echo("\r\n")
+		w.state = stateNormal
+
+	case 0x7f, '\b':
+		if len(w.line) > 0 {
+			w.line = w.line[:len(w.line)-1]
+			w.echo("\b \b")
+		}
+
+	default:
+		if len(w.line) < MaxLine {
+			w.line = append(w.line, r)
+			w.echo(string(r))
+		}
+	}
+}
+
+// call calls a handler without the lock held, so that it may call
+// SetEnabled.
+func (w *Watcher) call(f func()) {
+	w.lock.Unlock()
+	defer w.lock.Lock()
+	f()
+}
+
+func (w *Watcher) echo(v string) {
+	if w.Echo != nil {
+		io.WriteString(w.Echo, v)
+	}
+}
@@ -0,0 +1,139 @@
+// +build go1.18
+
+package escapewatcher
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzWatcher checks that Watcher behaves the same however
+// its input is split into reads, that it forwards everything that isn't
+// typed at the signal prompt, and that escapes only fire at the start of
+// a line. The seed corpus in testdata/fuzz has terminal captures.
+//
+// Run it with "go test -fuzz FuzzWatcher".
+func FuzzWatcher(f *testing.F) {
+	f.Add([]byte("hello\n~.bye"), []byte{1})
+	f.Add([]byte("\n~.\n~.."), []byte{2, 3})
+	f.Add([]byte("sleep 100\n~sHUP\r~.\n"), []byte{4, 1, 64})
+	f.Add([]byte("a~.b\r~.c"), []byte{1, 2})
+
+	f.Fuzz(func(t *testing.T, data, splits []byte) {
+		for _, signal := range []bool{false, true} {
+			// A byte at a time tells us where each escape fired.
+			want := testEscapeRun(data, nil, signal)
+			got := testEscapeRun(data, splits, signal)
+			require.Equal(t, want.output, got.output)
+			require.Equal(t, want.prompt, got.prompt)
+			require.Equal(t, want.signals, got.signals)
+			require.Equal(t, len(want.cancels), len(got.cancels))
+
+			if !signal {
+				require.Equal(t, data, want.output)
+			}
+
+			for _, i := range want.cancels {
+				require.True(t, i >= 2, "cancel at %d", i)
+				require.Equal(t, byte('.'), data[i])
+				require.Equal(t, byte('~'), data[i-1])
+				if signal {
+					require.Contains(t, "\r\n", string(data[i-2]))
+				} else {
+					require.Equal(t, byte('\n'), data[i-2])
+				}
+			}
+
+			for _, name := range want.signals {
+				require.NotEmpty(t, name)
+				require.True(t, len(name) <= MaxLine)
+			}
+		}
+	})
+}
+
+// testEscapeResult is what a Watcher did with its input.
+type testEscapeResult struct {
+	output  []byte
+	prompt  []byte
+	signals []string
+
+	// cancels are the offsets in the input at which Cancel was called.
+	// These are only exact when reading a byte at a time.
+	cancels []int
+}
+
+// testEscapeRun reads data through a Watcher. Each read of the
+// input returns as many bytes as the next entry of splits, cycling
+// through them, or a single byte if splits is empty. The last bytes are
+// returned along with io.EOF.
+func testEscapeRun(data, splits []byte, signal bool) *testEscapeResult {
+	var result testEscapeResult
+	input := &testChunkReader{data: data, splits: splits}
+	handlers := map[byte]Handler{
+		'.': {
+			Func: func() {
+				result.cancels = append(result.cancels, input.offset-1)
+			},
+			Forward: true,
+		},
+	}
+
+	var prompt bytes.Buffer
+	if signal {
+		handlers['s'] = Handler{
+			Prompt: "signal: ",
+			Line: func(name string) {
+				result.signals = append(result.signals, name)
+			},
+		}
+	}
+	ew := New(input, handlers)
+	ew.Echo = &prompt
+
+	buf := make([]byte, 256)
+	for {
+		n, err := ew.Read(buf)
+		result.output = append(result.output, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+	}
+
+	result.prompt = prompt.Bytes()
+	return &result
+}
+
+// testChunkReader reads data in chunks of the sizes given by splits.
+type testChunkReader struct {
+	data   []byte
+	splits []byte
+	offset int
+	reads  int
+}
+
+func (r *testChunkReader) Read(b []byte) (int, error) {
+	if r.offset >= len(r.data) {
+		return 0, io.EOF
+	}
+
+	size := 1
+	if len(r.splits) > 0 {
+		size = int(r.splits[r.reads%len(r.splits)])%128 + 1
+	}
+	r.reads++
+	if size > len(b) {
+		size = len(b)
+	}
+
+	n := copy(b[:size], r.data[r.offset:])
+	r.offset += n
+	if r.offset >= len(r.data) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
@@ -0,0 +1,391 @@
+package escapewatcher
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	t.Run("closes the context when the escape sequence is seen", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.WriteByte('\n')
+		buf.WriteByte('~')
+		buf.WriteByte('.')
+
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(&buf, cancel)
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.True(t, ok, "context was not canceled")
+	})
+
+	t.Run("can see the sequence within a buffer", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.WriteString("hello")
+		buf.WriteByte('\n')
+		buf.WriteByte('~')
+		buf.WriteByte('.')
+		buf.WriteString("bye")
+
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(&buf, cancel)
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.True(t, ok, "context was not canceled")
+	})
+
+	t.Run("can see the sequence across reads", func(t *testing.T) {
+		r, w := io.Pipe()
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(r, cancel)
+
+		go w.Write([]byte("hello\n"))
+
+		junk := make([]byte, 1024)
+
+		n, err := ew.Read(junk)
+		require.NoError(t, err)
+
+		assert.Equal(t, 6, n)
+
+		go w.Write([]byte("~."))
+
+		n, err = ew.Read(junk)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, n)
+
+		assert.True(t, ok, "context was not canceled")
+	})
+
+	t.Run("can see the sequence across reads split on ~ and .", func(t *testing.T) {
+		r, w := io.Pipe()
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(r, cancel)
+
+		go w.Write([]byte("hello\n~"))
+
+		junk := make([]byte, 1024)
+
+		n, err := ew.Read(junk)
+		require.NoError(t, err)
+
+		assert.Equal(t, 7, n)
+
+		go w.Write([]byte("."))
+
+		n, err = ew.Read(junk)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, n)
+
+		assert.True(t, ok, "context was not canceled")
+	})
+
+	t.Run("resets track state after newline", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.WriteString("\nx~.")
+
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(&buf, cancel)
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.False(t, ok, "context was canceled")
+		assert.Equal(t, stateNormal, ew.state)
+	})
+
+	t.Run("resets track state after tilde", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.WriteString("\n~x.")
+
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(&buf, cancel)
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.False(t, ok, "context was canceled")
+
+		assert.Equal(t, stateNormal, ew.state)
+	})
+
+	t.Run("follows newlines into escape state", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.WriteString("\n\n~.")
+
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(&buf, cancel)
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.True(t, ok, "context was not canceled")
+	})
+
+	t.Run("reads a signal name after the signal sequence", func(t *testing.T) {
+		var buf, prompt, out bytes.Buffer
+
+		buf.WriteString("hello\n~sHUP\rbye")
+
+		var sigs []string
+		ew := New(&buf, map[byte]Handler{
+			's': {
+				Prompt: "signal: ",
+				Line:   func(name string) { sigs = append(sigs, name) },
+			},
+		})
+		ew.Echo = &prompt
+
+		io.Copy(&out, ew)
+
+		assert.Equal(t, []string{"HUP"}, sigs)
+		assert.Equal(t, "hello\n~bye", out.String())
+		assert.Contains(t, prompt.String(), "signal: HUP")
+	})
+
+	t.Run("forwards sequences without a handler", func(t *testing.T) {
+		var buf, out bytes.Buffer
+
+		buf.WriteString("\n~sHUP\r")
+
+		ew := testWatcher(&buf, func() {})
+
+		io.Copy(&out, ew)
+
+		assert.Equal(t, "\n~sHUP\r", out.String())
+	})
+
+	t.Run("aborts the signal prompt", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.WriteString("\n~sHU\x03\r")
+
+		var sigs []string
+		ew := New(&buf, map[byte]Handler{
+			's': {Line: func(name string) { sigs = append(sigs, name) }},
+		})
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.Empty(t, sigs)
+		assert.Equal(t, stateNormal, ew.state)
+	})
+
+	t.Run("sees the sequence in data read along with EOF", func(t *testing.T) {
+		var out bytes.Buffer
+		var ok bool
+
+		cancel := func() {
+			ok = true
+		}
+
+		ew := testWatcher(iotest.DataErrReader(bytes.NewReader([]byte("hello\n~."))), cancel)
+
+		io.Copy(&out, ew)
+
+		assert.True(t, ok, "context was not canceled")
+		assert.Equal(t, "hello\n~.", out.String())
+	})
+
+	t.Run("cancels once per sequence", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		buf.WriteString("\n~..")
+
+		var count int
+		ew := testWatcher(&buf, func() { count++ })
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.Equal(t, 1, count)
+		assert.Equal(t, stateNormal, ew.state)
+	})
+
+	t.Run("consumes the character after the tilde", func(t *testing.T) {
+		var out bytes.Buffer
+		var count int
+		ew := New(bytes.NewReader([]byte("ls\n~ols\n")), map[byte]Handler{
+			'.': {Func: func() { t.Fatal("should not cancel") }, Forward: true},
+			'o': {Func: func() { count++ }},
+		})
+
+		io.Copy(&out, ew)
+
+		assert.Equal(t, 1, count)
+		assert.Equal(t, "ls\n~ls\n", out.String())
+	})
+
+	t.Run("forwards ~o without a handler", func(t *testing.T) {
+		var out bytes.Buffer
+		ew := testWatcher(bytes.NewReader([]byte("\n~o")), func() {})
+
+		io.Copy(&out, ew)
+
+		assert.Equal(t, "\n~o", out.String())
+	})
+
+	t.Run("calls the handler each time", func(t *testing.T) {
+		var out bytes.Buffer
+		var count int
+		ew := New(bytes.NewReader([]byte("top\n~p\x03\n~p")), map[byte]Handler{
+			'.': {Func: func() { t.Fatal("should not cancel") }, Forward: true},
+			'p': {Func: func() { count++ }},
+		})
+
+		io.Copy(&out, ew)
+
+		assert.Equal(t, 2, count)
+		assert.Equal(t, "top\n~\x03\n~", out.String())
+	})
+
+	t.Run("sees a newline right after a tilde", func(t *testing.T) {
+		var count int
+		ew := testWatcher(bytes.NewReader([]byte("\n~\n~.")), func() { count++ })
+
+		io.Copy(ioutil.Discard, ew)
+
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("ends lines on carriage returns if asked", func(t *testing.T) {
+		var count int
+		ew := testWatcher(bytes.NewReader([]byte("ls\r~.")), func() { count++ })
+		io.Copy(ioutil.Discard, ew)
+		assert.Equal(t, 0, count)
+
+		ew = testWatcher(bytes.NewReader([]byte("ls\r~.")), func() { count++ })
+		ew.CarriageReturn = true
+		io.Copy(ioutil.Discard, ew)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("stacks with other readers", func(t *testing.T) {
+		var out bytes.Buffer
+		var count int
+		input := iotest.DataErrReader(iotest.OneByteReader(
+			bytes.NewReader([]byte("ls\n~.\n~~p"))))
+		ew := New(input, map[byte]Handler{
+			'.': {Func: func() { count++ }, Forward: true},
+			'p': {Func: func() { t.Fatal("not a sequence") }},
+		})
+
+		io.Copy(&out, iotest.HalfReader(ew))
+
+		assert.Equal(t, 1, count)
+		assert.Equal(t, "ls\n~.\n~~p", out.String())
+	})
+}
+
+func TestWatcherSetEnabled(t *testing.T) {
+	t.Run("forwards everything while disabled", func(t *testing.T) {
+		var count int
+		r, w := io.Pipe()
+		ew := testWatcher(r, func() { count++ })
+		ew.SetEnabled(false)
+
+		buf := make([]byte, 1024)
+		go w.Write([]byte("\n~.\x00\n~."))
+		n, err := ew.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "\n~.\x00\n~.", string(buf[:n]))
+		assert.Zero(t, count)
+
+		// Sequences are seen again after the next newline
+		ew.SetEnabled(true)
+		go w.Write([]byte("~.\n~."))
+		n, err = ew.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "~.\n~.", string(buf[:n]))
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("a handler disables the rest of the read", func(t *testing.T) {
+		var out bytes.Buffer
+		var ew *Watcher
+		ew = New(bytes.NewReader([]byte("\n~b\n~b\n~s")), map[byte]Handler{
+			'b': {Func: func() { ew.SetEnabled(false) }},
+			's': {Line: func(string) { t.Fatal("not a sequence") }},
+		})
+
+		io.Copy(&out, ew)
+
+		assert.Equal(t, "\n~\n~b\n~s", out.String())
+	})
+
+	t.Run("abandons a line being read", func(t *testing.T) {
+		var prompt bytes.Buffer
+		var lines []string
+		r, w := io.Pipe()
+		ew := New(r, map[byte]Handler{
+			's': {Line: func(line string) { lines = append(lines, line) }},
+		})
+		ew.Echo = &prompt
+
+		buf := make([]byte, 1024)
+		go w.Write([]byte("\n~sHU"))
+		_, err := ew.Read(buf)
+		require.NoError(t, err)
+
+		ew.SetEnabled(true)
+		go w.Write([]byte("P\r"))
+		n, err := ew.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "P\r", string(buf[:n]))
+		assert.Empty(t, lines)
+	})
+}
+
+// testWatcher returns a Watcher of r that calls cancel on "~.", which is
+// forwarded like the sequence of a session that ends.
+func testWatcher(r io.Reader, cancel func()) *Watcher {
+	return New(r, map[byte]Handler{
+		'.': {Func: cancel, Forward: true},
+	})
+}
@@ -1,6 +1,8 @@
 package flag
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"regexp"
 	"strconv"
@@ -61,6 +63,28 @@ func envDurationDefault(key string, def time.Duration) time.Duration {
 	return def
 }
 
+// envError is the error for an environment variable with a value that
+// isn't valid for its flag.
+func envError(key, value string, err error) error {
+	// strconv errors repeat the function and value, so we only keep why.
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		err = numErr.Err
+	}
+
+	return fmt.Errorf("invalid value %q for the %s environment variable: %s",
+		value, key, err)
+}
+
+func containsString(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
 // wrapAtLengthWithPadding wraps the given text at the maxLineLength, taking
 // into account any provided left padding.
 func wrapAtLengthWithPadding(s string, pad int) string {
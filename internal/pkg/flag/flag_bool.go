@@ -22,9 +22,12 @@ type BoolVar struct {
 
 func (f *Set) BoolVar(i *BoolVar) {
 	def := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
 		if b, err := strconv.ParseBool(v); err == nil {
 			def = b
+		} else {
+			envErr = envError(i.EnvVar, v, err)
 		}
 	}
 
@@ -36,6 +39,7 @@ func (f *Set) BoolVar(i *BoolVar) {
 		EnvVar:     i.EnvVar,
 		Value:      newBoolValue(i, def, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
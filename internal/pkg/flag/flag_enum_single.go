@@ -24,8 +24,14 @@ type EnumSingleVar struct {
 
 func (f *Set) EnumSingleVar(i *EnumSingleVar) {
 	initial := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
-		initial = v
+		if containsString(i.Values, v) {
+			initial = v
+		} else {
+			envErr = envError(i.EnvVar, v, fmt.Errorf(
+				"must be one of: %s", strings.Join(i.Values, ", ")))
+		}
 	}
 
 	def := i.Default
@@ -40,6 +46,7 @@ func (f *Set) EnumSingleVar(i *EnumSingleVar) {
 		EnvVar:     i.EnvVar,
 		Value:      newEnumSingleValue(i, initial, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
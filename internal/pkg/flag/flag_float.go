@@ -21,9 +21,12 @@ type Float64Var struct {
 
 func (f *Set) Float64Var(i *Float64Var) {
 	initial := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
-		if i, err := strconv.ParseFloat(v, 64); err == nil {
-			initial = i
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			initial = f
+		} else {
+			envErr = envError(i.EnvVar, v, err)
 		}
 	}
 
@@ -40,6 +43,7 @@ func (f *Set) Float64Var(i *Float64Var) {
 		EnvVar:     i.EnvVar,
 		Value:      newFloat64Value(initial, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
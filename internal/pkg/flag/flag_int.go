@@ -22,9 +22,12 @@ type IntVar struct {
 
 func (f *Set) IntVar(i *IntVar) {
 	initial := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
-		if i, err := strconv.ParseInt(v, 0, 64); err == nil {
-			initial = int(i)
+		if n, err := strconv.ParseInt(v, 0, 64); err == nil {
+			initial = int(n)
+		} else {
+			envErr = envError(i.EnvVar, v, err)
 		}
 	}
 
@@ -41,6 +44,7 @@ func (f *Set) IntVar(i *IntVar) {
 		EnvVar:     i.EnvVar,
 		Value:      newIntValue(i, initial, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
@@ -94,9 +98,12 @@ type Int64Var struct {
 
 func (f *Set) Int64Var(i *Int64Var) {
 	initial := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
-		if i, err := strconv.ParseInt(v, 0, 64); err == nil {
-			initial = i
+		if n, err := strconv.ParseInt(v, 0, 64); err == nil {
+			initial = n
+		} else {
+			envErr = envError(i.EnvVar, v, err)
 		}
 	}
 
@@ -113,6 +120,7 @@ func (f *Set) Int64Var(i *Int64Var) {
 		EnvVar:     i.EnvVar,
 		Value:      newInt64Value(i, initial, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
@@ -166,9 +174,12 @@ type UintVar struct {
 
 func (f *Set) UintVar(i *UintVar) {
 	initial := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
-		if i, err := strconv.ParseUint(v, 0, 64); err == nil {
-			initial = uint(i)
+		if n, err := strconv.ParseUint(v, 0, 64); err == nil {
+			initial = uint(n)
+		} else {
+			envErr = envError(i.EnvVar, v, err)
 		}
 	}
 
@@ -185,6 +196,7 @@ func (f *Set) UintVar(i *UintVar) {
 		EnvVar:     i.EnvVar,
 		Value:      newUintValue(i, initial, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
@@ -238,9 +250,12 @@ type Uint64Var struct {
 
 func (f *Set) Uint64Var(i *Uint64Var) {
 	initial := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
-		if i, err := strconv.ParseUint(v, 0, 64); err == nil {
-			initial = i
+		if n, err := strconv.ParseUint(v, 0, 64); err == nil {
+			initial = n
+		} else {
+			envErr = envError(i.EnvVar, v, err)
 		}
 	}
 
@@ -257,6 +272,7 @@ func (f *Set) Uint64Var(i *Uint64Var) {
 		EnvVar:     i.EnvVar,
 		Value:      newUint64Value(i, initial, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
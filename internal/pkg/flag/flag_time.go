@@ -22,9 +22,12 @@ type DurationVar struct {
 
 func (f *Set) DurationVar(i *DurationVar) {
 	initial := i.Default
+	var envErr error
 	if v, exist := os.LookupEnv(i.EnvVar); exist {
 		if d, err := time.ParseDuration(appendDurationSuffix(v)); err == nil {
 			initial = d
+		} else {
+			envErr = envError(i.EnvVar, v, err)
 		}
 	}
 
@@ -41,6 +44,7 @@ func (f *Set) DurationVar(i *DurationVar) {
 		EnvVar:     i.EnvVar,
 		Value:      newDurationValue(initial, i.Target, i.Hidden),
 		Completion: i.Completion,
+		envErr:     envErr,
 	})
 }
 
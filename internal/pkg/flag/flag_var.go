@@ -17,6 +17,10 @@ type VarFlag struct {
 	EnvVar     string
 	Value      flag.Value
 	Completion complete.Predictor
+
+	// envErr is set if EnvVar has a value that isn't valid for the flag.
+	// Parse returns it unless the flag is given.
+	envErr error
 }
 
 func (f *Set) VarFlag(i *VarFlag) {
@@ -62,8 +62,37 @@ func (f *Sets) Completions() complete.Flags {
 }
 
 // Parse parses the given flags, returning any errors.
+// Values from environment variables that aren't valid for their flag are
+// an error, unless the flag is given.
 func (f *Sets) Parse(args []string) error {
-	return f.unionSet.Parse(args)
+	if err := f.unionSet.Parse(args); err != nil {
+		return err
+	}
+
+	given := map[string]struct{}{}
+	f.unionSet.Visit(func(fl *flag.Flag) {
+		given[fl.Name] = struct{}{}
+	})
+
+	for _, set := range f.flagSets {
+		for _, v := range set.vars {
+			if v.envErr == nil {
+				continue
+			}
+
+			_, ok := given[v.Name]
+			for _, a := range v.Aliases {
+				if _, aok := given[a]; aok {
+					ok = true
+				}
+			}
+			if !ok {
+				return v.envErr
+			}
+		}
+	}
+
+	return nil
 }
 
 // Parsed reports whether the command-line flags have been parsed.
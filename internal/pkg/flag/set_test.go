@@ -1,7 +1,9 @@
 package flag
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -33,3 +35,53 @@ func TestSets(t *testing.T) {
 	require.Equal(int(21), valA)
 	require.Equal(int(42), valB)
 }
+
+func TestSets_envVar(t *testing.T) {
+	const envVar = "WAYPOINT_TEST_FLAG_TIMEOUT"
+
+	cases := []struct {
+		Name     string
+		Env      string
+		Args     []string
+		Expected time.Duration
+		Err      string
+	}{
+		{"default", "", nil, time.Minute, ""},
+		{"env", "30s", nil, 30 * time.Second, ""},
+		{"flag wins", "30s", []string{"-timeout", "10s"}, 10 * time.Second, ""},
+		{"alias wins", "30s", []string{"-t", "10s"}, 10 * time.Second, ""},
+		{"invalid env", "soon", nil, 0, envVar},
+		{"invalid env with flag", "soon", []string{"-timeout", "10s"}, 10 * time.Second, ""},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			if tt.Env != "" {
+				require.NoError(os.Setenv(envVar, tt.Env))
+				defer os.Unsetenv(envVar)
+			}
+
+			var val time.Duration
+			sets := NewSets()
+			sets.NewSet("A").DurationVar(&DurationVar{
+				Name:    "timeout",
+				Aliases: []string{"t"},
+				Default: time.Minute,
+				EnvVar:  envVar,
+				Target:  &val,
+			})
+
+			err := sets.Parse(tt.Args)
+			if tt.Err != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tt.Err)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tt.Expected, val)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Package scrub redacts secrets from data before it is persisted, such as
+// in logs. Data sent live to a user should never be scrubbed; this is only
+// for the copies we keep.
+package scrub
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"sort"
+)
+
+// Redacted is what secrets are replaced with.
+const Redacted = "[REDACTED]"
+
+// MinValueLength is the minimum length of a secret value. Shorter values
+// are ignored since they'd match too much unrelated data.
+const MinValueLength = 6
+
+// DefaultPatterns match common ways that secrets are passed on a command
+// line, such as "--token=abc". Only the value is redacted.
+var DefaultPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)-[a-z0-9_-]*(?:token|password|passwd|secret|api[_-]?key)[a-z0-9_-]*=(\S+)`),
+}
+
+// secretFlag matches an argument that is a flag whose value is given in
+// the next argument, such as "--password" in "--password abc".
+var secretFlag = regexp.MustCompile(`(?i)^-[a-z0-9_-]*(?:token|password|passwd|secret|api[_-]?key)[a-z0-9_-]*$`)
+
+// Scrubber redacts matches of a set of patterns and secret values.
+type Scrubber struct {
+	patterns []*regexp.Regexp
+	values   [][]byte
+	maxValue int
+}
+
+// New returns a Scrubber that redacts matches of the given patterns and
+// any occurrence of the given secret values. If a pattern has a submatch
+// only the first submatch is redacted, otherwise the whole match is.
+func New(patterns []*regexp.Regexp, values []string) *Scrubber {
+	s := &Scrubber{patterns: patterns}
+	for _, v := range values {
+		if len(v) < MinValueLength {
+			continue
+		}
+
+		s.values = append(s.values, []byte(v))
+		if len(v) > s.maxValue {
+			s.maxValue = len(v)
+		}
+	}
+
+	// Match longer values first so a value that contains another is
+	// redacted entirely.
+	sort.Slice(s.values, func(i, j int) bool {
+		return len(s.values[i]) > len(s.values[j])
+	})
+
+	return s
+}
+
+// Default returns a Scrubber that uses DefaultPatterns.
+func Default() *Scrubber {
+	return New(DefaultPatterns, nil)
+}
+
+// String returns v with any secrets redacted.
+func (s *Scrubber) String(v string) string {
+	return string(s.Bytes([]byte(v)))
+}
+
+// Bytes returns v with any secrets redacted. The input is not modified.
+func (s *Scrubber) Bytes(v []byte) []byte {
+	return redact(v, s.ranges(v))
+}
+
+// Args returns a copy of args with any secrets redacted. In addition to
+// the patterns, the argument following a flag such as "--password" is
+// redacted.
+func (s *Scrubber) Args(args []string) []string {
+	result := make([]string, len(args))
+	for i, arg := range args {
+		if i > 0 && secretFlag.MatchString(args[i-1]) {
+			result[i] = Redacted
+			continue
+		}
+
+		result[i] = s.String(arg)
+	}
+
+	return result
+}
+
+// Writer returns a writer that redacts secrets from the data written to
+// it before writing it to w. See Writer for details.
+func (s *Scrubber) Writer(w io.Writer) *Writer {
+	return &Writer{s: s, w: w}
+}
+
+// ranges returns the sorted, non-overlapping ranges of v to redact.
+func (s *Scrubber) ranges(v []byte) [][2]int {
+	var result [][2]int
+	for _, p := range s.patterns {
+		for _, m := range p.FindAllSubmatchIndex(v, -1) {
+			if len(m) >= 4 && m[2] >= 0 {
+				result = append(result, [2]int{m[2], m[3]})
+			} else {
+				result = append(result, [2]int{m[0], m[1]})
+			}
+		}
+	}
+
+	for _, value := range s.values {
+		for offset := 0; offset < len(v); {
+			idx := bytes.Index(v[offset:], value)
+			if idx < 0 {
+				break
+			}
+
+			start := offset + idx
+			result = append(result, [2]int{start, start + len(value)})
+			offset = start + len(value)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	// Merge overlapping ranges
+	sort.Slice(result, func(i, j int) bool { return result[i][0] < result[j][0] })
+	merged := result[:1]
+	for _, r := range result[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1] {
+			if r[1] > last[1] {
+				last[1] = r[1]
+			}
+
+			continue
+		}
+
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// redact returns v with the given ranges replaced with Redacted.
+func redact(v []byte, ranges [][2]int) []byte {
+	if len(ranges) == 0 {
+		return v
+	}
+
+	var buf bytes.Buffer
+	last := 0
+	for _, r := range ranges {
+		buf.Write(v[last:r[0]])
+		buf.WriteString(Redacted)
+		last = r[1]
+	}
+	buf.Write(v[last:])
+
+	return buf.Bytes()
+}
+
+// maxBuffer is how much data Writer buffers without a newline before it
+// writes what it safely can.
+const maxBuffer = 4096
+
+// Writer redacts secrets from the data written to it. Data is buffered
+// up to each newline so that secrets split across writes are still found,
+// which means patterns never match across a newline. Close must be called
+// to write any remaining data.
+type Writer struct {
+	s   *Scrubber
+	w   io.Writer
+	buf []byte
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	// Write out every complete line
+	if idx := bytes.LastIndexByte(w.buf, '\n'); idx >= 0 {
+		if err := w.flush(idx + 1); err != nil {
+			return 0, err
+		}
+	}
+
+	// If we have a long line, write what we can. We keep enough data
+	// buffered that a secret value we've only seen part of isn't written.
+	if len(w.buf) > maxBuffer {
+		cut := len(w.buf) - w.s.maxValue
+		for _, r := range w.s.ranges(w.buf) {
+			if r[0] < cut && r[1] > cut {
+				cut = r[1]
+			}
+		}
+
+		if err := w.flush(cut); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Close writes any remaining buffered data. This doesn't close the
+// underlying writer.
+func (w *Writer) Close() error {
+	return w.flush(len(w.buf))
+}
+
+// flush writes the first n bytes of the buffer.
+func (w *Writer) flush(n int) error {
+	if n <= 0 {
+		return nil
+	}
+
+	_, err := w.w.Write(w.s.Bytes(w.buf[:n]))
+	w.buf = append(w.buf[:0], w.buf[n:]...)
+	return err
+}
@@ -4,12 +4,21 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"sync"
+	"sync/atomic"
 
 	"github.com/hashicorp/go-hclog"
 )
 
+var (
+	interceptLock sync.Mutex
+	intercept     func()
+)
+
 // WithInterrupt returns a Context that is done when an interrupt signal is received.
 // It also returns a closer function that should be deferred for proper cleanup.
+//
+// While Intercept is in effect, interrupts call its function instead.
 func WithInterrupt(ctx context.Context, log hclog.Logger) (context.Context, func()) {
 	log.Trace("starting interrupt listener for context cancellation")
 
@@ -22,13 +31,26 @@ func WithInterrupt(ctx context.Context, log hclog.Logger) (context.Context, func
 	go func() {
 		log.Trace("interrupt listener goroutine started")
 
-		select {
-		case <-ch:
-			log.Warn("interrupt received, cancelling context")
-			cancel()
-		case <-ctx.Done():
-			log.Warn("context cancelled, stopping interrupt listener loop")
-			return
+		for {
+			select {
+			case <-ch:
+				interceptLock.Lock()
+				fn := intercept
+				interceptLock.Unlock()
+				if fn != nil {
+					log.Info("interrupt received, handled by the command")
+					fn()
+					continue
+				}
+
+				log.Warn("interrupt received, cancelling context")
+				cancel()
+				return
+
+			case <-ctx.Done():
+				log.Warn("context cancelled, stopping interrupt listener loop")
+				return
+			}
 		}
 	}()
 
@@ -40,3 +62,44 @@ func WithInterrupt(ctx context.Context, log hclog.Logger) (context.Context, func
 		cancel()
 	}
 }
+
+// Intercept makes interrupts call fn instead of cancelling the contexts of
+// WithInterrupt, for commands that handle interrupts themselves, such as
+// to shut down gracefully. fn is called once for each interrupt. The
+// returned function stops intercepting.
+func Intercept(fn func()) func() {
+	interceptLock.Lock()
+	defer interceptLock.Unlock()
+	intercept = fn
+
+	return func() {
+		interceptLock.Lock()
+		defer interceptLock.Unlock()
+		intercept = nil
+	}
+}
+
+// WithGracefulInterrupt intercepts interrupts for a shutdown in two stages.
+// The first interrupt closes the returned channel so that the caller can
+// start shutting down gracefully, and the second cancels the returned
+// context to force it. The returned function stops intercepting and
+// cancels the context, and should be deferred.
+func WithGracefulInterrupt(ctx context.Context) (context.Context, <-chan struct{}, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	drainCh := make(chan struct{})
+
+	var count int32
+	stop := Intercept(func() {
+		if atomic.AddInt32(&count, 1) == 1 {
+			close(drainCh)
+			return
+		}
+
+		cancel()
+	})
+
+	return ctx, drainCh, func() {
+		stop()
+		cancel()
+	}
+}
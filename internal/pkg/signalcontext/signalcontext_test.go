@@ -0,0 +1,55 @@
+// +build !windows
+
+package signalcontext
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithGracefulInterrupt(t *testing.T) {
+	require := require.New(t)
+
+	base, closer := WithInterrupt(context.Background(), hclog.NewNullLogger())
+	defer closer()
+
+	ctx, drainCh, stop := WithGracefulInterrupt(base)
+	defer stop()
+
+	interrupt := func() {
+		require.NoError(syscall.Kill(os.Getpid(), syscall.SIGINT))
+	}
+
+	// The first interrupt starts draining
+	interrupt()
+	select {
+	case <-drainCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("first interrupt didn't start draining")
+	}
+	require.NoError(ctx.Err())
+
+	// The second forces the shutdown, but the base context is untouched
+	interrupt()
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("second interrupt didn't cancel the context")
+	}
+	require.NoError(base.Err())
+
+	// Once stopped, interrupts cancel the base context again
+	stop()
+	interrupt()
+	select {
+	case <-base.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("interrupt didn't cancel the base context")
+	}
+}
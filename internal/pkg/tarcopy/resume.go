@@ -0,0 +1,120 @@
+package tarcopy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ChunkSize is the size of the chunks that a Partial is checksummed in.
+// A resumed copy continues after the last chunk that matches.
+const ChunkSize = 1024 * 1024
+
+// paxOffset is the PAX record marking a resumed entry. Its value is the
+// offset in the file that the contents in the archive start at.
+const paxOffset = "WAYPOINT.offset"
+
+// Partial describes a partial copy of a file left at the destination by
+// an interrupted copy. Setting Options.Resume to it makes Archive only
+// send the part of the file that the destination doesn't already have.
+type Partial struct {
+	// Size is the size of the partial file, zero if there is none.
+	Size int64
+
+	// Chunks are the SHA-256 of each consecutive ChunkSize chunk of the
+	// partial file. The last chunk may be shorter.
+	Chunks [][]byte
+}
+
+// PartialFile returns the partial copy of the file name that Extract
+// would write to dest. This follows the same rules as Extract: if dest
+// is an existing directory the file is name within it, otherwise it is
+// dest itself. If the file doesn't exist, the Partial is empty.
+func PartialFile(dest, name string) (*Partial, error) {
+	target := dest
+	if fi, err := os.Stat(dest); err == nil && fi.IsDir() {
+		target = filepath.Join(dest, name)
+	}
+
+	fi, err := os.Lstat(target)
+	if os.IsNotExist(err) {
+		return &Partial{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() {
+		return nil, fmt.Errorf("can't resume copying to %s, it isn't a regular file", target)
+	}
+
+	f, err := os.Open(target)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	result := &Partial{}
+	for {
+		h := sha256.New()
+		n, err := io.CopyN(h, f, ChunkSize)
+		if n > 0 {
+			result.Size += n
+			result.Chunks = append(result.Chunks, h.Sum(nil))
+		}
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// resumeOffset returns how much of the start of f, which is size bytes,
+// matches the partial copy. The partial copy is compared chunk by chunk
+// and the offset is the end of the last chunk that matches, so zero means
+// the whole file has to be sent.
+func resumeOffset(f io.ReaderAt, size int64, partial *Partial) (int64, error) {
+	var offset int64
+	for _, sum := range partial.Chunks {
+		n := partial.Size - offset
+		if n > ChunkSize {
+			n = ChunkSize
+		}
+		if n <= 0 || offset+n > size {
+			break
+		}
+
+		h := sha256.New()
+		if _, err := io.Copy(h, io.NewSectionReader(f, offset, n)); err != nil {
+			return 0, err
+		}
+		if !bytes.Equal(h.Sum(nil), sum) {
+			break
+		}
+
+		offset += n
+	}
+
+	return offset, nil
+}
+
+// parseOffset returns the resume offset of an entry, zero if it isn't
+// resumed.
+func parseOffset(records map[string]string) (int64, error) {
+	v, ok := records[paxOffset]
+	if !ok {
+		return 0, nil
+	}
+
+	offset, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid resume offset %q", v)
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,497 @@
+// Package tarcopy copies files and directories as a tar stream, such as
+// between a client and a remote instance. Archive writes paths to a tar
+// stream and Extract writes a tar stream to a destination.
+//
+// Problems with individual files don't stop a copy. They are collected in
+// the Result so that the rest of the files are still copied. Both sides
+// compute the same checksum of what was copied so that the receiver can
+// confirm it got everything the sender sent.
+package tarcopy
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Options are the options for Archive and Extract.
+type Options struct {
+	// FollowSymlinks archives the files that symlinks point to rather than
+	// the symlinks themselves. This is only used by Archive.
+	FollowSymlinks bool
+
+	// Progress, if set, is called as file contents are copied with the
+	// totals so far. This is called often, so it should be cheap.
+	Progress func(files, bytes int64)
+
+	// Resume, if set, is the partial copy at the destination of the file
+	// being archived. Only the part of the file after what matches is
+	// sent, and Extract continues the partial copy from there. This is
+	// only used by Archive and only applies to a single regular file.
+	Resume *Partial
+}
+
+// Result is the result of a copy.
+type Result struct {
+	// Files and Bytes are the number of entries and bytes of file
+	// contents copied.
+	Files int64
+	Bytes int64
+
+	// Checksum is the SHA-256 of the names, types and contents of the
+	// entries in the archive. Archive and Extract compute the same value
+	// for the same archive, even if some entries couldn't be extracted.
+	Checksum []byte
+
+	// Errors are the entries that couldn't be copied.
+	Errors []*FileError
+
+	// Resumed is the number of bytes of a resumed file that were already
+	// at the destination. These aren't counted in Bytes, but are covered
+	// by Checksum so that it still compares whole files.
+	Resumed int64
+}
+
+// FileError is an error copying a single entry.
+type FileError struct {
+	Path string
+	Err  error
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// Archive writes the files and directories at paths to w as a tar archive.
+// Each path is archived under its base name, so archiving "/a/b" results
+// in entries such as "b" and "b/c". The archive is complete when this
+// returns. The error is only set if writing to w failed.
+func Archive(w io.Writer, paths []string, opts *Options) (*Result, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	a := &archiver{
+		tw:     tar.NewWriter(w),
+		opts:   opts,
+		result: &Result{},
+		sum:    sha256.New(),
+	}
+	for _, p := range paths {
+		if err := a.add(p, filepath.Base(p), nil); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.tw.Close(); err != nil {
+		return nil, err
+	}
+
+	a.result.Checksum = a.sum.Sum(nil)
+	return a.result, nil
+}
+
+type archiver struct {
+	tw     *tar.Writer
+	opts   *Options
+	result *Result
+	sum    hash.Hash
+}
+
+// add archives the file at p as name. parents are the directories we're
+// within, used to detect symlink cycles when following symlinks.
+func (a *archiver) add(p, name string, parents []os.FileInfo) error {
+	stat := os.Lstat
+	if a.opts.FollowSymlinks {
+		stat = os.Stat
+	}
+
+	info, err := stat(p)
+	if err != nil {
+		a.fileError(name, err)
+		return nil
+	}
+
+	var link string
+	switch mode := info.Mode(); {
+	case mode.IsRegular(), mode.IsDir():
+
+	case mode&os.ModeSymlink != 0:
+		link, err = os.Readlink(p)
+		if err != nil {
+			a.fileError(name, err)
+			return nil
+		}
+
+	default:
+		a.fileError(name, fmt.Errorf("unsupported file type %s", mode.Type()))
+		return nil
+	}
+
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		a.fileError(name, err)
+		return nil
+	}
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+
+	// Ownership doesn't carry over to another machine so leave it out.
+	hdr.Uid, hdr.Gid, hdr.Uname, hdr.Gname = 0, 0, "", ""
+
+	// Open regular files before writing the header so that a file we
+	// can't read is skipped entirely rather than left half-written.
+	var f *os.File
+	if info.Mode().IsRegular() {
+		f, err = os.Open(p)
+		if err != nil {
+			a.fileError(name, err)
+			return nil
+		}
+		defer f.Close()
+	}
+
+	// When resuming, only the contents after what the destination already
+	// has go in the archive.
+	var offset int64
+	if f != nil && parents == nil && a.opts.Resume != nil {
+		offset, err = resumeOffset(f, hdr.Size, a.opts.Resume)
+		if err != nil {
+			a.fileError(name, err)
+			return nil
+		}
+		if offset > 0 {
+			hdr.Size -= offset
+			hdr.PAXRecords = map[string]string{paxOffset: strconv.FormatInt(offset, 10)}
+		}
+	}
+
+	if err := a.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	a.result.Files++
+	writeEntrySum(a.sum, hdr)
+
+	if offset > 0 {
+		// The checksum covers the whole file, so the part we skip is
+		// read again for it.
+		if _, err := io.CopyN(a.sum, f, offset); err != nil {
+			return fmt.Errorf("error copying %s: %s", name, err)
+		}
+		a.result.Resumed += offset
+	}
+
+	if f != nil {
+		// If the file shrinks while we copy it, the tar writer fails and
+		// the archive is unusable, so that is fatal.
+		w := io.MultiWriter(a.sum, &progressWriter{result: a.result, opts: a.opts}, a.tw)
+		if _, err := io.CopyN(w, f, hdr.Size); err != nil {
+			return fmt.Errorf("error copying %s: %s", name, err)
+		}
+	}
+
+	if !info.IsDir() {
+		return nil
+	}
+
+	for _, parent := range parents {
+		if os.SameFile(parent, info) {
+			a.fileError(name, fmt.Errorf("symlink cycle, not descending"))
+			return nil
+		}
+	}
+
+	entries, err := readDirNames(p)
+	if err != nil {
+		a.fileError(name, err)
+		return nil
+	}
+
+	parents = append(parents, info)
+	for _, entry := range entries {
+		if err := a.add(filepath.Join(p, entry), path.Join(name, entry), parents); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *archiver) fileError(name string, err error) {
+	a.result.Errors = append(a.result.Errors, &FileError{Path: name, Err: err})
+}
+
+// Extract reads a tar archive from r and writes it to dest, stopping at
+// the end of the archive. If dest is an existing directory, the entries
+// are extracted into it. Otherwise dest must not exist and the archive
+// must have a single top-level entry, which is created as dest.
+//
+// Entries are never written outside of dest, including through symlinks
+// that were extracted earlier. The error is only set if reading the
+// archive failed.
+func Extract(r io.Reader, dest string, opts *Options) (*Result, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	e := &extractor{
+		opts:   opts,
+		result: &Result{},
+		sum:    sha256.New(),
+		dest:   filepath.Clean(dest),
+	}
+	if fi, err := os.Stat(dest); err == nil && fi.IsDir() {
+		e.into = true
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		e.result.Files++
+		writeEntrySum(e.sum, hdr)
+		if err := e.extract(tr, hdr); err != nil {
+			e.result.Errors = append(e.result.Errors, &FileError{Path: hdr.Name, Err: err})
+		}
+
+		// Consume whatever of the contents we didn't write so that the
+		// checksum still covers them.
+		w := io.MultiWriter(e.sum, &progressWriter{result: e.result, opts: e.opts})
+		if _, err := io.Copy(w, tr); err != nil {
+			return nil, err
+		}
+	}
+
+	// Directory modes are set last so that read-only directories
+	// don't prevent us from creating their contents.
+	for i := len(e.dirs) - 1; i >= 0; i-- {
+		d := e.dirs[i]
+		if err := os.Chmod(d.path, d.mode); err != nil {
+			e.result.Errors = append(e.result.Errors, &FileError{Path: d.name, Err: err})
+		}
+	}
+
+	e.result.Checksum = e.sum.Sum(nil)
+	return e.result, nil
+}
+
+type extractor struct {
+	opts   *Options
+	result *Result
+	sum    hash.Hash
+	dest   string
+	into   bool
+	top    string
+	dirs   []extractDir
+}
+
+type extractDir struct {
+	name string
+	path string
+	mode os.FileMode
+}
+
+// extract writes a single entry. The contents of regular files are read
+// from r, teeing them into our checksum.
+func (e *extractor) extract(r io.Reader, hdr *tar.Header) error {
+	target, err := e.target(hdr.Name)
+	if err != nil {
+		return err
+	}
+
+	mode := os.FileMode(hdr.Mode).Perm()
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.MkdirAll(target, 0700); err != nil {
+			return err
+		}
+		e.dirs = append(e.dirs, extractDir{name: hdr.Name, path: target, mode: mode})
+
+	case tar.TypeReg, tar.TypeRegA:
+		// Replace rather than write through an existing symlink.
+		if fi, err := os.Lstat(target); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+			if err := os.Remove(target); err != nil {
+				return err
+			}
+		}
+
+		offset, err := parseOffset(hdr.PAXRecords)
+		if err != nil {
+			return err
+		}
+
+		flag := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+		if offset > 0 {
+			flag = os.O_RDWR
+		}
+		f, err := os.OpenFile(target, flag, mode)
+		if err != nil {
+			return err
+		}
+
+		if offset > 0 {
+			if err := e.resume(f, offset); err != nil {
+				f.Close()
+				return err
+			}
+		}
+
+		// The file is written last so that if writing fails, what we read
+		// is still part of the checksum.
+		w := io.MultiWriter(e.sum, &progressWriter{result: e.result, opts: e.opts}, f)
+		_, err = io.Copy(w, r)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+
+		// An existing file keeps its mode on open so set it explicitly.
+		if err := os.Chmod(target, mode); err != nil {
+			return err
+		}
+		if !hdr.ModTime.IsZero() {
+			os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+		}
+
+	case tar.TypeSymlink:
+		os.Remove(target)
+		if err := os.Symlink(hdr.Linkname, target); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unsupported entry type %q", string(hdr.Typeflag))
+	}
+
+	return nil
+}
+
+// resume continues writing a partial file after offset. What is already
+// there is added to our checksum so that it still covers the whole file.
+func (e *extractor) resume(f *os.File, offset int64) error {
+	n, err := io.CopyN(e.sum, f, offset)
+	if err == io.EOF {
+		return fmt.Errorf("can't resume, the file is only %d bytes but %d were expected", n, offset)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := f.Truncate(offset); err != nil {
+		return err
+	}
+
+	e.result.Resumed += offset
+	return nil
+}
+
+// target returns the path to extract the named entry to. This returns an
+// error if the entry would be written outside of our destination.
+func (e *extractor) target(name string) (string, error) {
+	for _, part := range strings.Split(name, "/") {
+		if part == ".." {
+			return "", fmt.Errorf("refusing to extract entry outside of the destination")
+		}
+	}
+
+	name = path.Clean("/" + name)[1:]
+	if name == "" {
+		return "", fmt.Errorf("invalid entry name")
+	}
+
+	rel := filepath.FromSlash(name)
+	if !e.into {
+		// The top-level entry becomes dest itself.
+		parts := strings.SplitN(name, "/", 2)
+		if e.top == "" {
+			e.top = parts[0]
+		}
+		if parts[0] != e.top {
+			return "", fmt.Errorf(
+				"can't copy multiple entries to %s, it isn't an existing directory", e.dest)
+		}
+
+		rel = ""
+		if len(parts) > 1 {
+			rel = filepath.FromSlash(parts[1])
+		}
+	}
+
+	// Make sure that no parent of the target within dest is a symlink,
+	// since an earlier entry could have made one point anywhere.
+	target := e.dest
+	if rel != "" {
+		dir := e.dest
+		parts := strings.Split(rel, string(filepath.Separator))
+		for _, part := range parts[:len(parts)-1] {
+			dir = filepath.Join(dir, part)
+			if fi, err := os.Lstat(dir); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+				return "", fmt.Errorf("refusing to write through symlink %s", dir)
+			}
+		}
+
+		target = filepath.Join(e.dest, rel)
+	}
+
+	return target, nil
+}
+
+// writeEntrySum adds the identity of an entry to the checksum. The
+// contents of regular files are added separately as they are copied.
+func writeEntrySum(h hash.Hash, hdr *tar.Header) {
+	fmt.Fprintf(h, "%s\x00%c\x00%s\x00", hdr.Name, hdr.Typeflag, hdr.Linkname)
+}
+
+// readDirNames returns the sorted names of the entries of a directory.
+func readDirNames(p string) ([]string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	names, err := f.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// progressWriter counts file contents toward the result and reports
+// the progress.
+type progressWriter struct {
+	result *Result
+	opts   *Options
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.result.Bytes += int64(len(p))
+	if w.opts.Progress != nil {
+		w.opts.Progress(w.result.Files, w.result.Bytes)
+	}
+
+	return len(p), nil
+}
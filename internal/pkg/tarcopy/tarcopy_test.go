@@ -0,0 +1,255 @@
+package tarcopy
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveExtract(t *testing.T) {
+	src := testTempDir(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dir", "sub"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "dir", "a.txt"), []byte("hello"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "dir", "sub", "run.sh"), []byte("#!/bin/sh"), 0755))
+	require.NoError(t, os.Symlink("a.txt", filepath.Join(src, "dir", "link")))
+
+	var buf bytes.Buffer
+	sent, err := Archive(&buf, []string{filepath.Join(src, "dir")}, nil)
+	require.NoError(t, err)
+	require.Empty(t, sent.Errors)
+	require.Equal(t, int64(5), sent.Files)
+	require.Equal(t, int64(14), sent.Bytes)
+
+	t.Run("to a new path", func(t *testing.T) {
+		require := require.New(t)
+		dest := filepath.Join(testTempDir(t), "copy")
+
+		received, err := Extract(bytes.NewReader(buf.Bytes()), dest, nil)
+		require.NoError(err)
+		require.Empty(received.Errors)
+		require.Equal(sent.Checksum, received.Checksum)
+
+		data, err := ioutil.ReadFile(filepath.Join(dest, "a.txt"))
+		require.NoError(err)
+		require.Equal("hello", string(data))
+
+		fi, err := os.Stat(filepath.Join(dest, "sub", "run.sh"))
+		require.NoError(err)
+		require.Equal(os.FileMode(0755), fi.Mode().Perm())
+
+		link, err := os.Readlink(filepath.Join(dest, "link"))
+		require.NoError(err)
+		require.Equal("a.txt", link)
+	})
+
+	t.Run("into an existing directory", func(t *testing.T) {
+		require := require.New(t)
+		dest := testTempDir(t)
+
+		_, err := Extract(bytes.NewReader(buf.Bytes()), dest, nil)
+		require.NoError(err)
+		require.FileExists(filepath.Join(dest, "dir", "a.txt"))
+	})
+}
+
+func TestArchive_followSymlinks(t *testing.T) {
+	require := require.New(t)
+
+	src := testTempDir(t)
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("hello"), 0644))
+	require.NoError(os.Symlink("a.txt", filepath.Join(src, "link")))
+
+	var buf bytes.Buffer
+	_, err := Archive(&buf, []string{filepath.Join(src, "link")}, &Options{FollowSymlinks: true})
+	require.NoError(err)
+
+	hdr, err := tar.NewReader(&buf).Next()
+	require.NoError(err)
+	require.Equal("link", hdr.Name)
+	require.Equal(byte(tar.TypeReg), hdr.Typeflag)
+}
+
+func TestExtract_escape(t *testing.T) {
+	outside := testTempDir(t)
+
+	cases := []struct {
+		Name    string
+		Entries []*tar.Header
+	}{
+		{
+			"parent directory",
+			[]*tar.Header{
+				{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0755},
+				{Name: "dir/../../escaped", Typeflag: tar.TypeReg, Mode: 0644},
+			},
+		},
+		{
+			"through a symlink",
+			[]*tar.Header{
+				{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0755},
+				{Name: "dir/link", Typeflag: tar.TypeSymlink, Linkname: outside},
+				{Name: "dir/link/escaped", Typeflag: tar.TypeReg, Mode: 0644},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			var buf bytes.Buffer
+			tw := tar.NewWriter(&buf)
+			for _, hdr := range tt.Entries {
+				require.NoError(tw.WriteHeader(hdr))
+			}
+			require.NoError(tw.Close())
+
+			dest := testTempDir(t)
+			result, err := Extract(&buf, dest, nil)
+			require.NoError(err)
+
+			_, err = os.Stat(filepath.Join(outside, "escaped"))
+			require.True(os.IsNotExist(err))
+			_, err = os.Stat(filepath.Join(filepath.Dir(dest), "escaped"))
+			require.True(os.IsNotExist(err))
+			require.Len(result.Errors, 1)
+		})
+	}
+}
+
+func TestExtract_fileErrors(t *testing.T) {
+	require := require.New(t)
+
+	src := testTempDir(t)
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("hello"), 0644))
+	require.NoError(ioutil.WriteFile(filepath.Join(src, "b.txt"), []byte("world"), 0644))
+
+	var buf bytes.Buffer
+	sent, err := Archive(&buf, []string{
+		filepath.Join(src, "a.txt"),
+		filepath.Join(src, "b.txt"),
+	}, nil)
+	require.NoError(err)
+
+	// One file can't be written since a directory is in the way, but the
+	// other still is
+	dest := testTempDir(t)
+	require.NoError(os.Mkdir(filepath.Join(dest, "a.txt"), 0755))
+	received, err := Extract(&buf, dest, nil)
+	require.NoError(err)
+	require.Len(received.Errors, 1)
+	require.Equal("a.txt", received.Errors[0].Path)
+	require.Equal(sent.Checksum, received.Checksum)
+	require.FileExists(filepath.Join(dest, "b.txt"))
+}
+
+func TestArchiveExtract_resume(t *testing.T) {
+	src := testTempDir(t)
+	data := make([]byte, 3*ChunkSize+100)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "big"), data, 0644))
+
+	var full bytes.Buffer
+	sent, err := Archive(&full, []string{filepath.Join(src, "big")}, nil)
+	require.NoError(t, err)
+
+	// testPartial extracts the archive as if the stream was killed part
+	// way through, leaving a partial file.
+	testPartial := func(t *testing.T) string {
+		dest := filepath.Join(testTempDir(t), "big")
+		_, err := Extract(io.LimitReader(bytes.NewReader(full.Bytes()), 2*ChunkSize+4096), dest, nil)
+		require.Error(t, err)
+
+		fi, err := os.Stat(dest)
+		require.NoError(t, err)
+		require.True(t, fi.Size() > ChunkSize)
+		require.True(t, fi.Size() < int64(len(data)))
+		return dest
+	}
+
+	t.Run("continues after the partial file", func(t *testing.T) {
+		require := require.New(t)
+		dest := testPartial(t)
+
+		partial, err := PartialFile(dest, "big")
+		require.NoError(err)
+
+		var buf bytes.Buffer
+		resent, err := Archive(&buf, []string{filepath.Join(src, "big")}, &Options{Resume: partial})
+		require.NoError(err)
+		require.Equal(partial.Size, resent.Resumed)
+		require.Equal(int64(len(data))-partial.Size, resent.Bytes)
+		require.Equal(sent.Checksum, resent.Checksum)
+
+		received, err := Extract(&buf, dest, nil)
+		require.NoError(err)
+		require.Empty(received.Errors)
+		require.Equal(partial.Size, received.Resumed)
+		require.Equal(sent.Checksum, received.Checksum)
+
+		actual, err := ioutil.ReadFile(dest)
+		require.NoError(err)
+		require.Equal(data, actual)
+	})
+
+	t.Run("partial file that doesn't match", func(t *testing.T) {
+		require := require.New(t)
+		dest := testPartial(t)
+
+		// Corrupt the first chunk so that nothing can be reused
+		f, err := os.OpenFile(dest, os.O_WRONLY, 0)
+		require.NoError(err)
+		_, err = f.WriteAt([]byte("corrupt"), 10)
+		require.NoError(err)
+		require.NoError(f.Close())
+
+		partial, err := PartialFile(dest, "big")
+		require.NoError(err)
+
+		var buf bytes.Buffer
+		resent, err := Archive(&buf, []string{filepath.Join(src, "big")}, &Options{Resume: partial})
+		require.NoError(err)
+		require.Equal(int64(0), resent.Resumed)
+		require.Equal(int64(len(data)), resent.Bytes)
+
+		received, err := Extract(&buf, dest, nil)
+		require.NoError(err)
+		require.Equal(sent.Checksum, received.Checksum)
+
+		actual, err := ioutil.ReadFile(dest)
+		require.NoError(err)
+		require.Equal(data, actual)
+	})
+
+	t.Run("no partial file", func(t *testing.T) {
+		require := require.New(t)
+		dest := testTempDir(t)
+
+		partial, err := PartialFile(dest, "big")
+		require.NoError(err)
+		require.Equal(int64(0), partial.Size)
+
+		var buf bytes.Buffer
+		_, err = Archive(&buf, []string{filepath.Join(src, "big")}, &Options{Resume: partial})
+		require.NoError(err)
+		received, err := Extract(&buf, dest, nil)
+		require.NoError(err)
+		require.Equal(sent.Checksum, received.Checksum)
+		require.FileExists(filepath.Join(dest, "big"))
+	})
+}
+
+func testTempDir(t *testing.T) string {
+	td, err := ioutil.TempDir("", "tarcopy")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(td) })
+	return td
+}
@@ -0,0 +1,307 @@
+package tunnel
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// MaxDatagram is the largest datagram a PacketMux forwards. Larger
+// datagrams are dropped. This keeps frames well below typical message
+// size limits and is larger than datagrams usually are in practice.
+const MaxDatagram = maxFrameData
+
+// DefaultIdleTimeout is the default PacketMux.IdleTimeout.
+const DefaultIdleTimeout = 2 * time.Minute
+
+// PacketMux forwards UDP datagrams over a stream of frames. Like UDP
+// itself this is unreliable: datagrams are dropped rather than retried
+// if they can't be delivered, and may be lost if the stream is slow.
+//
+// One end receives datagrams from local peers with Serve and sends each
+// in a frame with the address of the peer. The other end relays them to
+// its target from a socket per peer, so that replies from the target can
+// be sent back to the right peer. A peer's socket is closed once the peer
+// has been idle for IdleTimeout, like a NAT mapping expiring.
+type PacketMux struct {
+	// stats is first so that it is 64-bit aligned for atomic operations.
+	stats Stats
+
+	// Send sends a frame to the other end. This is never called
+	// concurrently.
+	Send func(*Frame) error
+
+	// Dial, on the end that relays to the target, opens the socket for a
+	// new peer. If this is nil, datagrams from the other end are written
+	// to the socket given to Serve.
+	Dial func() (net.Conn, error)
+
+	// IdleTimeout is how long a peer's socket is kept without datagrams
+	// either way. If this is zero, DefaultIdleTimeout is used.
+	IdleTimeout time.Duration
+
+	// Logger, if set, logs peers and dropped datagrams.
+	Logger hclog.Logger
+
+	sendLock sync.Mutex
+	lock     sync.Mutex
+	pc       net.PacketConn
+	peers    map[string]*peer
+	closed   bool
+}
+
+// Serve sends the datagrams received on pc to the other end and writes
+// the replies from the other end back to their peer. This returns when pc
+// is closed or fails, and pc is closed when the PacketMux is.
+func (m *PacketMux) Serve(pc net.PacketConn) error {
+	m.lock.Lock()
+	if m.closed {
+		m.lock.Unlock()
+		pc.Close()
+		return ErrClosed
+	}
+	m.pc = pc
+	m.lock.Unlock()
+
+	// We read one more byte than we forward to detect datagrams that are
+	// too large rather than forwarding them truncated.
+	buf := make([]byte, MaxDatagram+1)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		m.forward(addr.String(), buf[:n])
+	}
+}
+
+// Handle handles a frame from the other end.
+func (m *PacketMux) Handle(f *Frame) {
+	if f.Peer == "" {
+		return
+	}
+	if len(f.Data) > MaxDatagram {
+		m.drop(f.Peer, len(f.Data))
+		return
+	}
+
+	atomic.AddInt64(&m.stats.Datagrams, 1)
+	atomic.AddInt64(&m.stats.BytesReceived, int64(len(f.Data)))
+
+	if m.Dial == nil {
+		m.writeTo(f)
+		return
+	}
+
+	p, err := m.peer(f.Peer)
+	if err != nil {
+		m.logger().Debug("error opening socket for peer", "peer", f.Peer, "err", err)
+		atomic.AddInt64(&m.stats.Failed, 1)
+		return
+	}
+
+	p.touch()
+	if _, err := p.conn.Write(f.Data); err != nil {
+		m.logger().Debug("error writing datagram", "peer", f.Peer, "err", err)
+	}
+}
+
+// Stats returns the totals of the datagrams so far. Connections is the
+// number of peers that a socket was opened for.
+func (m *PacketMux) Stats() Stats {
+	return Stats{
+		Connections:   atomic.LoadInt64(&m.stats.Connections),
+		Failed:        atomic.LoadInt64(&m.stats.Failed),
+		BytesSent:     atomic.LoadInt64(&m.stats.BytesSent),
+		BytesReceived: atomic.LoadInt64(&m.stats.BytesReceived),
+		Datagrams:     atomic.LoadInt64(&m.stats.Datagrams),
+		Dropped:       atomic.LoadInt64(&m.stats.Dropped),
+	}
+}
+
+// Len returns the number of peers with an open socket.
+func (m *PacketMux) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.peers)
+}
+
+// Close closes the sockets of all peers and the PacketConn given to
+// Serve.
+func (m *PacketMux) Close() {
+	m.lock.Lock()
+	m.closed = true
+	pc := m.pc
+	peers := m.peers
+	m.peers = nil
+	m.lock.Unlock()
+
+	if pc != nil {
+		pc.Close()
+	}
+	for _, p := range peers {
+		p.conn.Close()
+	}
+}
+
+// forward sends a datagram from a local peer to the other end.
+func (m *PacketMux) forward(peer string, data []byte) {
+	if len(data) > MaxDatagram {
+		m.drop(peer, len(data))
+		return
+	}
+
+	f := &Frame{Peer: peer, Data: append([]byte(nil), data...)}
+	if err := m.send(f); err != nil {
+		m.logger().Debug("error sending datagram", "peer", peer, "err", err)
+		return
+	}
+
+	atomic.AddInt64(&m.stats.Datagrams, 1)
+	atomic.AddInt64(&m.stats.BytesSent, int64(len(data)))
+}
+
+// writeTo writes a datagram from the other end to its peer on the
+// PacketConn given to Serve.
+func (m *PacketMux) writeTo(f *Frame) {
+	m.lock.Lock()
+	pc := m.pc
+	m.lock.Unlock()
+	if pc == nil {
+		return
+	}
+
+	addr, err := net.ResolveUDPAddr("udp", f.Peer)
+	if err != nil {
+		m.logger().Debug("invalid peer address", "peer", f.Peer, "err", err)
+		return
+	}
+
+	if _, err := pc.WriteTo(f.Data, addr); err != nil {
+		m.logger().Debug("error writing datagram", "peer", f.Peer, "err", err)
+	}
+}
+
+// peer returns the peer with the given address, opening its socket if
+// it doesn't have one.
+func (m *PacketMux) peer(addr string) (*peer, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.closed {
+		return nil, ErrClosed
+	}
+	if p, ok := m.peers[addr]; ok {
+		return p, nil
+	}
+
+	// The dial is done with the lock held but dialing UDP only creates
+	// the socket so it doesn't block.
+	conn, err := m.Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &peer{mux: m, addr: addr, conn: conn}
+	p.touch()
+	if m.peers == nil {
+		m.peers = map[string]*peer{}
+	}
+	m.peers[addr] = p
+	atomic.AddInt64(&m.stats.Connections, 1)
+
+	m.logger().Debug("opened socket for peer", "peer", addr, "local", conn.LocalAddr())
+	go p.read()
+	return p, nil
+}
+
+func (m *PacketMux) drop(peer string, size int) {
+	m.logger().Debug("dropping datagram that is too large",
+		"peer", peer, "size", size, "max", MaxDatagram)
+	atomic.AddInt64(&m.stats.Dropped, 1)
+}
+
+func (m *PacketMux) send(f *Frame) error {
+	m.sendLock.Lock()
+	defer m.sendLock.Unlock()
+	return m.Send(f)
+}
+
+func (m *PacketMux) idleTimeout() time.Duration {
+	if m.IdleTimeout == 0 {
+		return DefaultIdleTimeout
+	}
+
+	return m.IdleTimeout
+}
+
+func (m *PacketMux) logger() hclog.Logger {
+	if m.Logger == nil {
+		return hclog.NewNullLogger()
+	}
+
+	return m.Logger
+}
+
+// peer is the socket for a single peer on the end that relays datagrams
+// to the target.
+type peer struct {
+	mux  *PacketMux
+	addr string
+	conn net.Conn
+
+	// active is the time of the last datagram either way, in Unix
+	// nanoseconds.
+	active int64
+}
+
+func (p *peer) touch() {
+	atomic.StoreInt64(&p.active, time.Now().UnixNano())
+}
+
+// read sends replies from the target back to the peer until the peer is
+// idle or the socket fails.
+func (p *peer) read() {
+	defer p.close()
+
+	timeout := p.mux.idleTimeout()
+	buf := make([]byte, MaxDatagram+1)
+	for {
+		p.conn.SetReadDeadline(time.Now().Add(timeout))
+		n, err := p.conn.Read(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				// Datagrams to the target count as activity too so we only
+				// give up once neither way has had any.
+				idle := time.Since(time.Unix(0, atomic.LoadInt64(&p.active)))
+				if idle < timeout {
+					continue
+				}
+
+				p.mux.logger().Debug("closing idle socket for peer", "peer", p.addr)
+				return
+			}
+
+			p.mux.logger().Debug("error reading from socket for peer", "peer", p.addr, "err", err)
+			return
+		}
+
+		p.touch()
+		p.mux.forward(p.addr, buf[:n])
+	}
+}
+
+// close closes the socket and removes the peer so that its next datagram
+// opens a new one.
+func (p *peer) close() {
+	p.conn.Close()
+
+	p.mux.lock.Lock()
+	defer p.mux.lock.Unlock()
+	if p.mux.peers[p.addr] == p {
+		delete(p.mux.peers, p.addr)
+	}
+}
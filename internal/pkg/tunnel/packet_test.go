@@ -0,0 +1,138 @@
+package tunnel
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketMux(t *testing.T) {
+	require := require.New(t)
+
+	echo := testUDPEchoServer(t)
+	local, remote := testPacketMuxPair(t, echo, 0)
+	addr := testPacketListener(t, local)
+
+	// Each peer gets its own replies
+	for i := 0; i < 3; i++ {
+		conn, err := net.Dial("udp", addr)
+		require.NoError(err)
+		defer conn.Close()
+
+		msg := fmt.Sprintf("hello %d", i)
+		_, err = conn.Write([]byte(msg))
+		require.NoError(err)
+
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		buf := make([]byte, 64)
+		n, err := conn.Read(buf)
+		require.NoError(err)
+		require.Equal(msg, string(buf[:n]))
+	}
+
+	require.Equal(3, remote.Len())
+	stats := local.Stats()
+	require.Equal(int64(6), stats.Datagrams)
+	require.Equal(int64(21), stats.BytesSent)
+	require.Equal(int64(21), stats.BytesReceived)
+	require.Equal(int64(3), remote.Stats().Connections)
+}
+
+func TestPacketMux_tooLarge(t *testing.T) {
+	require := require.New(t)
+
+	echo := testUDPEchoServer(t)
+	local, _ := testPacketMuxPair(t, echo, 0)
+	addr := testPacketListener(t, local)
+
+	conn, err := net.Dial("udp", addr)
+	require.NoError(err)
+	defer conn.Close()
+	_, err = conn.Write(make([]byte, MaxDatagram+1))
+	require.NoError(err)
+
+	require.Eventually(func() bool {
+		return local.Stats().Dropped == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(int64(0), local.Stats().Datagrams)
+}
+
+func TestPacketMux_idle(t *testing.T) {
+	require := require.New(t)
+
+	echo := testUDPEchoServer(t)
+	local, remote := testPacketMuxPair(t, echo, 50*time.Millisecond)
+	addr := testPacketListener(t, local)
+
+	conn, err := net.Dial("udp", addr)
+	require.NoError(err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(err)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 64))
+	require.NoError(err)
+
+	// The peer's socket is closed once it is idle
+	require.Eventually(func() bool {
+		return remote.Len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A new socket is opened if the peer comes back
+	_, err = conn.Write([]byte("again"))
+	require.NoError(err)
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	require.NoError(err)
+	require.Equal("again", string(buf[:n]))
+	require.Equal(int64(2), remote.Stats().Connections)
+}
+
+// testPacketMuxPair returns two packet muxes connected to each other. The
+// remote one relays datagrams to target.
+func testPacketMuxPair(t *testing.T, target string, idle time.Duration) (*PacketMux, *PacketMux) {
+	local := &PacketMux{}
+	remote := &PacketMux{
+		IdleTimeout: idle,
+		Dial: func() (net.Conn, error) {
+			return net.Dial("udp", target)
+		},
+	}
+	local.Send = testPipe(t, remote.Handle)
+	remote.Send = testPipe(t, local.Handle)
+	t.Cleanup(local.Close)
+	t.Cleanup(remote.Close)
+	return local, remote
+}
+
+// testPacketListener serves m on a local UDP socket.
+func testPacketListener(t *testing.T, m *PacketMux) string {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go m.Serve(pc)
+	return pc.LocalAddr().String()
+}
+
+// testUDPEchoServer writes each datagram back to its sender.
+func testUDPEchoServer(t *testing.T) string {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { pc.Close() })
+
+	go func() {
+		buf := make([]byte, 64*1024)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			pc.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	return pc.LocalAddr().String()
+}
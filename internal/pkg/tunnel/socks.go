@@ -0,0 +1,154 @@
+package tunnel
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// SOCKS5 protocol values from RFC 1928 that we use.
+const (
+	socksVersion = 5
+
+	socksMethodNoAuth       = 0x00
+	socksMethodNoAcceptable = 0xff
+
+	socksCommandConnect = 1
+
+	socksAddrIPv4   = 1
+	socksAddrDomain = 3
+	socksAddrIPv6   = 4
+
+	socksSucceeded            = 0x00
+	socksGeneralFailure       = 0x01
+	socksNotAllowed           = 0x02
+	socksHostUnreachable      = 0x04
+	socksConnectionRefused    = 0x05
+	socksCommandNotSupported  = 0x07
+	socksAddrTypeNotSupported = 0x08
+)
+
+// SOCKSHandshake performs the server side of a SOCKS5 handshake on conn
+// and returns the address the client asked to connect to. Only the
+// CONNECT command without authentication is supported. Other requests
+// are refused and return an error. Once the connection to the address has
+// been made or has failed, the result must be sent with SOCKSReply.
+func SOCKSHandshake(conn io.ReadWriter) (string, error) {
+	// The greeting is the version followed by the authentication
+	// methods the client supports.
+	var hdr [2]byte
+	if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+		return "", err
+	}
+	if hdr[0] != socksVersion {
+		return "", fmt.Errorf("unsupported SOCKS version %d", hdr[0])
+	}
+
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+	if bytes.IndexByte(methods, socksMethodNoAuth) < 0 {
+		conn.Write([]byte{socksVersion, socksMethodNoAcceptable})
+		return "", errors.New("the SOCKS client requires authentication, which isn't supported")
+	}
+	if _, err := conn.Write([]byte{socksVersion, socksMethodNoAuth}); err != nil {
+		return "", err
+	}
+
+	// The request is the version, command, a reserved byte and the
+	// address type followed by the address and port.
+	var req [4]byte
+	if _, err := io.ReadFull(conn, req[:]); err != nil {
+		return "", err
+	}
+	if req[0] != socksVersion {
+		return "", fmt.Errorf("unsupported SOCKS version %d", req[0])
+	}
+
+	var host string
+	switch req[3] {
+	case socksAddrIPv4, socksAddrIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if req[3] == socksAddrIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = ip.String()
+
+	case socksAddrDomain:
+		var n [1]byte
+		if _, err := io.ReadFull(conn, n[:]); err != nil {
+			return "", err
+		}
+		name := make([]byte, n[0])
+		if _, err := io.ReadFull(conn, name); err != nil {
+			return "", err
+		}
+		host = string(name)
+
+	default:
+		writeSOCKSReply(conn, socksAddrTypeNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", req[3])
+	}
+
+	var port uint16
+	if err := binary.Read(conn, binary.BigEndian, &port); err != nil {
+		return "", err
+	}
+
+	if req[1] != socksCommandConnect {
+		writeSOCKSReply(conn, socksCommandNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS command %d, only CONNECT is supported", req[1])
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(port))), nil
+}
+
+// SOCKSReply sends the result of connecting to the client after
+// SOCKSHandshake. err is the error connecting, if any.
+func SOCKSReply(conn io.Writer, err error) error {
+	code := byte(socksSucceeded)
+	if err != nil {
+		code = socksReplyCode(err)
+	}
+
+	return writeSOCKSReply(conn, code)
+}
+
+// socksReplyCode returns the reply code for an error connecting. Errors
+// usually come from the other end of a tunnel as a message so we can
+// only go by the message.
+func socksReplyCode(err error) byte {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "not allowed"):
+		return socksNotAllowed
+	case strings.Contains(msg, "connection refused"):
+		return socksConnectionRefused
+	case strings.Contains(msg, "no such host"),
+		strings.Contains(msg, "unreachable"),
+		strings.Contains(msg, "timeout"):
+		return socksHostUnreachable
+	default:
+		return socksGeneralFailure
+	}
+}
+
+// writeSOCKSReply writes a reply with the given code. We don't report
+// the address we bound to since clients don't need it for CONNECT.
+func writeSOCKSReply(w io.Writer, code byte) error {
+	_, err := w.Write([]byte{
+		socksVersion, code, 0,
+		socksAddrIPv4, 0, 0, 0, 0,
+		0, 0,
+	})
+	return err
+}
@@ -0,0 +1,128 @@
+package tunnel
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSOCKSHandshake(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Request  []byte
+		Expected string
+		Reply    byte
+	}{
+		{
+			"IPv4",
+			[]byte{5, 1, 0, 1, 10, 0, 0, 1, 0x1f, 0x90},
+			"10.0.0.1:8080",
+			0,
+		},
+		{
+			"domain",
+			append(append([]byte{5, 1, 0, 3, 11}, "example.com"...), 0, 80),
+			"example.com:80",
+			0,
+		},
+		{
+			"IPv6",
+			[]byte{5, 1, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 187},
+			"[::1]:443",
+			0,
+		},
+		{
+			"BIND isn't supported",
+			[]byte{5, 2, 0, 1, 10, 0, 0, 1, 0, 80},
+			"",
+			socksCommandNotSupported,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+
+			type result struct {
+				addr string
+				err  error
+			}
+			resultCh := make(chan result, 1)
+			go func() {
+				addr, err := SOCKSHandshake(server)
+				resultCh <- result{addr, err}
+			}()
+
+			// Offer username/password and no authentication
+			_, err := client.Write([]byte{5, 2, 2, 0})
+			require.NoError(err)
+			method := make([]byte, 2)
+			_, err = io.ReadFull(client, method)
+			require.NoError(err)
+			require.Equal([]byte{5, 0}, method)
+
+			go client.Write(tt.Request)
+			if tt.Expected == "" {
+				reply := make([]byte, 10)
+				_, err = io.ReadFull(client, reply)
+				require.NoError(err)
+				require.Equal(tt.Reply, reply[1])
+				require.Error((<-resultCh).err)
+				return
+			}
+
+			r := <-resultCh
+			require.NoError(r.err)
+			require.Equal(tt.Expected, r.addr)
+		})
+	}
+}
+
+func TestSOCKSHandshake_auth(t *testing.T) {
+	require := require.New(t)
+
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := SOCKSHandshake(server)
+		errCh <- err
+	}()
+
+	// Only username/password is offered
+	_, err := client.Write([]byte{5, 1, 2})
+	require.NoError(err)
+	method := make([]byte, 2)
+	_, err = io.ReadFull(client, method)
+	require.NoError(err)
+	require.Equal([]byte{5, socksMethodNoAcceptable}, method)
+	require.Error(<-errCh)
+}
+
+func TestSOCKSReplyCode(t *testing.T) {
+	cases := []struct {
+		Err      string
+		Expected byte
+	}{
+		{"dial tcp 10.0.0.1:80: connect: connection refused", socksConnectionRefused},
+		{"connecting to 10.0.0.1:80 is not allowed by the port forwarding policy", socksNotAllowed},
+		{"dial tcp: lookup nope: no such host", socksHostUnreachable},
+		{"dial tcp 10.0.0.1:80: i/o timeout", socksHostUnreachable},
+		{"something else", socksGeneralFailure},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Err, func(t *testing.T) {
+			require.Equal(t, tt.Expected, socksReplyCode(errors.New(tt.Err)))
+		})
+	}
+}
@@ -0,0 +1,533 @@
+// Package tunnel multiplexes TCP connections over a single stream of
+// frames, such as a gRPC stream, for port forwarding.
+//
+// Each end of the stream has a Mux. A connection accepted on one end is
+// added to its Mux, which asks the other end to dial its side of the
+// connection. Data is then copied both ways until both sides have closed.
+// Either end may accept connections, but only one end of a stream should
+// so that connection IDs don't collide.
+//
+// UDP datagrams are forwarded with a PacketMux instead, which uses the
+// same frames.
+package tunnel
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// ErrClosed is returned when adding a connection to a closed Mux.
+var ErrClosed = errors.New("tunnel is closed")
+
+// maxFrameData is the most connection data sent in a single frame. This
+// keeps frames well below typical message size limits.
+const maxFrameData = 32 * 1024
+
+// connWriteQueue is the number of frames of data buffered for each
+// connection before Handle blocks waiting for them to be written.
+const connWriteQueue = 16
+
+// Frame is a single message of the tunnel protocol.
+type Frame struct {
+	// ID identifies the connection. The end that accepted the connection
+	// chooses its ID.
+	ID uint64
+
+	// Open asks the other end to dial its side of a new connection. The
+	// other end replies with Open once it has connected, or Error if it
+	// couldn't.
+	Open bool
+
+	// Addr, with Open, is the address for the other end to dial. If it is
+	// empty, the other end decides where to connect.
+	Addr string
+
+	// Data is data to write to the connection.
+	Data []byte
+
+	// Close means the sender has nothing more to write to the connection.
+	// The connection is done once both ends have sent Close.
+	Close bool
+
+	// Error, if set, aborts the connection, such as when the other end
+	// couldn't dial it. No more frames are sent for the connection.
+	Error string
+
+	// DialError, with Error, is set when the other end couldn't dial the
+	// connection and its Dial returned a *DialError.
+	DialError *DialError
+
+	// Peer is set instead of ID for a datagram of a PacketMux. It is the
+	// address of the local peer that Data is from or for.
+	Peer string
+}
+
+// DialError is the error of dialing a connection when one or more
+// addresses were tried, such as both the IPv4 and IPv6 loopback addresses.
+type DialError struct {
+	Attempts []DialAttempt
+}
+
+// DialAttempt is a single address that was tried.
+type DialAttempt struct {
+	Addr string
+	Err  string
+}
+
+// Add records that dialing addr failed with err. The error of a
+// *net.OpError is unwrapped since it only repeats the address.
+func (e *DialError) Add(addr string, err error) {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Err != nil {
+		err = opErr.Err
+		if sysErr, ok := err.(*os.SyscallError); ok {
+			err = sysErr.Err
+		}
+	}
+
+	e.Attempts = append(e.Attempts, DialAttempt{Addr: addr, Err: err.Error()})
+}
+
+func (e *DialError) Error() string {
+	if len(e.Attempts) == 0 {
+		return "no address to connect to"
+	}
+
+	var b strings.Builder
+	for i, a := range e.Attempts {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "connecting to %s: %s", a.Addr, a.Err)
+	}
+
+	return b.String()
+}
+
+// Mux multiplexes connections over a stream of frames. Frames from the
+// other end must be passed to Handle from a single goroutine.
+type Mux struct {
+	// stats is first so that it is 64-bit aligned for atomic operations.
+	stats Stats
+
+	// Send sends a frame to the other end. This is never called
+	// concurrently.
+	Send func(*Frame) error
+
+	// Dial, if set, dials the local side of a connection that the other
+	// end opened. addr is the address the other end asked for, if any. If
+	// this is nil, such connections are refused. If the error is a
+	// *DialError, it is sent to the other end as is.
+	Dial func(addr string) (net.Conn, error)
+
+	// Logger, if set, logs connections opening and closing.
+	Logger hclog.Logger
+
+	sendLock  sync.Mutex
+	lock      sync.Mutex
+	conns     map[uint64]*conn
+	nextID    uint64
+	closed    bool
+	lastError string
+}
+
+// Stats are totals of the connections of a Mux.
+type Stats struct {
+	// Connections is the number of connections opened, including those
+	// that failed.
+	Connections int64
+
+	// Failed is the number of connections that couldn't be opened or
+	// were aborted because of an error.
+	Failed int64
+
+	// Active is the number of connections open now. This is only used by
+	// a Mux.
+	Active int64
+
+	// LastError is the error of the last connection that failed, if any.
+	// This is only used by a Mux.
+	LastError string
+
+	// BytesSent and BytesReceived are the amounts of data sent to and
+	// received from the other end.
+	BytesSent     int64
+	BytesReceived int64
+
+	// Datagrams is the number of datagrams forwarded either way and
+	// Dropped is the number that were too large to forward. These are
+	// only used by a PacketMux.
+	Datagrams int64
+	Dropped   int64
+}
+
+// Add forwards a connection that was accepted locally. The other end is
+// asked to dial its side, to addr if it is set, and data is copied until
+// both sides are closed. The connection is closed once it is done.
+//
+// connected, if set, is called once the other end has connected or
+// failed to, before any data from the other end is written to the
+// connection. It isn't called if the connection is closed first.
+func (m *Mux) Add(nc net.Conn, addr string, connected func(error)) error {
+	m.lock.Lock()
+	m.nextID++
+	c, err := m.newConnLocked(m.nextID)
+	m.lock.Unlock()
+	if err != nil {
+		nc.Close()
+		return err
+	}
+	c.connected = connected
+
+	m.logger().Debug("opening connection", "id", c.id, "remote", nc.RemoteAddr(), "addr", addr)
+	if err := m.send(&Frame{ID: c.id, Open: true, Addr: addr}); err != nil {
+		nc.Close()
+		c.close()
+		return err
+	}
+
+	c.start(nc)
+	return nil
+}
+
+// Handle handles a frame from the other end.
+func (m *Mux) Handle(f *Frame) {
+	c := m.conn(f.ID)
+	if f.Open && c == nil {
+		m.open(f.ID, f.Addr)
+		return
+	}
+	if c == nil {
+		// The connection is already gone. Frames can cross in flight
+		// with our own Close or Error so this is expected.
+		return
+	}
+
+	if f.Error != "" {
+		m.logger().Debug("connection aborted by the other end", "id", c.id, "error", f.Error)
+		m.failed(f.Error)
+		if f.DialError != nil {
+			c.notify(f.DialError)
+		} else {
+			c.notify(errors.New(f.Error))
+		}
+		c.close()
+		return
+	}
+
+	if f.Open {
+		// The other end connected a connection we added.
+		c.notify(nil)
+		return
+	}
+
+	if len(f.Data) > 0 {
+		select {
+		case c.writeCh <- f.Data:
+		case <-c.doneCh:
+			return
+		}
+	}
+
+	if f.Close && !c.writeClosed {
+		c.writeClosed = true
+		close(c.writeCh)
+	}
+}
+
+// Stats returns the totals of the connections so far. This is safe to
+// call concurrently with everything else, such as to show progress.
+func (m *Mux) Stats() Stats {
+	m.lock.Lock()
+	active, lastError := len(m.conns), m.lastError
+	m.lock.Unlock()
+
+	return Stats{
+		Connections:   atomic.LoadInt64(&m.stats.Connections),
+		Failed:        atomic.LoadInt64(&m.stats.Failed),
+		Active:        int64(active),
+		LastError:     lastError,
+		BytesSent:     atomic.LoadInt64(&m.stats.BytesSent),
+		BytesReceived: atomic.LoadInt64(&m.stats.BytesReceived),
+	}
+}
+
+// Len returns the number of open connections.
+func (m *Mux) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.conns)
+}
+
+// Close aborts all connections, telling the other end if it is still
+// there. Connections can't be added or opened after this.
+func (m *Mux) Close() {
+	m.lock.Lock()
+	m.closed = true
+	conns := make([]*conn, 0, len(m.conns))
+	for _, c := range m.conns {
+		conns = append(conns, c)
+	}
+	m.lock.Unlock()
+
+	for _, c := range conns {
+		c.fail(ErrClosed)
+	}
+}
+
+// open dials the local side of a connection the other end accepted.
+// Dialing happens in the background since it can be slow and we don't
+// want to hold up frames for other connections. Data that arrives in the
+// meantime is queued.
+func (m *Mux) open(id uint64, addr string) {
+	if m.Dial == nil {
+		m.send(&Frame{ID: id, Error: "this end of the tunnel doesn't accept connections"})
+		return
+	}
+
+	m.lock.Lock()
+	c, err := m.newConnLocked(id)
+	m.lock.Unlock()
+	if err != nil {
+		m.send(&Frame{ID: id, Error: err.Error()})
+		return
+	}
+
+	go func() {
+		nc, err := m.Dial(addr)
+		if err != nil {
+			m.logger().Debug("error dialing connection", "id", id, "addr", addr, "err", err)
+			c.fail(err)
+			return
+		}
+
+		m.logger().Debug("opened connection", "id", id, "remote", nc.RemoteAddr())
+		if err := m.send(&Frame{ID: id, Open: true}); err != nil {
+			nc.Close()
+			c.close()
+			return
+		}
+
+		c.start(nc)
+	}()
+}
+
+func (m *Mux) newConnLocked(id uint64) (*conn, error) {
+	if m.closed {
+		return nil, ErrClosed
+	}
+	if _, ok := m.conns[id]; ok {
+		return nil, errors.New("duplicate connection ID")
+	}
+
+	c := &conn{
+		mux:     m,
+		id:      id,
+		writeCh: make(chan []byte, connWriteQueue),
+		doneCh:  make(chan struct{}),
+	}
+	if m.conns == nil {
+		m.conns = map[uint64]*conn{}
+	}
+	m.conns[id] = c
+	atomic.AddInt64(&m.stats.Connections, 1)
+	return c, nil
+}
+
+// failed counts a connection that failed with the error msg.
+func (m *Mux) failed(msg string) {
+	atomic.AddInt64(&m.stats.Failed, 1)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.lastError = msg
+}
+
+func (m *Mux) conn(id uint64) *conn {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.conns[id]
+}
+
+func (m *Mux) send(f *Frame) error {
+	m.sendLock.Lock()
+	defer m.sendLock.Unlock()
+	return m.Send(f)
+}
+
+func (m *Mux) logger() hclog.Logger {
+	if m.Logger == nil {
+		return hclog.NewNullLogger()
+	}
+
+	return m.Logger
+}
+
+// conn is a single connection of a Mux.
+type conn struct {
+	mux     *Mux
+	id      uint64
+	writeCh chan []byte
+	doneCh  chan struct{}
+
+	// writeClosed is true once writeCh is closed. connected is called
+	// once the other end connects a connection we added. These are only
+	// used by Handle so they aren't locked.
+	writeClosed bool
+	connected   func(error)
+
+	lock      sync.Mutex
+	nc        net.Conn
+	readDone  bool
+	writeDone bool
+	closed    bool
+}
+
+// start starts copying data for the connection once its local side is
+// connected.
+func (c *conn) start(nc net.Conn) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.closed {
+		nc.Close()
+		return
+	}
+
+	c.nc = nc
+	go c.read()
+	go c.write()
+}
+
+// read sends what is read from the local side to the other end.
+func (c *conn) read() {
+	buf := make([]byte, maxFrameData)
+	for {
+		n, err := c.nc.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf)
+			if err := c.mux.send(&Frame{ID: c.id, Data: data}); err != nil {
+				c.close()
+				return
+			}
+			atomic.AddInt64(&c.mux.stats.BytesSent, int64(n))
+		}
+
+		if err == io.EOF {
+			if err := c.mux.send(&Frame{ID: c.id, Close: true}); err != nil {
+				c.close()
+				return
+			}
+
+			c.halfDone(true)
+			return
+		}
+		if err != nil {
+			c.fail(err)
+			return
+		}
+	}
+}
+
+// write writes data from the other end to the local side. Once the other
+// end has nothing more to send, we close our side for writing.
+func (c *conn) write() {
+	for {
+		select {
+		case data, ok := <-c.writeCh:
+			if !ok {
+				if cw, ok := c.nc.(interface{ CloseWrite() error }); ok {
+					cw.CloseWrite()
+				}
+
+				c.halfDone(false)
+				return
+			}
+
+			n, err := c.nc.Write(data)
+			atomic.AddInt64(&c.mux.stats.BytesReceived, int64(n))
+			if err != nil {
+				c.fail(err)
+				return
+			}
+
+		case <-c.doneCh:
+			return
+		}
+	}
+}
+
+// notify calls connected, if set, with the result of connecting.
+func (c *conn) notify(err error) {
+	if c.connected != nil {
+		c.connected(err)
+		c.connected = nil
+	}
+}
+
+// halfDone marks reading or writing as done and closes the connection
+// once both are.
+func (c *conn) halfDone(read bool) {
+	c.lock.Lock()
+	if read {
+		c.readDone = true
+	} else {
+		c.writeDone = true
+	}
+	done := c.readDone && c.writeDone
+	c.lock.Unlock()
+
+	if done {
+		c.mux.logger().Debug("connection closed", "id", c.id)
+		c.close()
+	}
+}
+
+// fail aborts the connection because of an error on our side and tells
+// the other end. Errors caused by us closing the connection are ignored.
+func (c *conn) fail(err error) {
+	c.lock.Lock()
+	closed := c.closed
+	c.lock.Unlock()
+	if closed {
+		return
+	}
+
+	c.mux.logger().Debug("connection error", "id", c.id, "err", err)
+	if err != ErrClosed {
+		c.mux.failed(err.Error())
+	}
+	f := &Frame{ID: c.id, Error: err.Error()}
+	if de, ok := err.(*DialError); ok {
+		f.DialError = de
+	}
+	c.mux.send(f)
+	c.close()
+}
+
+// close closes the connection and removes it from the Mux.
+func (c *conn) close() {
+	c.lock.Lock()
+	if c.closed {
+		c.lock.Unlock()
+		return
+	}
+	c.closed = true
+	close(c.doneCh)
+	if c.nc != nil {
+		c.nc.Close()
+	}
+	c.lock.Unlock()
+
+	c.mux.lock.Lock()
+	delete(c.mux.conns, c.id)
+	c.mux.lock.Unlock()
+}
@@ -0,0 +1,260 @@
+package tunnel
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMux(t *testing.T) {
+	require := require.New(t)
+
+	echo := testEchoServer(t)
+	local, remote := testMuxPair(t, func(string) (net.Conn, error) {
+		return net.Dial("tcp", echo)
+	})
+	ln := testListener(t, local)
+
+	// Several connections at once, each closing its write side so that
+	// the echo server ends the connection.
+	errCh := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		go func(i int) {
+			conn, err := net.Dial("tcp", ln)
+			if err != nil {
+				errCh <- err
+				return
+			}
+			defer conn.Close()
+
+			msg := fmt.Sprintf("hello %d", i)
+			if _, err := conn.Write([]byte(msg)); err != nil {
+				errCh <- err
+				return
+			}
+			conn.(*net.TCPConn).CloseWrite()
+
+			data, err := ioutil.ReadAll(conn)
+			if err == nil && string(data) != msg {
+				err = fmt.Errorf("expected %q, got %q", msg, data)
+			}
+			errCh <- err
+		}(i)
+	}
+	for i := 0; i < 5; i++ {
+		require.NoError(<-errCh)
+	}
+
+	// Both ends clean up once the connections are done
+	require.Eventually(func() bool {
+		return local.Len() == 0 && remote.Len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stats := local.Stats()
+	require.Equal(int64(5), stats.Connections)
+	require.Equal(int64(0), stats.Failed)
+	require.Equal(int64(35), stats.BytesSent)
+	require.Equal(int64(35), stats.BytesReceived)
+}
+
+func TestMux_addr(t *testing.T) {
+	require := require.New(t)
+
+	echo := testEchoServer(t)
+	addrCh := make(chan string, 1)
+	local, _ := testMuxPair(t, func(addr string) (net.Conn, error) {
+		addrCh <- addr
+		return net.Dial("tcp", echo)
+	})
+
+	client, server := net.Pipe()
+	defer client.Close()
+	connectedCh := make(chan error, 1)
+	require.NoError(local.Add(server, "example.com:80", func(err error) {
+		connectedCh <- err
+	}))
+
+	require.Equal("example.com:80", <-addrCh)
+	require.NoError(<-connectedCh)
+
+	_, err := client.Write([]byte("hello"))
+	require.NoError(err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(client, buf)
+	require.NoError(err)
+	require.Equal("hello", string(buf))
+}
+
+func TestMux_dialError(t *testing.T) {
+	require := require.New(t)
+
+	local, remote := testMuxPair(t, func(string) (net.Conn, error) {
+		return nil, fmt.Errorf("connection refused")
+	})
+	ln := testListener(t, local)
+
+	conn, err := net.Dial("tcp", ln)
+	require.NoError(err)
+	defer conn.Close()
+
+	// The local connection is closed since the other side failed
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	require.Error(err)
+	require.Equal(0, local.Len())
+	require.Equal(0, remote.Len())
+	require.Equal(int64(1), local.Stats().Failed)
+	require.Equal("connection refused", local.Stats().LastError)
+}
+
+func TestMux_dialErrorAttempts(t *testing.T) {
+	require := require.New(t)
+
+	local, _ := testMuxPair(t, func(string) (net.Conn, error) {
+		var err DialError
+		err.Add("127.0.0.1:80", &net.OpError{
+			Op:  "dial",
+			Net: "tcp",
+			Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED},
+		})
+		err.Add("[::1]:80", errors.New("i/o timeout"))
+		return nil, &err
+	})
+
+	client, server := net.Pipe()
+	defer client.Close()
+	connectedCh := make(chan error, 1)
+	require.NoError(local.Add(server, "", func(err error) {
+		connectedCh <- err
+	}))
+
+	// The error of each address reaches this end
+	err := <-connectedCh
+	var dialErr *DialError
+	require.True(errors.As(err, &dialErr))
+	require.Equal([]DialAttempt{
+		{Addr: "127.0.0.1:80", Err: syscall.ECONNREFUSED.Error()},
+		{Addr: "[::1]:80", Err: "i/o timeout"},
+	}, dialErr.Attempts)
+	require.Contains(err.Error(), "connecting to [::1]:80: i/o timeout")
+}
+
+func TestMux_close(t *testing.T) {
+	require := require.New(t)
+
+	echo := testEchoServer(t)
+	local, remote := testMuxPair(t, func(string) (net.Conn, error) {
+		return net.Dial("tcp", echo)
+	})
+	ln := testListener(t, local)
+
+	conn, err := net.Dial("tcp", ln)
+	require.NoError(err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(err)
+	_, err = io.ReadFull(conn, make([]byte, 5))
+	require.NoError(err)
+	require.Equal(int64(1), local.Stats().Active)
+
+	// Closing tears down open connections
+	local.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	require.Error(err)
+	require.Eventually(func() bool {
+		return remote.Len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(ErrClosed, local.Add(&net.TCPConn{}, "", nil))
+}
+
+// testMuxPair returns two muxes connected to each other. Frames are
+// delivered in order from a single goroutine per direction like a stream.
+func testMuxPair(t *testing.T, dial func(string) (net.Conn, error)) (*Mux, *Mux) {
+	local := &Mux{}
+	remote := &Mux{Dial: dial}
+	local.Send = testPipe(t, remote.Handle)
+	remote.Send = testPipe(t, local.Handle)
+	t.Cleanup(local.Close)
+	t.Cleanup(remote.Close)
+	return local, remote
+}
+
+// testPipe returns a Send func that delivers frames to handle.
+func testPipe(t *testing.T, handle func(*Frame)) func(*Frame) error {
+	ch := make(chan *Frame, 64)
+	doneCh := make(chan struct{})
+	t.Cleanup(func() { close(doneCh) })
+	go func() {
+		for {
+			select {
+			case f := <-ch:
+				handle(f)
+			case <-doneCh:
+				return
+			}
+		}
+	}()
+
+	return func(f *Frame) error {
+		select {
+		case ch <- f:
+			return nil
+		case <-doneCh:
+			return io.EOF
+		}
+	}
+}
+
+// testListener accepts connections and adds them to the mux.
+func testListener(t *testing.T, m *Mux) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			m.Add(conn, "", nil)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+// testEchoServer writes back whatever it reads and closes the
+// connection once the client stops writing.
+func testEchoServer(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
@@ -72,6 +72,10 @@ func authUnaryInterceptor(checker AuthChecker) grpc.UnaryServerInterceptor {
 // attached to the context. A token is extract from that metadata and the given
 // AuthChecker is invoked to guard calling the target handler. Effectively
 // it implements authentication in front of any stream call.
+//
+// The token is only checked when the stream starts. Long-lived streams
+// such as exec sessions keep running if the token expires or stops being
+// valid later, and new streams use whatever token the client sends then.
 func authStreamInterceptor(checker AuthChecker) grpc.StreamServerInterceptor {
 	return func(
 		srv interface{},
@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type trivialAuth struct {
@@ -55,3 +57,58 @@ func TestAuthUnaryInterceptor(t *testing.T) {
 	require.Equal("bar", chk.method)
 	require.Equal(DefaultEffects, chk.effects)
 }
+
+// expiringAuth accepts a token until it is expired.
+type expiringAuth struct {
+	expired bool
+	calls   int
+}
+
+func (a *expiringAuth) Authenticate(ctx context.Context, token string, endpoint string, effects []string) error {
+	a.calls++
+	if a.expired {
+		return status.Errorf(codes.Unauthenticated, "token has expired")
+	}
+
+	return nil
+}
+
+// testServerStream is a grpc.ServerStream with a fixed context.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func TestAuthStreamInterceptor_expiry(t *testing.T) {
+	require := require.New(t)
+
+	var chk expiringAuth
+	f := authStreamInterceptor(&chk)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"authorization": []string{"this-is-a-token"},
+	})
+	ss := &testServerStream{ctx: ctx}
+
+	// The token expires while the stream is running, which doesn't end it.
+	err := f(nil, ss, &grpc.StreamServerInfo{FullMethod: "/foo/StartExecStream"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			chk.expired = true
+			return nil
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, chk.calls)
+
+	// A new stream with the expired token is rejected.
+	err = f(nil, ss, &grpc.StreamServerInfo{FullMethod: "/foo/StartExecStream"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			t.Fatal("handler should not be called")
+			return nil
+		},
+	)
+	require.Error(err)
+	require.Equal(codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,249 @@
+package execclient
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// The exec benchmarks run sessions against the fake stream, so they
+// measure the client's own overhead on the hot path: each frame of
+// output or input goes through Run once per op. The fake stream keeps a
+// copy of every request, which is included in the allocations of the
+// input benchmarks.
+//
+// To show that a change doesn't regress, run the benchmarks several
+// times before and after it and compare the results with benchstat
+// (golang.org/x/perf/cmd/benchstat):
+//
+//	go test -run XXX -bench BenchmarkExec -benchmem -count 10 \
+//	    ./internal/server/execclient > old.txt
+//	(apply the change and run the same command into new.txt)
+//	benchstat old.txt new.txt
+//
+// BenchmarkExecReadChunkSize sweeps Client.ReadChunkSize, and
+// BenchmarkExecOutput_chunkSize in internal/ceb sweeps the output chunk
+// size of the entrypoint. They measure the cost per byte of each chunk
+// size, which is what matters on fast links. On slow links, what matters
+// is how long a chunk takes to arrive, so compare the keystroke latency
+// over the link itself before recommending a smaller size.
+
+// benchFrameSizes are the sizes of the frames of output and input.
+var benchFrameSizes = []struct {
+	name string
+	size int
+}{
+	{"64B", 64},
+	{"4KB", 4 * 1024},
+	{"64KB", 64 * 1024},
+}
+
+// BenchmarkExecOutputThroughput measures receiving output frames and
+// writing them to Stdout.
+func BenchmarkExecOutputThroughput(b *testing.B) {
+	for _, tt := range benchFrameSizes {
+		b.Run(tt.name, func(b *testing.B) {
+			frame := execclienttest.Stdout(string(bytes.Repeat([]byte("x"), tt.size)))
+			steps := []execclienttest.Step{
+				execclienttest.Respond(execclienttest.Open("s1")),
+			}
+			for i := 0; i < b.N; i++ {
+				steps = append(steps, execclienttest.Respond(frame))
+			}
+			steps = append(steps, execclienttest.Respond(execclienttest.Exit(0)))
+
+			c := testClient(b, execclienttest.NewStream(b, steps...))
+			benchRun(b, c, tt.size)
+		})
+	}
+}
+
+// BenchmarkExecInputThroughput measures reading input frames from Stdin
+// and sending them.
+func BenchmarkExecInputThroughput(b *testing.B) {
+	for _, tt := range benchFrameSizes {
+		b.Run(tt.name, func(b *testing.B) {
+			var input benchInput
+			stream := execclienttest.NewStream(b,
+				execclienttest.Respond(execclienttest.Open("s1")),
+				execclienttest.Step{
+					Response: execclienttest.Exit(0),
+					Wait:     input.after(b.N * tt.size),
+					Desc:     "all input",
+				},
+			)
+
+			c := testClient(b, stream)
+			c.Stdin = &benchReader{
+				frame: bytes.Repeat([]byte("x"), tt.size),
+				n:     b.N,
+			}
+			benchRun(b, c, tt.size)
+		})
+	}
+}
+
+// benchChunkSizes are the chunk sizes swept, from the smallest to the
+// largest allowed.
+var benchChunkSizes = []struct {
+	name string
+	size int
+}{
+	{"512B", MinChunkSize},
+	{"4KB", 4 * 1024},
+	{"32KB", DefaultReadChunkSize},
+	{"256KB", 256 * 1024},
+	{"1MB", MaxChunkSize},
+}
+
+// BenchmarkExecReadChunkSize measures reading and sending Stdin with each
+// ReadChunkSize. Each op is a MaxChunkSize of input, however many chunks
+// that takes.
+func BenchmarkExecReadChunkSize(b *testing.B) {
+	for _, tt := range benchChunkSizes {
+		b.Run(tt.name, func(b *testing.B) {
+			var input benchInput
+			stream := execclienttest.NewStream(b,
+				execclienttest.Respond(execclienttest.Open("s1")),
+				execclienttest.Step{
+					Response: execclienttest.Exit(0),
+					Wait:     input.after(b.N * MaxChunkSize),
+					Desc:     "all input",
+				},
+			)
+
+			c := testClient(b, stream)
+			c.ReadChunkSize = tt.size
+			c.Stdin = &benchReader{
+				frame: bytes.Repeat([]byte("x"), MaxChunkSize),
+				n:     b.N,
+			}
+			benchRun(b, c, MaxChunkSize)
+		})
+	}
+}
+
+// BenchmarkExecKeystrokeLatency measures the round trip of a frame of
+// input that is echoed back, such as a keystroke or a paste. Each op is
+// one round trip.
+func BenchmarkExecKeystrokeLatency(b *testing.B) {
+	for _, tt := range benchFrameSizes {
+		b.Run(tt.name, func(b *testing.B) {
+			frame := bytes.Repeat([]byte("x"), tt.size)
+			echo := execclienttest.Stdout(string(frame))
+
+			var input benchInput
+			steps := []execclienttest.Step{
+				execclienttest.Respond(execclienttest.Open("s1")),
+			}
+			for i := 1; i <= b.N; i++ {
+				steps = append(steps, execclienttest.Step{
+					Response: echo,
+					Wait:     input.after(i * tt.size),
+					Desc:     "a keystroke",
+				})
+			}
+			steps = append(steps, execclienttest.Respond(execclienttest.Exit(0)))
+
+			stdinR, stdinW := io.Pipe()
+			stdout := &benchEchoWriter{ch: make(chan struct{}, 1), size: tt.size}
+			c := testClient(b, execclienttest.NewStream(b, steps...))
+			c.Stdin = stdinR
+			c.Stdout = stdout
+
+			errCh := make(chan error, 1)
+			b.SetBytes(int64(tt.size))
+			b.ReportAllocs()
+			b.ResetTimer()
+			go func() {
+				_, err := c.Run()
+				errCh <- err
+			}()
+			for i := 0; i < b.N; i++ {
+				if _, err := stdinW.Write(frame); err != nil {
+					b.Fatal(err)
+				}
+				<-stdout.ch
+			}
+			require.NoError(b, <-errCh)
+			b.StopTimer()
+			stdinW.Close()
+		})
+	}
+}
+
+// benchRun runs a session of c as the benchmark, for size bytes per op.
+func benchRun(b *testing.B, c *Client, size int) {
+	b.SetBytes(int64(size))
+	b.ReportAllocs()
+	b.ResetTimer()
+	_, err := c.Run()
+	b.StopTimer()
+	require.NoError(b, err)
+}
+
+// benchInput counts the input received by a stream for the waits of its
+// steps. Only the requests received since the last wait are looked at so
+// that waiting stays cheap over a long benchmark.
+type benchInput struct {
+	seen  int
+	bytes int
+}
+
+// after returns a wait for n bytes of input in total.
+func (in *benchInput) after(n int) func([]*pb.ExecStreamRequest) bool {
+	return func(requests []*pb.ExecStreamRequest) bool {
+		for _, req := range requests[in.seen:] {
+			if v, ok := req.Event.(*pb.ExecStreamRequest_Input_); ok {
+				in.bytes += len(v.Input.Data)
+			}
+		}
+		in.seen = len(requests)
+
+		return in.bytes >= n
+	}
+}
+
+// benchReader returns frame n times, a frame at most per read.
+type benchReader struct {
+	frame []byte
+	n     int
+	off   int
+}
+
+func (r *benchReader) Read(b []byte) (int, error) {
+	if r.n == 0 {
+		return 0, io.EOF
+	}
+
+	n := copy(b, r.frame[r.off:])
+	r.off += n
+	if r.off == len(r.frame) {
+		r.off = 0
+		r.n--
+	}
+
+	return n, nil
+}
+
+// benchEchoWriter signals ch each time size bytes have been written.
+type benchEchoWriter struct {
+	ch      chan struct{}
+	size    int
+	written int
+}
+
+func (w *benchEchoWriter) Write(p []byte) (int, error) {
+	w.written += len(p)
+	for w.written >= w.size {
+		w.written -= w.size
+		w.ch <- struct{}{}
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,490 @@
+package execclient
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	sshterm "golang.org/x/crypto/ssh/terminal"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/internal/pkg/escapewatcher"
+)
+
+// Broadcast runs a command on several instances at once with a single
+// terminal, like cluster SSH. The output of each session is shown on
+// lines prefixed with its instance ID and input is sent to all of the
+// sessions at once.
+//
+// After a newline, "~f" switches between sending input to all sessions
+// and to each single session in turn, "~." ends all sessions, and "~~"
+// sends a "~". A session that exits early is reported and the others
+// continue.
+type Broadcast struct {
+	Logger  hclog.Logger
+	UI      terminal.UI
+	Context context.Context
+	Stdin   io.Reader
+	Stdout  io.Writer
+
+	// Clients are the sessions to run, one for each instance with
+	// InstanceId set. Their Stdin, Stdout, Stderr and Context are set by
+	// Run. Input is only read if some client isn't ReadOnly, and the
+	// terminal is put in raw mode if some client has ForcePTY set.
+	Clients []*Client
+
+	// Drain, if set, starts a graceful shutdown once it is closed. The
+	// remote commands that are still running are sent SIGINT and have up
+	// to DrainTimeout to exit, with a countdown shown meanwhile, before
+	// they are ended like when Context is cancelled. Run then returns
+	// ErrShutdownForced.
+	Drain        <-chan struct{}
+	DrainTimeout time.Duration
+
+	lock     sync.Mutex
+	sessions []*broadcastSession
+	focus    int
+	out      *prefixedOutput
+	draining bool
+	forced   bool
+}
+
+// broadcastSession is a single session of a Broadcast.
+type broadcastSession struct {
+	client *Client
+	name   string
+
+	// input is where input for the session is written. This is nil if
+	// the session doesn't take input.
+	input io.Writer
+
+	// done is set once the session has exited. It is protected by the
+	// Broadcast lock.
+	done bool
+}
+
+// broadcastAll is the focus when input goes to all sessions.
+const broadcastAll = -1
+
+// Run runs all the sessions until they exit and returns the highest exit
+// code. A session that fails to run is shown as an error and counts as
+// exit code 1.
+func (b *Broadcast) Run() (int, error) {
+	if len(b.Clients) == 0 {
+		return 0, errors.New("no sessions to run")
+	}
+	if b.Logger == nil {
+		b.Logger = hclog.NewNullLogger()
+	}
+	if b.Context == nil {
+		b.Context = context.Background()
+	}
+
+	ctx, cancel := context.WithCancel(b.Context)
+	defer cancel()
+
+	var readInput, raw bool
+	for _, c := range b.Clients {
+		readInput = readInput || !c.ReadOnly
+		raw = raw || c.ForcePTY
+	}
+
+	// Like Run, we use raw mode for PTYs only if we are sending input so
+	// that Ctrl-C still works locally otherwise.
+	if f, ok := b.Stdin.(*os.File); ok && readInput && raw && sshterm.IsTerminal(int(f.Fd())) {
+		oldState, err := sshterm.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return 0, err
+		}
+		defer sshterm.Restore(int(f.Fd()), oldState)
+	} else {
+		raw = false
+	}
+
+	// Close our UI if we can. The sessions share it so they mustn't.
+	if closer, ok := b.UI.(io.Closer); ok {
+		closer.Close()
+	}
+
+	b.out = &prefixedOutput{W: b.Stdout, Raw: raw}
+	b.focus = broadcastAll
+	b.sessions = nil
+	for i, c := range b.Clients {
+		name := c.InstanceId
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+
+		s := &broadcastSession{client: c, name: name}
+		w := b.out.Writer(name)
+		c.Stdout = w
+		c.Stderr = w
+		c.Context = ctx
+		if b.UI != nil {
+			c.UI = b.UI
+			c.KeepUI = true
+		}
+		c.noEscape = true
+		if !c.ReadOnly {
+			pr, pw := io.Pipe()
+			c.Stdin = pr
+			s.input = pw
+		}
+
+		b.sessions = append(b.sessions, s)
+	}
+
+	if readInput {
+		b.message("input goes to all instances, press Enter and type ~f to switch")
+		go b.readInput(cancel)
+	}
+
+	if b.Drain != nil {
+		go b.drain(ctx, cancel)
+	}
+
+	var wg sync.WaitGroup
+	codes := make([]int, len(b.sessions))
+	for i, s := range b.sessions {
+		wg.Add(1)
+		go func(i int, s *broadcastSession) {
+			defer wg.Done()
+			codes[i] = b.runSession(s)
+		}(i, s)
+	}
+	wg.Wait()
+
+	code := 0
+	for _, v := range codes {
+		if v > code {
+			code = v
+		}
+	}
+
+	// Once draining, the sessions ending because Context was cancelled
+	// means the shutdown was forced too.
+	b.lock.Lock()
+	forced := b.forced || (b.draining && b.Context.Err() != nil)
+	b.lock.Unlock()
+	if forced {
+		return code, ErrShutdownForced
+	}
+
+	return code, nil
+}
+
+// drain waits for Drain and then signals the remote commands to exit,
+// ending the sessions with cancel if they haven't within DrainTimeout.
+func (b *Broadcast) drain(ctx context.Context, cancel func()) {
+	select {
+	case <-b.Drain:
+	case <-ctx.Done():
+		return
+	}
+
+	b.lock.Lock()
+	b.draining = true
+	b.lock.Unlock()
+
+	// The sessions may have ended on their own meanwhile, in which case
+	// there's nothing to wait for.
+	running := b.running()
+	if len(running) == 0 {
+		return
+	}
+
+	b.message(fmt.Sprintf(
+		"interrupted, sending SIGINT to %d sessions and waiting up to %s for them "+
+			"to exit, interrupt again to end them now",
+		len(running), b.DrainTimeout))
+	for _, s := range running {
+		if err := s.client.Signal("INT"); err != nil {
+			b.Logger.Debug("error signaling session", "instance", s.name, "err", err)
+		}
+	}
+
+	deadline := time.Now().Add(b.DrainTimeout)
+	timer := time.NewTimer(b.DrainTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(broadcastDrainCountdown)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if running := b.running(); len(running) > 0 {
+				b.message(fmt.Sprintf("waiting for %d sessions to exit, %s left",
+					len(running), time.Until(deadline).Round(time.Second)))
+			}
+
+		case <-timer.C:
+			b.message("sessions didn't exit in time, ending them")
+			b.lock.Lock()
+			b.forced = true
+			b.lock.Unlock()
+			cancel()
+			return
+
+		case <-ctx.Done():
+			// All the sessions ended, or they were ended by Context or
+			// the input.
+			return
+		}
+	}
+}
+
+// running returns the sessions that haven't exited yet.
+func (b *Broadcast) running() []*broadcastSession {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	var result []*broadcastSession
+	for _, s := range b.sessions {
+		if !s.done {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+// broadcastDrainCountdown is how often the countdown is shown while
+// waiting for sessions to exit after an interrupt.
+const broadcastDrainCountdown = 5 * time.Second
+
+// runSession runs a single session and returns its exit code.
+func (b *Broadcast) runSession(s *broadcastSession) int {
+	code, err := s.client.Run()
+	var exitErr *ExitError
+	switch {
+	case errors.Is(err, context.Canceled):
+		// All the sessions end together when canceled, so this isn't
+		// worth a message.
+		code = 1
+
+	case err != nil && !errors.As(err, &exitErr):
+		b.message(fmt.Sprintf("%s: %s", s.name, err))
+		code = 1
+
+	case s.client.Context.Err() == nil:
+		b.message(fmt.Sprintf("%s exited with code %d", s.name, code))
+	}
+
+	// Stop sending it input. Closing the pipe also ends the session's
+	// goroutine reading it.
+	if pw, ok := s.input.(*io.PipeWriter); ok {
+		pw.Close()
+	}
+
+	b.lock.Lock()
+	s.done = true
+	focused := b.focus != broadcastAll && b.sessions[b.focus] == s
+	if focused {
+		b.focus = broadcastAll
+	}
+	b.lock.Unlock()
+
+	if focused {
+		b.message("input goes to all instances")
+	}
+
+	return code
+}
+
+// readInput reads Stdin and routes it to the sessions until Stdin ends or
+// the "~." escape, which calls cancel.
+func (b *Broadcast) readInput(cancel func()) {
+	if err := b.routeInput(b.Stdin, cancel); err != nil {
+		// Like a single session, EOF on our input is EOF for all of them.
+		b.lock.Lock()
+		sessions := b.sessions
+		b.lock.Unlock()
+		for _, s := range sessions {
+			if pw, ok := s.input.(*io.PipeWriter); ok {
+				pw.Close()
+			}
+		}
+	}
+}
+
+// routeInput reads r and sends it to the sessions, handling our escapes
+// with an escapewatcher.Watcher. This returns the error reading r, or nil
+// after the "~." escape, which calls cancel.
+//
+// The watcher calls handlers in the middle of a read, so we read through
+// it a byte at a time from a buffer. That way input typed before "~f" is
+// sent to the session that had the focus then. We still send input as it
+// comes in, once nothing more is buffered.
+func (b *Broadcast) routeInput(r io.Reader, cancel func()) error {
+	var focus, cancelled bool
+	br := bufio.NewReader(r)
+	ew := escapewatcher.New(br, map[byte]escapewatcher.Handler{
+		'.': {Func: func() { cancelled = true }, Forward: true},
+		'f': {Func: func() { focus = true }},
+
+		// The first "~" is forwarded as typed, so "~~" sends just one.
+		'~': {},
+	})
+
+	// Enter sends a carriage return in raw mode, as it does for exec.
+	ew.CarriageReturn = b.out.Raw
+
+	var out []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := ew.Read(buf)
+		out = append(out, buf[:n]...)
+		if !focus && !cancelled && err == nil && br.Buffered() > 0 {
+			continue
+		}
+
+		b.write(out)
+		out = out[:0]
+		switch {
+		case cancelled:
+			cancel()
+			return nil
+
+		case err != nil:
+			return err
+
+		case focus:
+			focus = false
+			b.focusNext()
+		}
+	}
+}
+
+// write sends input to the focused session or to all sessions.
+func (b *Broadcast) write(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	b.lock.Lock()
+	var targets []*broadcastSession
+	for i, s := range b.sessions {
+		if s.done || s.input == nil {
+			continue
+		}
+		if b.focus == broadcastAll || b.focus == i {
+			targets = append(targets, s)
+		}
+	}
+	b.lock.Unlock()
+
+	for _, s := range targets {
+		if _, err := s.input.Write(data); err != nil {
+			b.Logger.Debug("error sending input", "instance", s.name, "err", err)
+		}
+	}
+}
+
+// focusNext switches input to the next session that is still running,
+// or back to all sessions after the last one.
+func (b *Broadcast) focusNext() {
+	b.lock.Lock()
+	next := broadcastAll
+	for i := b.focus + 1; i < len(b.sessions); i++ {
+		s := b.sessions[i]
+		if !s.done && s.input != nil {
+			next = i
+			break
+		}
+	}
+	b.focus = next
+	b.lock.Unlock()
+
+	if next == broadcastAll {
+		b.message("input goes to all instances")
+	} else {
+		b.message(fmt.Sprintf("input goes to %s only", b.sessions[next].name))
+	}
+}
+
+// message shows a message of our own on its own line.
+func (b *Broadcast) message(msg string) {
+	fmt.Fprintf(b.out.Writer("waypoint"), "%s\n", msg)
+}
+
+// prefixedOutput interleaves the output of several sessions on one
+// writer. Each line is prefixed with the name of the session it is from.
+// When a session writes while another is in the middle of a line, that
+// line is ended first.
+type prefixedOutput struct {
+	W io.Writer
+
+	// Raw is true if the terminal is in raw mode so our own line breaks
+	// need carriage returns.
+	Raw bool
+
+	lock sync.Mutex
+	last *prefixWriter
+}
+
+// Writer returns a writer that prefixes lines with name.
+func (o *prefixedOutput) Writer(name string) io.Writer {
+	return &prefixWriter{out: o, prefix: []byte("[" + name + "] "), lineStart: true}
+}
+
+type prefixWriter struct {
+	out    *prefixedOutput
+	prefix []byte
+
+	// lineStart is true if the next byte written starts a line, so needs
+	// the prefix. It is protected by the prefixedOutput lock.
+	lineStart bool
+}
+
+func (w *prefixWriter) Write(data []byte) (int, error) {
+	o := w.out
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	var buf []byte
+	if last := o.last; last != nil && last != w && !last.lineStart {
+		if o.Raw {
+			buf = append(buf, '\r')
+		}
+		buf = append(buf, '\n')
+		last.lineStart = true
+	}
+	o.last = w
+
+	for _, c := range data {
+		switch c {
+		case '\n':
+			// A PTY sends its own carriage returns, otherwise we add them
+			// in raw mode.
+			if o.Raw && (len(buf) == 0 || buf[len(buf)-1] != '\r') {
+				buf = append(buf, '\r')
+			}
+			w.lineStart = true
+
+		case '\r':
+			// Output such as a shell redrawing its prompt returns to the
+			// start of the line, so the prefix is written again after it.
+			w.lineStart = true
+
+		default:
+			if w.lineStart {
+				buf = append(buf, w.prefix...)
+				w.lineStart = false
+			}
+		}
+
+		buf = append(buf, c)
+	}
+
+	if _, err := o.W.Write(buf); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
@@ -0,0 +1,143 @@
+package execclient
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixedOutput(t *testing.T) {
+	t.Run("prefixes each line", func(t *testing.T) {
+		var buf bytes.Buffer
+		out := &prefixedOutput{W: &buf}
+		w := out.Writer("a")
+
+		io.WriteString(w, "one\ntw")
+		io.WriteString(w, "o\nthree\n")
+		require.Equal(t, "[a] one\n[a] two\n[a] three\n", buf.String())
+	})
+
+	t.Run("ends a partial line when another session writes", func(t *testing.T) {
+		var buf bytes.Buffer
+		out := &prefixedOutput{W: &buf}
+		a, b := out.Writer("a"), out.Writer("b")
+
+		io.WriteString(a, "$ ")
+		io.WriteString(b, "hello\n")
+		io.WriteString(a, "ls\n")
+		require.Equal(t, "[a] $ \n[b] hello\n[a] ls\n", buf.String())
+	})
+
+	t.Run("prefixes again after a carriage return", func(t *testing.T) {
+		var buf bytes.Buffer
+		out := &prefixedOutput{W: &buf, Raw: true}
+		w := out.Writer("a")
+
+		io.WriteString(w, "\r$ ")
+		io.WriteString(w, "\r$ ls\r\n")
+		require.Equal(t, "\r[a] $ \r[a] $ ls\r\n", buf.String())
+	})
+
+	t.Run("adds carriage returns in raw mode", func(t *testing.T) {
+		var buf bytes.Buffer
+		out := &prefixedOutput{W: &buf, Raw: true}
+		a, b := out.Writer("a"), out.Writer("b")
+
+		io.WriteString(a, "one")
+		io.WriteString(b, "two\n")
+		require.Equal(t, "[a] one\r\n[b] two\r\n", buf.String())
+	})
+}
+
+func TestBroadcastRouteInput(t *testing.T) {
+	testBroadcast := func() (*Broadcast, []*bytes.Buffer) {
+		var out bytes.Buffer
+		b := &Broadcast{
+			Logger: hclog.NewNullLogger(),
+			focus:  broadcastAll,
+			out:    &prefixedOutput{W: &out, Raw: true},
+		}
+
+		var inputs []*bytes.Buffer
+		for _, name := range []string{"a", "b"} {
+			var input bytes.Buffer
+			inputs = append(inputs, &input)
+			b.sessions = append(b.sessions, &broadcastSession{
+				client: &Client{},
+				name:   name,
+				input:  &input,
+			})
+		}
+
+		return b, inputs
+	}
+
+	t.Run("sends input to all sessions", func(t *testing.T) {
+		b, inputs := testBroadcast()
+
+		err := b.routeInput(strings.NewReader("ls\r"), nil)
+		require.Equal(t, io.EOF, err)
+		require.Equal(t, "ls\r", inputs[0].String())
+		require.Equal(t, "ls\r", inputs[1].String())
+	})
+
+	t.Run("switches focus", func(t *testing.T) {
+		b, inputs := testBroadcast()
+
+		// The "~" is forwarded as typed, before the focus switches.
+		b.routeInput(strings.NewReader("\r~fone\r~ftwo\r~fall\r"), nil)
+		require.Equal(t, "\r~one\r~all\r", inputs[0].String())
+		require.Equal(t, "\r~two\r~all\r", inputs[1].String())
+	})
+
+	t.Run("switches focus across reads", func(t *testing.T) {
+		b, inputs := testBroadcast()
+
+		b.routeInput(iotest.OneByteReader(strings.NewReader("\r~fone\r~ftwo\r")), nil)
+		require.Equal(t, "\r~one\r~", inputs[0].String())
+		require.Equal(t, "\r~two\r", inputs[1].String())
+	})
+
+	t.Run("skips sessions that are done", func(t *testing.T) {
+		b, inputs := testBroadcast()
+		b.sessions[0].done = true
+
+		b.routeInput(strings.NewReader("\r~fx"), nil)
+		require.Equal(t, 1, b.focus)
+		require.Equal(t, "", inputs[0].String())
+		require.Equal(t, "\r~x", inputs[1].String())
+	})
+
+	t.Run("only sees escapes after a newline", func(t *testing.T) {
+		b, inputs := testBroadcast()
+
+		b.routeInput(strings.NewReader("a~f\r~~\r~x"), nil)
+		require.Equal(t, broadcastAll, b.focus)
+		require.Equal(t, "a~f\r~\r~x", inputs[0].String())
+	})
+
+	t.Run("only sees escapes after a carriage return in raw mode", func(t *testing.T) {
+		b, inputs := testBroadcast()
+		b.out.Raw = false
+
+		b.routeInput(strings.NewReader("ls\r~fone\n~ftwo\n"), nil)
+		require.Equal(t, 0, b.focus)
+		require.Equal(t, "ls\r~fone\n~two\n", inputs[0].String())
+		require.Equal(t, "ls\r~fone\n~", inputs[1].String())
+	})
+
+	t.Run("ends all sessions", func(t *testing.T) {
+		b, inputs := testBroadcast()
+		var cancelled bool
+
+		err := b.routeInput(strings.NewReader("exit\r~.ignored"), func() { cancelled = true })
+		require.NoError(t, err)
+		require.True(t, cancelled)
+		require.Equal(t, "exit\r~.", inputs[1].String())
+	})
+}
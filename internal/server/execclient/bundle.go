@@ -0,0 +1,331 @@
+package execclient
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/version"
+)
+
+const (
+	// debugBundleEvents is the number of protocol messages a DebugBundle
+	// keeps. Only the first ones are kept since they show how the session
+	// started, which is where most problems are.
+	debugBundleEvents = 1000
+
+	// debugBundleLogLines is the number of log lines a DebugBundle keeps.
+	// Only the last ones are kept.
+	debugBundleLogLines = 500
+)
+
+// debugBundleScrubber redacts secrets from the logs in a bundle. On top
+// of the default patterns, this redacts tokens logged as key/value pairs.
+var debugBundleScrubber = scrub.New(append([]*regexp.Regexp{
+	regexp.MustCompile(`(?i)(?:token|authorization|password|secret)[a-z_]*["]?[=:] ?["]?([^\s"]+)`),
+}, scrub.DefaultPatterns...), nil)
+
+// DebugBundle records what happens in a session so that it can be written
+// to a zip file to attach to bug reports. It never records the data of
+// the session, such as its input, output and arguments, only the type and
+// size of each message, and secrets are redacted from the logs.
+//
+// Set Client.DebugBundle to record a session and call Write once Run
+// returns. Log lines are only recorded if Client.Logger is an
+// hclog.InterceptLogger.
+type DebugBundle struct {
+	// Conn, if set, is the connection to the server. Its state changes
+	// during the session are recorded.
+	Conn *grpc.ClientConn
+
+	lock    sync.Mutex
+	start   time.Time
+	info    debugBundleInfo
+	events  bytes.Buffer
+	nevents int
+	states  bytes.Buffer
+	logs    []string
+}
+
+// debugBundleInfo is the summary of a session in a bundle.
+type debugBundleInfo struct {
+	Time time.Time `json:"time"`
+
+	ClientVersion string `json:"client_version"`
+	ServerVersion string `json:"server_version,omitempty"`
+	ServerAPI     string `json:"server_api,omitempty"`
+	Entrypoint    string `json:"entrypoint_protocol,omitempty"`
+
+	DeploymentId  string `json:"deployment_id"`
+	DeploymentSeq uint64 `json:"deployment_seq"`
+	InstanceId    string `json:"instance_id,omitempty"`
+	Container     string `json:"container,omitempty"`
+	Task          string `json:"task,omitempty"`
+	SessionId     string `json:"session_id"`
+	Attached      string `json:"attached_instance_id,omitempty"`
+	Detach        bool   `json:"detach,omitempty"`
+	Watch         bool   `json:"watch,omitempty"`
+
+	TERM         string `json:"term"`
+	GOOS         string `json:"goos"`
+	GOARCH       string `json:"goarch"`
+	Terminal     bool   `json:"stdout_terminal"`
+	TerminalSize string `json:"terminal_size,omitempty"`
+
+	ExitCode int    `json:"exit_code"`
+	Error    string `json:"error,omitempty"`
+}
+
+// NewDebugBundle returns an empty DebugBundle.
+func NewDebugBundle() *DebugBundle {
+	return &DebugBundle{}
+}
+
+// Write writes the bundle to a zip file at path. It contains info.json
+// describing the session and its environment, protocol.log with the
+// messages of the session, connection.log with the state changes of Conn
+// and client.log with the last log lines.
+func (b *DebugBundle) Write(path string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	info, err := json.MarshalIndent(&b.info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	var logs bytes.Buffer
+	for _, line := range b.logs {
+		logs.WriteString(line)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range []struct {
+		name string
+		data []byte
+	}{
+		{"info.json", info},
+		{"protocol.log", b.events.Bytes()},
+		{"connection.log", b.states.Bytes()},
+		{"client.log", debugBundleScrubber.Bytes(logs.Bytes())},
+	} {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(f.data); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0600)
+}
+
+// begin starts recording a run of c. It returns the function to call
+// with the result of the run. All the recording methods do nothing on a
+// nil bundle so that the client can call them unconditionally.
+func (b *DebugBundle) begin(c *Client, sessionId string) func(int, error) {
+	if b == nil {
+		return func(int, error) {}
+	}
+
+	ctx, cancel := context.WithCancel(c.Context)
+
+	b.lock.Lock()
+	b.start = time.Now()
+	b.info = debugBundleInfo{
+		Time:          b.start,
+		ClientVersion: version.GetVersion().FullVersionNumber(true),
+		DeploymentId:  c.DeploymentId,
+		DeploymentSeq: c.DeploymentSeq,
+		InstanceId:    c.InstanceId,
+		Container:     c.Container,
+		Task:          c.Task,
+		SessionId:     sessionId,
+		Detach:        c.Detach,
+		Watch:         c.Watch,
+		TERM:          os.Getenv("TERM"),
+		GOOS:          runtime.GOOS,
+		GOARCH:        runtime.GOARCH,
+	}
+	if f, ok := c.isTerminal(c.Stdout); ok {
+		b.info.Terminal = true
+		size, err := c.windowSize(f)
+		var invalid *invalidWindowSizeError
+		switch {
+		case err == nil:
+			b.info.TerminalSize = fmt.Sprintf("%dx%d", size.Cols, size.Rows)
+		case errors.As(err, &invalid):
+			b.info.TerminalSize = fmt.Sprintf("%dx%d (invalid)", invalid.Cols, invalid.Rows)
+		}
+	}
+	b.lock.Unlock()
+
+	// The server's version, which also tells us the entrypoint protocol
+	// versions it supports. Entrypoints don't report their version.
+	versionCtx, versionCancel := context.WithTimeout(ctx, 5*time.Second)
+	resp, err := c.Client.GetVersionInfo(versionCtx, &empty.Empty{})
+	versionCancel()
+	if err == nil && resp.Info != nil {
+		b.lock.Lock()
+		b.info.ServerVersion = resp.Info.Version
+		if v := resp.Info.Api; v != nil {
+			b.info.ServerAPI = fmt.Sprintf("current %d, minimum %d", v.Current, v.Minimum)
+		}
+		if v := resp.Info.Entrypoint; v != nil {
+			b.info.Entrypoint = fmt.Sprintf("current %d, minimum %d", v.Current, v.Minimum)
+		}
+		b.lock.Unlock()
+	}
+
+	if b.Conn != nil {
+		go b.watchConn(ctx)
+	}
+
+	var sink hclog.SinkAdapter
+	if l, ok := c.Logger.(hclog.InterceptLogger); ok {
+		sink = &debugBundleSink{
+			SinkAdapter: hclog.NewSinkAdapter(&hclog.LoggerOptions{
+				Level:  hclog.Debug,
+				Output: debugBundleLog{b},
+			}),
+			sessionId: sessionId,
+		}
+		l.RegisterSink(sink)
+	}
+
+	return func(code int, err error) {
+		if sink != nil {
+			c.Logger.(hclog.InterceptLogger).DeregisterSink(sink)
+		}
+		cancel()
+
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		b.info.ExitCode = code
+		var exitErr *ExitError
+		if err != nil && !errors.As(err, &exitErr) {
+			b.info.Error = debugBundleScrubber.String(err.Error())
+		}
+	}
+}
+
+// event records a message sent or received. Only its type and size are
+// recorded, never its data.
+func (b *DebugBundle) event(dir string, msg proto.Message) {
+	if b == nil {
+		return
+	}
+
+	var event interface{}
+	switch v := msg.(type) {
+	case *pb.ExecStreamRequest:
+		event = v.Event
+	case *pb.ExecStreamResponse:
+		event = v.Event
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.nevents++
+	if b.nevents > debugBundleEvents {
+		return
+	}
+
+	fmt.Fprintf(&b.events, "%s %s %T %d bytes\n",
+		b.since(), dir, event, proto.Size(msg))
+	if b.nevents == debugBundleEvents {
+		fmt.Fprintf(&b.events, "(later messages are not recorded)\n")
+	}
+}
+
+// attached records the instance the session attached to.
+func (b *DebugBundle) attached(instanceId string) {
+	if b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.info.Attached = instanceId
+}
+
+// watchConn records the state changes of Conn until ctx is done.
+func (b *DebugBundle) watchConn(ctx context.Context) {
+	state := b.Conn.GetState()
+	for {
+		b.lock.Lock()
+		fmt.Fprintf(&b.states, "%s %s\n", b.since(), state)
+		b.lock.Unlock()
+
+		if state == connectivity.Shutdown || !b.Conn.WaitForStateChange(ctx, state) {
+			return
+		}
+		state = b.Conn.GetState()
+	}
+}
+
+// since returns the time since the session began for the logs of the
+// bundle. The lock must be held.
+func (b *DebugBundle) since() string {
+	return fmt.Sprintf("+%.3fs", time.Since(b.start).Seconds())
+}
+
+// debugBundleSink only passes on the log lines of one session. A sink
+// registered on a logger gets the lines of all loggers derived from the
+// same one, which includes those of other sessions if they share it.
+type debugBundleSink struct {
+	hclog.SinkAdapter
+	sessionId string
+}
+
+func (s *debugBundleSink) Accept(name string, level hclog.Level, msg string, args ...interface{}) {
+	for i := 0; i+1 < len(args); i += 2 {
+		if args[i] == "exec_session_id" {
+			if args[i+1] == s.sessionId {
+				s.SinkAdapter.Accept(name, level, msg, args...)
+			}
+
+			return
+		}
+	}
+}
+
+// debugBundleLog keeps the last log lines written to it in the bundle.
+// A sink writes each log line with a single call.
+type debugBundleLog struct {
+	b *DebugBundle
+}
+
+func (w debugBundleLog) Write(p []byte) (int, error) {
+	w.b.lock.Lock()
+	defer w.b.lock.Unlock()
+
+	w.b.logs = append(w.b.logs, string(p))
+	if n := len(w.b.logs); n > debugBundleLogLines {
+		w.b.logs = w.b.logs[n-debugBundleLogLines:]
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,125 @@
+package execclient
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestDebugBundle(t *testing.T) {
+	require := require.New(t)
+
+	waypoint := execclienttest.NewWaypoint(execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.Respond(execclienttest.Stdout("private output")),
+		execclienttest.Respond(execclienttest.Exit(3)),
+	))
+	waypoint.Version = &pb.VersionInfo{Version: "v1.2.3"}
+
+	bundle := NewDebugBundle()
+	c := &Client{
+		Logger: hclog.NewInterceptLogger(&hclog.LoggerOptions{
+			Output: ioutil.Discard,
+		}),
+		Context:       context.Background(),
+		Client:        waypoint,
+		DeploymentId:  "d1",
+		DeploymentSeq: 7,
+		Args:          []string{"cat", "private-arg"},
+		Stdin:         bytes.NewReader(nil),
+		Stdout:        ioutil.Discard,
+		Stderr:        ioutil.Discard,
+		ReadOnly:      true,
+		DebugBundle:   bundle,
+	}
+
+	code, err := c.Run()
+	require.Error(err)
+	require.Equal(3, code)
+
+	td, err := ioutil.TempDir("", "waypoint")
+	require.NoError(err)
+	defer os.RemoveAll(td)
+	path := filepath.Join(td, "bundle.zip")
+	require.NoError(bundle.Write(path))
+
+	files := map[string]string{}
+	zr, err := zip.OpenReader(path)
+	require.NoError(err)
+	defer zr.Close()
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(err)
+		data, err := ioutil.ReadAll(r)
+		r.Close()
+		require.NoError(err)
+		files[f.Name] = string(data)
+	}
+	require.Len(files, 4)
+
+	// The session and where it ran
+	var info debugBundleInfo
+	require.NoError(json.Unmarshal([]byte(files["info.json"]), &info))
+	require.Equal("d1", info.DeploymentId)
+	require.Equal(uint64(7), info.DeploymentSeq)
+	require.Equal("i1", info.Attached)
+	require.Equal("v1.2.3", info.ServerVersion)
+	require.Equal(3, info.ExitCode)
+	require.Empty(info.Error, "exit code isn't an error")
+	require.NotEmpty(info.SessionId)
+
+	// The types of the messages, but not their data
+	require.Contains(files["protocol.log"], "send *gen.ExecStreamRequest_Start_")
+	require.Contains(files["protocol.log"], "recv *gen.ExecStreamResponse_Output_")
+	require.Contains(files["client.log"], "starting exec session")
+	for name, data := range files {
+		require.NotContains(data, "private", name)
+	}
+}
+
+func TestDebugBundleSink(t *testing.T) {
+	require := require.New(t)
+
+	// Sessions share the logger, so the sink sees all of their lines
+	b := NewDebugBundle()
+	log := hclog.NewInterceptLogger(&hclog.LoggerOptions{Output: ioutil.Discard})
+	log.RegisterSink(&debugBundleSink{
+		SinkAdapter: hclog.NewSinkAdapter(&hclog.LoggerOptions{
+			Output: debugBundleLog{b},
+		}),
+		sessionId: "s1",
+	})
+
+	log.With("exec_session_id", "s1").Info("ours")
+	log.With("exec_session_id", "s2").Info("theirs")
+	log.Named("tunnel").Info("no session")
+
+	require.Len(b.logs, 1)
+	require.Contains(b.logs[0], "ours")
+}
+
+func TestDebugBundleScrubber(t *testing.T) {
+	require := require.New(t)
+
+	for _, line := range []string{
+		`[DEBUG] connecting: token=abcdef123456`,
+		`[DEBUG] connecting: auth_token="abcdef123456"`,
+		`[DEBUG] connecting: authorization: abcdef123456`,
+	} {
+		scrubbed := debugBundleScrubber.String(line)
+		require.NotContains(scrubbed, "abcdef123456", line)
+		require.Contains(scrubbed, "[REDACTED]", line)
+	}
+}
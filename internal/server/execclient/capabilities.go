@@ -0,0 +1,101 @@
+package execclient
+
+import (
+	"sort"
+)
+
+// The optional features of the exec protocol that we support. Each party
+// of a session announces the capabilities it supports and a feature is
+// only used if every party involved in it supports it, so that older
+// servers and entrypoints don't silently ignore what they don't know.
+// See ExecStreamRequest.Start.client_capabilities.
+const (
+	// capabilityPing is support for ping and pong events. The server
+	// forwards them and the instance answers them.
+	capabilityPing = "ping"
+
+	// capabilityStatus is support for status events before the instance
+	// attaches. Only the server sends them, so this isn't negotiated.
+	capabilityStatus = "status"
+
+	// capabilityStdinEOF is support for closing the stdin of the command.
+	// The server forwards it and the instance closes stdin.
+	capabilityStdinEOF = "stdin_eof"
+
+	// capabilitySequence is support for running a sequence of commands.
+	// The server forwards it and the instance runs the commands.
+	capabilitySequence = "sequence"
+
+	// capabilityAttached is support for the attached event, once the
+	// instance runs the command. Only the server sends it, so this isn't
+	// negotiated. Older servers never send it.
+	capabilityAttached = "attached"
+
+	// capabilityClosed is support for the closed event, when the server
+	// ends a session itself. Only the server sends it, so this isn't
+	// negotiated.
+	capabilityClosed = "closed"
+)
+
+// clientCapabilities are the capabilities we announce.
+var clientCapabilities = []string{
+	capabilityPing, capabilityStatus, capabilityStdinEOF, capabilitySequence,
+	capabilityAttached, capabilityClosed,
+}
+
+// capabilities is a set of negotiated capabilities.
+type capabilities map[string]struct{}
+
+// negotiateCapabilities returns the capabilities of ours that all of
+// others support too. Capabilities of others that we don't know about
+// are ignored.
+func negotiateCapabilities(ours []string, others ...[]string) capabilities {
+	result := capabilities{}
+	for _, name := range ours {
+		result[name] = struct{}{}
+	}
+
+	for _, other := range others {
+		supported := make(map[string]bool, len(other))
+		for _, name := range other {
+			supported[name] = true
+		}
+
+		for name := range result {
+			if !supported[name] {
+				delete(result, name)
+			}
+		}
+	}
+
+	return result
+}
+
+// Has returns true if the capability name was negotiated.
+func (c capabilities) Has(name string) bool {
+	_, ok := c[name]
+	return ok
+}
+
+// List returns the negotiated capabilities in order, such as for logs.
+func (c capabilities) List() []string {
+	result := make([]string, 0, len(c))
+	for name := range c {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// hasCapability returns true if caps includes the capability name. This is
+// for capabilities only one party announces, which aren't negotiated.
+func hasCapability(caps []string, name string) bool {
+	for _, v := range caps {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,23 @@
+package execclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateCapabilities(t *testing.T) {
+	require := require.New(t)
+
+	caps := negotiateCapabilities([]string{"ping", "resize"},
+		[]string{"resize", "ping", "future"},
+		[]string{"ping", "other"})
+	require.True(caps.Has("ping"))
+	require.False(caps.Has("resize"))
+	require.False(caps.Has("future"))
+	require.Equal([]string{"ping"}, caps.List())
+
+	caps = negotiateCapabilities([]string{"ping"}, nil)
+	require.False(caps.Has("ping"))
+	require.Empty(caps.List())
+}
@@ -0,0 +1,45 @@
+package execclient
+
+import "fmt"
+
+// DefaultReadChunkSize is the most Stdin read and sent at a time if
+// Client.ReadChunkSize isn't set.
+const DefaultReadChunkSize = 32 * 1024
+
+// MinChunkSize and MaxChunkSize bound Client.ReadChunkSize and
+// Client.OutputChunkSize. Smaller chunks would be mostly message overhead,
+// and larger ones hold data back for too long to be worth it.
+const (
+	MinChunkSize = 512
+	MaxChunkSize = 1024 * 1024
+)
+
+// CheckChunkSize returns an error if the chunk size n isn't zero and is
+// outside of MinChunkSize and MaxChunkSize. Run bounds chunk sizes itself,
+// so this is for callers that would rather reject such a size, such as
+// from a flag.
+func CheckChunkSize(n int) error {
+	if n != 0 && (n < MinChunkSize || n > MaxChunkSize) {
+		return fmt.Errorf("chunk size must be between %d and %d bytes",
+			MinChunkSize, MaxChunkSize)
+	}
+
+	return nil
+}
+
+// chunkSize returns the chunk size n bounded to MinChunkSize and
+// MaxChunkSize, or def if n is zero.
+func chunkSize(n, def int) int {
+	switch {
+	case n == 0:
+		return def
+
+	case n < MinChunkSize:
+		return MinChunkSize
+
+	case n > MaxChunkSize:
+		return MaxChunkSize
+	}
+
+	return n
+}
@@ -0,0 +1,58 @@
+package execclient
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+)
+
+func TestChunkSize(t *testing.T) {
+	cases := []struct {
+		Name     string
+		N        int
+		Expected int
+		Valid    bool
+	}{
+		{"default", 0, DefaultReadChunkSize, true},
+		{"in range", 4096, 4096, true},
+		{"too small", 1, MinChunkSize, false},
+		{"too large", 1 << 30, MaxChunkSize, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, chunkSize(tt.N, DefaultReadChunkSize))
+			require.Equal(t, tt.Valid, CheckChunkSize(tt.N) == nil)
+		})
+	}
+}
+
+func TestClientRun_chunkSize(t *testing.T) {
+	require := require.New(t)
+
+	input := strings.Repeat("0123456789abcdef", 4096/16)
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.AfterInput(input, execclienttest.Exit(0)),
+	)
+
+	c := testClient(t, stream)
+	c.Stdin = strings.NewReader(input)
+	c.ReadChunkSize = 1024
+	c.OutputChunkSize = 1
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	require.Equal(input, string(stream.Input()))
+
+	// The input is sent in chunks, and the output chunk size asked for
+	// is bounded.
+	for _, req := range stream.Requests() {
+		require.True(len(req.GetInput().GetData()) <= 1024)
+	}
+	require.Equal(uint32(MinChunkSize), stream.Start().OutputChunkSize)
+}
@@ -1,23 +1,50 @@
 package execclient
 
 import (
-	"bytes"
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/containerd/console"
+	"github.com/dustin/go-humanize"
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-hclog"
 	grpc_net_conn "github.com/mitchellh/go-grpc-net-conn"
-	sshterm "golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/pkg/escapewatcher"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 )
 
+// DefaultMaxMessageSize is the default maximum size of a message sent
+// to the server. This matches the default gRPC receive limit.
+const DefaultMaxMessageSize = 4 * 1024 * 1024
+
+// messageOverhead is the room we leave in each message for everything
+// other than the input data itself.
+const messageOverhead = 1024
+
+// MaxScriptSize is the maximum size of Client.Script. The server rejects
+// larger scripts.
+const MaxScriptSize = 256 * 1024
+
+// Client runs a command in an instance of a deployment with Run, or
+// forwards ports or copies files with the methods for those. A Client is
+// used for one session at a time. Many Clients may share the connection
+// to the server that Client uses and run at once, such as a bot running
+// many sessions: nothing is changed on the connection, and each session
+// is its own stream. Logger, Metrics and Tracer may be shared too, as may
+// UI if KeepUI is set.
 type Client struct {
 	Logger        hclog.Logger
 	UI            terminal.UI
@@ -29,100 +56,687 @@ type Client struct {
 	Stdin         io.Reader
 	Stdout        io.Writer
 	Stderr        io.Writer
+
+	// InstanceId, if set, is the instance of the deployment to exec into.
+	// Otherwise the server chooses the least loaded instance.
+	InstanceId string
+
+	// KillGracePeriod is how long the remote command is given to exit
+	// after the session ends before it is killed. If zero, the entrypoint
+	// default is used.
+	KillGracePeriod time.Duration
+
+	// Limits are optional resource limits for the remote command. Any
+	// limits the instance can't apply are shown as a warning on Stderr.
+	Limits *pb.ExecStreamRequest_Limits
+
+	// RequirePTY fails the exec if a PTY is requested (because Stdout is a
+	// terminal) but the instance can't allocate one. By default, the
+	// session continues without a PTY.
+	RequirePTY bool
+
+	// TargetContainer runs the command inside the application container
+	// for deployments where the entrypoint runs in a separate container.
+	TargetContainer bool
+
+	// Container runs the command in the named container of the instance,
+	// such as another container of its Kubernetes pod.
+	Container string
+
+	// Task runs the command in the named task of the instance's Nomad
+	// allocation. This is required if the instance runs several tasks.
+	Task string
+
+	// NoInjectEnv, if true, doesn't set the WAYPOINT_* environment
+	// variables describing the deployment and instance for the command.
+	NoInjectEnv bool
+
+	// StatsInterval, if non-zero, requests resource usage of the remote
+	// command at this interval. In PTY mode this is shown in the terminal
+	// title, otherwise it is written to Stderr.
+	StatsInterval time.Duration
+
+	// ForcePTY requests a PTY even if Stdout isn't a terminal. DisablePTY
+	// never requests one. If neither is set, a PTY is requested only if
+	// Stdout is a terminal.
+	ForcePTY   bool
+	DisablePTY bool
+
+	// ReadOnly doesn't send Stdin to the remote command. Without a PTY,
+	// the remote command sees EOF on its stdin.
+	ReadOnly bool
+
+	// Detach starts the command in a session that keeps running after we
+	// disconnect. Run returns as soon as the session is open after showing
+	// its session ID. Stdin is never sent; unless ReadOnly is set, the
+	// command's stdin is left open for clients that attach later. A PTY is
+	// only requested with ForcePTY.
+	Detach bool
+
+	// OrphanPolicy is what the instance does with processes the command
+	// started in the background once the command exits. By default they
+	// are killed.
+	OrphanPolicy pb.ExecStreamRequest_OrphanPolicy
+
+	// SessionId, if set, attaches to this detached session rather than
+	// starting a new command. Its buffered output is shown first, followed
+	// by live output. Whether a PTY is used and Stdin is sent is determined
+	// by how the session was started, though ReadOnly is still honored.
+	SessionId string
+
+	// Watch, with SessionId, watches a session another client is running
+	// rather than attaching to a detached session. Only the session's
+	// output is shown; Stdin is never sent.
+	Watch bool
+
+	// Tracer, if set, traces the session. See Tracer for the spans.
+	Tracer Tracer
+
+	// Metrics, if set, receives the metrics of the session. See
+	// MetricsSink for the metrics.
+	Metrics MetricsSink
+
+	// DebugBundle, if set, records the session for a debug bundle.
+	DebugBundle *DebugBundle
+
+	// ServerAddr is the address of the server Client is connected to, if
+	// known. It is only used in error messages.
+	ServerAddr string
+
+	// Script, if set, is run in place of a command from the image. Args
+	// are then the arguments to the script rather than a command line.
+	// Interpreter is the command line used to run it; if empty, a script
+	// starting with "#!" is executed directly and others use /bin/sh.
+	Script      []byte
+	Interpreter string
+
+	// FollowSymlinks makes CopyTo copy the files that symlinks point to
+	// rather than the symlinks themselves.
+	FollowSymlinks bool
+
+	// Resume makes CopyTo and CopyFrom continue an interrupted copy of a
+	// single file, only copying what isn't already at the destination.
+	Resume bool
+
+	// ForwardTargetAddr, if set, is the IP on the instance that PortForward
+	// and PortForwardUDP connect to instead of the loopback address.
+	// ForwardFamily is the address family the instance tries first when
+	// connecting to the loopback address, or to a name with
+	// PortForwardSOCKS. The other family is tried if that fails.
+	ForwardTargetAddr string
+	ForwardFamily     pb.ExecStreamRequest_PortForward_Family
+
+	// ForwardStatusInterval, if non-zero, shows a status line for port
+	// forwarding at this interval: the open connections, the totals so far
+	// and the last error. The status line is also shown on SIGUSR1. It is
+	// written to Stderr if set, so that it doesn't mix with data on Stdout.
+	ForwardStatusInterval time.Duration
+
+	// Drain, if set, starts a graceful shutdown of port forwarding once it
+	// is closed. No more connections are accepted, and those still open
+	// have up to DrainTimeout to end, with a countdown shown meanwhile.
+	// Forwarding then ends and returns nil if they all did, or
+	// ErrShutdownForced if the timeout passed or Context was cancelled.
+	Drain        <-chan struct{}
+	DrainTimeout time.Duration
+
+	// Sinks receive the output of the remote command in addition to
+	// Stdout and Stderr. NoMirror doesn't write the output of channels
+	// that have a sink to Stdout or Stderr. Messages such as warnings are
+	// always written to Stderr.
+	Sinks    []*Sink
+	NoMirror bool
+
+	// ColorStderr, if true, colors the output of the remote command on
+	// stderr red when Stdout and Stderr write to the same place, such as
+	// a terminal. Output on both channels then goes to Stdout in the order
+	// it arrives, except that a line of one channel is never torn by
+	// output of the other.
+	ColorStderr bool
+
+	// Summary, if true, writes a summary of how the remote command exited
+	// and the resources it used to Stderr once it exits.
+	Summary bool
+
+	// PingInterval, if non-zero, pings the instance at this interval to
+	// measure the round trip time of the session. The round trips are
+	// reported to Metrics and included in the Summary. Pings are only sent
+	// if the server and the instance support them, so sessions that attach
+	// to or watch another session don't ping.
+	PingInterval time.Duration
+
+	// LatencyTitle, if set with PingInterval and a PTY, sets the terminal
+	// title to this followed by the current round trip time, such as
+	// "myapp v12 · 48ms".
+	LatencyTitle string
+
+	// SpillThreshold, if non-zero, keeps the session responsive when output
+	// comes in faster than Stdout can take it, such as when cat-ing a huge
+	// file. Once this many bytes are waiting to be written, further output
+	// is written to a temporary file instead until the "~o" escape is
+	// typed, which shows the end of the file and resumes the live output.
+	// The path of the file is shown when the session ends. This also
+	// enables the "~p" escape, which pauses and unpauses the live output.
+	// While paused, output is held and spilled past this threshold.
+	SpillThreshold int64
+
+	// ConnectTimeout, if non-zero, is how long we wait for the session to
+	// open, from creating the stream to receiving the open event. Unlike a
+	// deadline on Context, this doesn't limit the session once it opens.
+	ConnectTimeout time.Duration
+
+	// MaxOutput, if non-zero, limits the output of the command written to
+	// Stdout, Stderr and Sinks to this many bytes, not counting ANSI escape
+	// sequences such as colors. Further output is dropped, and once the
+	// session ends a notice on Stderr gives how much output there was. With
+	// MaxOutputAbort, the command is instead sent SIGTERM as soon as the
+	// limit is exceeded and the session ends with ErrMaxOutput.
+	MaxOutput      int64
+	MaxOutputAbort bool
+
+	// Strict, if true, fails the session if output events from the
+	// instance are lost or arrive out of order. Otherwise these are
+	// logged, counted in Metrics and included in the Summary.
+	Strict bool
+
+	// CloseStdin, if true, closes the stdin of the command once Stdin
+	// reaches EOF, such as at the end of a file, so that the command sees
+	// the end of its input. If reading Stdin fails, the command is sent
+	// SIGTERM rather than seeing what was read as all of its input.
+	// Instances that don't support closing stdin are warned about. Escape
+	// sequences aren't handled since Stdin is data rather than typed.
+	CloseStdin bool
+
+	// StdinSize, if non-zero, is the size of Stdin, such as of the file it
+	// reads. The progress of sending it is shown on Stderr if that is a
+	// terminal.
+	StdinSize int64
+
+	// ConsoleKeyMap overrides the sequences sent for special keys typed in
+	// a Windows console without VT input, such as {"home": "\x1b[1~"} for
+	// remote programs expecting the VT220 keys rather than xterm's. Keys
+	// are named "up", "down", "left", "right", "home", "end", "insert",
+	// "delete", "pageup", "pagedown", "backspace" and "f1" to "f12", and
+	// only unmodified keys are overridden.
+	ConsoleKeyMap map[string]string
+
+	// Env are environment variables as KEY=VALUE to set for the command,
+	// overriding those of the instance and those the server injects.
+	Env []string
+
+	// Sequence are commands to run after Args, one after another in the
+	// same session on the same instance. The next command only runs once
+	// the previous one exited with zero, unless KeepGoing is set. Run
+	// returns the exit of the last command that ran and Hooks.CommandExit
+	// is called for each. Instances that can't run a sequence only run
+	// Args, which is warned about. This can't be used with Script.
+	Sequence  [][]string
+	KeepGoing bool
+
+	// MirrorToLogs also writes the output of the command to the logs of
+	// the app, tagged with the session ID, such as to keep a record of
+	// maintenance. Instances that don't support this ignore it.
+	MirrorToLogs bool
+
+	// Quiet, if true, doesn't show warnings that are only advice, such as
+	// that the locale of the command can't show non-ASCII characters.
+	Quiet bool
+
+	// LocalLocale is the locale of our terminal, used to warn if the
+	// command runs with a PTY in a locale that can't show the characters
+	// ours can. If empty, it is read from LC_ALL, LC_CTYPE and LANG.
+	LocalLocale string
+
+	// KeepUI, if true, doesn't close UI once the session starts, which is
+	// otherwise done so that its output doesn't mix with the command's.
+	// Set this if UI is shared by other sessions or is still used after
+	// Run returns.
+	KeepUI bool
+
+	// Hooks are called as the session of Run progresses.
+	Hooks Hooks
+
+	// PhaseCallback, if set, is called with each phase Run goes through
+	// as it connects the session, such as to show progress in a GUI. The
+	// status of UI then isn't shown, nor is the message once the command
+	// runs. It is called like Hooks.
+	PhaseCallback func(Phase)
+
+	// Attempt is the number of this attempt at the session out of
+	// Attempts, for callers that retry Run. If Attempts is more than one,
+	// the attempt is shown while the session connects.
+	Attempt, Attempts int
+
+	// MaxMessageSize is the maximum size of a message sent to the server.
+	// Stdin is chunked so that each message fits within this. If zero,
+	// DefaultMaxMessageSize is used.
+	MaxMessageSize int
+
+	// ReadChunkSize is the most Stdin read and sent at a time. Larger
+	// chunks are faster over fast links, while smaller ones keep sessions
+	// over slow links interactive. If zero, DefaultReadChunkSize is used.
+	// Sizes outside of MinChunkSize and MaxChunkSize are bounded to them.
+	ReadChunkSize int
+
+	// OutputChunkSize, if set, asks the instance to read and send at most
+	// this much output at a time. It is bounded like ReadChunkSize, and
+	// the instance may bound it further. Instances that don't support
+	// this use their default.
+	OutputChunkSize int
+
+	// noEscape doesn't handle escape sequences in Stdin. A Broadcast
+	// handles them itself before input gets to its sessions.
+	noEscape bool
+
+	// The terminal operations on Stdin and Stdout. These are replaced in
+	// tests; if nil, the real terminal is used.
+	terminalDetector terminalDetector
+	rawModer         rawModer
+	consoleSizer     consoleSizer
+
+	// output queues the writes to Stdout and Stderr while Run is
+	// executing if SpillThreshold is set. It is only used by the goroutine
+	// running Run.
+	output *outputQueue
+
+	// interleave orders the output of both channels while Run is
+	// executing if Stdout and Stderr write to the same place.
+	interleave *lineInterleaver
+
+	// outputLimit counts the output while Run is executing if MaxOutput
+	// is set. It is only used by the goroutine running Run.
+	outputLimit *outputLimit
+
+	// rawRestore restores our terminal while Run is executing if it put
+	// the terminal into raw mode. See restoreTerminal.
+	rawRestore func() error
+
+	// stream is the active exec stream while Run is executing.
+	streamLock sync.Mutex
+	stream     *syncStream
+
+	// state is returned by SessionState.
+	stateLock sync.Mutex
+	state     SessionState
+}
+
+// Hooks are functions called as a session progresses, such as to report
+// its progress elsewhere. They are called from the goroutine running Run
+// so they should return quickly. Any of them may be nil.
+type Hooks struct {
+	// Open is called with the ID of the session once the server opens it.
+	Open func(sessionId string)
+
+	// Attached is called with the ID of the instance running the command
+	// once it attaches to the session.
+	Attached func(instanceId string)
+
+	// CommandExit is called as each command of a Sequence exits, with its
+	// index: 0 for Args and 1 onwards for the commands of the Sequence.
+	CommandExit func(index int, exit *pb.ExecStreamResponse_Exit)
+
+	// Error is called with the error that Run returns if the session
+	// failed or was canceled, such as to show it, once our terminal is out
+	// of raw mode. It isn't called if the command exits, even
+	// unsuccessfully.
+	Error func(err error)
 }
 
+// Run runs the command and returns its exit code once it exits. If the
+// command exits unsuccessfully, the error is an *ExitError describing how.
+//
+// The exit code is only that of the command if it exited. Otherwise it is
+// zero and the error says why the session ended: cancellation, by Context
+// or the "~." escape, returns an error wrapping the error of Context or
+// context.Canceled. Other errors are a *SessionError naming the session,
+// and common errors from the server within it are a *StatusError
+// explaining them. Errors of the server or instance breaking the exec
+// protocol wrap ErrProtocol.
 func (c *Client) Run() (int, error) {
+	c.setDefaults()
+
+	// We choose the ID of a new session so that it is in our logs and
+	// errors from the start, and the server and entrypoint log it too.
+	sessionId := c.SessionId
+	if sessionId == "" {
+		var err error
+		sessionId, err = newSessionId()
+		if err != nil {
+			return 0, err
+		}
+	}
+	log := c.Logger.With("exec_session_id", sessionId)
+	log.Debug("starting exec session", "deployment_seq", c.DeploymentSeq)
+
+	debugEnd := c.DebugBundle.begin(c, sessionId)
+	trace := c.startTrace()
+
+	// However the session ends, even by panicking, our terminal leaves raw
+	// mode before anything else happens so that what is shown next, such
+	// as the error, isn't garbled.
+	defer c.restoreTerminal()
+	code, err := c.run(trace, log, sessionId)
+	c.restoreTerminal()
+
+	err = c.translateError(err, false)
+	trace.End(code, err)
+	debugEnd(code, err)
+	err = sessionError(sessionId, err)
+
+	var exitErr *ExitError
+	if err != nil && !errors.As(err, &exitErr) && c.Hooks.Error != nil {
+		c.Hooks.Error(err)
+	}
+
+	return code, err
+}
+
+// restoreTerminal restores our terminal if run put it into raw mode. This
+// may be called more than once, only the first call restores it.
+func (c *Client) restoreTerminal() {
+	restore := c.rawRestore
+	if restore == nil {
+		return
+	}
+
+	c.rawRestore = nil
+	if err := restore(); err != nil {
+		c.Logger.Warn("error restoring the terminal", "err", err)
+	}
+}
+
+func (c *Client) run(trace *execTrace, log hclog.Logger, sessionId string) (int, error) {
+	trace.Phase("resolve target")
+	if n := len(c.Script); n > MaxScriptSize {
+		return 0, fmt.Errorf(
+			"script is %d bytes, the maximum size is %d bytes", n, MaxScriptSize)
+	}
+	if len(c.Sequence) > 0 && len(c.Script) > 0 {
+		return 0, fmt.Errorf("a sequence of commands can't be used with a script")
+	}
+
 	// Determine if we should allocate a pty. If we should, we need to send
 	// along a TERM value to the remote end that matches our own.
+	// ptyF is our terminal, if Stdout is one, and pty is whether the
+	// session uses a PTY.
 	var ptyReq *pb.ExecStreamRequest_PTY
 	var ptyF *os.File
-	var status terminal.Status
+	var sizeDefaulted bool
+	phases := &phaseReporter{f: c.PhaseCallback}
+	if c.SessionId == "" {
+		phases.last.DeploymentSeq = c.DeploymentSeq
+	}
 
-	if f, ok := c.Stdout.(*os.File); ok && sshterm.IsTerminal(int(f.Fd())) {
-		status = c.UI.Status()
-		defer status.Close()
-		status.Update(fmt.Sprintf("Connecting to deployment v%d...", c.DeploymentSeq))
+	if f, ok := c.isTerminal(c.Stdout); ok {
+		// Without a callback we show the phases on our UI.
+		if phases.f == nil {
+			var closeStatus func()
+			phases.f, closeStatus = c.uiPhases()
+			defer closeStatus()
+		}
 
 		ptyF = f
-		c, err := console.ConsoleFromFile(ptyF)
-		if err != nil {
-			return 0, err
-		}
+	}
 
-		sz, err := c.Size()
-		c = nil
-		if err != nil {
-			return 0, err
-		}
+	if c.SessionId != "" && c.Watch {
+		phases.Report(StageConnecting, fmt.Sprintf("Watching session %s...", c.SessionId))
+	} else if c.SessionId != "" {
+		phases.Report(StageConnecting, fmt.Sprintf("Attaching to session %s...", c.SessionId))
+	} else {
+		phases.Report(StageConnecting, fmt.Sprintf("Connecting to deployment v%d...", c.DeploymentSeq))
+	}
 
+	// When attaching, the session already decided if it has a PTY so we
+	// learn that from the open message below.
+	pty := c.SessionId == "" && c.wantPTY(ptyF != nil)
+	readOnly := c.ReadOnly || c.Watch
+	if pty {
 		ptyReq = &pb.ExecStreamRequest_PTY{
-			Enable: true,
-			Term:   os.Getenv("TERM"),
-			WindowSize: &pb.ExecStreamRequest_WindowSize{
-				Rows:   int32(sz.Height),
-				Cols:   int32(sz.Width),
-				Height: int32(sz.Height),
-				Width:  int32(sz.Width),
-			},
+			Enable:  true,
+			Term:    os.Getenv("TERM"),
+			Require: c.RequirePTY,
+		}
+
+		// We can only determine the window size if we have a terminal.
+		// If it reports an invalid size, the PTY starts with the default
+		// size and gets ours once it is valid.
+		if ptyF != nil {
+			size, err := c.windowSize(ptyF)
+			var invalid *invalidWindowSizeError
+			if errors.As(err, &invalid) {
+				log.Warn("terminal reports an invalid size, using the default size until it is valid",
+					"rows", invalid.Rows, "cols", invalid.Cols,
+					"default_rows", defaultRows, "default_cols", defaultCols)
+				size, err = newWindowSize(defaultRows, defaultCols), nil
+				sizeDefaulted = true
+			}
+			if err != nil {
+				return 0, err
+			}
+
+			ptyReq.WindowSize = size
 		}
 	}
+	c.updateState(func(s *SessionState) {
+		*s = SessionState{PTY: pty, WindowSize: ptyReq.GetWindowSize()}
+	})
+
+	// The connect timeout only applies until the session opens, so rather
+	// than a deadline on the stream, we cancel the stream if it expires.
+	streamCtx, streamCancel := context.WithCancel(trace.StreamContext())
+	defer streamCancel()
+	connect := c.startConnectTimer(streamCancel)
+	defer connect.Stop()
 
 	// Start our exec stream
-	client, err := c.Client.StartExecStream(c.Context)
+	rawClient, err := c.Client.StartExecStream(streamCtx)
 	if err != nil {
-		return 0, err
+		return 0, connect.Err(c.translateError(err, true))
 	}
 
+	client := &syncStream{Waypoint_StartExecStreamClient: rawClient, debug: c.DebugBundle}
 	defer client.CloseSend()
 
-	if status != nil {
-		status.Update("Initializing session...")
+	// Make the stream available for Signal
+	c.setStream(client)
+	defer c.setStream(nil)
+
+	phases.Report(StageInitializing, "Initializing session...")
+
+	var grace string
+	if c.KillGracePeriod > 0 {
+		grace = c.KillGracePeriod.String()
 	}
 
-	// Send the start event
-	if err := client.Send(&pb.ExecStreamRequest{
+	var statsInterval string
+	if c.StatsInterval > 0 {
+		statsInterval = c.StatsInterval.String()
+	}
+
+	// Send the start event, or the attach event if we're attaching.
+	startReq := &pb.ExecStreamRequest{
 		Event: &pb.ExecStreamRequest_Start_{
 			Start: &pb.ExecStreamRequest_Start{
-				DeploymentId: c.DeploymentId,
-				Args:         c.Args,
-				Pty:          ptyReq,
+				DeploymentId:       c.DeploymentId,
+				Args:               c.Args,
+				Pty:                ptyReq,
+				KillGracePeriod:    grace,
+				Limits:             c.Limits,
+				TargetContainer:    c.TargetContainer,
+				StatsInterval:      statsInterval,
+				OrphanPolicy:       c.OrphanPolicy,
+				NoStdin:            c.ReadOnly,
+				InstanceId:         c.InstanceId,
+				Detach:             c.Detach,
+				Script:             c.Script,
+				Interpreter:        c.Interpreter,
+				Container:          c.Container,
+				Task:               c.Task,
+				NoInjectEnv:        c.NoInjectEnv,
+				SessionId:          sessionId,
+				ClientCapabilities: clientCapabilities,
+				Env:                c.Env,
+				Sequence:           sequenceCommands(c.Sequence),
+				KeepGoing:          c.KeepGoing,
+				MirrorToLogs:       c.MirrorToLogs,
+				OutputChunkSize:    uint32(chunkSize(c.OutputChunkSize, 0)),
 			},
 		},
-	}); err != nil {
-		return 0, err
 	}
-
-	if status != nil {
-		status.Update("Waiting for instance assignment...")
+	if c.SessionId != "" {
+		startReq = &pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Attach_{
+				Attach: &pb.ExecStreamRequest_Attach{
+					SessionId:          c.SessionId,
+					ClientCapabilities: clientCapabilities,
+				},
+			},
+		}
+		if c.Watch {
+			startReq = &pb.ExecStreamRequest{
+				Event: &pb.ExecStreamRequest_Watch_{
+					Watch: &pb.ExecStreamRequest_Watch{
+						SessionId:          c.SessionId,
+						ClientCapabilities: clientCapabilities,
+					},
+				},
+			}
+		}
+	}
+	if err := client.Send(startReq); err != nil {
+		return 0, connect.Err(c.translateError(err, true))
 	}
 
-	// Receive our open message. If this fails then we weren't assigned.
-	resp, err := client.Recv()
+	phases.Report(StageWaiting, "Waiting for instance assignment...")
+	trace.Phase("wait for assignment")
+
+	// Receive our open message, showing the status the server reports
+	// until then. If this fails then we weren't assigned.
+	var lastStatus string
+	resp, err := c.recvStatus(log, client, phases, &lastStatus)
 	if err != nil {
-		return 1, err
+		return 0, waitError(connect.Err(c.translateError(err, true)), lastStatus)
+	}
+	if !connect.Stop() {
+		// The timer expired just as the session opened, so the stream is
+		// being canceled.
+		return 0, waitError(connect.Err(nil), lastStatus)
 	}
-	if _, ok := resp.Event.(*pb.ExecStreamResponse_Open_); !ok {
-		return 1, fmt.Errorf("internal protocol error: unexpected opening message")
+	open, ok := resp.Event.(*pb.ExecStreamResponse_Open_)
+	if !ok {
+		return 0, fmt.Errorf("%w: unexpected opening message", ErrProtocol)
 	}
+	trace.Assigned()
+	phases.last.SessionId = open.Open.SessionId
 
-	if ptyF != nil {
-		status.Close()
-		c.UI.Output("Connected to deployment v%d", c.DeploymentSeq, terminal.WithSuccessStyle())
+	// A detached session is running on its own now so we're done.
+	if c.Detach {
+		phases.Report(StageDetached, fmt.Sprintf("Started detached session %s on deployment v%d",
+			open.Open.SessionId, c.DeploymentSeq))
+		c.uiOutput("Started detached session %s on deployment v%d",
+			open.Open.SessionId, c.DeploymentSeq, terminal.WithSuccessStyle())
+		c.uiOutput("Attach to it with \"waypoint exec attach %s\"", open.Open.SessionId)
+		return 0, nil
 	}
 
+	trace.SetAttribute(traceAttrSessionId, open.Open.SessionId)
+	phases.Report(StageOpen, fmt.Sprintf("Opened session %s", open.Open.SessionId))
+	if c.Hooks.Open != nil {
+		c.Hooks.Open(open.Open.SessionId)
+	}
+
+	// The optional features we use are those that we, the server and the
+	// instance all support, which we know once the instance attaches.
+	// Sessions we attach to or watch weren't started by us so the server
+	// announces no capabilities for them.
+	var caps capabilities
+	negotiate := func(raw bool, attached *pb.ExecStreamResponse_Attached) {
+		caps = negotiateCapabilities(clientCapabilities,
+			open.Open.ServerCapabilities, attached.InstanceCapabilities)
+		log.Debug("negotiated exec capabilities", "capabilities", caps.List())
+		if len(c.Sequence) > 0 && c.SessionId == "" && !caps.Has(capabilitySequence) {
+			c.printWarning(raw, "the instance can't run a sequence of commands, "+
+				"only the first command runs")
+		}
+		c.updateState(func(s *SessionState) {
+			s.InstanceId = attached.InstanceId
+			s.PTY = pty
+			s.Capabilities = caps.List()
+		})
+	}
+
+	if c.SessionId != "" {
+		pty = open.Open.Pty
+		readOnly = readOnly || open.Open.NoStdin
+	}
+	c.updateState(func(s *SessionState) {
+		s.SessionId = open.Open.SessionId
+		s.PTY = pty
+	})
+
+	// If we requested a PTY, wait for the instance to attach so that we
+	// know if we got one before we put our terminal into raw mode. When
+	// attaching, the instance attached long ago and we'll see that event
+	// in the replayed output. Older servers don't send the attached event,
+	// so if they don't announce it or something else comes first, we
+	// assume we got a PTY and handle that event in the loop below.
+	var attached *pb.ExecStreamResponse_Attached
+	var pending *pb.ExecStreamResponse
+	if pty && c.SessionId == "" &&
+		hasCapability(open.Open.ServerCapabilities, capabilityAttached) {
+		phases.Report(StageAttaching, "Waiting for instance to attach...")
+		resp, err := c.recvStatus(log, client, phases, &lastStatus)
+		if err != nil {
+			return 0, waitError(err, lastStatus)
+		}
+
+		if event, ok := resp.Event.(*pb.ExecStreamResponse_Attached_); ok {
+			attached = event.Attached
+			lastStatus = ""
+			phases.Attached(attached.InstanceId)
+			if attached.PtyUnavailable {
+				pty = false
+			}
+		} else {
+			log.Debug("expected attached event", "event", fmt.Sprintf("%T", resp.Event))
+			pending = resp
+		}
+	}
+
+	if c.SessionId != "" && c.Watch {
+		phases.Report(StageRunning, fmt.Sprintf(
+			"Watching session %s, your input isn't sent to it", c.SessionId))
+	} else if c.SessionId != "" {
+		phases.Report(StageRunning, fmt.Sprintf("Attached to session %s", c.SessionId))
+	} else {
+		phases.Report(StageRunning, fmt.Sprintf("Connected to deployment v%d, session %s",
+			c.DeploymentSeq, open.Open.SessionId))
+	}
+
+	if attached != nil {
+		trace.SetAttribute(traceAttrInstanceId, attached.InstanceId)
+		c.handleAttached(log, false, attached)
+		negotiate(false, attached)
+	}
+	trace.SetAttribute(traceAttrPTY, pty)
+	trace.Phase("session")
+
 	// Close our UI if we can
-	if closer, ok := c.UI.(io.Closer); ok {
+	if closer, ok := c.UI.(io.Closer); ok && !c.KeepUI {
 		closer.Close()
 	}
 
-	if ptyF != nil {
-		// We need to go into raw mode with stdin
-		if f, ok := c.Stdin.(*os.File); ok {
-			oldState, err := sshterm.MakeRaw(int(f.Fd()))
+	if pty {
+		// We need to go into raw mode with stdin. If we aren't sending
+		// input we leave the terminal alone so Ctrl-C still works locally.
+		if f, ok := c.Stdin.(*os.File); ok && !readOnly {
+			restore, err := c.makeRaw(f)
 			if err != nil {
 				return 0, err
 			}
-			defer sshterm.Restore(int(f.Fd()), oldState)
+
+			// Run restores the terminal as soon as we return.
+			c.rawRestore = restore
 		}
 
 		fmt.Fprintf(c.Stdout, "\r")
@@ -133,44 +747,198 @@ func (c *Client) Run() (int, error) {
 	ctx, cancel := context.WithCancel(c.Context)
 	defer cancel()
 
-	input := &EscapeWatcher{Cancel: cancel, Input: c.Stdin}
+	// Output goes through a queue that can spill to a file if asked, so
+	// that a burst of output doesn't hold up the session.
+	var output *outputQueue
+	if c.SpillThreshold > 0 {
+		output = newOutputQueue(c.SpillThreshold, func(path string, err error) {
+			if err != nil {
+				c.printWarning(pty, "output is backing up but can't be spilled to a file: "+err.Error())
+				return
+			}
+
+			c.printWarning(pty, fmt.Sprintf(
+				"output exceeds %s, spilling to %s; press Enter and type ~o to resume the live view",
+				humanize.Bytes(uint64(c.SpillThreshold)), path))
+		})
+		c.output = output
+		defer c.closeOutput(pty)
+	}
+
+	// If stdout and stderr end up in the same place, we write the output
+	// of both a line at a time so that they don't tear each other's lines.
+	if sameWriter(c.Stdout, c.Stderr) {
+		c.interleave = &lineInterleaver{Color: c.ColorStderr}
+		defer c.flushInterleaved()
+	}
+
+	if c.MaxOutput > 0 {
+		c.outputLimit = &outputLimit{Max: c.MaxOutput}
+		defer func() { c.outputLimit = nil }()
+	}
+
+	// Legacy Windows consoles report special keys such as the arrows as
+	// input records rather than bytes, so we translate them to the VT
+	// sequences that the remote PTY expects.
+	var input io.Reader = c.Stdin
+	if f, ok := c.Stdin.(*os.File); ok && pty && !readOnly {
+		if r := consoleInput(f, c.ConsoleKeyMap); r != nil {
+			input = r
+		}
+	}
+	if !c.noEscape && !c.CloseStdin {
+		handlers := map[byte]escapewatcher.Handler{
+			'.': {Func: cancel, Forward: true},
+		}
+		if pty {
+			// We only allow the signal escape in PTY mode since we're in raw
+			// mode and can echo the signal name as it is typed.
+			handlers['s'] = escapewatcher.Handler{
+				Prompt: "signal: ",
+				Line: func(name string) {
+					if err := c.Signal(name); err != nil {
+						log.Warn("error sending signal", "signal", name, "err", err)
+					}
+				},
+			}
+		}
+
+		if output != nil {
+			handlers['o'] = escapewatcher.Handler{Func: func() {
+				output.Resume(c.Stdout, []byte(stderrLine(pty, fmt.Sprintf(
+					"waypoint: resuming the live view after the last %s of spilled output",
+					humanize.Bytes(spillTailSize)))))
+			}}
+
+			// Pausing only holds the output locally. We keep receiving it,
+			// spilling past SpillThreshold, so that the instance isn't held
+			// up and input such as Ctrl-C still gets to the command.
+			handlers['p'] = escapewatcher.Handler{Func: func() {
+				if pending, ok := output.Pause(); ok {
+					c.printWarning(pty, fmt.Sprintf(
+						"output paused with %s waiting to be shown; press Enter and type ~p to resume",
+						humanize.Bytes(uint64(pending))))
+					return
+				}
+
+				c.printWarning(pty, "output resumed")
+				held, spilling := output.Unpause()
+				if spilling {
+					output.Resume(c.Stdout, []byte(stderrLine(pty, fmt.Sprintf(
+						"waypoint: %s of output came in while paused, showing the last %s of it",
+						humanize.Bytes(uint64(held)), humanize.Bytes(spillTailSize)))))
+				}
+			}}
+		}
+
+		ew := escapewatcher.New(input, handlers)
+		if pty {
+			// Enter sends a carriage return in raw mode.
+			ew.Echo = c.Stdout
+			ew.CarriageReturn = true
+		}
+		input = ew
+	}
 
 	// Build our connection. We only build the stdin sending side because
 	// we can receive other message types from our recv.
-	go io.Copy(&grpc_net_conn.Conn{
-		Stream:  client,
-		Request: &pb.ExecStreamRequest{},
-		Encode: grpc_net_conn.SimpleEncoder(func(msg proto.Message) *[]byte {
-			req := msg.(*pb.ExecStreamRequest)
-			if req.Event == nil {
-				req.Event = &pb.ExecStreamRequest_Input_{
-					Input: &pb.ExecStreamRequest_Input{},
-				}
-			}
+	// If we close stdin, stdinErrCh receives the error reading it, or nil
+	// once it reaches EOF.
+	var stdinErrCh chan error
+	var stdinW *stdinWriter
+	if !readOnly {
+		input, stopProgress := c.showStdinProgress(ctx, input)
+		if c.CloseStdin {
+			stdinErrCh = make(chan error, 1)
+		}
 
-			return &req.Event.(*pb.ExecStreamRequest_Input_).Input.Data
-		}),
-	}, input)
+		chunk := chunkSize(c.ReadChunkSize, DefaultReadChunkSize)
+		stdinW = &stdinWriter{ctx: ctx, w: trace.Input(c.inputWriter(client, chunk))}
+		go func() {
+			eof, err := copyStdin(ctx, stdinW, input, chunk)
+			stopProgress()
+			if stdinErrCh != nil && (eof || err != nil) {
+				stdinErrCh <- err
+			}
+		}()
+	}
 
-	// Add our recv blocker that sends data
+	// Add our recv blocker that sends data. Once the stream ends, we send
+	// along why so that Run can return it.
 	recvCh := make(chan *pb.ExecStreamResponse)
+	recvErrCh := make(chan error, 1)
 	go func() {
 		defer cancel()
+
+		// An event that came while we waited for the attached event is
+		// delivered first.
+		if pending != nil {
+			select {
+			case recvCh <- pending:
+			case <-ctx.Done():
+				return
+			}
+		}
+
 		for {
 			resp, err := client.Recv()
 			if err != nil {
-				c.Logger.Error("receive error", "err", err)
+				log.Error("receive error", "err", err)
+				recvErrCh <- err
 				return
 			}
 
-			recvCh <- resp
+			// We stop delivering once Run is returning, such as after an
+			// escape, so that this goroutine doesn't block forever.
+			select {
+			case recvCh <- resp:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 
-	// Listen for window change events
-	winchCh := make(chan os.Signal, 1)
-	registerSigwinch(winchCh)
-	defer signal.Stop(winchCh)
+	// Listen for window change events. These only matter if the PTY of
+	// the session follows the size of our terminal, so without one we
+	// don't listen at all and winchCh is nil so that it never fires.
+	var winchCh chan os.Signal
+	var sizeCheckCh <-chan time.Time
+	if pty && ptyF != nil {
+		winchCh = make(chan os.Signal, 1)
+		registerSigwinch(winchCh)
+		defer signal.Stop(winchCh)
+
+		// The session may have been sized for another terminal, so when
+		// attaching we send our size right away.
+		if c.SessionId != "" {
+			c.sendWindowSize(client, ptyF)
+		}
+
+		// If the session started with the default size, we check our size
+		// until it is valid to send it, since a window that is still
+		// opening may not signal the change. sizeCheckCh is nil otherwise.
+		if sizeDefaulted {
+			ticker := time.NewTicker(windowSizeCheckInterval)
+			defer ticker.Stop()
+			sizeCheckCh = ticker.C
+		}
+	}
+
+	// Ping the instance if we measure the round trip time and everyone
+	// supports it. pingCh is nil otherwise so that it never fires.
+	var rtt rttEstimator
+	var pingCh <-chan time.Time
+	if c.PingInterval > 0 {
+		ticker := time.NewTicker(c.PingInterval)
+		defer ticker.Stop()
+		pingCh = ticker.C
+		if caps.Has(capabilityPing) {
+			c.sendPing(client, &rtt)
+		}
+	}
+	var titleStats string
+	var seq outputSequence
+	var stdinEOF bool
 
 	// Loop for data
 	for {
@@ -178,47 +946,617 @@ func (c *Client) Run() (int, error) {
 		case resp := <-recvCh:
 			switch event := resp.Event.(type) {
 			case *pb.ExecStreamResponse_Output_:
-				// TODO: stderr
-				out := c.Stdout
-				io.Copy(out, bytes.NewReader(event.Output.Data))
+				trace.Output(event.Output.Data)
+				if lost, err := seq.Check(event.Output); err != nil {
+					log.Warn("output sequence error", "err", err)
+					trace.OutputGap(lost)
+					if c.Strict {
+						return 0, fmt.Errorf("strict mode: %s", err)
+					}
+				}
+
+				c.writeOutput(pty, event.Output)
+				if c.MaxOutputAbort && c.outputLimit.Exceeded() {
+					log.Info("output limit exceeded, stopping the command")
+					client.Send(&pb.ExecStreamRequest{
+						Event: &pb.ExecStreamRequest_Signal_{
+							Signal: &pb.ExecStreamRequest_Signal{Name: "TERM"},
+						},
+					})
+					c.printWarning(pty, fmt.Sprintf(
+						"output exceeded the limit of %s, stopping the command",
+						humanize.Bytes(uint64(c.MaxOutput))))
+					return 0, ErrMaxOutput
+				}
+
+			case *pb.ExecStreamResponse_Status_:
+				// We only show the status while waiting before the loop,
+				// but keep it in case the session fails before attaching.
+				log.Debug("session status", "status", event.Status.Message)
+				lastStatus = event.Status.Message
+
+			case *pb.ExecStreamResponse_Attached_:
+				lastStatus = ""
+				trace.SetAttribute(traceAttrInstanceId, event.Attached.InstanceId)
+				phases.Attached(event.Attached.InstanceId)
+				c.handleAttached(log, pty, event.Attached)
+				negotiate(pty, event.Attached)
+				if pingCh != nil && caps.Has(capabilityPing) {
+					c.sendPing(client, &rtt)
+				}
+				if stdinEOF {
+					c.sendStdinEOF(pty, client, caps)
+				}
+
+			case *pb.ExecStreamResponse_Warning_:
+				c.printWarning(pty, event.Warning.Message)
+
+			case *pb.ExecStreamResponse_Replayed_:
+				msg := "--- end of buffered output, now live ---"
+				if v := event.Replayed.DroppedBytes; v > 0 {
+					msg = fmt.Sprintf(
+						"--- end of buffered output (%s of older output discarded), now live ---",
+						humanize.Bytes(uint64(v)))
+				}
+				c.printWarning(pty, msg)
+
+			case *pb.ExecStreamResponse_Watcher_:
+				c.printWarning(pty, formatWatcher(event.Watcher))
+
+			case *pb.ExecStreamResponse_Stats_:
+				msg := formatStats(event.Stats)
+				if pty {
+					// Set the terminal title so we don't interfere with
+					// the output of the command.
+					titleStats = msg
+					c.writeTitle(titleStats, &rtt)
+				} else {
+					c.printWarning(false, msg)
+				}
+
+			case *pb.ExecStreamResponse_Pong_:
+				d, ok := rtt.Pong(event.Pong.Id)
+				if !ok {
+					continue
+				}
+
+				trace.RoundTrip(d)
+				if pty && c.LatencyTitle != "" {
+					c.writeTitle(titleStats, &rtt)
+				}
+
+			case *pb.ExecStreamResponse_CommandExit_:
+				if v := event.CommandExit.Exit.GetStartError(); v != nil {
+					c.printStderr(pty, startErrorMessage(v))
+				}
+				if c.Hooks.CommandExit != nil {
+					c.Hooks.CommandExit(int(event.CommandExit.Index), event.CommandExit.Exit)
+				}
 
 			case *pb.ExecStreamResponse_Exit_:
-				return int(event.Exit.Code), nil
+				if v := event.Exit.StartError; v != nil {
+					c.printStderr(pty, startErrorMessage(v))
+				}
+				if c.outputLimit.Exceeded() {
+					c.printWarning(pty, fmt.Sprintf(
+						"output was truncated at %s, the command wrote %s in total",
+						humanize.Bytes(uint64(c.MaxOutput)),
+						humanize.Bytes(uint64(c.outputLimit.Seen()))))
+				}
+
+				if c.Summary {
+					msg := formatExitSummary(event.Exit)
+					if v := rtt.Summary(); v != "" {
+						msg += ", " + v
+					}
+					if v := seq.Summary(); v != "" {
+						msg += ", " + v
+					}
+
+					c.printWarning(pty, msg)
+				}
+
+				// Stop sending stdin, discarding what's left of it. If a
+				// send is blocked since the server no longer reads the
+				// stream, it would hold up closing the stream, so we abort
+				// the stream instead.
+				cancel()
+				if stdinW != nil && stdinW.Busy() {
+					streamCancel()
+				}
+
+				return int(event.Exit.Code), exitError(event.Exit)
+
+			case *pb.ExecStreamResponse_Closed_:
+				// The stream ends right after this, so like on exit we stop
+				// sending stdin.
+				cancel()
+				if stdinW != nil && stdinW.Busy() {
+					streamCancel()
+				}
+
+				return 0, closedError(log, event.Closed)
 
 			default:
-				c.Logger.Warn("unknown event type",
+				log.Warn("unknown event type",
 					"type", fmt.Sprintf("%T", resp.Event))
 			}
 
-		case <-winchCh:
-			// Window change, send new size
-			c, err := console.ConsoleFromFile(ptyF)
+		case err := <-stdinErrCh:
+			// We only close stdin once, after the instance attaches so
+			// that we know if it can.
+			stdinErrCh = nil
 			if err != nil {
+				log.Warn("error reading stdin", "err", err)
+				c.printWarning(pty, "error reading stdin, stopping the command: "+err.Error())
+				client.Send(&pb.ExecStreamRequest{
+					Event: &pb.ExecStreamRequest_Signal_{
+						Signal: &pb.ExecStreamRequest_Signal{Name: "TERM"},
+					},
+				})
 				continue
 			}
 
-			sz, err := c.Size()
-			if err != nil {
-				continue
+			stdinEOF = true
+			if caps != nil {
+				c.sendStdinEOF(pty, client, caps)
 			}
 
-			// Send the new window size
-			if err := client.Send(&pb.ExecStreamRequest{
-				Event: &pb.ExecStreamRequest_Winch{
-					Winch: &pb.ExecStreamRequest_WindowSize{
-						Rows:   int32(sz.Height),
-						Cols:   int32(sz.Width),
-						Height: int32(sz.Height),
-						Width:  int32(sz.Width),
-					},
-				},
-			}); err != nil {
-				// Ignore this error
+		case <-pingCh:
+			if caps.Has(capabilityPing) {
+				c.sendPing(client, &rtt)
+			}
+
+		case <-winchCh:
+			// Window change, send new size
+			if !pty || ptyF == nil {
 				continue
 			}
 
+			if c.sendWindowSize(client, ptyF) {
+				sizeCheckCh = nil
+			}
+
+		case <-sizeCheckCh:
+			if c.sendWindowSize(client, ptyF) {
+				log.Info("terminal reports a valid size, resizing the PTY")
+				sizeCheckCh = nil
+			}
+
 		case <-ctx.Done():
-			return 1, nil
+			select {
+			case err := <-recvErrCh:
+				if err == io.EOF {
+					err = fmt.Errorf("%w: the stream ended without the exit status of the command", ErrProtocol)
+				}
+				if isStreamError(err) {
+					return 0, waitError(err, lastStatus)
+				}
+			default:
+			}
+
+			// Otherwise we were canceled, by Context or the "~." escape.
+			if err := c.Context.Err(); err != nil {
+				return 0, fmt.Errorf("session canceled: %w", err)
+			}
+
+			return 0, fmt.Errorf("session ended with the escape sequence: %w", context.Canceled)
+		}
+	}
+}
+
+// connectTimer cancels a stream if its session doesn't open within the
+// connect timeout. A nil *connectTimer never expires.
+type connectTimer struct {
+	timeout time.Duration
+	timer   *time.Timer
+	expired int32
+}
+
+// startConnectTimer returns a timer calling cancel once ConnectTimeout
+// expires, or nil if there is no ConnectTimeout.
+func (c *Client) startConnectTimer(cancel func()) *connectTimer {
+	if c.ConnectTimeout <= 0 {
+		return nil
+	}
+
+	t := &connectTimer{timeout: c.ConnectTimeout}
+	t.timer = time.AfterFunc(t.timeout, func() {
+		atomic.StoreInt32(&t.expired, 1)
+		cancel()
+	})
+
+	return t
+}
+
+// Stop stops the timer once the session opens. This returns false if the
+// timer already expired.
+func (t *connectTimer) Stop() bool {
+	if t == nil {
+		return true
+	}
+
+	return t.timer.Stop()
+}
+
+// Err returns the error to return for err, which is a timeout error if
+// the timer expired since err is then likely the cancellation.
+func (t *connectTimer) Err(err error) error {
+	if t == nil || atomic.LoadInt32(&t.expired) == 0 {
+		return err
+	}
+
+	return status.Errorf(codes.DeadlineExceeded,
+		"timed out after %s waiting for the session to open", t.timeout)
+}
+
+// recvStatus receives the next event from stream other than status
+// events, which are reported as phases and recorded in lastStatus. The
+// server closing the session is returned as a *ClosedError.
+func (c *Client) recvStatus(
+	log hclog.Logger,
+	stream *syncStream,
+	phases *phaseReporter,
+	lastStatus *string,
+) (*pb.ExecStreamResponse, error) {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		if closed := resp.GetClosed(); closed != nil {
+			return nil, closedError(log, closed)
+		}
+
+		event, ok := resp.Event.(*pb.ExecStreamResponse_Status_)
+		if !ok {
+			return resp, nil
+		}
+
+		msg := event.Status.Message
+		log.Debug("session status", "status", msg)
+		*lastStatus = msg
+		phases.Status(msg)
+	}
+}
+
+// sendWindowSize sends the size of the terminal f to the stream and
+// reports whether it did. Errors are ignored since the window size is best
+// effort, and nothing is sent if there is no terminal or its size is
+// invalid, in which case the PTY keeps its size.
+func (c *Client) sendWindowSize(stream *syncStream, f *os.File) bool {
+	if f == nil {
+		return false
+	}
+
+	size, err := c.windowSize(f)
+	if err != nil {
+		c.Logger.Debug("not sending the window size", "err", err)
+		return false
+	}
+
+	if err := stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Winch{Winch: size},
+	}); err != nil {
+		return false
+	}
+
+	c.updateState(func(s *SessionState) { s.WindowSize = size })
+	return true
+}
+
+// sendStdinEOF closes the stdin of the command if the instance supports
+// it, or warns that it can't. See printWarning for the meaning of raw.
+func (c *Client) sendStdinEOF(raw bool, stream *syncStream, caps capabilities) {
+	if !caps.Has(capabilityStdinEOF) {
+		c.printWarning(raw, "the instance can't close the stdin of the command, "+
+			"so it won't see the end of its input")
+		return
+	}
+
+	stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Eof: true},
+		},
+	})
+}
+
+// sendPing sends the next ping of rtt to the stream. Errors are ignored
+// since the round trip time is best effort.
+func (c *Client) sendPing(stream *syncStream, rtt *rttEstimator) {
+	stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Ping_{
+			Ping: &pb.ExecStreamRequest_Ping{
+				Id: rtt.Ping(),
+			},
+		},
+	})
+}
+
+// writeTitle sets the terminal title to LatencyTitle with the current
+// round trip time, if known, and stats, the latest resource usage of the
+// command, if any.
+func (c *Client) writeTitle(stats string, rtt *rttEstimator) {
+	var parts []string
+	if d, ok := rtt.Estimate(); ok && c.LatencyTitle != "" {
+		parts = append(parts, c.LatencyTitle, formatRTT(d))
+	}
+	if stats != "" {
+		parts = append(parts, stats)
+	}
+
+	c.write(c.Stdout, []byte(fmt.Sprintf("\x1b]0;%s\x07", strings.Join(parts, " · "))), false)
+}
+
+// wantPTY returns true if the session should use a PTY. isTerminal is
+// true if Stdout is a terminal.
+func (c *Client) wantPTY(isTerminal bool) bool {
+	switch {
+	case c.DisablePTY:
+		return false
+	case c.ForcePTY:
+		return true
+	case c.Detach:
+		// Our terminal isn't the one that will be showing the session.
+		return false
+	default:
+		return isTerminal
+	}
+}
+
+// Signal sends a signal to the remote command of the running session. The
+// name is a signal name such as "HUP" or "SIGUSR1". This is safe to call
+// concurrently with Run. An error is returned if no session is active.
+// If the remote side rejects the signal, a warning is shown on Stderr.
+func (c *Client) Signal(name string) error {
+	c.streamLock.Lock()
+	stream := c.stream
+	c.streamLock.Unlock()
+	if stream == nil {
+		return fmt.Errorf("no active exec session")
+	}
+
+	return stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Signal_{
+			Signal: &pb.ExecStreamRequest_Signal{
+				Name: name,
+			},
+		},
+	})
+}
+
+// inputWriter returns a writer that sends stdin to the stream. Large
+// writes are split across multiple messages so that no message exceeds
+// the maximum message size, or chunk bytes of input if chunk is set.
+func (c *Client) inputWriter(stream grpc.ClientStream, chunk int) io.Writer {
+	max := c.MaxMessageSize
+	if max <= 0 {
+		max = DefaultMaxMessageSize
+	}
+
+	size := max - messageOverhead
+	if size <= 0 {
+		size = max / 2
+	}
+	if chunk > 0 && chunk < size {
+		size = chunk
+	}
+
+	return &grpc_net_conn.Conn{
+		Stream:  stream,
+		Request: &pb.ExecStreamRequest{},
+		Encode: grpc_net_conn.ChunkedEncoder(grpc_net_conn.SimpleEncoder(func(msg proto.Message) *[]byte {
+			req := msg.(*pb.ExecStreamRequest)
+			if req.Event == nil {
+				req.Event = &pb.ExecStreamRequest_Input_{
+					Input: &pb.ExecStreamRequest_Input{},
+				}
+			}
+
+			return &req.Event.(*pb.ExecStreamRequest_Input_).Input.Data
+		}), size),
+	}
+}
+
+// closedError returns the error of a session that the server closed with
+// the event closed.
+func closedError(log hclog.Logger, closed *pb.ExecStreamResponse_Closed) error {
+	log.Info("the server closed the session", "reason", closed.Reason.String(),
+		"message", closed.Message)
+	return &ClosedError{Reason: closed.Reason, Message: closed.Message}
+}
+
+// isStreamError returns true if err ending the exec stream should be
+// reported to the caller. This excludes a normal close and cancellation.
+func isStreamError(err error) bool {
+	return err != io.EOF && status.Code(err) != codes.Canceled
+}
+
+// handleAttached handles the attached event from the instance. See
+// printWarning for the meaning of raw.
+func (c *Client) handleAttached(log hclog.Logger, raw bool, event *pb.ExecStreamResponse_Attached) {
+	c.DebugBundle.attached(event.InstanceId)
+	if c.Hooks.Attached != nil {
+		c.Hooks.Attached(event.InstanceId)
+	}
+	log.Debug("attached to instance", "instance_id", event.InstanceId,
+		"allocation_id", event.AllocationId, "task", event.Task)
+	if v := event.UnsupportedLimits; len(v) > 0 {
+		c.printWarning(raw, fmt.Sprintf(
+			"the instance does not support these limits, ignoring: %s",
+			strings.Join(v, ", ")))
+	}
+	if msg := c.localeWarning(event.Locale); msg != "" {
+		c.printWarning(raw, msg)
+	}
+}
+
+// setDefaults sets the optional fields that are unset, so that a Client
+// only needs Client, DeploymentId and its IO to run a session.
+func (c *Client) setDefaults() {
+	if c.Logger == nil {
+		c.Logger = hclog.NewNullLogger()
+	}
+	if c.Context == nil {
+		c.Context = context.Background()
+	}
+}
+
+// uiStatus returns a status of the UI, or one that shows nothing if there
+// is no UI.
+func (c *Client) uiStatus() terminal.Status {
+	if c.UI == nil {
+		return nullStatus{}
+	}
+
+	return c.UI.Status()
+}
+
+// uiOutput outputs a message to the UI, if there is one.
+func (c *Client) uiOutput(msg string, raw ...interface{}) {
+	if c.UI != nil {
+		c.UI.Output(msg, raw...)
+	}
+}
+
+// nullStatus is a terminal.Status that shows nothing.
+type nullStatus struct{}
+
+func (nullStatus) Update(string)       {}
+func (nullStatus) Step(string, string) {}
+func (nullStatus) Close() error        { return nil }
+
+func (c *Client) setStream(s *syncStream) {
+	c.streamLock.Lock()
+	defer c.streamLock.Unlock()
+	c.stream = s
+}
+
+// printWarning writes a warning from the remote side to Stderr. If raw is
+// true then the terminal is in raw mode and we need our own carriage returns.
+func (c *Client) printWarning(raw bool, msg string) {
+	c.printStderr(raw, "waypoint: "+msg)
+}
+
+// printStderr writes a message on its own line to Stderr. See printWarning
+// for the meaning of raw.
+func (c *Client) printStderr(raw bool, msg string) {
+	if c.Stderr == nil {
+		c.Logger.Warn("message from remote", "message", msg)
+		return
+	}
+
+	c.write(c.Stderr, []byte(stderrLine(raw, msg)), false)
+}
+
+// stderrLine returns msg on its own line. See printWarning for the meaning
+// of raw.
+func stderrLine(raw bool, msg string) string {
+	nl := "\n"
+	if raw {
+		nl = "\r\n"
+	}
+
+	return nl + msg + nl
+}
+
+// write writes data to w, through the output queue if there is one. output
+// is true for the output of the remote command, which may be spilled.
+func (c *Client) write(w io.Writer, data []byte, output bool) {
+	if c.output != nil {
+		c.output.Write(w, data, output)
+		return
+	}
+
+	w.Write(data)
+}
+
+// flushInterleaved writes the output held to order the output channels
+// once the session ends.
+func (c *Client) flushInterleaved() {
+	interleave := c.interleave
+	c.interleave = nil
+	c.write(c.Stdout, interleave.Flush(), true)
+}
+
+// closeOutput writes the rest of the queued output and tells the user
+// where the output that was spilled is. See printWarning for the meaning
+// of raw.
+func (c *Client) closeOutput(raw bool) {
+	output := c.output
+	c.output = nil
+	path, n := output.Close()
+	if path == "" {
+		return
+	}
+
+	if n == 0 {
+		os.Remove(path)
+		return
+	}
+
+	c.printWarning(raw, fmt.Sprintf("%s of output was spilled to %s",
+		humanize.Bytes(uint64(n)), path))
+}
+
+// newSessionId returns a random version 4 UUID to identify a new session.
+func newSessionId() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// formatStats returns a human-friendly summary of the resource usage.
+func formatStats(v *pb.ExecStreamResponse_Stats) string {
+	cpu := time.Duration(v.CpuTimeMs) * time.Millisecond
+	return fmt.Sprintf("cpu %s, memory %s", cpu, humanize.Bytes(uint64(v.RssBytes)))
+}
+
+// formatWatcher returns the message shown when a watcher joins or leaves.
+func formatWatcher(v *pb.ExecStreamResponse_Watcher) string {
+	action := "left"
+	if v.Joined {
+		action = "joined"
+	}
+
+	return fmt.Sprintf("--- a watcher %s this session, %d watching ---", action, v.Watchers)
+}
+
+// sequenceCommands converts the commands of a Sequence for the request.
+func sequenceCommands(seq [][]string) []*pb.ExecStreamRequest_Command {
+	if len(seq) == 0 {
+		return nil
+	}
+
+	result := make([]*pb.ExecStreamRequest_Command, len(seq))
+	for i, args := range seq {
+		result[i] = &pb.ExecStreamRequest_Command{Args: args}
+	}
+	return result
+}
+
+// startErrorMessage returns the message to show the user when the remote
+// command could not be started.
+func startErrorMessage(v *pb.ExecStreamResponse_StartError) string {
+	switch v.Reason {
+	case pb.ExecStreamResponse_StartError_NOT_FOUND:
+		msg := "command not found: " + v.Command
+		if v.Path != "" {
+			msg += fmt.Sprintf(" (searched PATH=%s)", v.Path)
 		}
+
+		return msg
+
+	case pb.ExecStreamResponse_StartError_PERMISSION_DENIED:
+		return fmt.Sprintf("permission denied: %s could not be executed", v.Command)
+
+	default:
+		return fmt.Sprintf("failed to start %s: %s", v.Command, v.Message)
 	}
 }
@@ -0,0 +1,153 @@
+// +build !windows
+
+package execclient
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// TestClientRun_concurrent runs sessions while output arrives, the
+// window is resized, signals are sent and input is typed all at once,
+// ending either with the command exiting or with cancellation. Run it
+// with -race; it is kept short enough to run many times with -count.
+func TestClientRun_concurrent(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		exit := i%2 == 0
+		name := "cancel"
+		if exit {
+			name = "exit"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			testClientRunConcurrent(t, exit, time.Duration(i)*time.Millisecond)
+		})
+	}
+}
+
+func testClientRunConcurrent(t *testing.T, exit bool, cancelAfter time.Duration) {
+	require := require.New(t)
+
+	steps := []execclienttest.Step{
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+	}
+	for i := 0; i < 200; i++ {
+		steps = append(steps, execclienttest.Respond(execclienttest.Stdout("x")))
+	}
+	if exit {
+		steps = append(steps, execclienttest.Respond(execclienttest.Exit(0)))
+	} else {
+		// The session never ends on its own
+		steps = append(steps, execclienttest.Step{
+			Wait: func([]*pb.ExecStreamRequest) bool { return false },
+			Desc: "cancellation",
+		})
+	}
+	stream := execclienttest.NewStream(t, steps...)
+
+	term := &testTerminal{rows: 24, cols: 80}
+	c, stdout := testTerminalClient(t, stream, term)
+	defer stdout.Close()
+
+	// Our own stdin so that we can keep typing
+	stdinR, stdinW, err := os.Pipe()
+	require.NoError(err)
+	defer stdinR.Close()
+	c.Stdin.(*os.File).Close()
+	c.Stdin = stdinR
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.Context = ctx
+
+	// Input, resizes and signals until the session is over
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			if _, err := stdinW.Write([]byte("a")); err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			case <-time.After(time.Millisecond):
+			}
+
+			term.lock.Lock()
+			term.rows = 24 + i%10
+			term.lock.Unlock()
+			unix.Kill(os.Getpid(), unix.SIGWINCH)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(time.Millisecond):
+			}
+
+			c.Signal("HUP")
+		}
+	}()
+
+	if !exit {
+		time.AfterFunc(cancelAfter, cancel)
+	}
+
+	resultCh := make(chan error, 1)
+	go func() {
+		_, err := c.Run()
+		resultCh <- err
+	}()
+
+	select {
+	case err := <-resultCh:
+		if exit {
+			require.NoError(err)
+		}
+
+	case <-time.After(10 * time.Second):
+		t.Fatal("Run didn't return")
+	}
+
+	close(done)
+	stdinW.Close()
+	wg.Wait()
+
+	term.lock.Lock()
+	require.Equal(len(term.raw), term.restored)
+	term.lock.Unlock()
+
+	require.Eventually(func() bool {
+		return testExecGoroutines() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The stream always ends up closed
+	require.Error(stream.Send(&pb.ExecStreamRequest{}))
+}
@@ -0,0 +1,646 @@
+package execclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientInputWriter(t *testing.T) {
+	require := require.New(t)
+
+	const max = 64 * 1024
+	c := &Client{MaxMessageSize: max}
+	stream := &testMaxMsgStream{max: max}
+	w := c.inputWriter(stream, 0)
+
+	// A single write that is far larger than the max message size
+	data := bytes.Repeat([]byte("0123456789abcdef"), 20*1024*1024/16)
+	n, err := w.Write(data)
+	require.NoError(err)
+	require.Equal(len(data), n)
+	require.True(stream.count > len(data)/max)
+	require.Equal(data, stream.data.Bytes())
+}
+
+func TestClientRun(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.AfterInput("hello\n", execclienttest.Stdout("hello\n")),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	var stdout bytes.Buffer
+	c := testClient(t, stream)
+	c.Stdin = strings.NewReader("hello\n")
+	c.Stdout = &stdout
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	require.Equal("hello\n", stdout.String())
+
+	start := stream.Start()
+	require.Equal("d1", start.DeploymentId)
+	require.Equal([]string{"cat"}, start.Args)
+	require.Nil(start.Pty, "stdout isn't a terminal")
+	require.Equal([]byte("hello\n"), stream.Input())
+}
+
+func TestClientRun_stderr(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Stdout("out 1\n")),
+		execclienttest.Respond(execclienttest.Stderr("err\n")),
+		execclienttest.Respond(execclienttest.Stdout("out 2\n")),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	var stdout, stderr bytes.Buffer
+	c := testClient(t, stream)
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+
+	_, err := c.Run()
+	require.NoError(err)
+	require.Equal("out 1\nout 2\n", stdout.String())
+	require.Equal("err\n", stderr.String())
+}
+
+func TestClientRun_exitCode(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Exit(42)),
+	)
+
+	code, err := testClient(t, stream).Run()
+	require.Equal(42, code)
+
+	// The command exiting unsuccessfully isn't a failure of the session
+	var exitErr *ExitError
+	require.True(errors.As(err, &exitErr))
+	require.Equal(42, exitErr.Code)
+	var sessionErr *SessionError
+	require.False(errors.As(err, &sessionErr))
+}
+
+func TestClientRun_streamError(t *testing.T) {
+	t.Run("before open", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Fail(status.Error(codes.Unavailable, "connection refused")),
+		)
+
+		code, err := testClient(t, stream).Run()
+		require.Error(err)
+		require.Equal(0, code)
+
+		// The error is explained, names the session and keeps its status
+		var statusErr *StatusError
+		require.True(errors.As(err, &statusErr))
+		var sessionErr *SessionError
+		require.True(errors.As(err, &sessionErr))
+		require.Equal(stream.Start().SessionId, sessionErr.SessionId)
+		require.Equal(codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("after open", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout bytes.Buffer
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.Stdout("partial")),
+			execclienttest.Fail(status.Error(codes.Internal, "boom")),
+		)
+		c := testClient(t, stream)
+		c.Stdout = &stdout
+
+		code, err := c.Run()
+		require.Error(err)
+		require.Equal(0, code)
+		require.Equal(codes.Internal, status.Code(err))
+		require.Equal("partial", stdout.String())
+	})
+
+	t.Run("while waiting", func(t *testing.T) {
+		require := require.New(t)
+
+		const msg = "instance i1 selected, waiting for its entrypoint to acknowledge the session"
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Status(msg)),
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Fail(status.Error(codes.DeadlineExceeded, "context deadline exceeded")),
+		)
+
+		code, err := testClient(t, stream).Run()
+		require.Error(err)
+		require.Equal(0, code)
+		require.Contains(stream.Start().ClientCapabilities, "status")
+
+		// The error says what the session was waiting for
+		var statusErr *StatusError
+		require.True(errors.As(err, &statusErr))
+		require.Equal("the session didn't start, the server last reported: "+msg, statusErr.Message)
+		require.Equal(codes.DeadlineExceeded, status.Code(err))
+	})
+}
+
+func TestClientRun_noExit(t *testing.T) {
+	t.Run("stream ends", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.Stdout("partial")),
+		)
+
+		// Without an exit event there's no exit code to report
+		code, err := testClient(t, stream).Run()
+		require.Equal(0, code)
+		require.True(errors.Is(err, ErrProtocol))
+		var sessionErr *SessionError
+		require.True(errors.As(err, &sessionErr))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.AfterInput("never", execclienttest.Exit(0)),
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		c := testClient(t, stream)
+		c.Context = ctx
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		code, err := c.Run()
+		require.Equal(0, code)
+		require.True(errors.Is(err, context.Canceled))
+		var sessionErr *SessionError
+		require.False(errors.As(err, &sessionErr))
+	})
+
+	t.Run("escape", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.AfterInput("never", execclienttest.Exit(0)),
+		)
+		c := testClient(t, stream)
+		c.Stdin = strings.NewReader("\n~.")
+
+		code, err := c.Run()
+		require.Equal(0, code)
+		require.True(errors.Is(err, context.Canceled))
+	})
+}
+
+func TestClientRun_escapeSignal(t *testing.T) {
+	require := require.New(t)
+
+	isHUP := func(requests []*pb.ExecStreamRequest) bool {
+		for _, req := range requests {
+			if req.GetSignal().GetName() == "HUP" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.Step{
+			Response: execclienttest.Exit(0),
+			Wait:     isHUP,
+			Desc:     "signal HUP",
+		},
+	)
+
+	term := &testTerminal{rows: 24, cols: 80}
+	c, stdout := testTerminalClient(t, stream, term)
+	defer stdout.Close()
+
+	// Enter sends a carriage return in raw mode, which starts a line
+	// for the escape like a newline does.
+	testTypeInput(t, c, "\r~sHUP\r")
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	require.True(isHUP(stream.Requests()))
+}
+
+func TestClientRun_escapeCarriageReturn(t *testing.T) {
+	t.Run("ends a line in a PTY session", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.AfterInput("never", execclienttest.Exit(0)),
+		)
+		c := testClient(t, stream)
+		c.ForcePTY = true
+		c.Stdin = strings.NewReader("ls\r~.")
+
+		code, err := c.Run()
+		require.Equal(0, code)
+		require.True(errors.Is(err, context.Canceled))
+	})
+
+	t.Run("is input without a PTY", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.AfterInput("ls\r~.", execclienttest.Exit(0)),
+		)
+		c := testClient(t, stream)
+		c.Stdin = strings.NewReader("ls\r~.")
+
+		code, err := c.Run()
+		require.NoError(err)
+		require.Equal(0, code)
+	})
+}
+
+func TestClientRun_phases(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Status("starting instance")),
+		execclienttest.Respond(execclienttest.WithCapabilities(
+			execclienttest.Open("s1"), capabilityAttached)),
+		execclienttest.Respond(execclienttest.Status("pulling image")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	var phases []Phase
+	c := testClient(t, stream)
+	c.DeploymentSeq = 3
+	c.ForcePTY = true
+	c.PhaseCallback = func(p Phase) { phases = append(phases, p) }
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	require.Equal([]Phase{
+		{Stage: StageConnecting, Message: "Connecting to deployment v3...", DeploymentSeq: 3},
+		{Stage: StageInitializing, Message: "Initializing session...", DeploymentSeq: 3},
+		{Stage: StageWaiting, Message: "Waiting for instance assignment...", DeploymentSeq: 3},
+		{Stage: StageWaiting, Message: "Starting instance...", DeploymentSeq: 3},
+		{Stage: StageOpen, Message: "Opened session s1", DeploymentSeq: 3, SessionId: "s1"},
+		{Stage: StageAttaching, Message: "Waiting for instance to attach...", DeploymentSeq: 3, SessionId: "s1"},
+		{Stage: StageAttaching, Message: "Pulling image...", DeploymentSeq: 3, SessionId: "s1"},
+		{Stage: StageAttached, Message: "Attached to instance i1", DeploymentSeq: 3, SessionId: "s1", InstanceId: "i1"},
+		{Stage: StageRunning, Message: "Connected to deployment v3, session s1", DeploymentSeq: 3, SessionId: "s1", InstanceId: "i1"},
+	}, phases)
+}
+
+func TestClientRun_ptyWithoutAttached(t *testing.T) {
+	cases := []struct {
+		Name string
+		Caps []string
+	}{
+		// Older servers never send the attached event.
+		{"not announced", nil},
+
+		// Something else comes first, so we can't wait for it.
+		{"announced", []string{capabilityAttached}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			stream := execclienttest.NewStream(t,
+				execclienttest.Respond(execclienttest.WithCapabilities(
+					execclienttest.Open("s1"), tt.Caps...)),
+				execclienttest.Respond(execclienttest.Stdout("hello\r\n")),
+				execclienttest.Respond(execclienttest.Exit(0)),
+			)
+
+			var stdout bytes.Buffer
+			c := testClient(t, stream)
+			c.ForcePTY = true
+			c.Stdout = &stdout
+
+			// The session runs as if the instance attached with a PTY.
+			code, err := c.Run()
+			require.NoError(err)
+			require.Equal(0, code)
+			require.Contains(stdout.String(), "hello\r\n")
+			require.True(stream.Start().Pty.GetEnable())
+		})
+	}
+}
+
+func TestClientUIPhases(t *testing.T) {
+	require := require.New(t)
+
+	ui := &testPhaseUI{}
+	c := &Client{UI: ui}
+	f, closeStatus := c.uiPhases()
+	f(Phase{Stage: StageConnecting, Message: "Connecting..."})
+	f(Phase{Stage: StageOpen, Message: "Opened session s1"})
+	f(Phase{Stage: StageWaiting, Message: "Waiting..."})
+	f(Phase{Stage: StageRunning, Message: "Connected"})
+	f(Phase{Stage: StageAttached, Message: "Attached to instance i1"})
+	closeStatus()
+
+	require.Equal([]string{"Connecting...", "Waiting..."}, ui.updates)
+	require.Equal([]string{"Connected"}, ui.outputs)
+	require.True(ui.closed)
+}
+
+func TestClientUIPhases_refresh(t *testing.T) {
+	defer func(tick, plain time.Duration) {
+		phaseTick, phasePlainTick = tick, plain
+	}(phaseTick, phasePlainTick)
+	phaseTick = 10 * time.Millisecond
+	phasePlainTick = time.Hour
+
+	t.Run("interactive", func(t *testing.T) {
+		require := require.New(t)
+
+		ui := &testPhaseUI{interactive: true}
+		s := newPhaseStatus(ui, phaseTick)
+		s.start = time.Now().Add(-14 * time.Second)
+		s.attempt, s.attempts = 2, 5
+		s.Update(Phase{Stage: StageWaiting, Message: "Waiting for instance assignment..."})
+		s.Update(Phase{Stage: StageWaiting, Message: "Starting instance..."})
+
+		// The status is refreshed while waiting.
+		require.Eventually(func() bool {
+			return len(ui.Updates()) > 3
+		}, time.Second, 5*time.Millisecond)
+		require.Equal(
+			"Waiting for instance assignment... 14s (attempt 2/5) - Starting instance...",
+			ui.Updates()[2])
+
+		// It isn't once the session opened.
+		s.Stop()
+		n := len(ui.Updates())
+		time.Sleep(5 * phaseTick)
+		require.Len(ui.Updates(), n)
+
+		s.Update(Phase{Stage: StageAttaching, Message: "Waiting for instance to attach..."})
+		s.Close()
+		require.Equal("Waiting for instance to attach...", ui.Updates()[n])
+		require.True(ui.closed)
+	})
+
+	t.Run("not interactive", func(t *testing.T) {
+		require := require.New(t)
+
+		// Each refresh would be a line, so there are only a few.
+		ui := &testPhaseUI{}
+		c := &Client{UI: ui}
+		f, closeStatus := c.uiPhases()
+		f(Phase{Stage: StageWaiting, Message: "Waiting for instance assignment..."})
+		time.Sleep(5 * phaseTick)
+		closeStatus()
+
+		require.Equal([]string{"Waiting for instance assignment..."}, ui.Updates())
+	})
+}
+
+func TestPhaseLine(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Server   string
+		Elapsed  time.Duration
+		Attempt  int
+		Attempts int
+		Expected string
+	}{
+		{"first second", "", 300 * time.Millisecond, 0, 0, "Waiting..."},
+		{"elapsed", "", 14*time.Second + 400*time.Millisecond, 0, 0, "Waiting... 14s"},
+		{"attempt", "", 2 * time.Minute, 2, 5, "Waiting... 2m0s (attempt 2/5)"},
+		{"single attempt", "", 0, 1, 1, "Waiting..."},
+		{"server", "Pulling image...", 3 * time.Second, 0, 0, "Waiting... 3s - Pulling image..."},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected,
+				phaseLine("Waiting...", tt.Server, tt.Elapsed, tt.Attempt, tt.Attempts))
+		})
+	}
+}
+
+// testPhaseUI is a UI that records the status updates and output that
+// show the phases of a session.
+type testPhaseUI struct {
+	terminal.UI
+
+	interactive bool
+
+	lock    sync.Mutex
+	updates []string
+	outputs []string
+	closed  bool
+}
+
+func (ui *testPhaseUI) Interactive() bool       { return ui.interactive }
+func (ui *testPhaseUI) Status() terminal.Status { return ui }
+
+// Updates returns the status updates so far.
+func (ui *testPhaseUI) Updates() []string {
+	ui.lock.Lock()
+	defer ui.lock.Unlock()
+	return append([]string(nil), ui.updates...)
+}
+
+func (ui *testPhaseUI) Output(msg string, raw ...interface{}) {
+	var args []interface{}
+	for _, v := range raw {
+		if _, ok := v.(terminal.Option); !ok {
+			args = append(args, v)
+		}
+	}
+	ui.outputs = append(ui.outputs, fmt.Sprintf(msg, args...))
+}
+
+func (ui *testPhaseUI) Update(msg string) {
+	ui.lock.Lock()
+	defer ui.lock.Unlock()
+	ui.updates = append(ui.updates, msg)
+}
+
+func (ui *testPhaseUI) Step(string, string) {}
+func (ui *testPhaseUI) Close() error        { ui.closed = true; return nil }
+
+func TestClientSessionId(t *testing.T) {
+	require := require.New(t)
+
+	first := execclienttest.NewStream(t)
+	second := execclienttest.NewStream(t)
+	c := testClient(t, first)
+	c.Client = execclienttest.NewWaypoint(first, second)
+
+	// The stream ends before the session opens.
+	_, err := c.Run()
+	require.Error(err)
+
+	// We chose the session's ID and the error names it.
+	start := first.Start()
+	require.Regexp(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, start.SessionId)
+
+	var sessionErr *SessionError
+	require.True(errors.As(err, &sessionErr))
+	require.Equal(start.SessionId, sessionErr.SessionId)
+	require.Equal("session "+start.SessionId+" failed: EOF", err.Error())
+
+	// Every session gets its own ID.
+	_, err = c.Run()
+	require.Error(err)
+	require.NotEqual(start.SessionId, second.Start().SessionId)
+}
+
+func TestExitError(t *testing.T) {
+	require := require.New(t)
+
+	// A successful exit is not an error
+	require.NoError(exitError(&pb.ExecStreamResponse_Exit{}))
+
+	err := exitError(&pb.ExecStreamResponse_Exit{
+		Code:       139,
+		Signal:     "SIGSEGV",
+		CoreDumped: true,
+	})
+	var exitErr *ExitError
+	require.True(errors.As(err, &exitErr))
+	require.Equal(139, exitErr.Code)
+	require.Equal("command terminated by signal SIGSEGV (core dumped)", err.Error())
+}
+
+// testMaxMsgStream is a grpc.ClientStream that fails any message larger
+// than max and records the input data of the messages it is sent.
+type testMaxMsgStream struct {
+	grpc.ClientStream
+
+	max   int
+	count int
+	data  bytes.Buffer
+}
+
+func (s *testMaxMsgStream) SendMsg(m interface{}) error {
+	req := m.(*pb.ExecStreamRequest)
+	if size := proto.Size(req); size > s.max {
+		return status.Errorf(codes.ResourceExhausted,
+			"message larger than max (%d vs. %d)", size, s.max)
+	}
+
+	s.count++
+	s.data.Write(req.Event.(*pb.ExecStreamRequest_Input_).Input.Data)
+	return nil
+}
+
+// testClient returns a client that runs "cat" on deployment d1 over
+// stream. Its output is discarded and it sends no input.
+func testClient(t testing.TB, stream *execclienttest.Stream) *Client {
+	return &Client{
+		Logger:        hclog.NewNullLogger(),
+		Context:       context.Background(),
+		Client:        execclienttest.NewWaypoint(stream),
+		DeploymentId:  "d1",
+		DeploymentSeq: 1,
+		Args:          []string{"cat"},
+		Stdin:         bytes.NewReader(nil),
+		Stdout:        ioutil.Discard,
+		Stderr:        ioutil.Discard,
+	}
+}
+
+func TestClientRun_connectTimeout(t *testing.T) {
+	t.Run("open before the deadline", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Step{
+				Response: execclienttest.Open("s1"),
+				Delay:    10 * time.Millisecond,
+			},
+			execclienttest.Respond(execclienttest.Exit(0)),
+		)
+		c := testClient(t, stream)
+		c.ConnectTimeout = time.Second
+
+		code, err := c.Run()
+		require.NoError(err)
+		require.Equal(0, code)
+	})
+
+	t.Run("open after the deadline", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Step{
+				Response: execclienttest.Open("s1"),
+				Delay:    200 * time.Millisecond,
+			},
+			execclienttest.Respond(execclienttest.Exit(0)),
+		)
+		c := testClient(t, stream)
+		c.ConnectTimeout = 20 * time.Millisecond
+
+		code, err := c.Run()
+		require.Error(err)
+		require.Equal(0, code)
+		require.Equal(codes.DeadlineExceeded, status.Code(err))
+		require.Contains(err.Error(), "timed out after 20ms waiting for the session to open")
+	})
+
+	t.Run("session outlives the timeout", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Step{
+				Response: execclienttest.Stdout("done\n"),
+				Delay:    100 * time.Millisecond,
+			},
+			execclienttest.Respond(execclienttest.Exit(0)),
+		)
+		var stdout bytes.Buffer
+		c := testClient(t, stream)
+		c.Stdout = &stdout
+		c.ConnectTimeout = 10 * time.Millisecond
+
+		code, err := c.Run()
+		require.NoError(err)
+		require.Equal(0, code)
+		require.Equal("done\n", stdout.String())
+	})
+}
@@ -0,0 +1,79 @@
+package execclient
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientRun_commandSequence(t *testing.T) {
+	t.Run("runs the sequence", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Open("s1"), "sequence")),
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Attached("i1"), "sequence")),
+			execclienttest.Respond(execclienttest.CommandExit(0, 0)),
+			execclienttest.Respond(execclienttest.CommandExit(1, 3)),
+			execclienttest.Respond(execclienttest.Exit(3)),
+		)
+
+		var stderr bytes.Buffer
+		var codes []int32
+		c := testClient(t, stream)
+		c.Stderr = &stderr
+		c.Sequence = [][]string{{"false"}, {"echo", "skipped"}}
+		c.Hooks.CommandExit = func(index int, exit *pb.ExecStreamResponse_Exit) {
+			require.Equal(len(codes), index)
+			codes = append(codes, exit.Code)
+		}
+
+		code, err := c.Run()
+		require.Equal(3, code)
+		var exitErr *ExitError
+		require.True(errors.As(err, &exitErr))
+		require.Equal([]int32{0, 3}, codes)
+		require.Empty(stderr.String())
+
+		start := stream.Start()
+		require.Len(start.Sequence, 2)
+		require.Equal([]string{"false"}, start.Sequence[0].Args)
+		require.False(start.KeepGoing)
+	})
+
+	t.Run("instance can't run a sequence", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.Attached("i1")),
+			execclienttest.Respond(execclienttest.Exit(0)),
+		)
+
+		var stderr bytes.Buffer
+		c := testClient(t, stream)
+		c.Stderr = &stderr
+		c.Sequence = [][]string{{"true"}}
+
+		code, err := c.Run()
+		require.NoError(err)
+		require.Equal(0, code)
+		require.Contains(stderr.String(), "only the first command runs")
+	})
+
+	t.Run("script", func(t *testing.T) {
+		c := testClient(t, execclienttest.NewStream(t))
+		c.Script = []byte("true")
+		c.Sequence = [][]string{{"true"}}
+
+		_, err := c.Run()
+		require.Error(t, err)
+	})
+}
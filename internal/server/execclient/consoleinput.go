@@ -0,0 +1,14 @@
+// +build !windows
+
+package execclient
+
+import (
+	"io"
+	"os"
+)
+
+// consoleInput returns nil since only Windows consoles report keys as
+// input records rather than as the bytes typed.
+func consoleInput(f *os.File, keyMap map[string]string) io.Reader {
+	return nil
+}
@@ -0,0 +1,102 @@
+// +build windows
+
+package execclient
+
+import (
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// enableVirtualTerminalInput is the console mode in which the console
+// translates special keys to VT sequences itself.
+const enableVirtualTerminalInput = 0x0200
+
+// keyEvent is the event type of an input record with a key event.
+const keyEvent = 0x0001
+
+var procReadConsoleInputW = windows.NewLazySystemDLL("kernel32.dll").NewProc("ReadConsoleInputW")
+
+// inputRecord is an INPUT_RECORD. The event is a union, of which we only
+// read the KEY_EVENT_RECORD.
+type inputRecord struct {
+	EventType uint16
+	_         uint16
+	Event     [16]byte
+}
+
+// keyEventRecord is a KEY_EVENT_RECORD.
+type keyEventRecord struct {
+	KeyDown         int32
+	RepeatCount     uint16
+	VirtualKeyCode  uint16
+	VirtualScanCode uint16
+	UnicodeChar     uint16
+	ControlKeyState uint32
+}
+
+// consoleInput returns a reader of the input of the console f with
+// special keys translated to xterm sequences, or nil if f isn't a console
+// or the console already sends VT sequences.
+func consoleInput(f *os.File, keyMap map[string]string) io.Reader {
+	var mode uint32
+	if err := windows.GetConsoleMode(windows.Handle(f.Fd()), &mode); err != nil {
+		return nil
+	}
+	if mode&enableVirtualTerminalInput != 0 {
+		return nil
+	}
+
+	return &consoleReader{
+		handle:     windows.Handle(f.Fd()),
+		translator: consoleKeyTranslator{KeyMap: keyMap},
+	}
+}
+
+// consoleReader reads the key events of a console as input.
+type consoleReader struct {
+	handle     windows.Handle
+	translator consoleKeyTranslator
+	records    [16]inputRecord
+	buf        []byte
+}
+
+func (r *consoleReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		var n uint32
+		ret, _, err := procReadConsoleInputW.Call(
+			uintptr(r.handle),
+			uintptr(unsafe.Pointer(&r.records[0])),
+			uintptr(len(r.records)),
+			uintptr(unsafe.Pointer(&n)),
+		)
+		if ret == 0 {
+			if err == syscall.Errno(0) {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+
+		for _, rec := range r.records[:n] {
+			if rec.EventType != keyEvent {
+				continue
+			}
+
+			ev := (*keyEventRecord)(unsafe.Pointer(&rec.Event[0]))
+			r.buf = append(r.buf, r.translator.Translate(consoleKey{
+				KeyDown:      ev.KeyDown != 0,
+				Repeat:       ev.RepeatCount,
+				VirtualKey:   ev.VirtualKeyCode,
+				Char:         ev.UnicodeChar,
+				ControlState: ev.ControlKeyState,
+			})...)
+		}
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,179 @@
+package execclient
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// consoleKey is a key event read from a Windows console. The fields are
+// those of a KEY_EVENT_RECORD.
+type consoleKey struct {
+	KeyDown      bool
+	Repeat       uint16
+	VirtualKey   uint16
+	Char         uint16
+	ControlState uint32
+}
+
+// The control key states of a consoleKey.
+const (
+	consoleRightAlt  = 0x0001
+	consoleLeftAlt   = 0x0002
+	consoleRightCtrl = 0x0004
+	consoleLeftCtrl  = 0x0008
+	consoleShift     = 0x0010
+)
+
+// consoleSpecialKey is a key that Windows consoles without VT input report
+// by its virtual key code alone. Its xterm sequence is a CSI sequence
+// ending with final, such as "\x1b[A", or "\x1b[" code "~" if final is
+// '~'. F1 to F4 use SS3 rather than CSI when unmodified.
+type consoleSpecialKey struct {
+	name  string
+	code  int
+	final byte
+	ss3   bool
+}
+
+// consoleSpecialKeys are the special keys by virtual key code.
+var consoleSpecialKeys = map[uint16]consoleSpecialKey{
+	0x21: {name: "pageup", code: 5, final: '~'},
+	0x22: {name: "pagedown", code: 6, final: '~'},
+	0x23: {name: "end", code: 1, final: 'F'},
+	0x24: {name: "home", code: 1, final: 'H'},
+	0x25: {name: "left", code: 1, final: 'D'},
+	0x26: {name: "up", code: 1, final: 'A'},
+	0x27: {name: "right", code: 1, final: 'C'},
+	0x28: {name: "down", code: 1, final: 'B'},
+	0x2D: {name: "insert", code: 2, final: '~'},
+	0x2E: {name: "delete", code: 3, final: '~'},
+	0x70: {name: "f1", code: 1, final: 'P', ss3: true},
+	0x71: {name: "f2", code: 1, final: 'Q', ss3: true},
+	0x72: {name: "f3", code: 1, final: 'R', ss3: true},
+	0x73: {name: "f4", code: 1, final: 'S', ss3: true},
+	0x74: {name: "f5", code: 15, final: '~'},
+	0x75: {name: "f6", code: 17, final: '~'},
+	0x76: {name: "f7", code: 18, final: '~'},
+	0x77: {name: "f8", code: 19, final: '~'},
+	0x78: {name: "f9", code: 20, final: '~'},
+	0x79: {name: "f10", code: 21, final: '~'},
+	0x7A: {name: "f11", code: 23, final: '~'},
+	0x7B: {name: "f12", code: 24, final: '~'},
+}
+
+// consoleBackspace is the virtual key code of backspace, which consoles
+// report as ^H but terminals usually send as DEL.
+const consoleBackspace = 0x08
+
+// consoleKeyTranslator translates the key events of a Windows console
+// without VT input into the xterm sequences that remote PTYs expect.
+type consoleKeyTranslator struct {
+	// KeyMap overrides the sequence of unmodified special keys by name,
+	// such as "home" or "backspace". See Client.ConsoleKeyMap.
+	KeyMap map[string]string
+
+	// highSurrogate is the first half of a character outside the BMP,
+	// which consoles report as two key events.
+	highSurrogate uint16
+}
+
+// Translate returns the input for the key event k, which may be empty.
+func (t *consoleKeyTranslator) Translate(k consoleKey) []byte {
+	if !k.KeyDown {
+		return nil
+	}
+
+	seq := t.sequence(k)
+	if len(seq) == 0 {
+		return nil
+	}
+
+	repeat := int(k.Repeat)
+	if repeat < 1 {
+		repeat = 1
+	}
+
+	return []byte(strings.Repeat(seq, repeat))
+}
+
+func (t *consoleKeyTranslator) sequence(k consoleKey) string {
+	shift := k.ControlState&consoleShift != 0
+	alt := k.ControlState&(consoleLeftAlt|consoleRightAlt) != 0
+	ctrl := k.ControlState&(consoleLeftCtrl|consoleRightCtrl) != 0
+
+	if key, ok := consoleSpecialKeys[k.VirtualKey]; ok {
+		// xterm encodes modifiers as a parameter of one plus a bit each
+		// for shift, alt and ctrl.
+		mod := 1
+		if shift {
+			mod += 1
+		}
+		if alt {
+			mod += 2
+		}
+		if ctrl {
+			mod += 4
+		}
+
+		if mod == 1 {
+			if v, ok := t.KeyMap[key.name]; ok {
+				return v
+			}
+		}
+
+		switch {
+		case mod > 1 && key.final == '~':
+			return fmt.Sprintf("\x1b[%d;%d~", key.code, mod)
+		case mod > 1:
+			return fmt.Sprintf("\x1b[1;%d%c", mod, key.final)
+		case key.final == '~':
+			return fmt.Sprintf("\x1b[%d~", key.code)
+		case key.ss3:
+			return "\x1bO" + string(key.final)
+		default:
+			return "\x1b[" + string(key.final)
+		}
+	}
+
+	if k.VirtualKey == consoleBackspace {
+		seq := "\x7f"
+		if v, ok := t.KeyMap["backspace"]; ok {
+			seq = v
+		}
+		if alt {
+			seq = "\x1b" + seq
+		}
+
+		return seq
+	}
+
+	// Everything else is a character, if any, such as one that a control
+	// key combination produces. Modifier keys alone have none.
+	if k.Char == 0 {
+		return ""
+	}
+
+	r := rune(k.Char)
+	switch {
+	case utf16.IsSurrogate(r) && r < 0xdc00:
+		t.highSurrogate = k.Char
+		return ""
+
+	case utf16.IsSurrogate(r):
+		r = utf16.DecodeRune(rune(t.highSurrogate), r)
+		t.highSurrogate = 0
+	}
+
+	var buf [utf8.UTFMax]byte
+	seq := string(buf[:utf8.EncodeRune(buf[:], r)])
+
+	// Alt is sent as an escape prefix, except with ctrl as well since
+	// that is AltGr producing the character.
+	if alt && !ctrl {
+		seq = "\x1b" + seq
+	}
+
+	return seq
+}
@@ -0,0 +1,141 @@
+package execclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsoleKeyTranslator(t *testing.T) {
+	cases := []struct {
+		Name   string
+		KeyMap map[string]string
+		Keys   []consoleKey
+		Expect string
+	}{
+		{
+			"character",
+			nil,
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x41, Char: 'a'},
+				{KeyDown: false, Repeat: 1, VirtualKey: 0x41, Char: 'a'},
+			},
+			"a",
+		},
+
+		{
+			"repeat",
+			nil,
+			[]consoleKey{{KeyDown: true, Repeat: 3, VirtualKey: 0x26}},
+			"\x1b[A\x1b[A\x1b[A",
+		},
+
+		{
+			"arrows",
+			nil,
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x26},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x28},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x27},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x25},
+			},
+			"\x1b[A\x1b[B\x1b[C\x1b[D",
+		},
+
+		{
+			"home end",
+			nil,
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x24},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x23},
+			},
+			"\x1b[H\x1b[F",
+		},
+
+		{
+			"function keys",
+			nil,
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x70},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x74},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x7B},
+			},
+			"\x1bOP\x1b[15~\x1b[24~",
+		},
+
+		{
+			"modifiers",
+			nil,
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x25, ControlState: consoleLeftCtrl},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x2E, ControlState: consoleShift},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x70, ControlState: consoleShift | consoleRightAlt},
+			},
+			"\x1b[1;5D\x1b[3;2~\x1b[1;4P",
+		},
+
+		{
+			"control character",
+			nil,
+			[]consoleKey{{KeyDown: true, Repeat: 1, VirtualKey: 0x43, Char: 0x03, ControlState: consoleLeftCtrl}},
+			"\x03",
+		},
+
+		{
+			"alt and altgr",
+			nil,
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x42, Char: 'b', ControlState: consoleLeftAlt},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x45, Char: '€', ControlState: consoleRightAlt | consoleLeftCtrl},
+			},
+			"\x1bb€",
+		},
+
+		{
+			"backspace",
+			nil,
+			[]consoleKey{{KeyDown: true, Repeat: 1, VirtualKey: 0x08, Char: 0x08}},
+			"\x7f",
+		},
+
+		{
+			"surrogate pair",
+			nil,
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, Char: 0xd83d},
+				{KeyDown: true, Repeat: 1, Char: 0xde00},
+			},
+			"😀",
+		},
+
+		{
+			"modifier alone",
+			nil,
+			[]consoleKey{{KeyDown: true, Repeat: 1, VirtualKey: 0x10, ControlState: consoleShift}},
+			"",
+		},
+
+		{
+			"key map",
+			map[string]string{"home": "\x1b[1~", "backspace": "\x08"},
+			[]consoleKey{
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x24},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x24, ControlState: consoleShift},
+				{KeyDown: true, Repeat: 1, VirtualKey: 0x08, Char: 0x08},
+			},
+			"\x1b[1~\x1b[1;2H\x08",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			tr := &consoleKeyTranslator{KeyMap: tt.KeyMap}
+			var actual []byte
+			for _, k := range tt.Keys {
+				actual = append(actual, tr.Translate(k)...)
+			}
+
+			require.Equal(t, tt.Expect, string(actual))
+		})
+	}
+}
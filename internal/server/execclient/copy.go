@@ -0,0 +1,385 @@
+package execclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/pkg/tarcopy"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// CopyError is returned when some files couldn't be copied. The other
+// files were still copied.
+type CopyError struct {
+	Errors []*tarcopy.FileError
+}
+
+func (e *CopyError) Error() string {
+	if len(e.Errors) == 1 {
+		return fmt.Sprintf("failed to copy %s", e.Errors[0])
+	}
+
+	return fmt.Sprintf("%d files couldn't be copied", len(e.Errors))
+}
+
+// CopyTo copies the local file or directory to the remote path on an
+// instance of the deployment. If remote is an existing directory, local is
+// copied into it, otherwise local is created as remote. Progress is shown
+// with UI. Symlinks are copied as symlinks unless FollowSymlinks is set.
+// If Resume is set, local must be a single file and only the part of it
+// that isn't already at remote is sent.
+//
+// If some files can't be copied, the rest still are and the error is a
+// *CopyError listing them.
+func (c *Client) CopyTo(ctx context.Context, local, remote string) error {
+	c.setDefaults()
+
+	copyTo := &pb.ExecStreamRequest_CopyTo{Path: remote}
+	if c.Resume {
+		stat := os.Lstat
+		if c.FollowSymlinks {
+			stat = os.Stat
+		}
+
+		fi, err := stat(local)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return fmt.Errorf("only a single file can be resumed, %s isn't a regular file", local)
+		}
+
+		copyTo.ResumeName = filepath.Base(local)
+	}
+
+	status := c.uiStatus()
+	defer status.Close()
+
+	// Cancelling ends the stream, which also stops the archive below if
+	// we return early.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.startSession(ctx, status, &pb.ExecStreamRequest_Start{
+		CopyTo: copyTo,
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.CloseSend()
+
+	// Send the archive while we handle events from the instance. The
+	// archive is buffered since tar writes headers in small pieces. When
+	// resuming, this waits until the instance tells us what it has.
+	type archiveResult struct {
+		result *tarcopy.Result
+		err    error
+	}
+	archiveCh := make(chan archiveResult, 1)
+	startArchive := func(resume *tarcopy.Partial) {
+		go func() {
+			w := bufio.NewWriterSize(c.inputWriter(stream, 0), 32*1024)
+			result, err := tarcopy.Archive(w, []string{local}, &tarcopy.Options{
+				FollowSymlinks: c.FollowSymlinks,
+				Resume:         resume,
+			})
+			if err == nil {
+				err = w.Flush()
+			}
+
+			archiveCh <- archiveResult{result: result, err: err}
+		}()
+	}
+	if !c.Resume {
+		startArchive(nil)
+	}
+
+	var remoteResult *pb.ExecStreamResponse_CopyResult
+	for {
+		resp, err := copyRecv(stream)
+		if err != nil {
+			return err
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.ExecStreamResponse_Attached_:
+			status.Update("Copying files...")
+
+		case *pb.ExecStreamResponse_CopyPartial_:
+			if event.CopyPartial.Size > 0 {
+				status.Update(fmt.Sprintf("Verifying the %s already copied...",
+					humanize.Bytes(uint64(event.CopyPartial.Size))))
+			}
+
+			startArchive(&tarcopy.Partial{
+				Size:   event.CopyPartial.Size,
+				Chunks: event.CopyPartial.ChunkChecksums,
+			})
+
+		case *pb.ExecStreamResponse_CopyProgress_:
+			status.Update(fmt.Sprintf("Copying files... %d files, %s",
+				event.CopyProgress.Files, humanize.Bytes(uint64(event.CopyProgress.Bytes))))
+
+		case *pb.ExecStreamResponse_Warning_:
+			c.uiOutput(event.Warning.Message, terminal.WithWarningStyle())
+
+		case *pb.ExecStreamResponse_CopyResult_:
+			remoteResult = event.CopyResult
+
+		case *pb.ExecStreamResponse_Exit_:
+			if remoteResult == nil {
+				return fmt.Errorf("the copy ended without a result (exit code %d)",
+					event.Exit.Code)
+			}
+
+			local := <-archiveCh
+			if local.err != nil {
+				return local.err
+			}
+
+			return c.copyComplete(status, remote, local.result, copyResult(remoteResult))
+
+		case *pb.ExecStreamResponse_Closed_:
+			return closedError(c.Logger, event.Closed)
+
+		default:
+			c.Logger.Warn("unknown event type",
+				"type", fmt.Sprintf("%T", resp.Event))
+		}
+	}
+}
+
+// CopyFrom copies the remote file or directory on an instance of the
+// deployment to the local path. remote may be a glob pattern such as
+// "/var/log/*.log", which is expanded on the instance. If local is an
+// existing directory, the files are copied into it, otherwise the single
+// file or directory is created as local. Progress is shown with UI. If
+// Resume is set, remote must be a single file and only the part of it
+// that isn't already at local is received.
+//
+// If some files can't be copied, the rest still are and the error is a
+// *CopyError listing them.
+func (c *Client) CopyFrom(ctx context.Context, remote, local string) error {
+	c.setDefaults()
+
+	copyFrom := &pb.ExecStreamRequest_CopyFrom{Path: remote}
+	if c.Resume {
+		if strings.ContainsAny(remote, "*?[") {
+			return fmt.Errorf("only a single file can be resumed, not a pattern such as %q", remote)
+		}
+
+		partial, err := tarcopy.PartialFile(local, path.Base(remote))
+		if err != nil {
+			return err
+		}
+
+		copyFrom.Resume = &pb.ExecStreamResponse_CopyPartial{
+			Size:           partial.Size,
+			ChunkChecksums: partial.Chunks,
+		}
+	}
+
+	status := c.uiStatus()
+	defer status.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.startSession(ctx, status, &pb.ExecStreamRequest_Start{
+		CopyFrom: copyFrom,
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.CloseSend()
+
+	// Extract the archive as it arrives. The archive marks its own end so
+	// once extraction is done we discard anything left, such as padding.
+	type extractResult struct {
+		result *tarcopy.Result
+		err    error
+	}
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	extractCh := make(chan extractResult, 1)
+	go func() {
+		var last time.Time
+		result, err := tarcopy.Extract(pr, local, &tarcopy.Options{
+			Progress: func(files, bytes int64) {
+				if time.Since(last) < copyProgressInterval {
+					return
+				}
+				last = time.Now()
+
+				status.Update(fmt.Sprintf("Copying files... %d files, %s",
+					files, humanize.Bytes(uint64(bytes))))
+			},
+		})
+		extractCh <- extractResult{result: result, err: err}
+		io.Copy(ioutil.Discard, pr)
+	}()
+
+	var remoteResult *pb.ExecStreamResponse_CopyResult
+	for {
+		resp, err := copyRecv(stream)
+		if err != nil {
+			return err
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.ExecStreamResponse_Attached_:
+			status.Update("Copying files...")
+
+		case *pb.ExecStreamResponse_Output_:
+			if _, err := pw.Write(event.Output.Data); err != nil {
+				return err
+			}
+
+		case *pb.ExecStreamResponse_Warning_:
+			c.uiOutput(event.Warning.Message, terminal.WithWarningStyle())
+
+		case *pb.ExecStreamResponse_CopyResult_:
+			remoteResult = event.CopyResult
+
+		case *pb.ExecStreamResponse_Exit_:
+			if remoteResult == nil {
+				return fmt.Errorf("the copy ended without a result (exit code %d)",
+					event.Exit.Code)
+			}
+
+			// An archive that ends early is an error from the extraction
+			pw.CloseWithError(io.ErrUnexpectedEOF)
+			received := <-extractCh
+			if received.err != nil {
+				return received.err
+			}
+
+			return c.copyComplete(status, local, copyResult(remoteResult), received.result)
+
+		case *pb.ExecStreamResponse_Closed_:
+			return closedError(c.Logger, event.Closed)
+
+		default:
+			c.Logger.Warn("unknown event type",
+				"type", fmt.Sprintf("%T", resp.Event))
+		}
+	}
+}
+
+// copyProgressInterval is the minimum time between progress updates when
+// we're receiving files.
+const copyProgressInterval = 250 * time.Millisecond
+
+// startSession starts a session that doesn't run a command, such as a
+// file copy, with the given start event and waits for it to open. The deployment, instance and container options of
+// the start event are set from the client.
+func (c *Client) startSession(
+	ctx context.Context,
+	status terminal.Status,
+	start *pb.ExecStreamRequest_Start,
+) (*syncStream, error) {
+	status.Update(fmt.Sprintf("Connecting to deployment v%d...", c.DeploymentSeq))
+
+	rawClient, err := c.Client.StartExecStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream := &syncStream{Waypoint_StartExecStreamClient: rawClient}
+
+	start.DeploymentId = c.DeploymentId
+	start.InstanceId = c.InstanceId
+	start.TargetContainer = c.TargetContainer
+	start.SessionId, err = newSessionId()
+	if err != nil {
+		stream.CloseSend()
+		return nil, err
+	}
+	c.Logger.Debug("starting exec session", "exec_session_id", start.SessionId,
+		"deployment_seq", c.DeploymentSeq)
+
+	if err := stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{Start: start},
+	}); err != nil {
+		stream.CloseSend()
+		return nil, err
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		stream.CloseSend()
+		return nil, err
+	}
+	if _, ok := resp.Event.(*pb.ExecStreamResponse_Open_); !ok {
+		stream.CloseSend()
+		return nil, fmt.Errorf("%w: unexpected opening message", ErrProtocol)
+	}
+
+	status.Update("Waiting for instance to attach...")
+	return stream, nil
+}
+
+// copyRecv receives the next event of a file copy session.
+func copyRecv(stream *syncStream) (*pb.ExecStreamResponse, error) {
+	resp, err := stream.Recv()
+	if err == io.EOF {
+		return nil, fmt.Errorf("the instance disconnected before the copy finished")
+	}
+
+	return resp, err
+}
+
+// copyComplete verifies that what was received matches what was sent and
+// reports the result of a copy.
+func (c *Client) copyComplete(status terminal.Status, dest string, sent, received *tarcopy.Result) error {
+	if !bytes.Equal(sent.Checksum, received.Checksum) {
+		status.Step(terminal.StatusError, "Copy failed")
+		return fmt.Errorf("checksum mismatch: the files received don't match the files sent")
+	}
+
+	var errs []*tarcopy.FileError
+	errs = append(errs, sent.Errors...)
+	errs = append(errs, received.Errors...)
+	msg := fmt.Sprintf("Copied %d files (%s) to %s",
+		received.Files-int64(len(received.Errors)), humanize.Bytes(uint64(received.Bytes)), dest)
+	if received.Resumed > 0 {
+		msg += fmt.Sprintf(", resuming after %s", humanize.Bytes(uint64(received.Resumed)))
+	}
+	if len(errs) > 0 {
+		status.Step(terminal.StatusWarn, msg)
+		return &CopyError{Errors: errs}
+	}
+
+	status.Step(terminal.StatusOK, msg)
+	return nil
+}
+
+// copyResult converts the result reported by the instance.
+func copyResult(v *pb.ExecStreamResponse_CopyResult) *tarcopy.Result {
+	result := &tarcopy.Result{
+		Files:    v.Files,
+		Bytes:    v.Bytes,
+		Checksum: v.Checksum,
+		Resumed:  v.ResumedBytes,
+	}
+	for _, fileErr := range v.Errors {
+		result.Errors = append(result.Errors, &tarcopy.FileError{
+			Path: fileErr.Path,
+			Err:  errors.New(fileErr.Message),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,209 @@
+package execclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// ErrProtocol is wrapped by the errors of sessions that end because the
+// server or instance broke the exec protocol, such as by ending the stream
+// without the exit status of the command.
+var ErrProtocol = errors.New("internal protocol error")
+
+// ErrShutdownForced is returned when a graceful shutdown started with
+// Drain didn't finish, because the drain timeout passed or the context was
+// cancelled first.
+var ErrShutdownForced = errors.New("shutdown was forced before everything in progress ended")
+
+// ErrMaxOutput is returned when a session is ended because the command
+// wrote more than Client.MaxOutput with MaxOutputAbort set.
+var ErrMaxOutput = errors.New("the command wrote more output than the limit")
+
+// ClosedError is returned when the server ends a session itself, such as
+// when the deployment of its instance is destroyed. The instance stops the
+// command as it does when the client goes away.
+type ClosedError struct {
+	// Reason is why the server closed the session.
+	Reason pb.ExecStreamResponse_Closed_Reason
+
+	// Message describes why for users, such as "deployment v12 was
+	// destroyed".
+	Message string
+}
+
+func (e *ClosedError) Error() string {
+	return "the server closed the session: " + e.Message
+}
+
+// StatusError is a gRPC error from the server with a message explaining
+// what to do about it. The original status is still available through
+// status.FromError and Status, so scripts can check the code.
+type StatusError struct {
+	// Message is the explanation of the error for users.
+	Message string
+
+	// Status is the original status.
+	Status *status.Status
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.Message, e.Status.Message())
+}
+
+// GRPCStatus returns the original status for status.FromError.
+func (e *StatusError) GRPCStatus() *status.Status {
+	return e.Status
+}
+
+// HumanError returns the message to show users, which is used by
+// clierrors.Humanize in place of the message of the status.
+func (e *StatusError) HumanError() string {
+	return e.Error()
+}
+
+// SessionError is an error of a session other than its command exiting
+// unsuccessfully. It names the session so that it can be found in the logs
+// of the server and entrypoint. The status of Err, if any, is still
+// available through status.FromError.
+type SessionError struct {
+	// SessionId is the ID of the session that failed.
+	SessionId string
+
+	// Err is the error the session failed with.
+	Err error
+}
+
+func (e *SessionError) Error() string {
+	return fmt.Sprintf("session %s failed: %s", e.SessionId, e.Err)
+}
+
+func (e *SessionError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the status of Err for status.FromError.
+func (e *SessionError) GRPCStatus() *status.Status {
+	return status.Convert(e.Err)
+}
+
+// HumanError returns the message to show users. Like clierrors.Humanize,
+// this uses the explanation of a *StatusError or the message of a status
+// rather than the full error.
+func (e *SessionError) HumanError() string {
+	msg := e.Err.Error()
+	var statusErr *StatusError
+	if errors.As(e.Err, &statusErr) {
+		msg = statusErr.HumanError()
+	} else if s, ok := status.FromError(e.Err); ok {
+		msg = s.Message()
+	}
+
+	return fmt.Sprintf("session %s failed: %s", e.SessionId, msg)
+}
+
+// sessionError wraps the error of a session in a *SessionError. The exit
+// of the command, cancellation and the server closing the session, which
+// explains itself, aren't failures of the session so those are returned
+// as they are.
+func sessionError(sessionId string, err error) error {
+	var exitErr *ExitError
+	var closedErr *ClosedError
+	if err == nil || errors.As(err, &exitErr) || errors.As(err, &closedErr) ||
+		errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled {
+		return err
+	}
+
+	return &SessionError{SessionId: sessionId, Err: err}
+}
+
+// waitError explains an error that ended a session before its instance
+// attached with the last status the server reported, if any, so that it is
+// clear what the session was waiting for. Errors that are already explained
+// are returned as they are.
+func waitError(err error, lastStatus string) error {
+	var statusErr *StatusError
+	if err == nil || lastStatus == "" || errors.As(err, &statusErr) {
+		return err
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("%w (last status: %s)", err, lastStatus)
+	}
+
+	return &StatusError{
+		Message: "the session didn't start, the server last reported: " + lastStatus,
+		Status:  s,
+	}
+}
+
+// translateError explains the common gRPC errors of running a session.
+// opening is true if the session hasn't been opened yet, since a
+// NotFound error then refers to what we asked for rather than to
+// something the command was looking for. Other errors, and errors that
+// aren't statuses, are returned as they are.
+func (c *Client) translateError(err error, opening bool) error {
+	var statusErr *StatusError
+	if err == nil || errors.As(err, &statusErr) {
+		return err
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var msg string
+	switch s.Code() {
+	case codes.Unauthenticated:
+		msg = "your token is invalid or expired, get a new one with " +
+			"\"waypoint token exchange\" or set one with \"waypoint context create\""
+
+	case codes.PermissionDenied:
+		msg = "permission denied to " + c.action()
+
+	case codes.NotFound:
+		if !opening {
+			return err
+		}
+
+		if c.SessionId != "" {
+			msg = fmt.Sprintf("session %s not found, it may have exited", c.SessionId)
+		} else {
+			msg = fmt.Sprintf("deployment v%d or its instance wasn't found, run "+
+				"\"waypoint deployment list\" to see the deployments", c.DeploymentSeq)
+		}
+
+	case codes.Unavailable:
+		if c.ServerAddr != "" {
+			msg = fmt.Sprintf("can't reach the Waypoint server at %s, check that it "+
+				"is running and that -server-addr is correct", c.ServerAddr)
+		} else {
+			msg = "can't reach the Waypoint server, check that it is running and " +
+				"that -server-addr or the current context is correct"
+		}
+
+	default:
+		return err
+	}
+
+	return &StatusError{Message: msg, Status: s}
+}
+
+// action describes what the session does for error messages.
+func (c *Client) action() string {
+	switch {
+	case c.SessionId != "" && c.Watch:
+		return "watch session " + c.SessionId
+	case c.SessionId != "":
+		return "attach to session " + c.SessionId
+	default:
+		return fmt.Sprintf("exec into deployment v%d", c.DeploymentSeq)
+	}
+}
@@ -0,0 +1,176 @@
+package execclient
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientTranslateError(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Client  *Client
+		Code    codes.Code
+		Opening bool
+		Message string
+	}{
+		{
+			"unauthenticated",
+			&Client{},
+			codes.Unauthenticated, false,
+			"your token is invalid or expired",
+		},
+		{
+			"permission denied exec",
+			&Client{DeploymentSeq: 3},
+			codes.PermissionDenied, true,
+			"permission denied to exec into deployment v3",
+		},
+		{
+			"permission denied watch",
+			&Client{SessionId: "s1", Watch: true},
+			codes.PermissionDenied, true,
+			"permission denied to watch session s1",
+		},
+		{
+			"deployment not found",
+			&Client{DeploymentSeq: 3},
+			codes.NotFound, true,
+			"waypoint deployment list",
+		},
+		{
+			"session not found",
+			&Client{SessionId: "s1"},
+			codes.NotFound, true,
+			"session s1 not found",
+		},
+		{
+			"not found once open",
+			&Client{DeploymentSeq: 3},
+			codes.NotFound, false,
+			"",
+		},
+		{
+			"unavailable",
+			&Client{ServerAddr: "waypoint.example.com:9701"},
+			codes.Unavailable, false,
+			"can't reach the Waypoint server at waypoint.example.com:9701",
+		},
+		{
+			"unavailable local",
+			&Client{},
+			codes.Unavailable, false,
+			"current context",
+		},
+		{
+			"other",
+			&Client{},
+			codes.InvalidArgument, true,
+			"",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			orig := status.Error(tt.Code, "original")
+			err := tt.Client.translateError(orig, tt.Opening)
+			if tt.Message == "" {
+				require.Equal(orig, err)
+				return
+			}
+
+			require.Contains(err.Error(), tt.Message)
+			require.Contains(err.Error(), "original")
+
+			// The original status is still available.
+			var statusErr *StatusError
+			require.True(errors.As(fmt.Errorf("wrapped: %w", err), &statusErr))
+			require.Equal(tt.Code, statusErr.Status.Code())
+			require.Equal(tt.Code, status.Code(err))
+			require.Equal("original", status.Convert(err).Message())
+
+			// Translating again leaves it alone.
+			require.Equal(err, tt.Client.translateError(err, tt.Opening))
+		})
+	}
+
+	t.Run("not a status", func(t *testing.T) {
+		err := errors.New("plain")
+		require.Equal(t, err, (&Client{}).translateError(err, true))
+	})
+}
+
+func TestSessionError(t *testing.T) {
+	require := require.New(t)
+
+	// The status is still available and users see its explanation.
+	c := &Client{DeploymentSeq: 3}
+	err := sessionError("s1", c.translateError(status.Error(codes.PermissionDenied, "original"), true))
+	require.Equal(codes.PermissionDenied, status.Code(err))
+	require.Equal("session s1 failed: permission denied to exec into deployment v3 (original)",
+		err.(*SessionError).HumanError())
+
+	// Other statuses show their message.
+	err = sessionError("s1", status.Error(codes.Internal, "boom"))
+	require.Equal(codes.Internal, status.Code(err))
+	require.Equal("session s1 failed: boom", err.(*SessionError).HumanError())
+
+	// The exit of the command, cancellation and the server closing the
+	// session are left alone.
+	exitErr := &ExitError{Code: 1}
+	require.Equal(error(exitErr), sessionError("s1", exitErr))
+	closedErr := &ClosedError{Message: "deployment v3 was destroyed"}
+	require.Equal(error(closedErr), sessionError("s1", closedErr))
+	canceled := status.Error(codes.Canceled, "canceled")
+	require.Equal(canceled, sessionError("s1", canceled))
+	require.NoError(sessionError("s1", nil))
+}
+
+func TestClientRun_closed(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Steps []execclienttest.Step
+	}{
+		{
+			"running",
+			[]execclienttest.Step{
+				execclienttest.Respond(execclienttest.Open("s1")),
+				execclienttest.Respond(execclienttest.Attached("i1")),
+				execclienttest.Respond(execclienttest.Stdout("hello\n")),
+				execclienttest.Respond(execclienttest.DeploymentDestroyed(12)),
+			},
+		},
+		{
+			"before open",
+			[]execclienttest.Step{
+				execclienttest.Respond(execclienttest.Status("instance i1 selected")),
+				execclienttest.Respond(execclienttest.DeploymentDestroyed(12)),
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			stream := execclienttest.NewStream(t, tt.Steps...)
+			c := testClient(t, stream)
+			_, err := c.Run()
+
+			var closedErr *ClosedError
+			require.True(errors.As(err, &closedErr), "unexpected error: %v", err)
+			require.Equal(pb.ExecStreamResponse_Closed_DEPLOYMENT_DESTROYED, closedErr.Reason)
+			require.Equal("the server closed the session: deployment v12 was destroyed", err.Error())
+			require.Contains(stream.Start().ClientCapabilities, "closed")
+		})
+	}
+}
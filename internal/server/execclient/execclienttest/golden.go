@@ -0,0 +1,66 @@
+package execclienttest
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files of exec transcripts")
+
+// normalizers replace the parts of output that change from run to run
+// so that golden files are stable.
+var normalizers = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	// Timestamps, as RFC 3339 or as logged
+	{
+		regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`),
+		"<TIME>",
+	},
+
+	// Session IDs chosen by the client
+	{
+		regexp.MustCompile(`\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`),
+		"<SESSION>",
+	},
+
+	// Instance and other server IDs
+	{
+		regexp.MustCompile(`\b[0-9A-HJKMNP-TV-Z]{26}\b`),
+		"<ID>",
+	},
+}
+
+// Normalize replaces timestamps, session IDs and instance IDs in data
+// with placeholders.
+func Normalize(data []byte) []byte {
+	for _, n := range normalizers {
+		data = n.re.ReplaceAll(data, []byte(n.repl))
+	}
+
+	return data
+}
+
+// Golden compares got, once normalized, with the golden file at path.
+// If the test is run with -update, the golden file is written instead.
+func Golden(t testing.TB, path string, got []byte) {
+	t.Helper()
+
+	got = Normalize(got)
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, got, 0644))
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	require.NoError(t, err, "run the test with -update to create %s", path)
+	require.Equal(t, string(want), string(got), "run the test with -update to update %s", path)
+}
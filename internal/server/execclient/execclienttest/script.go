@@ -0,0 +1,209 @@
+package execclienttest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Script is a declarative exec session: the input the client types and
+// the steps of its stream. Scripts are written one directive per line,
+// with blank lines and lines starting with "#" ignored:
+//
+//	stdin "ls\n"             input the client types, in order
+//	open s1                  the open response with session ID s1
+//	attached i1              the instance i1 attached
+//	stdout "data"            output on stdout, data is a Go string
+//	stderr "data"            output on stderr
+//	warning "message"        a warning from the instance
+//	exit 3                   the command exited with code 3
+//	fail NOT_FOUND "message" the stream ends with a gRPC status
+//
+// A response can be preceded by "after DURATION" to pause before it and
+// by "after-input DATA" to wait for input, in that order:
+//
+//	after 100ms after-input "ls\n" stdout "file.txt\n"
+type Script struct {
+	Stdin []byte
+	Steps []Step
+}
+
+// LoadScript parses the script at path.
+func LoadScript(t testing.TB, path string) *Script {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	script, err := ParseScript(f)
+	require.NoError(t, err, path)
+	return script
+}
+
+// ParseScript parses a script from r. See Script for the format.
+func ParseScript(r io.Reader) (*Script, error) {
+	var script Script
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+
+		if err := script.parseLine(text); err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &script, nil
+}
+
+func (s *Script) parseLine(text string) error {
+	directive, rest := scriptWord(text)
+	if directive == "stdin" {
+		data, err := scriptString(rest)
+		if err != nil {
+			return err
+		}
+
+		s.Stdin = append(s.Stdin, data...)
+		return nil
+	}
+
+	var step Step
+	for {
+		switch directive {
+		case "after":
+			var v string
+			v, rest = scriptWord(rest)
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return err
+			}
+
+			step.Delay = d
+
+		case "after-input":
+			var v string
+			v, rest = scriptWord(rest)
+			data, err := scriptString(v)
+			if err != nil {
+				return err
+			}
+
+			wait := AfterInput(data, nil)
+			step.Wait, step.Desc = wait.Wait, wait.Desc
+
+		default:
+			resp, err := scriptResponse(directive, rest)
+			if err != nil {
+				return err
+			}
+
+			if resp.Err != nil {
+				step.Err = resp.Err
+			} else {
+				step.Response = resp.Response
+			}
+
+			s.Steps = append(s.Steps, step)
+			return nil
+		}
+
+		directive, rest = scriptWord(rest)
+	}
+}
+
+// scriptResponse parses a response directive into a step.
+func scriptResponse(directive, rest string) (Step, error) {
+	switch directive {
+	case "open":
+		return Respond(Open(rest)), nil
+
+	case "attached":
+		return Respond(Attached(rest)), nil
+
+	case "stdout", "stderr", "warning":
+		data, err := scriptString(rest)
+		if err != nil {
+			return Step{}, err
+		}
+
+		switch directive {
+		case "stdout":
+			return Respond(Stdout(data)), nil
+		case "stderr":
+			return Respond(Stderr(data)), nil
+		default:
+			return Respond(Warning(data)), nil
+		}
+
+	case "exit":
+		code, err := strconv.ParseInt(rest, 10, 32)
+		if err != nil {
+			return Step{}, err
+		}
+
+		return Respond(Exit(int32(code))), nil
+
+	case "fail":
+		name, msg := scriptWord(rest)
+		var code codes.Code
+		if err := code.UnmarshalJSON([]byte(strconv.Quote(name))); err != nil {
+			return Step{}, err
+		}
+
+		data, err := scriptString(msg)
+		if err != nil {
+			return Step{}, err
+		}
+
+		return Fail(status.Error(code, data)), nil
+
+	default:
+		return Step{}, fmt.Errorf("unknown directive %q", directive)
+	}
+}
+
+// scriptWord splits the first word off text. A quoted word may contain
+// spaces.
+func scriptWord(text string) (string, string) {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, `"`) {
+		for i := 1; i < len(text); i++ {
+			switch text[i] {
+			case '\\':
+				i++
+			case '"':
+				return text[:i+1], strings.TrimSpace(text[i+1:])
+			}
+		}
+	}
+
+	if i := strings.IndexAny(text, " \t"); i >= 0 {
+		return text[:i], strings.TrimSpace(text[i:])
+	}
+
+	return text, ""
+}
+
+// scriptString parses a quoted Go string.
+func scriptString(v string) (string, error) {
+	data, err := strconv.Unquote(strings.TrimSpace(v))
+	if err != nil {
+		return "", fmt.Errorf("invalid string %s: %s", v, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,481 @@
+// Package execclienttest provides a scriptable fake exec stream so that
+// exec clients can be tested without a server.
+//
+// A test declares the responses of a Stream in order, each optionally
+// waiting for the requests it answers, and then asserts on the requests
+// the client sent:
+//
+//	stream := execclienttest.NewStream(t,
+//		execclienttest.Respond(execclienttest.Open("s1")),
+//		execclienttest.AfterInput("hello", execclienttest.Stdout("hello")),
+//		execclienttest.Respond(execclienttest.Exit(0)),
+//	)
+//	client.Client = execclienttest.NewWaypoint(stream)
+//	...
+//	require.Equal(t, []string{"cat"}, stream.Start().Args)
+package execclienttest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// Timeout is how long a step waits for the requests it answers before
+// the test fails.
+var Timeout = 5 * time.Second
+
+// Waypoint is a Waypoint client whose exec streams are the given fake
+// streams, in order. Calling other methods panics, except for
+// GetVersionInfo which returns Version.
+type Waypoint struct {
+	pb.WaypointClient
+
+	// Streams are returned by StartExecStream, one per call.
+	Streams []*Stream
+
+	// Version is returned by GetVersionInfo.
+	Version *pb.VersionInfo
+
+	// Context is the context of the last call to StartExecStream.
+	Context context.Context
+
+	lock sync.Mutex
+}
+
+// NewWaypoint returns a Waypoint client whose exec streams are streams.
+func NewWaypoint(streams ...*Stream) *Waypoint {
+	return &Waypoint{Streams: streams, Version: &pb.VersionInfo{}}
+}
+
+func (w *Waypoint) StartExecStream(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (pb.Waypoint_StartExecStreamClient, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.Context = ctx
+	if len(w.Streams) == 0 {
+		return nil, errors.New("execclienttest: no more exec streams")
+	}
+
+	s := w.Streams[0]
+	w.Streams = w.Streams[1:]
+	s.setContext(ctx)
+	return s, nil
+}
+
+func (w *Waypoint) GetVersionInfo(
+	ctx context.Context,
+	req *empty.Empty,
+	opts ...grpc.CallOption,
+) (*pb.GetVersionInfoResponse, error) {
+	return &pb.GetVersionInfoResponse{Info: w.Version}, nil
+}
+
+// Step is a single response of a Stream.
+type Step struct {
+	// Response is returned by Recv. If Err is set, Recv returns it
+	// instead.
+	Response *pb.ExecStreamResponse
+	Err      error
+
+	// Wait, if set, delays the step until it returns true for the
+	// requests received so far.
+	Wait func(requests []*pb.ExecStreamRequest) bool
+
+	// Desc describes what the step waits for when it times out.
+	Desc string
+
+	// Delay, if set, is how long the step pauses before it waits for
+	// requests and responds.
+	Delay time.Duration
+}
+
+// Respond returns a step that responds with resp right away.
+func Respond(resp *pb.ExecStreamResponse) Step {
+	return Step{Response: resp}
+}
+
+// Fail returns a step that ends the stream with err.
+func Fail(err error) Step {
+	return Step{Err: err}
+}
+
+// AfterInput returns a step that responds with resp once the input
+// received contains data.
+func AfterInput(data string, resp *pb.ExecStreamResponse) Step {
+	return Step{
+		Response: resp,
+		Wait: func(requests []*pb.ExecStreamRequest) bool {
+			return bytes.Contains(input(requests), []byte(data))
+		},
+		Desc: "input " + data,
+	}
+}
+
+// AfterWinch returns a step that responds with resp once a window size
+// change is received.
+func AfterWinch(resp *pb.ExecStreamResponse) Step {
+	return Step{
+		Response: resp,
+		Wait: func(requests []*pb.ExecStreamRequest) bool {
+			return len(winches(requests)) > 0
+		},
+		Desc: "a window size change",
+	}
+}
+
+// AfterPing returns a step that answers the ping with the given ID with a
+// pong once it is received.
+func AfterPing(id uint64) Step {
+	return Step{
+		Response: &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Pong_{
+				Pong: &pb.ExecStreamResponse_Pong{Id: id},
+			},
+		},
+		Wait: func(requests []*pb.ExecStreamRequest) bool {
+			for _, req := range requests {
+				if v, ok := req.Event.(*pb.ExecStreamRequest_Ping_); ok && v.Ping.Id == id {
+					return true
+				}
+			}
+
+			return false
+		},
+		Desc: fmt.Sprintf("ping %d", id),
+	}
+}
+
+// AfterStdinEOF returns a step that responds with resp once the client
+// closes the stdin of the command.
+func AfterStdinEOF(resp *pb.ExecStreamResponse) Step {
+	return Step{
+		Response: resp,
+		Wait: func(requests []*pb.ExecStreamRequest) bool {
+			for _, req := range requests {
+				if req.GetInput().GetEof() {
+					return true
+				}
+			}
+
+			return false
+		},
+		Desc: "stdin eof",
+	}
+}
+
+// Stream is a fake exec stream. Recv returns the responses of its steps
+// in order and then io.EOF. The requests sent are recorded for the test
+// to assert on.
+type Stream struct {
+	grpc.ClientStream
+
+	// InputWindow, if non-zero, is how many bytes of input are received
+	// before sending more input blocks, like a server that stopped reading
+	// the stream, until the context of the stream is done.
+	InputWindow int
+
+	t     testing.TB
+	lock  sync.Mutex
+	cond  *sync.Cond
+	steps []Step
+	sent  []*pb.ExecStreamRequest
+
+	// closed is true once CloseSend is called, which ends a step that is
+	// waiting since the client won't send more.
+	closed bool
+
+	// ctx is the context of the stream and inputBytes how much input was
+	// received, for InputWindow.
+	ctx        context.Context
+	inputBytes int
+}
+
+// NewStream returns a Stream that responds with steps.
+func NewStream(t testing.TB, steps ...Step) *Stream {
+	s := &Stream{t: t, steps: steps}
+	s.cond = sync.NewCond(&s.lock)
+	return s
+}
+
+func (s *Stream) Send(req *pb.ExecStreamRequest) error {
+	return s.SendMsg(req)
+}
+
+// SendMsg records a request. A copy is kept since clients may reuse
+// their request messages.
+func (s *Stream) SendMsg(m interface{}) error {
+	req, ok := m.(*pb.ExecStreamRequest)
+	if !ok {
+		s.t.Errorf("execclienttest: unexpected message %T", m)
+		return errors.New("execclienttest: unexpected message")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return io.EOF
+	}
+
+	if v, ok := req.Event.(*pb.ExecStreamRequest_Input_); ok && s.InputWindow > 0 {
+		for s.inputBytes >= s.InputWindow {
+			if s.ctx != nil && s.ctx.Err() != nil {
+				return s.ctx.Err()
+			}
+
+			s.cond.Wait()
+		}
+		s.inputBytes += len(v.Input.Data)
+	}
+
+	s.sent = append(s.sent, proto.Clone(req).(*pb.ExecStreamRequest))
+	s.cond.Broadcast()
+	return nil
+}
+
+func (s *Stream) CloseSend() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	s.cond.Broadcast()
+	return nil
+}
+
+func (s *Stream) Recv() (*pb.ExecStreamResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.steps) == 0 {
+		return nil, io.EOF
+	}
+	step := s.steps[0]
+
+	// Requests may come in while we pause
+	if step.Delay > 0 {
+		s.lock.Unlock()
+		time.Sleep(step.Delay)
+		s.lock.Lock()
+	}
+
+	if step.Wait != nil {
+		timedOut := false
+		timer := time.AfterFunc(Timeout, func() {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			timedOut = true
+			s.cond.Broadcast()
+		})
+		defer timer.Stop()
+
+		for !step.Wait(s.sent) {
+			switch {
+			case s.closed:
+				return nil, io.EOF
+
+			case timedOut:
+				s.t.Errorf("execclienttest: timed out waiting for %s", step.Desc)
+				return nil, errors.New("execclienttest: timed out")
+			}
+
+			s.cond.Wait()
+		}
+	}
+
+	s.steps = s.steps[1:]
+	return step.Response, step.Err
+}
+
+// setContext sets the context of the stream. Sends blocked by
+// InputWindow fail once it is done.
+func (s *Stream) setContext(ctx context.Context) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ctx = ctx
+
+	go func() {
+		<-ctx.Done()
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.cond.Broadcast()
+	}()
+}
+
+// Requests returns the requests received so far.
+func (s *Stream) Requests() []*pb.ExecStreamRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*pb.ExecStreamRequest(nil), s.sent...)
+}
+
+// Start returns the start event, which must be the first request.
+func (s *Stream) Start() *pb.ExecStreamRequest_Start {
+	requests := s.Requests()
+	if len(requests) == 0 {
+		s.t.Fatal("execclienttest: no requests received")
+	}
+
+	start, ok := requests[0].Event.(*pb.ExecStreamRequest_Start_)
+	if !ok {
+		s.t.Fatalf("execclienttest: first request is %T, not a start", requests[0].Event)
+	}
+
+	return start.Start
+}
+
+// Input returns all the input received so far.
+func (s *Stream) Input() []byte {
+	return input(s.Requests())
+}
+
+// Winches returns the window size changes received so far.
+func (s *Stream) Winches() []*pb.ExecStreamRequest_WindowSize {
+	return winches(s.Requests())
+}
+
+// Open returns an open response for the session.
+func Open(sessionId string) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Open_{
+			Open: &pb.ExecStreamResponse_Open{SessionId: sessionId},
+		},
+	}
+}
+
+// Attached returns the response of the instance attaching.
+func Attached(instanceId string) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Attached_{
+			Attached: &pb.ExecStreamResponse_Attached{InstanceId: instanceId},
+		},
+	}
+}
+
+// WithCapabilities sets the capabilities announced by an open or attached
+// response and returns it.
+func WithCapabilities(resp *pb.ExecStreamResponse, caps ...string) *pb.ExecStreamResponse {
+	switch event := resp.Event.(type) {
+	case *pb.ExecStreamResponse_Open_:
+		event.Open.ServerCapabilities = caps
+	case *pb.ExecStreamResponse_Attached_:
+		event.Attached.InstanceCapabilities = caps
+	}
+
+	return resp
+}
+
+// WithSequence sets the sequence number of an output response and
+// returns it.
+func WithSequence(resp *pb.ExecStreamResponse, seq uint64) *pb.ExecStreamResponse {
+	resp.GetOutput().Sequence = seq
+	return resp
+}
+
+// Status returns a status response with the message msg.
+func Status(msg string) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Status_{
+			Status: &pb.ExecStreamResponse_Status{Message: msg},
+		},
+	}
+}
+
+// Stdout returns an output response on stdout.
+func Stdout(data string) *pb.ExecStreamResponse {
+	return output(pb.ExecStreamResponse_Output_STDOUT, data)
+}
+
+// Stderr returns an output response on stderr.
+func Stderr(data string) *pb.ExecStreamResponse {
+	return output(pb.ExecStreamResponse_Output_STDERR, data)
+}
+
+// Warning returns a warning response from the instance.
+func Warning(msg string) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Warning_{
+			Warning: &pb.ExecStreamResponse_Warning{Message: msg},
+		},
+	}
+}
+
+// Exit returns the response of the command exiting with code.
+func Exit(code int32) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Exit_{
+			Exit: &pb.ExecStreamResponse_Exit{Code: code},
+		},
+	}
+}
+
+// CommandExit returns the response of the command at index of a sequence
+// exiting with code.
+func CommandExit(index, code int32) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_CommandExit_{
+			CommandExit: &pb.ExecStreamResponse_CommandExit{
+				Index: index,
+				Exit:  &pb.ExecStreamResponse_Exit{Code: code},
+			},
+		},
+	}
+}
+
+// DeploymentDestroyed returns the response of the server closing the
+// session since deployment v seq was destroyed.
+func DeploymentDestroyed(seq uint64) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Closed_{
+			Closed: &pb.ExecStreamResponse_Closed{
+				Reason:  pb.ExecStreamResponse_Closed_DEPLOYMENT_DESTROYED,
+				Message: fmt.Sprintf("deployment v%d was destroyed", seq),
+			},
+		},
+	}
+}
+
+func output(channel pb.ExecStreamResponse_Output_Channel, data string) *pb.ExecStreamResponse {
+	return &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Output_{
+			Output: &pb.ExecStreamResponse_Output{
+				Channel: channel,
+				Data:    []byte(data),
+			},
+		},
+	}
+}
+
+func input(requests []*pb.ExecStreamRequest) []byte {
+	var result []byte
+	for _, req := range requests {
+		if v, ok := req.Event.(*pb.ExecStreamRequest_Input_); ok {
+			result = append(result, v.Input.Data...)
+		}
+	}
+
+	return result
+}
+
+func winches(requests []*pb.ExecStreamRequest) []*pb.ExecStreamRequest_WindowSize {
+	var result []*pb.ExecStreamRequest_WindowSize
+	for _, req := range requests {
+		if v, ok := req.Event.(*pb.ExecStreamRequest_Winch); ok {
+			result = append(result, v.Winch)
+		}
+	}
+
+	return result
+}
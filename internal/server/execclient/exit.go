@@ -0,0 +1,84 @@
+package execclient
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// ExitError is returned by Run when the remote command exits with a
+// non-zero code or is terminated by a signal.
+type ExitError struct {
+	// Code is the exit code. If the command was terminated by a signal,
+	// this is 128 plus the signal number.
+	Code int
+
+	// Signal is the name of the signal that terminated the command, such
+	// as "SIGKILL". This is empty if the command exited on its own.
+	Signal string
+
+	// CoreDumped is true if the command dumped core.
+	CoreDumped bool
+
+	// Usage is the resource usage of the command. This may be nil if the
+	// instance doesn't report it.
+	Usage *pb.ExecStreamResponse_Exit_Usage
+}
+
+func (e *ExitError) Error() string {
+	if e.Signal == "" {
+		return fmt.Sprintf("command exited with code %d", e.Code)
+	}
+
+	msg := "command terminated by signal " + e.Signal
+	if e.CoreDumped {
+		msg += " (core dumped)"
+	}
+
+	return msg
+}
+
+// exitError returns the error to return from Run for the exit event.
+// This returns nil if the command exited successfully.
+func exitError(v *pb.ExecStreamResponse_Exit) error {
+	if v.Code == 0 && v.Signal == "" {
+		return nil
+	}
+
+	return &ExitError{
+		Code:       int(v.Code),
+		Signal:     v.Signal,
+		CoreDumped: v.CoreDumped,
+		Usage:      v.Usage,
+	}
+}
+
+// formatExitSummary returns a human-friendly summary of how the remote
+// command exited and the resources it used.
+func formatExitSummary(v *pb.ExecStreamResponse_Exit) string {
+	parts := []string{fmt.Sprintf("exit code %d", v.Code)}
+	if v.Signal != "" {
+		sig := "signal " + v.Signal
+		if v.CoreDumped {
+			sig += " (core dumped)"
+		}
+
+		parts = append(parts, sig)
+	}
+
+	if u := v.Usage; u != nil {
+		parts = append(parts,
+			fmt.Sprintf("user %s", time.Duration(u.UserTimeMs)*time.Millisecond),
+			fmt.Sprintf("sys %s", time.Duration(u.SystemTimeMs)*time.Millisecond),
+		)
+		if u.MaxRssBytes > 0 {
+			parts = append(parts, "max memory "+humanize.Bytes(uint64(u.MaxRssBytes)))
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,566 @@
+package execclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/pkg/tunnel"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// PortForward forwards the connections accepted by ln to port on an
+// instance of the deployment, which connects to it on the loopback address
+// or ForwardTargetAddr. All the connections share a single exec stream.
+// Connections that the instance can't connect are shown with the reason.
+// This runs until ctx is cancelled, in which case it returns nil, Drain
+// finishes or the session ends. ln is closed when this returns.
+func (c *Client) PortForward(ctx context.Context, ln net.Listener, port int) error {
+	c.setDefaults()
+
+	defer ln.Close()
+
+	var mux *tunnel.Mux
+	return c.portForward(ctx, &pb.ExecStreamRequest_PortForward{
+		Port:       int32(port),
+		TargetAddr: c.ForwardTargetAddr,
+		Family:     c.ForwardFamily,
+	}, func(send func(*tunnel.Frame) error) forwarder {
+		mux = &tunnel.Mux{Logger: c.Logger.Named("tunnel"), Send: send}
+		return mux
+	}, func(instanceId string) string {
+		// We only accept connections once the instance is there to
+		// connect them.
+		go c.acceptForward(ln, mux)
+
+		return fmt.Sprintf("Forwarding %s to %s on instance %s",
+			ln.Addr(), c.forwardTarget(port), instanceId)
+	}, func() { ln.Close() })
+}
+
+// PortForwardReverse forwards connections to port on 127.0.0.1 on an
+// instance of the deployment to the local address addr. The instance
+// listens on the port for as long as this runs, so it fails if the port
+// is already in use there. This runs until ctx is cancelled, in which case
+// it returns nil, or the session ends.
+func (c *Client) PortForwardReverse(ctx context.Context, port int, addr string) error {
+	c.setDefaults()
+
+	return c.portForward(ctx, &pb.ExecStreamRequest_PortForward{
+		Port:    int32(port),
+		Reverse: true,
+	}, func(send func(*tunnel.Frame) error) forwarder {
+		return &tunnel.Mux{
+			Logger: c.Logger.Named("tunnel"),
+			Send:   send,
+			Dial: func(string) (net.Conn, error) {
+				return net.DialTimeout("tcp", addr, forwardDialTimeout)
+			},
+		}
+	}, func(instanceId string) string {
+		return fmt.Sprintf("Forwarding port %d on instance %s to %s",
+			port, instanceId, addr)
+	}, nil)
+}
+
+// PortForwardSOCKS runs a SOCKS5 proxy on ln. Each CONNECT request is
+// forwarded to an instance of the deployment, which connects to the
+// requested address, so clients can reach anything the instance can. The
+// server's port forwarding policy may refuse some addresses. Only CONNECT
+// without authentication is supported. This runs until ctx is cancelled,
+// in which case it returns nil, or the session ends. ln is closed when
+// this returns.
+func (c *Client) PortForwardSOCKS(ctx context.Context, ln net.Listener) error {
+	c.setDefaults()
+
+	defer ln.Close()
+
+	var mux *tunnel.Mux
+	return c.portForward(ctx, &pb.ExecStreamRequest_PortForward{
+		Dynamic: true,
+		Family:  c.ForwardFamily,
+	}, func(send func(*tunnel.Frame) error) forwarder {
+		mux = &tunnel.Mux{Logger: c.Logger.Named("tunnel"), Send: send}
+		return mux
+	}, func(instanceId string) string {
+		go c.acceptSOCKS(ln, mux)
+
+		return fmt.Sprintf("SOCKS5 proxy on %s forwarding through instance %s",
+			ln.Addr(), instanceId)
+	}, func() { ln.Close() })
+}
+
+// PortForwardUDP forwards the UDP datagrams received on pc to port on an
+// instance of the deployment, and the replies back to their senders. This
+// is as unreliable as UDP: datagrams that can't be delivered are dropped,
+// as are datagrams over tunnel.MaxDatagram. This runs until ctx is
+// cancelled, in which case it returns nil, or the session ends. pc is
+// closed when this returns.
+func (c *Client) PortForwardUDP(ctx context.Context, pc net.PacketConn, port int) error {
+	c.setDefaults()
+
+	defer pc.Close()
+
+	var packets *tunnel.PacketMux
+	return c.portForward(ctx, &pb.ExecStreamRequest_PortForward{
+		Port:       int32(port),
+		Udp:        true,
+		TargetAddr: c.ForwardTargetAddr,
+		Family:     c.ForwardFamily,
+	}, func(send func(*tunnel.Frame) error) forwarder {
+		packets = &tunnel.PacketMux{Logger: c.Logger.Named("tunnel"), Send: send}
+		return packets
+	}, func(instanceId string) string {
+		go packets.Serve(pc)
+
+		return fmt.Sprintf("Forwarding UDP %s to %s on instance %s",
+			pc.LocalAddr(), c.forwardTarget(port), instanceId)
+	}, func() { pc.Close() })
+}
+
+// forwardDialTimeout is how long we wait to connect to the local address
+// for each connection when forwarding in reverse.
+const forwardDialTimeout = 10 * time.Second
+
+// socksHandshakeTimeout is how long a SOCKS client has to send its
+// request after connecting.
+const socksHandshakeTimeout = 10 * time.Second
+
+// forwarder is the end of a port forwarding tunnel, a *tunnel.Mux or a
+// *tunnel.PacketMux.
+type forwarder interface {
+	Handle(*tunnel.Frame)
+	Stats() tunnel.Stats
+	Close()
+}
+
+// portForward runs a port forwarding session. newForwarder creates our
+// end of the tunnel, which sends its frames with send. attached is called
+// once the instance has attached and returns the message to show.
+// stopAccepting, if set, stops new connections when draining. A summary of
+// the traffic is shown when the session ends.
+func (c *Client) portForward(
+	ctx context.Context,
+	fwd *pb.ExecStreamRequest_PortForward,
+	newForwarder func(send func(*tunnel.Frame) error) forwarder,
+	attached func(instanceId string) string,
+	stopAccepting func(),
+) error {
+	status := c.uiStatus()
+	defer status.Close()
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.startSession(sessionCtx, status, &pb.ExecStreamRequest_Start{
+		PortForward: fwd,
+	})
+	if err != nil {
+		return err
+	}
+	defer stream.CloseSend()
+
+	f := newForwarder(func(frame *tunnel.Frame) error {
+		return stream.Send(&pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Tunnel{
+				Tunnel: tunnelFramePB(frame),
+			},
+		})
+	})
+
+	// Connections are closed first so that they are all counted in the
+	// summary.
+	forwarding := false
+	defer func() {
+		f.Close()
+		if forwarding {
+			stats := f.Stats()
+			status.Step(terminal.StatusOK, forwardSummary(fwd, stats))
+			if stats.LastError != "" {
+				c.forwardOutput("Last error: "+stats.LastError, terminal.WithWarningStyle())
+			}
+		}
+	}()
+
+	var drain *forwardDrain
+	if c.Drain != nil {
+		drain = &forwardDrain{
+			Drain:         c.Drain,
+			Timeout:       c.DrainTimeout,
+			Status:        status,
+			Forwarder:     f,
+			StopAccepting: stopAccepting,
+			Cancel:        cancel,
+		}
+		go drain.Run(sessionCtx)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if drain != nil && drain.Started() {
+				return drain.Result()
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			if err == io.EOF {
+				return fmt.Errorf("the instance disconnected")
+			}
+
+			return err
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.ExecStreamResponse_Attached_:
+			status.Step(terminal.StatusOK, attached(event.Attached.InstanceId))
+			if !forwarding {
+				go c.forwardStatus(sessionCtx, fwd, f)
+			}
+			forwarding = true
+
+		case *pb.ExecStreamResponse_Tunnel:
+			f.Handle(tunnelFrame(event.Tunnel))
+
+		case *pb.ExecStreamResponse_Warning_:
+			c.uiOutput(event.Warning.Message, terminal.WithWarningStyle())
+
+		case *pb.ExecStreamResponse_Exit_:
+			return fmt.Errorf("port forwarding ended (exit code %d)", event.Exit.Code)
+
+		case *pb.ExecStreamResponse_Closed_:
+			return closedError(c.Logger, event.Closed)
+
+		default:
+			c.Logger.Warn("unknown event type",
+				"type", fmt.Sprintf("%T", resp.Event))
+		}
+	}
+}
+
+// forwardDrain shuts down port forwarding gracefully once Drain is
+// closed: it stops accepting connections and waits up to Timeout for the
+// open ones to end, then ends the session with Cancel.
+type forwardDrain struct {
+	Drain         <-chan struct{}
+	Timeout       time.Duration
+	Status        terminal.Status
+	Forwarder     forwarder
+	StopAccepting func()
+	Cancel        func()
+
+	lock    sync.Mutex
+	started bool
+	drained bool
+}
+
+// Run waits for Drain and then drains until the connections end, the
+// timeout passes or ctx is done.
+func (d *forwardDrain) Run(ctx context.Context) {
+	select {
+	case <-d.Drain:
+	case <-ctx.Done():
+		return
+	}
+
+	d.lock.Lock()
+	d.started = true
+	d.lock.Unlock()
+
+	if d.StopAccepting != nil {
+		d.StopAccepting()
+	}
+
+	deadline := time.Now().Add(d.Timeout)
+	ticker := time.NewTicker(forwardDrainTick)
+	defer ticker.Stop()
+	for {
+		active := d.Forwarder.Stats().Active
+		if active == 0 {
+			d.lock.Lock()
+			d.drained = true
+			d.lock.Unlock()
+			d.Cancel()
+			return
+		}
+
+		left := time.Until(deadline)
+		if left <= 0 {
+			d.Cancel()
+			return
+		}
+
+		d.Status.Update(fmt.Sprintf(
+			"Waiting for %d connections to close, %s left (interrupt again to force)",
+			active, left.Round(time.Second)))
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Started returns true if draining has started.
+func (d *forwardDrain) Started() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.started
+}
+
+// Result returns the error for a session that ended after draining
+// started: nil if all the connections ended in time.
+func (d *forwardDrain) Result() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.drained {
+		return nil
+	}
+
+	return ErrShutdownForced
+}
+
+// forwardDrainTick is how often we check whether connections have ended
+// while draining, and update the countdown.
+const forwardDrainTick = 250 * time.Millisecond
+
+// forwardStatus shows the status of port forwarding at
+// ForwardStatusInterval and on SIGUSR1 until ctx is done.
+func (c *Client) forwardStatus(ctx context.Context, fwd *pb.ExecStreamRequest_PortForward, f forwarder) {
+	statusCh := make(chan os.Signal, 1)
+	registerStatusSignal(statusCh)
+	defer signal.Stop(statusCh)
+
+	var tickCh <-chan time.Time
+	if c.ForwardStatusInterval > 0 {
+		ticker := time.NewTicker(c.ForwardStatusInterval)
+		defer ticker.Stop()
+		tickCh = ticker.C
+	}
+
+	for {
+		select {
+		case <-tickCh:
+		case <-statusCh:
+		case <-ctx.Done():
+			return
+		}
+
+		c.forwardOutput(forwardStatusLine(fwd, f.Stats()))
+	}
+}
+
+// forwardOutput outputs a message about port forwarding to the UI, on
+// Stderr if it is set so that the message can't be confused with
+// forwarded data on stdout.
+func (c *Client) forwardOutput(msg string, raw ...interface{}) {
+	if c.Stderr != nil {
+		raw = append(raw, terminal.WithWriter(c.Stderr))
+	}
+
+	c.uiOutput(msg, raw...)
+}
+
+// acceptForward adds the connections accepted by ln to the tunnel until
+// ln or the tunnel is closed.
+func (c *Client) acceptForward(ln net.Listener, mux *tunnel.Mux) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+
+		remote := conn.RemoteAddr()
+		if err := mux.Add(conn, "", func(err error) {
+			if err != nil {
+				c.forwardFailed(remote, err)
+			}
+		}); err != nil {
+			c.Logger.Debug("error forwarding connection", "err", err)
+			return
+		}
+	}
+}
+
+// forwardTarget describes where the instance connects to for port.
+func (c *Client) forwardTarget(port int) string {
+	if c.ForwardTargetAddr == "" {
+		return fmt.Sprintf("port %d", port)
+	}
+
+	return net.JoinHostPort(c.ForwardTargetAddr, strconv.Itoa(port))
+}
+
+// forwardFailed shows why the instance couldn't connect the connection
+// from remote, with the error of each address it tried.
+func (c *Client) forwardFailed(remote net.Addr, err error) {
+	c.Logger.Info("error connecting", "remote", remote, "err", err)
+
+	var dialErr *tunnel.DialError
+	if !errors.As(err, &dialErr) || len(dialErr.Attempts) <= 1 {
+		c.forwardOutput(fmt.Sprintf("Connection from %s failed: %s", remote, err),
+			terminal.WithErrorStyle())
+		return
+	}
+
+	msg := fmt.Sprintf("Connection from %s failed, the instance couldn't connect:", remote)
+	for _, a := range dialErr.Attempts {
+		msg += fmt.Sprintf("\n  %s: %s", a.Addr, a.Err)
+	}
+	c.forwardOutput(msg, terminal.WithErrorStyle())
+}
+
+// acceptSOCKS handles the SOCKS connections accepted by ln until ln is
+// closed.
+func (c *Client) acceptSOCKS(ln net.Listener, mux *tunnel.Mux) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+
+		go c.handleSOCKS(conn, mux)
+	}
+}
+
+// handleSOCKS reads the request of a SOCKS connection and adds it to the
+// tunnel to connect to the requested address. The client is told the
+// result once the instance has connected or failed to.
+func (c *Client) handleSOCKS(conn net.Conn, mux *tunnel.Mux) {
+	conn.SetDeadline(time.Now().Add(socksHandshakeTimeout))
+	addr, err := tunnel.SOCKSHandshake(conn)
+	if err != nil {
+		c.Logger.Debug("invalid SOCKS request", "remote", conn.RemoteAddr(), "err", err)
+		conn.Close()
+		return
+	}
+	conn.SetDeadline(time.Time{})
+
+	if err := mux.Add(conn, addr, func(err error) {
+		if err != nil {
+			c.forwardFailed(conn.RemoteAddr(), err)
+		}
+
+		tunnel.SOCKSReply(conn, err)
+	}); err != nil {
+		c.Logger.Debug("error forwarding connection", "err", err)
+	}
+}
+
+// forwardStatusLine describes a port forwarding session in progress.
+func forwardStatusLine(fwd *pb.ExecStreamRequest_PortForward, stats tunnel.Stats) string {
+	var msg string
+	if fwd.Udp {
+		msg = fmt.Sprintf("Forwarding: %d datagrams", stats.Datagrams)
+	} else {
+		msg = fmt.Sprintf("Forwarding: %d open, %d total", stats.Active, stats.Connections)
+		if stats.Failed > 0 {
+			msg += fmt.Sprintf(" (%d failed)", stats.Failed)
+		}
+	}
+
+	msg += fmt.Sprintf(", sent %s, received %s",
+		humanize.Bytes(uint64(stats.BytesSent)),
+		humanize.Bytes(uint64(stats.BytesReceived)))
+	if stats.LastError != "" {
+		msg += ", last error: " + stats.LastError
+	}
+
+	return msg
+}
+
+// forwardSummary describes the traffic of a port forwarding session.
+func forwardSummary(fwd *pb.ExecStreamRequest_PortForward, stats tunnel.Stats) string {
+	var msg string
+	if fwd.Udp {
+		msg = fmt.Sprintf("Forwarded %d datagrams", stats.Datagrams)
+		if stats.Dropped > 0 {
+			msg += fmt.Sprintf(" (%d dropped as too large)", stats.Dropped)
+		}
+	} else {
+		msg = fmt.Sprintf("Forwarded %d connections", stats.Connections)
+		if stats.Failed > 0 {
+			msg += fmt.Sprintf(" (%d failed)", stats.Failed)
+		}
+	}
+
+	return msg + fmt.Sprintf(", sent %s, received %s",
+		humanize.Bytes(uint64(stats.BytesSent)),
+		humanize.Bytes(uint64(stats.BytesReceived)))
+}
+
+// tunnelFrame converts a frame received on the exec stream.
+func tunnelFrame(v *pb.ExecStreamRequest_TunnelFrame) *tunnel.Frame {
+	return &tunnel.Frame{
+		ID:    v.ConnectionId,
+		Open:  v.Open,
+		Addr:  v.Address,
+		Data:  v.Data,
+		Close: v.Close,
+		Error: v.Error,
+		Peer:  v.Peer,
+
+		DialError: tunnelDialError(v.DialError),
+	}
+}
+
+// tunnelFramePB converts a frame to send on the exec stream.
+func tunnelFramePB(f *tunnel.Frame) *pb.ExecStreamRequest_TunnelFrame {
+	return &pb.ExecStreamRequest_TunnelFrame{
+		ConnectionId: f.ID,
+		Open:         f.Open,
+		Address:      f.Addr,
+		Data:         f.Data,
+		Close:        f.Close,
+		Error:        f.Error,
+		Peer:         f.Peer,
+		DialError:    tunnelDialErrorPB(f.DialError),
+	}
+}
+
+// tunnelDialError converts the dial error of a frame received on the exec
+// stream.
+func tunnelDialError(v *pb.ExecStreamRequest_DialError) *tunnel.DialError {
+	if v == nil {
+		return nil
+	}
+
+	result := &tunnel.DialError{}
+	for _, a := range v.Attempts {
+		result.Attempts = append(result.Attempts, tunnel.DialAttempt{
+			Addr: a.Address,
+			Err:  a.Error,
+		})
+	}
+
+	return result
+}
+
+// tunnelDialErrorPB converts the dial error of a frame to send on the exec
+// stream.
+func tunnelDialErrorPB(e *tunnel.DialError) *pb.ExecStreamRequest_DialError {
+	if e == nil {
+		return nil
+	}
+
+	result := &pb.ExecStreamRequest_DialError{}
+	for _, a := range e.Attempts {
+		result.Attempts = append(result.Attempts, &pb.ExecStreamRequest_DialError_Attempt{
+			Address: a.Addr,
+			Error:   a.Err,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,136 @@
+package execclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/pkg/tunnel"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestForwardStatusLine(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Fwd      *pb.ExecStreamRequest_PortForward
+		Stats    tunnel.Stats
+		Expected string
+	}{
+		{
+			"idle",
+			&pb.ExecStreamRequest_PortForward{Port: 80},
+			tunnel.Stats{},
+			"Forwarding: 0 open, 0 total, sent 0 B, received 0 B",
+		},
+
+		{
+			"connections",
+			&pb.ExecStreamRequest_PortForward{Port: 80},
+			tunnel.Stats{
+				Connections:   12,
+				Failed:        1,
+				Active:        2,
+				LastError:     "connecting to 127.0.0.1:80: connection refused",
+				BytesSent:     2000,
+				BytesReceived: 40000,
+			},
+			"Forwarding: 2 open, 12 total (1 failed), sent 2.0 kB, received 40 kB, " +
+				"last error: connecting to 127.0.0.1:80: connection refused",
+		},
+
+		{
+			"udp",
+			&pb.ExecStreamRequest_PortForward{Port: 53, Udp: true},
+			tunnel.Stats{Datagrams: 4, BytesSent: 100, BytesReceived: 300},
+			"Forwarding: 4 datagrams, sent 100 B, received 300 B",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, forwardStatusLine(tt.Fwd, tt.Stats))
+		})
+	}
+}
+
+func TestForwardDrain(t *testing.T) {
+	t.Run("connections end in time", func(t *testing.T) {
+		require := require.New(t)
+
+		f := &testForwarder{}
+		f.active = 2
+		drainCh := make(chan struct{})
+		var stopped int32
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		d := &forwardDrain{
+			Drain:         drainCh,
+			Timeout:       time.Minute,
+			Status:        nullStatus{},
+			Forwarder:     f,
+			StopAccepting: func() { atomic.StoreInt32(&stopped, 1) },
+			Cancel:        cancel,
+		}
+		go d.Run(ctx)
+		require.False(d.Started())
+
+		close(drainCh)
+		require.Eventually(func() bool {
+			return atomic.LoadInt32(&stopped) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.True(d.Started())
+		require.NoError(ctx.Err())
+
+		// Once the connections end, the session ends gracefully
+		atomic.StoreInt64(&f.active, 0)
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("draining didn't end")
+		}
+		require.NoError(d.Result())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		require := require.New(t)
+
+		f := &testForwarder{}
+		f.active = 1
+		drainCh := make(chan struct{})
+		close(drainCh)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		d := &forwardDrain{
+			Drain:     drainCh,
+			Timeout:   100 * time.Millisecond,
+			Status:    nullStatus{},
+			Forwarder: f,
+			Cancel:    cancel,
+		}
+		go d.Run(ctx)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("draining didn't time out")
+		}
+		require.Equal(ErrShutdownForced, d.Result())
+	})
+}
+
+// testForwarder is a forwarder with a number of active connections.
+type testForwarder struct {
+	active int64
+}
+
+func (f *testForwarder) Handle(*tunnel.Frame) {}
+func (f *testForwarder) Close()               {}
+
+func (f *testForwarder) Stats() tunnel.Stats {
+	return tunnel.Stats{Active: atomic.LoadInt64(&f.active)}
+}
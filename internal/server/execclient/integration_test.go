@@ -0,0 +1,376 @@
+// +build !windows
+
+package execclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess"
+)
+
+// These tests run the client against the single process server with a
+// fake entrypoint, covering the whole path of an exec session.
+
+func TestExecIntegration(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := singleprocess.TestServer(t)
+	dep := testExecDeployment(t, client)
+	entry := testExecEntrypoint(t, ctx, client, dep.Id, true)
+
+	stdinR, stdinW, err := os.Pipe()
+	require.NoError(err)
+	defer stdinW.Close()
+	defer stdinR.Close()
+
+	stdout, err := ioutil.TempFile("", "waypoint-exec")
+	require.NoError(err)
+	defer os.Remove(stdout.Name())
+	defer stdout.Close()
+
+	term := &testTerminal{rows: 24, cols: 80}
+	c := &Client{
+		Logger:           hclog.L(),
+		UI:               terminal.NonInteractiveUI(ctx),
+		Context:          ctx,
+		Client:           client,
+		DeploymentId:     dep.Id,
+		DeploymentSeq:    dep.Sequence,
+		Args:             []string{"cat"},
+		Stdin:            stdinR,
+		Stdout:           stdout,
+		Stderr:           ioutil.Discard,
+		terminalDetector: term,
+		rawModer:         term,
+		consoleSizer:     term,
+	}
+
+	type result struct {
+		code int
+		err  error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		code, err := c.Run()
+		resultCh <- result{code, err}
+	}()
+
+	// The session is assigned to our instance with our terminal's size
+	exec := entry.session(t)
+	require.Equal([]string{"cat"}, exec.Args)
+	require.NotNil(exec.Pty)
+	require.Equal(int32(24), exec.Pty.WindowSize.Rows)
+	require.Equal(int32(80), exec.Pty.WindowSize.Cols)
+
+	// Input is echoed back
+	_, err = io.WriteString(stdinW, "hello\n")
+	require.NoError(err)
+	require.Eventually(func() bool {
+		data, err := ioutil.ReadFile(stdout.Name())
+		return err == nil && bytes.Contains(data, []byte("hello\n"))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Window size changes are delivered
+	term.lock.Lock()
+	term.rows, term.cols = 40, 120
+	term.lock.Unlock()
+	require.NoError(unix.Kill(os.Getpid(), unix.SIGWINCH))
+	select {
+	case winch := <-entry.winchCh:
+		require.Equal(int32(40), winch.Rows)
+		require.Equal(int32(120), winch.Cols)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("window size change not delivered")
+	}
+
+	// The exit code of the command is returned
+	_, err = io.WriteString(stdinW, "exit 3\n")
+	require.NoError(err)
+	var r result
+	select {
+	case r = <-resultCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("session didn't exit")
+	}
+	require.Equal(3, r.code)
+	var exitErr *ExitError
+	require.True(errors.As(r.err, &exitErr))
+	require.Equal(3, exitErr.Code)
+	require.Equal(1, term.restored)
+
+	// Everything of the session stops once the caller closes stdin,
+	// which our input goroutine can't stop reading on its own.
+	stdinW.Close()
+	require.Eventually(func() bool {
+		return testExecGoroutines() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExecIntegration_noInstances(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := singleprocess.TestServer(t)
+	dep := testExecDeployment(t, client)
+
+	c := &Client{
+		Logger:       hclog.L(),
+		Context:      ctx,
+		Client:       client,
+		DeploymentId: dep.Id,
+		Args:         []string{"cat"},
+		Stdin:        bytes.NewReader(nil),
+		Stdout:       ioutil.Discard,
+		Stderr:       ioutil.Discard,
+	}
+
+	code, err := c.Run()
+	require.Error(err)
+	require.Equal(0, code)
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	var sessionErr *SessionError
+	require.True(errors.As(err, &sessionErr))
+
+	require.Eventually(func() bool {
+		return testExecGoroutines() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestExecIntegration_assignmentTimeout(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := singleprocess.TestServer(t)
+	dep := testExecDeployment(t, client)
+
+	// Our instance is assigned the session but never attaches to it
+	entry := testExecEntrypoint(t, ctx, client, dep.Id, false)
+
+	runCtx, runCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer runCancel()
+	c := &Client{
+		Logger:       hclog.L(),
+		Context:      runCtx,
+		Client:       client,
+		DeploymentId: dep.Id,
+		Args:         []string{"cat"},
+		ForcePTY:     true,
+		Stdin:        bytes.NewReader(nil),
+		Stdout:       ioutil.Discard,
+		Stderr:       ioutil.Discard,
+	}
+
+	code, err := c.Run()
+	require.Error(err)
+	require.Equal(0, code)
+	require.Equal(codes.DeadlineExceeded, status.Code(err))
+	var sessionErr *SessionError
+	require.True(errors.As(err, &sessionErr))
+	require.Equal(entry.session(t).SessionId, sessionErr.SessionId)
+
+	require.Eventually(func() bool {
+		return testExecGoroutines() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// testExecDeployment creates a deployment to exec into.
+func testExecDeployment(t *testing.T, client pb.WaypointClient) *pb.Deployment {
+	resp, err := client.UpsertDeployment(context.Background(), &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Component: &pb.Component{
+				Name: "testapp",
+			},
+		}),
+	})
+	require.NoError(t, err)
+	return resp.Deployment
+}
+
+// testEntrypoint is a fake entrypoint of an instance. Its sessions echo
+// their input and exit with N once they receive a line "exit N".
+type testEntrypoint struct {
+	t      *testing.T
+	client pb.WaypointClient
+
+	instanceId string
+
+	// attach is false if the instance never attaches to its sessions.
+	attach bool
+
+	// execCh receives the sessions assigned to the instance and winchCh
+	// the window size changes of its sessions.
+	execCh  chan *pb.EntrypointConfig_Exec
+	winchCh chan *pb.ExecStreamRequest_WindowSize
+}
+
+// testExecEntrypoint registers a fake entrypoint for the deployment. It
+// stops once ctx is done.
+func testExecEntrypoint(
+	t *testing.T,
+	ctx context.Context,
+	client pb.WaypointClient,
+	deploymentId string,
+	attach bool,
+) *testEntrypoint {
+	instanceId, err := server.Id()
+	require.NoError(t, err)
+
+	e := &testEntrypoint{
+		t:          t,
+		client:     client,
+		instanceId: instanceId,
+		attach:     attach,
+		execCh:     make(chan *pb.EntrypointConfig_Exec, 10),
+		winchCh:    make(chan *pb.ExecStreamRequest_WindowSize, 10),
+	}
+
+	stream, err := client.EntrypointConfig(ctx, &pb.EntrypointConfigRequest{
+		InstanceId:   instanceId,
+		DeploymentId: deploymentId,
+	})
+	require.NoError(t, err)
+
+	// Wait for the first config so that we know we're registered
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+
+	go func(resp *pb.EntrypointConfigResponse) {
+		var idx int64
+		for {
+			for _, exec := range resp.Config.Exec {
+				if exec.Index <= idx {
+					continue
+				}
+
+				idx = exec.Index
+				e.execCh <- exec
+				if e.attach {
+					go e.exec(ctx, exec)
+				}
+			}
+
+			var err error
+			resp, err = stream.Recv()
+			if err != nil {
+				return
+			}
+		}
+	}(resp)
+
+	return e
+}
+
+// session returns the next session assigned to the instance.
+func (e *testEntrypoint) session(t *testing.T) *pb.EntrypointConfig_Exec {
+	select {
+	case exec := <-e.execCh:
+		return exec
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("no session assigned")
+		return nil
+	}
+}
+
+var testExecExitRe = regexp.MustCompile(`exit (\d+)\n`)
+
+// exec runs a session until it exits or its stream ends.
+func (e *testEntrypoint) exec(ctx context.Context, exec *pb.EntrypointConfig_Exec) {
+	stream, err := e.client.EntrypointExecStream(ctx)
+	if err != nil {
+		e.t.Errorf("error opening exec stream: %s", err)
+		return
+	}
+	defer stream.CloseSend()
+
+	if err := stream.Send(&pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Open_{
+			Open: &pb.EntrypointExecRequest_Open{
+				InstanceId: e.instanceId,
+				Index:      exec.Index,
+			},
+		},
+	}); err != nil {
+		e.t.Errorf("error opening exec stream: %s", err)
+		return
+	}
+
+	var input []byte
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.EntrypointExecResponse_Input:
+			input = append(input, event.Input...)
+			if err := stream.Send(&pb.EntrypointExecRequest{
+				Event: &pb.EntrypointExecRequest_Output_{
+					Output: &pb.EntrypointExecRequest_Output{
+						Channel: pb.EntrypointExecRequest_Output_STDOUT,
+						Data:    event.Input,
+					},
+				},
+			}); err != nil {
+				return
+			}
+
+			if m := testExecExitRe.FindSubmatch(input); m != nil {
+				code, _ := strconv.Atoi(string(m[1]))
+				stream.Send(&pb.EntrypointExecRequest{
+					Event: &pb.EntrypointExecRequest_Exit_{
+						Exit: &pb.EntrypointExecRequest_Exit{Code: int32(code)},
+					},
+				})
+				return
+			}
+
+		case *pb.EntrypointExecResponse_Winch:
+			e.winchCh <- event.Winch
+		}
+	}
+}
+
+// testExecGoroutines returns the number of goroutines running for exec
+// sessions, in the client or the server.
+func testExecGoroutines() int {
+	buf := make([]byte, 1024*1024)
+	buf = buf[:runtime.Stack(buf, true)]
+
+	n := 0
+	for _, g := range bytes.Split(buf, []byte("\n\n")) {
+		if bytes.Contains(g, []byte("execclient.(*Client)")) ||
+			bytes.Contains(g, []byte("singleprocess.(*service).StartExecStream")) ||
+			bytes.Contains(g, []byte("singleprocess.(*service).EntrypointExecStream")) {
+			n++
+		}
+	}
+
+	return n
+}
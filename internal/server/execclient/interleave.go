@@ -0,0 +1,142 @@
+package execclient
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// interleaveMaxHeld is how much output of one channel is held while the
+// other is in the middle of a line before we write it anyway.
+const interleaveMaxHeld = 64 * 1024
+
+// The escape sequences that color stderr with ColorStderr.
+var (
+	colorStderr = []byte("\x1b[31m")
+	colorReset  = []byte("\x1b[0m")
+)
+
+// lineInterleaver orders the stdout and stderr of the remote command when
+// both are written to the same writer, such as a terminal, so that a line
+// of one channel isn't torn by output of the other.
+//
+// Output is written as it arrives, so across channels the order is the
+// order in which output frames arrived. The exception is while one channel
+// is in the middle of a line: output of the other channel is then held
+// until that line ends, or until more than interleaveMaxHeld bytes are
+// held, such as when the line is a prompt waiting for input.
+type lineInterleaver struct {
+	// Color, if true, colors the output of stderr red.
+	Color bool
+
+	lock    sync.Mutex
+	midLine pb.ExecStreamResponse_Output_Channel
+	held    []byte
+}
+
+// Write takes the output data of channel and returns what to write now,
+// which may include output of the other channel held until now.
+func (l *lineInterleaver) Write(channel pb.ExecStreamResponse_Output_Channel, data []byte) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.midLine != 0 && l.midLine != channel {
+		l.held = append(l.held, data...)
+		if len(l.held) <= interleaveMaxHeld {
+			return nil
+		}
+
+		// We can't hold any more, so the other line is torn after all.
+		l.midLine = 0
+		return l.release(channel)
+	}
+
+	result := l.take(channel, data)
+	if l.midLine == 0 && len(l.held) > 0 {
+		result = append(result, l.release(otherChannel(channel))...)
+	}
+
+	return result
+}
+
+// Flush returns the output that is still held, once no more output comes.
+func (l *lineInterleaver) Flush() []byte {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if len(l.held) == 0 {
+		return nil
+	}
+
+	return l.release(otherChannel(l.midLine))
+}
+
+// release returns the output held for channel. This must be called with
+// the lock held.
+func (l *lineInterleaver) release(channel pb.ExecStreamResponse_Output_Channel) []byte {
+	data := l.held
+	l.held = nil
+	return l.take(channel, data)
+}
+
+// take returns data of channel to write, colored if requested, and notes
+// whether the channel is now in the middle of a line. This must be called
+// with the lock held.
+func (l *lineInterleaver) take(channel pb.ExecStreamResponse_Output_Channel, data []byte) []byte {
+	l.midLine = 0
+	if data[len(data)-1] != '\n' {
+		l.midLine = channel
+	}
+
+	if !l.Color || channel != pb.ExecStreamResponse_Output_STDERR {
+		return append([]byte(nil), data...)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(colorStderr)
+	buf.Write(data)
+	buf.Write(colorReset)
+	return buf.Bytes()
+}
+
+// otherChannel returns the output channel that isn't channel.
+func otherChannel(channel pb.ExecStreamResponse_Output_Channel) pb.ExecStreamResponse_Output_Channel {
+	if channel == pb.ExecStreamResponse_Output_STDERR {
+		return pb.ExecStreamResponse_Output_STDOUT
+	}
+
+	return pb.ExecStreamResponse_Output_STDERR
+}
+
+// sameWriter returns true if a and b write to the same place: they are
+// the same writer, or files that are the same, such as a terminal open
+// as both stdout and stderr or "2>&1" in a shell.
+func sameWriter(a, b io.Writer) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	fa, okA := a.(*os.File)
+	fb, okB := b.(*os.File)
+	if okA && okB {
+		if fa == fb {
+			return true
+		}
+
+		sa, errA := fa.Stat()
+		sb, errB := fb.Stat()
+		return errA == nil && errB == nil && os.SameFile(sa, sb)
+	}
+
+	// Comparing interfaces panics if their type isn't comparable.
+	t := reflect.TypeOf(a)
+	return t == reflect.TypeOf(b) && t.Comparable() && a == b
+}
@@ -0,0 +1,200 @@
+package execclient
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestLineInterleaver(t *testing.T) {
+	stdout := pb.ExecStreamResponse_Output_STDOUT
+	stderr := pb.ExecStreamResponse_Output_STDERR
+
+	t.Run("holds the other channel mid-line", func(t *testing.T) {
+		require := require.New(t)
+
+		var l lineInterleaver
+		require.Equal("out", string(l.Write(stdout, []byte("out"))))
+		require.Empty(l.Write(stderr, []byte("err 1\nerr")))
+		require.Equal("put\nerr 1\nerr", string(l.Write(stdout, []byte("put\n"))))
+
+		// Now stderr is mid-line
+		require.Empty(l.Write(stdout, []byte("more\n")))
+		require.Equal(" 2\nmore\n", string(l.Write(stderr, []byte(" 2\n"))))
+		require.Empty(l.Flush())
+	})
+
+	t.Run("flush", func(t *testing.T) {
+		require := require.New(t)
+
+		var l lineInterleaver
+		require.Equal("Password: ", string(l.Write(stdout, []byte("Password: "))))
+		require.Empty(l.Write(stderr, []byte("warning\n")))
+		require.Equal("warning\n", string(l.Flush()))
+	})
+
+	t.Run("too much held", func(t *testing.T) {
+		require := require.New(t)
+
+		var l lineInterleaver
+		l.Write(stdout, []byte("prompt"))
+		held := bytes.Repeat([]byte("x"), interleaveMaxHeld)
+		require.Empty(l.Write(stderr, held))
+		require.Len(l.Write(stderr, []byte("y")), interleaveMaxHeld+1)
+
+		// Stderr is mid-line now
+		require.Empty(l.Write(stdout, []byte("\n")))
+	})
+
+	t.Run("color", func(t *testing.T) {
+		require := require.New(t)
+
+		l := lineInterleaver{Color: true}
+		require.Equal("out\n", string(l.Write(stdout, []byte("out\n"))))
+		require.Equal("\x1b[31merr\n\x1b[0m", string(l.Write(stderr, []byte("err\n"))))
+	})
+
+	t.Run("concurrent writes", func(t *testing.T) {
+		var l lineInterleaver
+		var lock sync.Mutex
+		var result bytes.Buffer
+
+		var wg sync.WaitGroup
+		for _, channel := range []pb.ExecStreamResponse_Output_Channel{stdout, stderr} {
+			wg.Add(1)
+			go func(channel pb.ExecStreamResponse_Output_Channel) {
+				defer wg.Done()
+				for _, chunk := range testInterleaveChunks(channel, 200) {
+					lock.Lock()
+					result.Write(l.Write(channel, []byte(chunk)))
+					lock.Unlock()
+				}
+			}(channel)
+		}
+		wg.Wait()
+		result.Write(l.Flush())
+
+		testRequireWholeLines(t, result.String(), 200)
+	})
+}
+
+func TestClientRun_interleave(t *testing.T) {
+	require := require.New(t)
+
+	// Lines of both channels arrive split into fragments, in random order.
+	stdoutChunks := testInterleaveChunks(pb.ExecStreamResponse_Output_STDOUT, 500)
+	stderrChunks := testInterleaveChunks(pb.ExecStreamResponse_Output_STDERR, 500)
+	steps := []execclienttest.Step{
+		execclienttest.Respond(execclienttest.Open("s1")),
+	}
+	for len(stdoutChunks) > 0 || len(stderrChunks) > 0 {
+		if len(stderrChunks) == 0 || (len(stdoutChunks) > 0 && rand.Intn(2) == 0) {
+			steps = append(steps, execclienttest.Respond(execclienttest.Stdout(stdoutChunks[0])))
+			stdoutChunks = stdoutChunks[1:]
+		} else {
+			steps = append(steps, execclienttest.Respond(execclienttest.Stderr(stderrChunks[0])))
+			stderrChunks = stderrChunks[1:]
+		}
+	}
+	steps = append(steps, execclienttest.Respond(execclienttest.Exit(0)))
+
+	var out bytes.Buffer
+	c := testClient(t, execclienttest.NewStream(t, steps...))
+	c.Stdout = &out
+	c.Stderr = &out
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	testRequireWholeLines(t, out.String(), 500)
+}
+
+func TestSameWriter(t *testing.T) {
+	require := require.New(t)
+
+	var a, b bytes.Buffer
+	require.True(sameWriter(&a, &a))
+	require.False(sameWriter(&a, &b))
+	require.False(sameWriter(&a, nil))
+	require.True(sameWriter(ioutil.Discard, ioutil.Discard))
+
+	// Writers that can't be compared
+	require.False(sameWriter(testFuncWriter(a.Write), testFuncWriter(a.Write)))
+
+	// Two files open on the same file, like "2>&1"
+	td, err := ioutil.TempDir("", "waypoint")
+	require.NoError(err)
+	defer os.RemoveAll(td)
+	path := filepath.Join(td, "out")
+	f1, err := os.Create(path)
+	require.NoError(err)
+	defer f1.Close()
+	f2, err := os.OpenFile(path, os.O_WRONLY, 0)
+	require.NoError(err)
+	defer f2.Close()
+	f3, err := os.Create(path + "2")
+	require.NoError(err)
+	defer f3.Close()
+	require.True(sameWriter(f1, f2))
+	require.False(sameWriter(f1, f3))
+}
+
+type testFuncWriter func([]byte) (int, error)
+
+func (f testFuncWriter) Write(p []byte) (int, error) { return f(p) }
+
+// testInterleaveChunks returns n numbered lines of channel split into
+// fragments of random sizes.
+func testInterleaveChunks(channel pb.ExecStreamResponse_Output_Channel, n int) []string {
+	name := "out"
+	if channel == pb.ExecStreamResponse_Output_STDERR {
+		name = "err"
+	}
+
+	var all strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&all, "%s line %d\n", name, i)
+	}
+
+	var result []string
+	rest := all.String()
+	for len(rest) > 0 {
+		size := 1 + rand.Intn(20)
+		if size > len(rest) {
+			size = len(rest)
+		}
+
+		result = append(result, rest[:size])
+		rest = rest[size:]
+	}
+
+	return result
+}
+
+// testRequireWholeLines requires that out has the n lines of each channel
+// from testInterleaveChunks, each in one piece and in order.
+func testRequireWholeLines(t *testing.T, out string, n int) {
+	next := map[string]int{}
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		var name string
+		var i int
+		_, err := fmt.Sscanf(line, "%s line %d", &name, &i)
+		require.NoError(t, err, line)
+		require.Equal(t, fmt.Sprintf("%s line %d", name, i), line)
+		require.Equal(t, next[name], i, line)
+		next[name]++
+	}
+
+	require.Equal(t, map[string]int{"out": n, "err": n}, next)
+}
@@ -0,0 +1,51 @@
+package execclient
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// localeWarning returns a warning if the command runs in the locale remote
+// while our terminal is UTF-8, in which case programs like shells show
+// non-ASCII characters as "?". This returns an empty string if there's
+// nothing to warn about, including with Quiet or if the instance didn't
+// report its locale.
+func (c *Client) localeWarning(remote string) string {
+	if c.Quiet || (remote != "C" && remote != "POSIX") {
+		return ""
+	}
+
+	if !isUTF8Locale(c.localLocale()) {
+		return ""
+	}
+
+	return fmt.Sprintf("the command runs in the %q locale, so non-ASCII characters "+
+		"may not display; set LANG=C.UTF-8 for it, such as with -set-locale", remote)
+}
+
+// localLocale returns LocalLocale, or the locale of our environment.
+func (c *Client) localLocale() string {
+	if c.LocalLocale != "" {
+		return c.LocalLocale
+	}
+
+	for _, k := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// isUTF8Locale returns true if the locale, such as "en_US.UTF-8", uses the
+// UTF-8 encoding.
+func isUTF8Locale(v string) bool {
+	v = strings.ToLower(v)
+	if idx := strings.IndexByte(v, '@'); idx >= 0 {
+		v = v[:idx]
+	}
+
+	return strings.HasSuffix(v, ".utf-8") || strings.HasSuffix(v, ".utf8")
+}
@@ -0,0 +1,71 @@
+package execclient
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientLocaleWarning(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Local  string
+		Remote string
+		Quiet  bool
+		Warn   bool
+	}{
+		{"C", "en_US.UTF-8", "C", false, true},
+		{"POSIX", "en_US.utf8", "POSIX", false, true},
+		{"modifier", "de_DE.UTF-8@euro", "C", false, true},
+		{"quiet", "en_US.UTF-8", "C", true, false},
+		{"remote UTF-8", "en_US.UTF-8", "C.UTF-8", false, false},
+		{"local not UTF-8", "en_US.ISO-8859-1", "C", false, false},
+		{"not reported", "en_US.UTF-8", "", false, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := &Client{LocalLocale: tt.Local, Quiet: tt.Quiet}
+			msg := c.localeWarning(tt.Remote)
+			if !tt.Warn {
+				require.Empty(t, msg)
+				return
+			}
+
+			require.Contains(t, msg, "LANG=C.UTF-8")
+		})
+	}
+}
+
+func TestClientRun_locale(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(&pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Attached_{
+				Attached: &pb.ExecStreamResponse_Attached{
+					InstanceId: "i1",
+					Locale:     "C",
+				},
+			},
+		}),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	var stderr bytes.Buffer
+	c := testClient(t, stream)
+	c.Stderr = &stderr
+	c.LocalLocale = "en_US.UTF-8"
+	c.Env = []string{"FOO=bar"}
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	require.Equal([]string{"FOO=bar"}, stream.Start().Env)
+	require.Contains(stderr.String(), `runs in the "C" locale`)
+}
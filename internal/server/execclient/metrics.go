@@ -0,0 +1,104 @@
+package execclient
+
+import (
+	"expvar"
+	"strings"
+	"time"
+)
+
+// MetricsSink receives the metrics of exec sessions. Its methods are
+// those of go-metrics, so a *metrics.Metrics or any go-metrics sink can
+// be used as a MetricsSink as it is. Timers are samples in milliseconds,
+// as with go-metrics MeasureSince.
+//
+// Run reports these metrics, which go-metrics prefixes with its service
+// name:
+//
+//	exec.session.start     counter of sessions started
+//	exec.session.end       counter of sessions ended
+//	exec.session.failed    counter of sessions that failed other than by
+//	                       the command exiting unsuccessfully
+//	exec.session.active    gauge of sessions running in this process
+//	exec.session.duration  timer of the whole session
+//	exec.assignment        timer of the wait for an instance
+//	exec.bytes_in          counter of input bytes sent
+//	exec.bytes_out         counter of output bytes received
+//	exec.rtt               timer of the round trip of each ping, if
+//	                       Client.PingInterval is set
+//	exec.output.gaps       counter of the times output events were lost
+//	exec.output.lost       counter of the output events lost
+//	exec.output.reordered  counter of the output events received out of
+//	                       order
+type MetricsSink interface {
+	SetGauge(key []string, val float32)
+	IncrCounter(key []string, val float32)
+	AddSample(key []string, val float32)
+}
+
+// The metric keys reported by Run.
+var (
+	metricSessionStart    = []string{"exec", "session", "start"}
+	metricSessionEnd      = []string{"exec", "session", "end"}
+	metricSessionFailed   = []string{"exec", "session", "failed"}
+	metricSessionActive   = []string{"exec", "session", "active"}
+	metricSessionDuration = []string{"exec", "session", "duration"}
+	metricAssignment      = []string{"exec", "assignment"}
+	metricBytesIn         = []string{"exec", "bytes_in"}
+	metricBytesOut        = []string{"exec", "bytes_out"}
+	metricRoundTrip       = []string{"exec", "rtt"}
+	metricOutputGaps      = []string{"exec", "output", "gaps"}
+	metricOutputLost      = []string{"exec", "output", "lost"}
+	metricOutputReordered = []string{"exec", "output", "reordered"}
+)
+
+// execActive is the number of sessions running in this process for the
+// exec.session.active gauge. It must be accessed atomically.
+var execActive int64
+
+// noopMetrics is the MetricsSink used if a Client has none.
+type noopMetrics struct{}
+
+func (noopMetrics) SetGauge([]string, float32)    {}
+func (noopMetrics) IncrCounter([]string, float32) {}
+func (noopMetrics) AddSample([]string, float32)   {}
+
+// ExpvarSink is a MetricsSink that publishes metrics with expvar, for
+// tools that have no go-metrics setup. Each metric is an entry of a
+// single expvar map with its key joined by dots. Counters are totals and
+// gauges are their last value. Timers are two entries, "KEY.count" and
+// "KEY.sum", from which the mean can be computed.
+type ExpvarSink struct {
+	m *expvar.Map
+}
+
+// NewExpvarSink returns an ExpvarSink publishing to the expvar map with
+// the given name, which is created if it doesn't exist yet.
+func NewExpvarSink(name string) *ExpvarSink {
+	m, ok := expvar.Get(name).(*expvar.Map)
+	if !ok {
+		m = expvar.NewMap(name)
+	}
+
+	return &ExpvarSink{m: m}
+}
+
+func (s *ExpvarSink) SetGauge(key []string, val float32) {
+	v := new(expvar.Float)
+	v.Set(float64(val))
+	s.m.Set(strings.Join(key, "."), v)
+}
+
+func (s *ExpvarSink) IncrCounter(key []string, val float32) {
+	s.m.AddFloat(strings.Join(key, "."), float64(val))
+}
+
+func (s *ExpvarSink) AddSample(key []string, val float32) {
+	name := strings.Join(key, ".")
+	s.m.AddFloat(name+".count", 1)
+	s.m.AddFloat(name+".sum", float64(val))
+}
+
+// milliseconds returns d in milliseconds for a timer.
+func milliseconds(d time.Duration) float32 {
+	return float32(d) / float32(time.Millisecond)
+}
@@ -0,0 +1,116 @@
+package execclient
+
+import (
+	"bytes"
+	"context"
+	"expvar"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+)
+
+func TestClientMetrics(t *testing.T) {
+	require := require.New(t)
+
+	metrics := &testMetrics{}
+	waypoint := execclienttest.NewWaypoint(
+		execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.Stdout("hello\n")),
+			execclienttest.Respond(execclienttest.Exit(3)),
+		),
+
+		// A session that fails before it opens
+		execclienttest.NewStream(t),
+	)
+
+	var stdout bytes.Buffer
+	c := &Client{
+		Logger:   hclog.NewNullLogger(),
+		Context:  context.Background(),
+		Client:   waypoint,
+		Args:     []string{"true"},
+		Stdin:    bytes.NewReader(nil),
+		Stdout:   &stdout,
+		Stderr:   &stdout,
+		ReadOnly: true,
+		Metrics:  metrics,
+	}
+
+	code, err := c.Run()
+	require.Error(err)
+	require.Equal(3, code)
+
+	// The command exiting unsuccessfully isn't a failed session
+	require.Equal([]string{
+		"counter exec.session.start 1",
+		"gauge exec.session.active 1",
+		"sample exec.assignment",
+		"gauge exec.session.active 0",
+		"counter exec.session.end 1",
+		"counter exec.bytes_in 0",
+		"counter exec.bytes_out 6",
+		"sample exec.session.duration",
+	}, metrics.calls)
+
+	// A session that fails before it opens
+	metrics.calls = nil
+	_, err = c.Run()
+	require.Error(err)
+	require.Contains(metrics.calls, "counter exec.session.failed 1")
+	require.NotContains(metrics.calls, "sample exec.assignment")
+}
+
+func TestExpvarSink(t *testing.T) {
+	require := require.New(t)
+
+	sink := NewExpvarSink("test_exec_metrics")
+	sink.IncrCounter([]string{"exec", "session", "start"}, 1)
+	sink.IncrCounter([]string{"exec", "session", "start"}, 1)
+	sink.SetGauge([]string{"exec", "session", "active"}, 2)
+	sink.SetGauge([]string{"exec", "session", "active"}, 1)
+	sink.AddSample([]string{"exec", "assignment"}, 10)
+	sink.AddSample([]string{"exec", "assignment"}, 20)
+
+	// A second sink with the same name shares the map
+	NewExpvarSink("test_exec_metrics").IncrCounter([]string{"exec", "session", "start"}, 1)
+
+	m := expvar.Get("test_exec_metrics").(*expvar.Map)
+	require.Equal("3", m.Get("exec.session.start").String())
+	require.Equal("1", m.Get("exec.session.active").String())
+	require.Equal("2", m.Get("exec.assignment.count").String())
+	require.Equal("30", m.Get("exec.assignment.sum").String())
+}
+
+// testMetrics is a MetricsSink that records its calls. Samples are
+// recorded without their value since they are timings.
+type testMetrics struct {
+	lock  sync.Mutex
+	calls []string
+}
+
+func (m *testMetrics) SetGauge(key []string, val float32) {
+	m.record("gauge %s %v", key, val)
+}
+
+func (m *testMetrics) IncrCounter(key []string, val float32) {
+	m.record("counter %s %v", key, val)
+}
+
+func (m *testMetrics) AddSample(key []string, val float32) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.calls = append(m.calls, "sample "+strings.Join(key, "."))
+}
+
+func (m *testMetrics) record(format string, key []string, val float32) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.calls = append(m.calls, fmt.Sprintf(format, strings.Join(key, "."), val))
+}
@@ -0,0 +1,125 @@
+package execclient
+
+// outputLimit limits the output of the remote command to Max bytes,
+// counting both channels together. ANSI escape sequences, such as those
+// for colors and moving the cursor, aren't counted so that output using
+// them heavily isn't cut off sooner than plain output. A nil
+// *outputLimit never limits.
+//
+// This isn't safe for concurrent use. Run only uses it from the goroutine
+// receiving output.
+type outputLimit struct {
+	Max int64
+
+	// seen is the number of bytes counted in total, including those past
+	// the limit.
+	seen  int64
+	state int
+}
+
+// The states of an outputLimit following escape sequences.
+const (
+	ansiGround = iota
+	ansiEscape
+	ansiIntermediate
+	ansiCSI
+	ansiString
+	ansiStringEscape
+)
+
+// Take counts data and returns how many of its bytes are within the
+// limit and may be written. Once the limit is exceeded this is zero.
+func (l *outputLimit) Take(data []byte) int {
+	if l == nil {
+		return len(data)
+	}
+
+	result := len(data)
+	if l.Exceeded() {
+		result = 0
+	}
+
+	for i, b := range data {
+		if !l.count(b) {
+			continue
+		}
+
+		l.seen++
+		if l.seen == l.Max+1 {
+			result = i
+		}
+	}
+
+	return result
+}
+
+// Exceeded returns true once more than Max bytes were counted.
+func (l *outputLimit) Exceeded() bool {
+	return l != nil && l.seen > l.Max
+}
+
+// Seen returns the number of bytes counted in total.
+func (l *outputLimit) Seen() int64 {
+	if l == nil {
+		return 0
+	}
+
+	return l.seen
+}
+
+// count follows the escape sequences and returns true if b is to be
+// counted because it isn't part of one.
+func (l *outputLimit) count(b byte) bool {
+	switch l.state {
+	case ansiEscape:
+		switch {
+		case b == '[':
+			l.state = ansiCSI
+		case b == ']' || b == 'P' || b == 'X' || b == '^' || b == '_':
+			// OSC, DCS, SOS, PM and APC are strings ending with ST.
+			l.state = ansiString
+		case b >= 0x20 && b <= 0x2f:
+			l.state = ansiIntermediate
+		default:
+			l.state = ansiGround
+		}
+		return false
+
+	case ansiIntermediate:
+		if b < 0x20 || b > 0x2f {
+			l.state = ansiGround
+		}
+		return false
+
+	case ansiCSI:
+		// Parameters and intermediates until the final byte
+		if b >= 0x40 && b <= 0x7e {
+			l.state = ansiGround
+		}
+		return false
+
+	case ansiString:
+		switch b {
+		case 0x07:
+			// BEL ends an OSC in xterm.
+			l.state = ansiGround
+		case 0x1b:
+			l.state = ansiStringEscape
+		}
+		return false
+
+	case ansiStringEscape:
+		l.state = ansiString
+		if b == '\\' {
+			l.state = ansiGround
+		}
+		return false
+	}
+
+	if b == 0x1b {
+		l.state = ansiEscape
+		return false
+	}
+
+	return true
+}
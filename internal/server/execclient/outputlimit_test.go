@@ -0,0 +1,152 @@
+package execclient
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestOutputLimit(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Max    int64
+		Chunks []string
+		Want   string
+		Seen   int64
+	}{
+		{
+			"within the limit",
+			10,
+			[]string{"hello\n", "bye\n"},
+			"hello\nbye\n",
+			10,
+		},
+		{
+			"cut within a chunk",
+			8,
+			[]string{"hello\n", "world\n", "more\n"},
+			"hello\nwo",
+			17,
+		},
+		{
+			"colors aren't counted",
+			6,
+			[]string{"\x1b[1;31mhello\x1b[0m\n", "x"},
+			"\x1b[1;31mhello\x1b[0m\n",
+			7,
+		},
+		{
+			"sequences split across chunks",
+			2,
+			[]string{"a\x1b", "[3", "8;5;1m", "b\x1b]0;title", "\x07c"},
+			"a\x1b[38;5;1mb\x1b]0;title\x07",
+			3,
+		},
+		{
+			"strings ending with ST",
+			1,
+			[]string{"\x1bPdata\x1b\\a\x1b(Bb"},
+			"\x1bPdata\x1b\\a\x1b(B",
+			2,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			l := &outputLimit{Max: tt.Max}
+			var out bytes.Buffer
+			for _, chunk := range tt.Chunks {
+				out.WriteString(chunk[:l.Take([]byte(chunk))])
+			}
+
+			require.Equal(tt.Want, out.String())
+			require.Equal(tt.Seen, l.Seen())
+			require.Equal(tt.Seen > tt.Max, l.Exceeded())
+		})
+	}
+
+	t.Run("nil never limits", func(t *testing.T) {
+		var l *outputLimit
+		require.Equal(t, 5, l.Take([]byte("hello")))
+		require.False(t, l.Exceeded())
+	})
+}
+
+func TestClientRun_maxOutput(t *testing.T) {
+	t.Run("truncate", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.Stdout("\x1b[31mhello\x1b[0m\n")),
+			execclienttest.Respond(execclienttest.Stderr("world\n")),
+			execclienttest.Respond(execclienttest.Stdout("more\n")),
+			execclienttest.Respond(execclienttest.Exit(0)),
+		)
+
+		var stdout, stderr bytes.Buffer
+		c := testClient(t, stream)
+		c.Stdout = &stdout
+		c.Stderr = &stderr
+		c.MaxOutput = 8
+
+		code, err := c.Run()
+		require.NoError(err)
+		require.Equal(0, code)
+		require.Equal("\x1b[31mhello\x1b[0m\n", stdout.String())
+		require.Contains(stderr.String(), "wo\n")
+		require.Contains(stderr.String(),
+			"output was truncated at 8 B, the command wrote 17 B in total")
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.Stdout("0123456789")),
+			execclienttest.Step{
+				Response: execclienttest.Exit(143),
+				Wait: func(requests []*pb.ExecStreamRequest) bool {
+					for _, req := range requests {
+						if req.GetSignal().GetName() == "TERM" {
+							return true
+						}
+					}
+
+					return false
+				},
+				Desc: "signal TERM",
+			},
+		)
+
+		var stdout, stderr bytes.Buffer
+		c := testClient(t, stream)
+		c.Stdout = &stdout
+		c.Stderr = &stderr
+		c.MaxOutput = 4
+		c.MaxOutputAbort = true
+
+		code, err := c.Run()
+		require.True(errors.Is(err, ErrMaxOutput))
+		require.Equal(0, code)
+		require.Equal("0123", stdout.String())
+		require.Contains(stderr.String(),
+			"output exceeded the limit of 4 B, stopping the command")
+
+		var signals []string
+		for _, req := range stream.Requests() {
+			if v := req.GetSignal(); v != nil {
+				signals = append(signals, v.Name)
+			}
+		}
+		require.Equal([]string{"TERM"}, signals)
+	})
+}
@@ -0,0 +1,263 @@
+package execclient
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+)
+
+// Stage is a stage that Run goes through as it connects a session. See
+// Phase.
+type Stage int
+
+const (
+	// StageConnecting is creating the stream to the server.
+	StageConnecting Stage = iota
+
+	// StageInitializing is sending the request that starts the session,
+	// or attaches to or watches it.
+	StageInitializing
+
+	// StageWaiting is waiting for the server to assign an instance and
+	// open the session.
+	StageWaiting
+
+	// StageOpen is once the server opened the session. SessionId is set
+	// from here on.
+	StageOpen
+
+	// StageAttaching is waiting for the instance to attach to the session.
+	// This is only waited for before StageRunning if a PTY was requested,
+	// so that we know whether we got one.
+	StageAttaching
+
+	// StageAttached is once the instance attached. InstanceId is set from
+	// here on. Without a PTY, or when attaching to or watching a session,
+	// this comes after StageRunning if at all.
+	StageAttached
+
+	// StageRunning is once the output of the command follows.
+	StageRunning
+
+	// StageDetached is once a detached session is started, which is the
+	// last stage of Run with Detach.
+	StageDetached
+)
+
+var stageNames = []string{
+	"connecting", "initializing", "waiting", "open", "attaching",
+	"attached", "running", "detached",
+}
+
+func (s Stage) String() string {
+	if s < 0 || int(s) >= len(stageNames) {
+		return "unknown"
+	}
+
+	return stageNames[s]
+}
+
+// Phase is a step of Run connecting a session, for callers showing their
+// own progress rather than the status of UI. See Client.PhaseCallback.
+type Phase struct {
+	Stage Stage
+
+	// Message describes the phase for people, such as "Waiting for instance
+	// assignment...". While waiting, the server may report its progress,
+	// such as starting an instance, which is then given as a Phase of the
+	// same stage with the progress as the message.
+	Message string
+
+	// DeploymentSeq is the deployment of the session. It is zero when
+	// attaching to or watching a session.
+	DeploymentSeq uint64
+
+	// SessionId and InstanceId are set once known. See StageOpen and
+	// StageAttached.
+	SessionId  string
+	InstanceId string
+}
+
+// phaseReporter reports the phases of a session to a PhaseCallback,
+// filling in what is known about the session so far.
+type phaseReporter struct {
+	f    func(Phase)
+	last Phase
+}
+
+// Report reports the next phase.
+func (r *phaseReporter) Report(stage Stage, msg string) {
+	r.last.Stage = stage
+	r.last.Message = msg
+	if r.f != nil {
+		r.f(r.last)
+	}
+}
+
+// Status reports a status of the server for the current stage.
+func (r *phaseReporter) Status(msg string) {
+	if msg != "" {
+		r.Report(r.last.Stage, strings.ToUpper(msg[:1])+msg[1:]+"...")
+	}
+}
+
+// Attached reports that the instance attached.
+func (r *phaseReporter) Attached(instanceId string) {
+	r.last.InstanceId = instanceId
+	r.Report(StageAttached, "Attached to instance "+instanceId)
+}
+
+// The status line of uiPhases is refreshed with how long the session has
+// been connecting every phaseTick, or every phasePlainTick if the UI isn't
+// interactive, where each refresh is a line of its own.
+var (
+	phaseTick      = time.Second
+	phasePlainTick = 10 * time.Second
+)
+
+// uiPhases returns the PhaseCallback that shows the phases on the status
+// of UI and the message of StageRunning as output, which is what Run does
+// if there is no PhaseCallback. Until the session opens, the status is
+// refreshed with how long it has been connecting. The returned function
+// closes the status.
+func (c *Client) uiPhases() (func(Phase), func()) {
+	tick := phaseTick
+	if c.UI != nil && !c.UI.Interactive() {
+		tick = phasePlainTick
+	}
+
+	s := newPhaseStatus(c.uiStatus(), tick)
+	s.attempt, s.attempts = c.Attempt, c.Attempts
+	return func(p Phase) {
+		switch p.Stage {
+		case StageOpen, StageAttached:
+			// These change nothing that we show, but how long we waited
+			// for the session to open no longer matters.
+			s.Stop()
+
+		case StageRunning:
+			s.Close()
+			c.uiOutput("%s", p.Message, terminal.WithSuccessStyle())
+
+		case StageDetached:
+			s.Close()
+
+		default:
+			s.Update(p)
+		}
+	}, func() { s.Close() }
+}
+
+// phaseStatus is the status of uiPhases. Until it is stopped, it shows the
+// message of the stage with how long the session has been connecting, the
+// attempt and the last status the server reported, refreshing this every
+// tick.
+type phaseStatus struct {
+	status   terminal.Status
+	start    time.Time
+	attempt  int
+	attempts int
+
+	lock    sync.Mutex
+	stage   Stage
+	msg     string
+	server  string
+	stopped bool
+	stopCh  chan struct{}
+}
+
+func newPhaseStatus(status terminal.Status, tick time.Duration) *phaseStatus {
+	s := &phaseStatus{
+		status: status,
+		start:  time.Now(),
+		stage:  -1,
+		stopCh: make(chan struct{}),
+	}
+
+	go func() {
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.lock.Lock()
+				if !s.stopped && s.msg != "" {
+					s.show()
+				}
+				s.lock.Unlock()
+
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+
+	return s
+}
+
+// Update shows the phase p. A phase of the same stage as the last one is
+// a status of the server, which is shown along with the stage.
+func (s *phaseStatus) Update(p Phase) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if p.Stage == s.stage {
+		s.server = p.Message
+	} else {
+		s.stage = p.Stage
+		s.msg = p.Message
+		s.server = ""
+	}
+
+	// Once the session opened, we only show what the phases say.
+	if s.stopped {
+		s.status.Update(p.Message)
+		return
+	}
+
+	s.show()
+}
+
+// show updates the status. The lock must be held.
+func (s *phaseStatus) show() {
+	s.status.Update(phaseLine(s.msg, s.server, time.Since(s.start), s.attempt, s.attempts))
+}
+
+// Stop stops refreshing the status.
+func (s *phaseStatus) Stop() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.stopped {
+		s.stopped = true
+		close(s.stopCh)
+	}
+}
+
+// Close stops refreshing and closes the status.
+func (s *phaseStatus) Close() {
+	s.Stop()
+	s.status.Close()
+}
+
+// phaseLine returns the status line of a stage with the message msg, such
+// as "Waiting for instance assignment... 14s (attempt 2/5) - Starting
+// instance...". The time is left out in the first second so that a quick
+// connection isn't cluttered with it.
+func phaseLine(msg, server string, elapsed time.Duration, attempt, attempts int) string {
+	line := msg
+	if elapsed >= time.Second {
+		line += " " + elapsed.Round(time.Second).String()
+	}
+	if attempts > 1 {
+		line += fmt.Sprintf(" (attempt %d/%d)", attempt, attempts)
+	}
+	if server != "" {
+		line += " - " + server
+	}
+
+	return line
+}
@@ -0,0 +1,126 @@
+package execclient
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// rttWindow is how many of the most recent round trips the p95 is
+	// computed over.
+	rttWindow = 128
+
+	// rttMaxPending is how many pings can wait for their pong. Older pings
+	// are forgotten so that an instance that never answers doesn't make us
+	// remember every ping.
+	rttMaxPending = 16
+)
+
+// rttEstimator estimates the round trip time of a session from the pings
+// we send and the pongs that answer them. Only pings are used because the
+// time from input to output depends on what the remote command does.
+//
+// This is only used from the receive loop of Run, so it isn't safe for
+// concurrent use.
+type rttEstimator struct {
+	lastId  uint64
+	pending map[uint64]time.Time
+
+	// window is a ring of the most recent round trips.
+	window []time.Duration
+	next   int
+
+	count    int
+	sum      time.Duration
+	min      time.Duration
+	smoothed time.Duration
+
+	// now returns the current time. This is replaced in tests.
+	now func() time.Time
+}
+
+// Ping returns the ID of the next ping to send.
+func (e *rttEstimator) Ping() uint64 {
+	if e.pending == nil {
+		e.pending = make(map[uint64]time.Time)
+	}
+
+	e.lastId++
+	e.pending[e.lastId] = e.time()
+	delete(e.pending, e.lastId-rttMaxPending)
+	return e.lastId
+}
+
+// Pong records the pong of the ping with the given ID and returns its
+// round trip time. This returns false for a ping we don't know about.
+func (e *rttEstimator) Pong(id uint64) (time.Duration, bool) {
+	sent, ok := e.pending[id]
+	if !ok {
+		return 0, false
+	}
+	delete(e.pending, id)
+
+	d := e.time().Sub(sent)
+	if len(e.window) < rttWindow {
+		e.window = append(e.window, d)
+	} else {
+		e.window[e.next] = d
+		e.next = (e.next + 1) % rttWindow
+	}
+
+	if e.count == 0 || d < e.min {
+		e.min = d
+	}
+	e.count++
+	e.sum += d
+
+	// The estimate is smoothed like TCP's so that a single slow round
+	// trip doesn't make it jump.
+	if e.count == 1 {
+		e.smoothed = d
+	} else {
+		e.smoothed += (d - e.smoothed) / 8
+	}
+
+	return d, true
+}
+
+// Estimate returns the current estimate of the round trip time, or false
+// if there hasn't been a round trip yet.
+func (e *rttEstimator) Estimate() (time.Duration, bool) {
+	return e.smoothed, e.count > 0
+}
+
+// Summary describes the round trips of the session, or returns an empty
+// string if there were none.
+func (e *rttEstimator) Summary() string {
+	if e.count == 0 {
+		return ""
+	}
+
+	sorted := append([]time.Duration(nil), e.window...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	p95 := sorted[(len(sorted)*95+99)/100-1]
+
+	return fmt.Sprintf("round trip min %s, avg %s, p95 %s",
+		formatRTT(e.min), formatRTT(e.sum/time.Duration(e.count)), formatRTT(p95))
+}
+
+func (e *rttEstimator) time() time.Time {
+	if e.now != nil {
+		return e.now()
+	}
+
+	return time.Now()
+}
+
+// formatRTT formats a round trip time to the millisecond, or to the
+// microsecond if it is under a millisecond.
+func formatRTT(d time.Duration) string {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond).String()
+	}
+
+	return d.Round(time.Millisecond).String()
+}
@@ -0,0 +1,161 @@
+package execclient
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+)
+
+func TestRTTEstimator(t *testing.T) {
+	t.Run("no round trips", func(t *testing.T) {
+		require := require.New(t)
+
+		var e rttEstimator
+		_, ok := e.Estimate()
+		require.False(ok)
+		require.Empty(e.Summary())
+	})
+
+	t.Run("round trips", func(t *testing.T) {
+		require := require.New(t)
+
+		now := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+		e := &rttEstimator{now: func() time.Time { return now }}
+
+		for i := 1; i <= 20; i++ {
+			id := e.Ping()
+			now = now.Add(time.Duration(i) * 10 * time.Millisecond)
+			d, ok := e.Pong(id)
+			require.True(ok)
+			require.Equal(time.Duration(i)*10*time.Millisecond, d)
+		}
+
+		d, ok := e.Estimate()
+		require.True(ok)
+		require.True(d > 10*time.Millisecond && d < 200*time.Millisecond)
+		require.Equal("round trip min 10ms, avg 105ms, p95 190ms", e.Summary())
+	})
+
+	t.Run("unknown pongs", func(t *testing.T) {
+		require := require.New(t)
+
+		var e rttEstimator
+		id := e.Ping()
+		_, ok := e.Pong(id + 1)
+		require.False(ok)
+
+		// A pong only counts once
+		_, ok = e.Pong(id)
+		require.True(ok)
+		_, ok = e.Pong(id)
+		require.False(ok)
+	})
+
+	t.Run("forgets unanswered pings", func(t *testing.T) {
+		require := require.New(t)
+
+		var e rttEstimator
+		first := e.Ping()
+		for i := 0; i < 100; i++ {
+			e.Ping()
+		}
+
+		require.Len(e.pending, rttMaxPending)
+		_, ok := e.Pong(first)
+		require.False(ok)
+	})
+}
+
+func TestFormatRTT(t *testing.T) {
+	require := require.New(t)
+	require.Equal("48ms", formatRTT(48*time.Millisecond+300*time.Microsecond))
+	require.Equal("1.5s", formatRTT(1500*time.Millisecond))
+	require.Equal("250µs", formatRTT(250*time.Microsecond+10))
+}
+
+func TestClientRun_ping(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.WithCapabilities(
+			execclienttest.Open("s1"), "ping")),
+		execclienttest.Respond(execclienttest.WithCapabilities(
+			execclienttest.Attached("i1"), "ping", "future")),
+		execclienttest.AfterPing(1),
+		execclienttest.AfterPing(2),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	var stderr bytes.Buffer
+	metrics := &testMetrics{}
+	c := testClient(t, stream)
+	c.Stderr = &stderr
+	c.Summary = true
+	c.PingInterval = 10 * time.Millisecond
+	c.Metrics = metrics
+
+	_, err := c.Run()
+	require.NoError(err)
+	require.Contains(stderr.String(), "waypoint: exit code 0, round trip min ")
+	require.Contains(metrics.calls, "sample exec.rtt")
+}
+
+func TestClientRun_noPing(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	var stderr bytes.Buffer
+	c := testClient(t, stream)
+	c.Stderr = &stderr
+	c.Summary = true
+
+	_, err := c.Run()
+	require.NoError(err)
+	require.Equal("\nwaypoint: exit code 0\n", stderr.String())
+	for _, req := range stream.Requests() {
+		require.Nil(req.GetPing())
+	}
+}
+
+func TestClientRun_pingUnsupported(t *testing.T) {
+	cases := map[string][]execclienttest.Step{
+		"server": {
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Attached("i1"), "ping")),
+		},
+
+		"instance": {
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Open("s1"), "ping")),
+			execclienttest.Respond(execclienttest.Attached("i1")),
+		},
+	}
+
+	for name, steps := range cases {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			stream := execclienttest.NewStream(t, append(steps,
+				execclienttest.Respond(execclienttest.Exit(0)))...)
+
+			c := testClient(t, stream)
+			c.PingInterval = time.Millisecond
+
+			_, err := c.Run()
+			require.NoError(err)
+			require.Contains(stream.Start().ClientCapabilities, "ping")
+			for _, req := range stream.Requests() {
+				require.Nil(req.GetPing())
+			}
+		})
+	}
+}
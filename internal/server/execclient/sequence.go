@@ -0,0 +1,78 @@
+package execclient
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// outputSequence verifies that the output events of each channel arrive
+// in order and without gaps using the sequence numbers the entrypoint
+// assigns to them. Output without sequence numbers, from entrypoints that
+// don't number it, isn't checked. The first number seen on each channel
+// is accepted as is since sessions we attach to or watch only replay
+// their recent output.
+//
+// This is only used from the receive loop of Run, so it isn't safe for
+// concurrent use.
+type outputSequence struct {
+	last map[pb.ExecStreamResponse_Output_Channel]uint64
+
+	// gaps is the number of times events were lost and lost is how many
+	// were lost in total. reordered is the number of events that arrived
+	// after a later event, which are also counted as lost when the later
+	// event arrives.
+	gaps      uint64
+	lost      uint64
+	reordered uint64
+}
+
+// Check records output and returns an error if it doesn't follow the
+// previous output of its channel. If events were lost, this also returns
+// how many.
+func (s *outputSequence) Check(output *pb.ExecStreamResponse_Output) (uint64, error) {
+	seq := output.Sequence
+	if seq == 0 {
+		return 0, nil
+	}
+
+	if s.last == nil {
+		s.last = make(map[pb.ExecStreamResponse_Output_Channel]uint64)
+	}
+
+	channel := strings.ToLower(output.Channel.String())
+	last, ok := s.last[output.Channel]
+	switch {
+	case !ok || seq == last+1:
+		s.last[output.Channel] = seq
+		return 0, nil
+
+	case seq > last+1:
+		lost := seq - last - 1
+		s.last[output.Channel] = seq
+		s.gaps++
+		s.lost += lost
+		return lost, fmt.Errorf("%d %s output event(s) lost after event %d",
+			lost, channel, last)
+
+	default:
+		s.reordered++
+		return 0, fmt.Errorf("%s output event %d arrived after event %d",
+			channel, seq, last)
+	}
+}
+
+// Summary describes the problems seen, or returns an empty string if
+// there were none.
+func (s *outputSequence) Summary() string {
+	var parts []string
+	if s.lost > 0 {
+		parts = append(parts, fmt.Sprintf("%d output event(s) lost in %d gap(s)", s.lost, s.gaps))
+	}
+	if s.reordered > 0 {
+		parts = append(parts, fmt.Sprintf("%d output event(s) out of order", s.reordered))
+	}
+
+	return strings.Join(parts, ", ")
+}
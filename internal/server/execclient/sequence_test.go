@@ -0,0 +1,95 @@
+package execclient
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestOutputSequence(t *testing.T) {
+	require := require.New(t)
+
+	output := func(channel pb.ExecStreamResponse_Output_Channel, seq uint64) *pb.ExecStreamResponse_Output {
+		return &pb.ExecStreamResponse_Output{Channel: channel, Sequence: seq}
+	}
+	stdout := pb.ExecStreamResponse_Output_STDOUT
+	stderr := pb.ExecStreamResponse_Output_STDERR
+
+	var s outputSequence
+
+	// The first number of each channel is accepted and channels are
+	// numbered separately.
+	for _, v := range []*pb.ExecStreamResponse_Output{
+		output(stdout, 5),
+		output(stdout, 6),
+		output(stderr, 1),
+		output(stdout, 7),
+		output(stderr, 2),
+		output(stdout, 0),
+	} {
+		lost, err := s.Check(v)
+		require.NoError(err)
+		require.Zero(lost)
+	}
+	require.Empty(s.Summary())
+
+	lost, err := s.Check(output(stdout, 10))
+	require.EqualError(err, "2 stdout output event(s) lost after event 7")
+	require.Equal(uint64(2), lost)
+
+	lost, err = s.Check(output(stdout, 9))
+	require.EqualError(err, "stdout output event 9 arrived after event 10")
+	require.Zero(lost)
+
+	lost, err = s.Check(output(stdout, 11))
+	require.NoError(err)
+	require.Zero(lost)
+
+	require.Equal("2 output event(s) lost in 1 gap(s), 1 output event(s) out of order", s.Summary())
+}
+
+func TestClientRun_outputGap(t *testing.T) {
+	steps := func() []execclienttest.Step {
+		return []execclienttest.Step{
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.WithSequence(execclienttest.Stdout("one\n"), 1)),
+			execclienttest.Respond(execclienttest.WithSequence(execclienttest.Stdout("three\n"), 3)),
+			execclienttest.Respond(execclienttest.Exit(0)),
+		}
+	}
+
+	t.Run("default", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout, stderr bytes.Buffer
+		metrics := &testMetrics{}
+		c := testClient(t, execclienttest.NewStream(t, steps()...))
+		c.Stdout = &stdout
+		c.Stderr = &stderr
+		c.Summary = true
+		c.Metrics = metrics
+
+		_, err := c.Run()
+		require.NoError(err)
+		require.Equal("one\nthree\n", stdout.String())
+		require.Contains(stderr.String(), "exit code 0, 1 output event(s) lost in 1 gap(s)")
+		require.Contains(metrics.calls, "counter exec.output.lost 1")
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout bytes.Buffer
+		c := testClient(t, execclienttest.NewStream(t, steps()...))
+		c.Stdout = &stdout
+		c.Strict = true
+
+		_, err := c.Run()
+		require.EqualError(err, "strict mode: 1 stdout output event(s) lost after event 1")
+		require.Equal("one\n", stdout.String())
+	})
+}
@@ -0,0 +1,76 @@
+// +build !windows
+
+package execclient
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+)
+
+func TestClientRun_winchWithoutTerminal(t *testing.T) {
+	cases := []struct {
+		Name     string
+		ForcePTY bool
+	}{
+		{"no PTY", false},
+
+		// The session has a PTY but it isn't sized to a terminal of ours,
+		// so there is no size to send when ours changes.
+		{"forced PTY", true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			// The session lasts long enough for the resizes to be seen.
+			stream := execclienttest.NewStream(t,
+				execclienttest.Respond(execclienttest.Open("s1")),
+				execclienttest.Respond(execclienttest.Attached("i1")),
+				execclienttest.Respond(execclienttest.Stdout("hello")),
+				execclienttest.Step{
+					Response: execclienttest.Exit(0),
+					Delay:    100 * time.Millisecond,
+				},
+			)
+
+			// Stdout isn't a terminal, but the sizer would answer if it
+			// were asked.
+			term := &testTerminal{notTerminal: true, rows: 24, cols: 80}
+			c := testClient(t, stream)
+			c.ForcePTY = tt.ForcePTY
+			c.terminalDetector = term
+			c.rawModer = term
+			c.consoleSizer = term
+
+			// Our window is resized throughout the session.
+			doneCh := make(chan struct{})
+			defer close(doneCh)
+			go func() {
+				ticker := time.NewTicker(5 * time.Millisecond)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ticker.C:
+						unix.Kill(os.Getpid(), unix.SIGWINCH)
+					case <-doneCh:
+						return
+					}
+				}
+			}()
+
+			code, err := c.Run()
+			require.NoError(err)
+			require.Equal(0, code)
+			require.Empty(stream.Winches())
+			require.Zero(term.sized)
+			require.Equal(tt.ForcePTY, stream.Start().Pty.GetEnable())
+		})
+	}
+}
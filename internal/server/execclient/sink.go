@@ -0,0 +1,107 @@
+package execclient
+
+import (
+	"io"
+	"os"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// Sink is an additional destination for one output channel of the remote
+// command, such as a file. If writing to a sink fails, a warning is shown
+// and the sink receives no more output, but the session continues.
+type Sink struct {
+	// Channel is the output channel this sink receives.
+	Channel pb.ExecStreamResponse_Output_Channel
+
+	// Name describes the sink in warnings, such as the path of a file.
+	Name string
+
+	// W is where output is written.
+	W io.Writer
+
+	err error
+}
+
+// FileSink returns a Sink that writes the channel to the file at path.
+// The file is created if it doesn't exist. If append is true, output is
+// added to the end of an existing file, otherwise the file is truncated.
+// Call Close once the session is done to close the file.
+func FileSink(
+	channel pb.ExecStreamResponse_Output_Channel,
+	path string,
+	append bool,
+) (*Sink, error) {
+	flags := os.O_CREATE | os.O_WRONLY
+	if append {
+		flags |= os.O_APPEND
+	} else {
+		flags |= os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sink{Channel: channel, Name: path, W: f}, nil
+}
+
+// Close closes the underlying writer if it is an io.Closer.
+func (s *Sink) Close() error {
+	if c, ok := s.W.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// write writes data to the sink. This returns an error only for the write
+// that failed; once a write fails, later writes do nothing.
+func (s *Sink) write(data []byte) error {
+	if s.err != nil {
+		return nil
+	}
+
+	_, s.err = s.W.Write(data)
+	return s.err
+}
+
+// writeOutput writes the output of the remote command to the sinks for
+// its channel and to Stdout or Stderr. See printWarning for the meaning
+// of raw.
+func (c *Client) writeOutput(raw bool, output *pb.ExecStreamResponse_Output) {
+	channel := output.Channel
+	if channel != pb.ExecStreamResponse_Output_STDERR {
+		channel = pb.ExecStreamResponse_Output_STDOUT
+	}
+
+	data := output.Data[:c.outputLimit.Take(output.Data)]
+	if len(data) == 0 {
+		return
+	}
+
+	mirror := true
+	for _, s := range c.Sinks {
+		if s.Channel != channel {
+			continue
+		}
+
+		mirror = !c.NoMirror
+		if err := s.write(data); err != nil {
+			c.printWarning(raw, "error writing output to "+s.Name+
+				", no more output will be written to it: "+err.Error())
+		}
+	}
+
+	if mirror && c.interleave != nil {
+		c.write(c.Stdout, c.interleave.Write(channel, data), true)
+	} else if mirror {
+		out := c.Stdout
+		if channel == pb.ExecStreamResponse_Output_STDERR && c.Stderr != nil {
+			out = c.Stderr
+		}
+
+		c.write(out, data, true)
+	}
+}
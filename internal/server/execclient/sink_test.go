@@ -0,0 +1,89 @@
+package execclient
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientWriteOutput(t *testing.T) {
+	output := func(ch pb.ExecStreamResponse_Output_Channel, v string) *pb.ExecStreamResponse_Output {
+		return &pb.ExecStreamResponse_Output{Channel: ch, Data: []byte(v)}
+	}
+
+	t.Run("demux and mirror", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout, stderr, file bytes.Buffer
+		c := &Client{
+			Logger: hclog.NewNullLogger(),
+			Stdout: &stdout,
+			Stderr: &stderr,
+			Sinks: []*Sink{
+				{Channel: pb.ExecStreamResponse_Output_STDERR, Name: "file", W: &file},
+			},
+		}
+
+		c.writeOutput(false, output(pb.ExecStreamResponse_Output_STDOUT, "out"))
+		c.writeOutput(false, output(pb.ExecStreamResponse_Output_STDERR, "err"))
+		require.Equal("out", stdout.String())
+		require.Equal("err", stderr.String())
+		require.Equal("err", file.String())
+	})
+
+	t.Run("no mirror", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout, stderr, file bytes.Buffer
+		c := &Client{
+			Logger:   hclog.NewNullLogger(),
+			Stdout:   &stdout,
+			Stderr:   &stderr,
+			NoMirror: true,
+			Sinks: []*Sink{
+				{Channel: pb.ExecStreamResponse_Output_STDOUT, Name: "file", W: &file},
+			},
+		}
+
+		c.writeOutput(false, output(pb.ExecStreamResponse_Output_STDOUT, "out"))
+		c.writeOutput(false, output(pb.ExecStreamResponse_Output_STDERR, "err"))
+		require.Empty(stdout.String())
+		require.Equal("out", file.String())
+
+		// Channels without a sink are still mirrored
+		require.Equal("err", stderr.String())
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout, stderr bytes.Buffer
+		sink := &Sink{
+			Channel: pb.ExecStreamResponse_Output_STDOUT,
+			Name:    "broken",
+			W:       errWriter{},
+		}
+		c := &Client{
+			Logger: hclog.NewNullLogger(),
+			Stdout: &stdout,
+			Stderr: &stderr,
+			Sinks:  []*Sink{sink},
+		}
+
+		c.writeOutput(false, output(pb.ExecStreamResponse_Output_STDOUT, "a"))
+		c.writeOutput(false, output(pb.ExecStreamResponse_Output_STDOUT, "b"))
+
+		// We warn once and keep writing to the terminal
+		require.Equal("ab", stdout.String())
+		require.Equal(1, bytes.Count(stderr.Bytes(), []byte("broken")))
+	})
+}
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
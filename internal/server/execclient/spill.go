@@ -0,0 +1,297 @@
+package execclient
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// spillTailSize is how much of the end of the spilled output is shown
+// when the live output resumes.
+const spillTailSize = 64 * 1024
+
+// outputQueue writes to Stdout and Stderr from its own goroutine so that
+// a slow terminal doesn't hold up the session. Once more than Threshold
+// bytes of output are waiting, output is spilled to a temporary file
+// instead until Resume is called. Messages of our own, such as warnings,
+// are never spilled.
+//
+// Output can also be paused, in which case it is held rather than written
+// until Unpause, and spilled past Threshold as if the terminal was slow.
+type outputQueue struct {
+	// Threshold is how many bytes of output can wait to be written
+	// before we spill.
+	Threshold int64
+
+	// OnSpill is called when we start spilling to the file at path, or
+	// with the error if the file can't be created, in which case we keep
+	// queueing. This is called without holding the lock so it can write.
+	OnSpill func(path string, err error)
+
+	lock    sync.Mutex
+	cond    *sync.Cond
+	chunks  []outputChunk
+	queued  int64
+	closed  bool
+	doneCh  chan struct{}
+	failed  bool
+	file    *os.File
+	spill   bool
+	spilled int64
+
+	// resumed is true after Resume until the output waiting is back under
+	// the threshold, so that we don't spill again right away.
+	resumed bool
+
+	// paused is true between Pause and Unpause. held is the output that
+	// came in meanwhile and heldBytes its size, including what was spilled.
+	paused    bool
+	held      []outputChunk
+	heldBytes int64
+}
+
+// outputChunk is data waiting to be written to w.
+type outputChunk struct {
+	w    io.Writer
+	data []byte
+}
+
+// newOutputQueue returns a queue spilling past threshold bytes and starts
+// writing.
+func newOutputQueue(threshold int64, onSpill func(string, error)) *outputQueue {
+	q := &outputQueue{
+		Threshold: threshold,
+		OnSpill:   onSpill,
+		doneCh:    make(chan struct{}),
+	}
+	q.cond = sync.NewCond(&q.lock)
+	go q.run()
+	return q
+}
+
+// Write queues data to be written to w. If output is true then data is
+// output of the remote command, which is spilled if too much is waiting.
+func (q *outputQueue) Write(w io.Writer, data []byte, output bool) {
+	if len(data) == 0 {
+		return
+	}
+
+	q.lock.Lock()
+	if output && q.paused {
+		q.heldBytes += int64(len(data))
+	}
+	if output && q.spill {
+		n, err := q.file.Write(data)
+		q.spilled += int64(n)
+		if err == nil {
+			q.lock.Unlock()
+			return
+		}
+
+		// We can't spill any more so we go back to waiting for the
+		// terminal with what didn't make it to the file.
+		q.spill = false
+		q.failed = true
+		data = data[n:]
+	}
+
+	if output && q.paused {
+		q.held = append(q.held, outputChunk{w: w, data: append([]byte(nil), data...)})
+		start, path, err := q.spillHeld()
+		q.lock.Unlock()
+
+		if start && q.OnSpill != nil {
+			q.OnSpill(path, err)
+		}
+		return
+	}
+
+	q.chunks = append(q.chunks, outputChunk{w: w, data: append([]byte(nil), data...)})
+	q.queued += int64(len(data))
+	q.cond.Broadcast()
+
+	var path string
+	var err error
+	start := output && !q.failed && !q.resumed && q.Threshold > 0 && q.queued > q.Threshold
+	if start {
+		if q.file == nil {
+			q.file, err = ioutil.TempFile("", "waypoint-exec-*.log")
+			q.failed = err != nil
+		}
+		if q.file != nil {
+			q.spill = true
+			path = q.file.Name()
+		}
+	}
+	q.lock.Unlock()
+
+	if start && q.OnSpill != nil {
+		q.OnSpill(path, err)
+	}
+}
+
+// Resume stops spilling and queues header followed by the end of the
+// spilled output to w, ahead of any later output. This returns false if
+// we weren't spilling.
+func (q *outputQueue) Resume(w io.Writer, header []byte) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if !q.spill {
+		return false
+	}
+	q.spill = false
+	q.resumed = true
+
+	size := int64(spillTailSize)
+	if size > q.spilled {
+		size = q.spilled
+	}
+
+	tail := make([]byte, size)
+	n, _ := q.file.ReadAt(tail, q.spilled-size)
+	q.chunks = append(q.chunks,
+		outputChunk{w: w, data: header},
+		outputChunk{w: w, data: tail[:n]},
+	)
+	q.queued += int64(len(header) + n)
+	q.cond.Broadcast()
+	return true
+}
+
+// Pause holds further output rather than writing it, until Unpause. This
+// returns how many bytes of output are still waiting to be written, or
+// false if we were already paused.
+func (q *outputQueue) Pause() (int64, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.paused {
+		return 0, false
+	}
+	q.paused = true
+	q.heldBytes = 0
+
+	var pending int64
+	for _, chunk := range q.chunks {
+		pending += int64(len(chunk.data))
+	}
+
+	return pending, true
+}
+
+// Unpause queues the output held since Pause. This returns how many bytes
+// of output came in while paused and whether output is being spilled, in
+// which case the caller should Resume to show the end of it.
+func (q *outputQueue) Unpause() (int64, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.paused = false
+
+	for _, chunk := range q.held {
+		q.chunks = append(q.chunks, chunk)
+		q.queued += int64(len(chunk.data))
+	}
+	q.held = nil
+	q.cond.Broadcast()
+
+	return q.heldBytes, q.spill
+}
+
+// spillHeld starts spilling if the output held while paused is past the
+// threshold, moving it to the spill file. This returns true if we started
+// spilling along with the path of the file or the error creating it, the
+// same as OnSpill takes. The lock must be held.
+func (q *outputQueue) spillHeld() (bool, string, error) {
+	var size int64
+	for _, chunk := range q.held {
+		size += int64(len(chunk.data))
+	}
+	if q.failed || q.Threshold <= 0 || size <= q.Threshold {
+		return false, "", nil
+	}
+
+	if q.file == nil {
+		var err error
+		q.file, err = ioutil.TempFile("", "waypoint-exec-*.log")
+		if err != nil {
+			q.failed = true
+			return true, "", err
+		}
+	}
+
+	for len(q.held) > 0 {
+		n, err := q.file.Write(q.held[0].data)
+		q.spilled += int64(n)
+		if err != nil {
+			// Keep holding what didn't make it to the file.
+			q.held[0].data = q.held[0].data[n:]
+			q.failed = true
+			return false, "", nil
+		}
+
+		q.held = q.held[1:]
+	}
+
+	q.spill = true
+	return true, q.file.Name(), nil
+}
+
+// Flush waits until everything queued has been written.
+func (q *outputQueue) Flush() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for q.queued > 0 {
+		q.cond.Wait()
+	}
+}
+
+// Close writes everything queued, including output held while paused,
+// stops the queue and closes the spill file. This returns the path of the
+// spill file and how much was spilled to it, if anything.
+func (q *outputQueue) Close() (string, int64) {
+	q.Unpause()
+
+	q.lock.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.lock.Unlock()
+	<-q.doneCh
+
+	if q.file == nil {
+		return "", 0
+	}
+
+	q.file.Close()
+	return q.file.Name(), q.spilled
+}
+
+func (q *outputQueue) run() {
+	defer close(q.doneCh)
+	for {
+		q.lock.Lock()
+		for len(q.chunks) == 0 && !q.closed {
+			q.cond.Wait()
+		}
+		if len(q.chunks) == 0 {
+			q.lock.Unlock()
+			return
+		}
+
+		chunk := q.chunks[0]
+		q.chunks[0] = outputChunk{}
+		q.chunks = q.chunks[1:]
+		q.lock.Unlock()
+
+		// Errors writing to the terminal are ignored like they are
+		// without a queue.
+		chunk.w.Write(chunk.data)
+
+		q.lock.Lock()
+		q.queued -= int64(len(chunk.data))
+		if q.queued <= q.Threshold {
+			q.resumed = false
+		}
+		q.cond.Broadcast()
+		q.lock.Unlock()
+	}
+}
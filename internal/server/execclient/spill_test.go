@@ -0,0 +1,311 @@
+package execclient
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestOutputQueue(t *testing.T) {
+	t.Run("writes in order", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout, stderr bytes.Buffer
+		q := newOutputQueue(0, nil)
+		q.Write(&stdout, []byte("a"), true)
+		q.Write(&stderr, []byte("b"), false)
+		q.Write(&stdout, []byte("c"), true)
+
+		path, n := q.Close()
+		require.Empty(path)
+		require.Zero(n)
+		require.Equal("ac", stdout.String())
+		require.Equal("b", stderr.String())
+	})
+
+	t.Run("spills past the threshold", func(t *testing.T) {
+		require := require.New(t)
+
+		// The terminal is stuck until we unblock it
+		term := &testBlockedWriter{}
+		term.lock.Lock()
+
+		var spills []string
+		var q *outputQueue
+		q = newOutputQueue(10, func(path string, err error) {
+			require.NoError(err)
+			spills = append(spills, path)
+			q.Write(term, []byte("[spilling]"), false)
+		})
+
+		// The third write puts us over the threshold
+		for i := 0; i < 3; i++ {
+			q.Write(term, []byte("live-"), true)
+		}
+		q.Write(term, []byte("spilled-1 "), true)
+		q.Write(term, []byte("spilled-2 "), true)
+		require.Len(spills, 1)
+		defer os.Remove(spills[0])
+
+		// Resuming shows the end of the spilled output first
+		require.True(q.Resume(term, []byte("[resumed]")))
+		require.False(q.Resume(term, nil))
+		q.Write(term, []byte("live"), true)
+
+		term.lock.Unlock()
+		path, n := q.Close()
+		require.Equal(spills[0], path)
+		require.Equal(int64(20), n)
+		require.Equal(
+			"live-live-live-[spilling][resumed]spilled-1 spilled-2 live",
+			term.String())
+
+		data, err := ioutil.ReadFile(path)
+		require.NoError(err)
+		require.Equal("spilled-1 spilled-2 ", string(data))
+	})
+
+	t.Run("messages are never spilled", func(t *testing.T) {
+		require := require.New(t)
+
+		term := &testBlockedWriter{}
+		term.lock.Lock()
+
+		q := newOutputQueue(1, func(string, error) { t.Fatal("should not spill") })
+		q.Write(term, []byte(strings.Repeat("x", 100)), false)
+		q.Write(term, []byte("y"), false)
+
+		term.lock.Unlock()
+		q.Flush()
+		require.Equal(strings.Repeat("x", 100)+"y", term.String())
+		q.Close()
+	})
+
+	t.Run("holds output while paused", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout bytes.Buffer
+		q := newOutputQueue(100, func(string, error) { t.Fatal("should not spill") })
+		q.Write(&stdout, []byte("before "), true)
+		q.Flush()
+
+		pending, ok := q.Pause()
+		require.True(ok)
+		require.Zero(pending)
+		_, ok = q.Pause()
+		require.False(ok)
+
+		q.Write(&stdout, []byte("held "), true)
+		q.Write(&stdout, []byte("[message] "), false)
+		q.Flush()
+		require.Equal("before [message] ", stdout.String())
+
+		held, spilling := q.Unpause()
+		require.Equal(int64(5), held)
+		require.False(spilling)
+		q.Write(&stdout, []byte("after"), true)
+
+		q.Close()
+		require.Equal("before [message] held after", stdout.String())
+	})
+
+	t.Run("spills past the threshold while paused", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout bytes.Buffer
+		var spills []string
+		q := newOutputQueue(10, func(path string, err error) {
+			require.NoError(err)
+			spills = append(spills, path)
+		})
+
+		q.Pause()
+		q.Write(&stdout, []byte("held-1 "), true)
+		require.Empty(spills)
+		q.Write(&stdout, []byte("held-2 "), true)
+		q.Write(&stdout, []byte("held-3 "), true)
+		require.Len(spills, 1)
+		defer os.Remove(spills[0])
+
+		held, spilling := q.Unpause()
+		require.Equal(int64(21), held)
+		require.True(spilling)
+		require.True(q.Resume(&stdout, []byte("[resumed]")))
+
+		path, n := q.Close()
+		require.Equal(spills[0], path)
+		require.Equal(int64(21), n)
+		require.Equal("[resumed]held-1 held-2 held-3 ", stdout.String())
+	})
+
+	t.Run("closing writes what is held", func(t *testing.T) {
+		require := require.New(t)
+
+		var stdout bytes.Buffer
+		q := newOutputQueue(100, nil)
+		q.Pause()
+		q.Write(&stdout, []byte("held"), true)
+
+		q.Close()
+		require.Equal("held", stdout.String())
+	})
+}
+
+func TestClientRun_escapePause(t *testing.T) {
+	require := require.New(t)
+
+	reached := make(chan struct{})
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.AfterInput("\r~", execclienttest.Stdout("held")),
+		testReachedStep(reached, "\r~\r~", execclienttest.Exit(0)),
+	)
+
+	var stdout, stderr testBlockedWriter
+	c := testClient(t, stream)
+	c.ForcePTY = true
+	c.SpillThreshold = 1024 * 1024
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	typed := testTypingInput(t, c)
+	defer typed.Close()
+
+	// Enter sends a carriage return in raw mode, which starts a line
+	// for the escape like a newline does.
+	_, err := io.WriteString(typed, "\r~p")
+	require.NoError(err)
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := c.Run()
+		errCh <- err
+	}()
+
+	// The output is held while paused
+	<-reached
+	require.Eventually(func() bool {
+		return strings.Contains(stderr.String(), "output paused")
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Never(func() bool {
+		return strings.Contains(stdout.String(), "held")
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	// And shown once resumed
+	_, err = io.WriteString(typed, "\r~p")
+	require.NoError(err)
+	require.NoError(<-errCh)
+	require.Contains(stderr.String(), "output resumed")
+	require.Contains(stdout.String(), "held")
+}
+
+func TestClientRun_escapeResume(t *testing.T) {
+	require := require.New(t)
+
+	// Spilled output goes to a temporary file that we clean up
+	dir, err := ioutil.TempDir("", "waypoint-exec")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	defer os.Setenv("TMPDIR", os.Getenv("TMPDIR"))
+	os.Setenv("TMPDIR", dir)
+
+	steps := []execclienttest.Step{
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+	}
+	for i := 0; i < 10; i++ {
+		steps = append(steps, execclienttest.Respond(execclienttest.Stdout("0123456789")))
+	}
+	reached := make(chan struct{})
+	steps = append(steps, testReachedStep(reached, "\r~", execclienttest.Exit(0)))
+	stream := execclienttest.NewStream(t, steps...)
+
+	// The terminal is stuck until we unblock it, so the output spills
+	var stdout, stderr testBlockedWriter
+	stdout.lock.Lock()
+	c := testClient(t, stream)
+	c.ForcePTY = true
+	c.SpillThreshold = 10
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	typed := testTypingInput(t, c)
+	defer typed.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := c.Run()
+		errCh <- err
+	}()
+
+	// Enter sends a carriage return in raw mode, which starts a line
+	// for the escape like a newline does.
+	<-reached
+	_, err = io.WriteString(typed, "\r~o")
+	require.NoError(err)
+	require.Eventually(func() bool {
+		return bytes.Contains(stream.Input(), []byte("\r~"))
+	}, 2*time.Second, 10*time.Millisecond)
+
+	stdout.lock.Unlock()
+	require.NoError(<-errCh)
+	require.Contains(stdout.String(), "resuming the live view")
+	require.Contains(stderr.String(), "spilling to")
+}
+
+// testReachedStep returns a step that closes reached once the stream
+// waits for it, and then responds with resp once the input received
+// contains data. Reaching it means the responses before it were handled.
+func testReachedStep(reached chan struct{}, data string, resp *pb.ExecStreamResponse) execclienttest.Step {
+	var once sync.Once
+	return execclienttest.Step{
+		Response: resp,
+		Wait: func(requests []*pb.ExecStreamRequest) bool {
+			once.Do(func() { close(reached) })
+
+			var input []byte
+			for _, req := range requests {
+				input = append(input, req.GetInput().GetData()...)
+			}
+			return bytes.Contains(input, []byte(data))
+		},
+		Desc: "input " + data,
+	}
+}
+
+// testTypingInput replaces the stdin of c with a pipe and returns the
+// side to type into.
+func testTypingInput(t *testing.T, c *Client) *os.File {
+	stdin, typed, err := os.Pipe()
+	require.NoError(t, err)
+	t.Cleanup(func() { stdin.Close() })
+
+	c.Stdin = stdin
+	return typed
+}
+
+// testBlockedWriter blocks writes while its lock is held.
+type testBlockedWriter struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (w *testBlockedWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.buf.Write(p)
+}
+
+func (w *testBlockedWriter) String() string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.buf.String()
+}
@@ -0,0 +1,51 @@
+package execclient
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// SessionState describes the session of Run as it was negotiated, such as
+// for callers drawing their own UI around the session.
+type SessionState struct {
+	// SessionId is the ID of the session once it opens.
+	SessionId string
+
+	// InstanceId is the instance running the command once it attaches.
+	InstanceId string
+
+	// PTY is true if the session uses a PTY. Until the instance attaches,
+	// this is whether we requested one. The instance may run the command
+	// without one if it can't allocate it.
+	PTY bool
+
+	// WindowSize is the window size last sent for the PTY, or nil if none
+	// was sent.
+	WindowSize *pb.ExecStreamRequest_WindowSize
+
+	// Capabilities are the optional features of the exec protocol that
+	// were negotiated once the instance attached, in order.
+	Capabilities []string
+}
+
+// SessionState returns the state of the session of Run, or of the last
+// session once Run returns. This is safe to call concurrently with Run.
+func (c *Client) SessionState() SessionState {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+
+	result := c.state
+	if result.WindowSize != nil {
+		result.WindowSize = proto.Clone(result.WindowSize).(*pb.ExecStreamRequest_WindowSize)
+	}
+	result.Capabilities = append([]string(nil), result.Capabilities...)
+	return result
+}
+
+// updateState changes the state returned by SessionState with f.
+func (c *Client) updateState(f func(*SessionState)) {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+	f(&c.state)
+}
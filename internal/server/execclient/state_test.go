@@ -0,0 +1,115 @@
+package execclient
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientSessionState(t *testing.T) {
+	t.Run("pty", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Open("s1"), "ping", "stdin_eof")),
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Attached("i1"), "ping")),
+			execclienttest.Respond(execclienttest.Exit(0)),
+		)
+
+		term := &testTerminal{rows: 24, cols: 80}
+		c, stdout := testTerminalClient(t, stream, term)
+		defer stdout.Close()
+		require.Equal(SessionState{}, c.SessionState())
+
+		// Callers may watch the state while the session runs
+		var wg sync.WaitGroup
+		doneCh := make(chan struct{})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-doneCh:
+					return
+				default:
+					c.SessionState()
+				}
+			}
+		}()
+
+		code, err := c.Run()
+		close(doneCh)
+		wg.Wait()
+		require.NoError(err)
+		require.Equal(0, code)
+
+		require.Equal(SessionState{
+			SessionId:  "s1",
+			InstanceId: "i1",
+			PTY:        true,
+			WindowSize: &pb.ExecStreamRequest_WindowSize{
+				Rows: 24, Cols: 80, Height: 24, Width: 80,
+			},
+			Capabilities: []string{"ping"},
+		}, c.SessionState())
+	})
+
+	t.Run("pty unavailable", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(&pb.ExecStreamResponse{
+				Event: &pb.ExecStreamResponse_Attached_{
+					Attached: &pb.ExecStreamResponse_Attached{
+						InstanceId:     "i1",
+						PtyUnavailable: true,
+					},
+				},
+			}),
+			execclienttest.Respond(execclienttest.Exit(0)),
+		)
+
+		c, stdout := testTerminalClient(t, stream, &testTerminal{rows: 24, cols: 80})
+		defer stdout.Close()
+
+		_, err := c.Run()
+		require.NoError(err)
+
+		state := c.SessionState()
+		require.False(state.PTY)
+		require.Equal("i1", state.InstanceId)
+		require.Empty(state.Capabilities)
+	})
+
+	t.Run("attach", func(t *testing.T) {
+		require := require.New(t)
+
+		open := execclienttest.Open("s9")
+		open.Event.(*pb.ExecStreamResponse_Open_).Open.Pty = true
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(open),
+			execclienttest.AfterWinch(execclienttest.Exit(0)),
+		)
+
+		c, stdout := testTerminalClient(t, stream, &testTerminal{rows: 30, cols: 100})
+		defer stdout.Close()
+		c.SessionId = "s9"
+
+		_, err := c.Run()
+		require.NoError(err)
+
+		// Our size is sent right away when attaching
+		state := c.SessionState()
+		require.True(state.PTY)
+		require.Equal("s9", state.SessionId)
+		require.Equal(int32(30), state.WindowSize.Rows)
+		require.Equal(int32(100), state.WindowSize.Cols)
+	})
+}
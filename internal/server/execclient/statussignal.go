@@ -0,0 +1,14 @@
+// +build !windows
+
+package execclient
+
+import (
+	"os"
+	"os/signal"
+
+	"golang.org/x/sys/unix"
+)
+
+func registerStatusSignal(statusCh chan os.Signal) {
+	signal.Notify(statusCh, unix.SIGUSR1)
+}
@@ -0,0 +1,9 @@
+// +build windows
+
+package execclient
+
+import "os"
+
+func registerStatusSignal(chan os.Signal) {
+	// Windows has no SIGUSR1, so status is only shown at an interval.
+}
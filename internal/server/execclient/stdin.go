@@ -0,0 +1,151 @@
+package execclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+// stdinProgressInterval is how often the progress of sending Stdin is
+// updated.
+const stdinProgressInterval = 250 * time.Millisecond
+
+// stdinProgressWidth is the width of the progress bar in characters.
+const stdinProgressWidth = 20
+
+// copyStdin copies r to w, reading up to chunk bytes at a time, until ctx
+// is done. This returns true if r reached EOF, and the error reading r if
+// any. Errors writing to w, such as once the session ends, just stop the
+// copy.
+func copyStdin(ctx context.Context, w io.Writer, r io.Reader, chunk int) (bool, error) {
+	buf := make([]byte, chunk)
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+		default:
+		}
+
+		n, err := r.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return false, nil
+			}
+		}
+
+		switch {
+		case err == io.EOF:
+			return true, nil
+
+		case err != nil:
+			return false, err
+		}
+	}
+}
+
+// stdinWriter sends Stdin with w until ctx is done, after which writes
+// fail so that whatever is left of Stdin is discarded rather than sent to
+// a command that exited.
+type stdinWriter struct {
+	ctx context.Context
+	w   io.Writer
+
+	// busy is non-zero while a write is in progress. A write can block
+	// for as long as the server doesn't read the stream.
+	busy int32
+}
+
+func (w *stdinWriter) Write(p []byte) (int, error) {
+	// We mark the write first so that Busy can't miss a write that
+	// starts after ctx is done.
+	atomic.StoreInt32(&w.busy, 1)
+	defer atomic.StoreInt32(&w.busy, 0)
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return w.w.Write(p)
+}
+
+// Busy returns true if a write is in progress.
+func (w *stdinWriter) Busy() bool {
+	return atomic.LoadInt32(&w.busy) != 0
+}
+
+// stdinProgress counts the bytes read from Stdin so that the progress of
+// sending it can be shown.
+type stdinProgress struct {
+	r    io.Reader
+	size int64
+	sent int64
+}
+
+func (p *stdinProgress) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	atomic.AddInt64(&p.sent, int64(n))
+	return n, err
+}
+
+// Line returns the progress as a single line, such as
+// "Sending stdin [=====               ] 25% 10 MB / 40 MB".
+func (p *stdinProgress) Line() string {
+	sent := atomic.LoadInt64(&p.sent)
+	if sent > p.size {
+		sent = p.size
+	}
+
+	done := 1.0
+	if p.size > 0 {
+		done = float64(sent) / float64(p.size)
+	}
+
+	bar := int(done * stdinProgressWidth)
+	return fmt.Sprintf("Sending stdin [%s%s] %d%% %s / %s",
+		strings.Repeat("=", bar), strings.Repeat(" ", stdinProgressWidth-bar),
+		int(done*100), humanize.Bytes(uint64(sent)), humanize.Bytes(uint64(p.size)))
+}
+
+// showStdinProgress shows the progress of sending Stdin of StdinSize bytes
+// on Stderr until the returned function is called, which shows the final
+// progress, or until ctx is done. This returns r as it is if Stderr isn't
+// a terminal since the progress is redrawn in place.
+func (c *Client) showStdinProgress(ctx context.Context, r io.Reader) (io.Reader, func()) {
+	if _, ok := c.isTerminal(c.Stderr); !ok || c.StdinSize <= 0 {
+		return r, func() {}
+	}
+
+	p := &stdinProgress{r: r, size: c.StdinSize}
+	draw := func() {
+		c.write(c.Stderr, []byte("\r"+p.Line()+"\x1b[K"), false)
+	}
+
+	doneCh := make(chan struct{})
+	stoppedCh := make(chan struct{})
+	go func() {
+		defer close(stoppedCh)
+		ticker := time.NewTicker(stdinProgressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				draw()
+			case <-doneCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return p, func() {
+		close(doneCh)
+		<-stoppedCh
+		draw()
+		c.write(c.Stderr, []byte("\r\n"), false)
+	}
+}
@@ -0,0 +1,223 @@
+package execclient
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientRun_closeStdin(t *testing.T) {
+	t.Run("after the instance attaches", func(t *testing.T) {
+		require := require.New(t)
+
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Open("s1"), "stdin_eof")),
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Attached("i1"), "stdin_eof")),
+			execclienttest.AfterStdinEOF(execclienttest.Exit(0)),
+		)
+		c := testClient(t, stream)
+		c.Stdin = strings.NewReader("hello\n~.\n")
+		c.CloseStdin = true
+
+		code, err := c.Run()
+		require.NoError(err)
+		require.Equal(0, code)
+
+		// Escapes aren't handled in the data and it all comes before the
+		// end of stdin
+		requests := stream.Requests()
+		require.True(requests[len(requests)-1].GetInput().GetEof())
+		require.Equal("hello\n~.\n", string(stream.Input()))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		require := require.New(t)
+
+		var stderr bytes.Buffer
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open("s1")),
+			execclienttest.Respond(execclienttest.Attached("i1")),
+			execclienttest.AfterInput("hello", execclienttest.Exit(0)),
+		)
+		c := testClient(t, stream)
+		c.Stdin = strings.NewReader("hello")
+		c.Stderr = &stderr
+		c.CloseStdin = true
+
+		code, err := c.Run()
+		require.NoError(err)
+		require.Equal(0, code)
+		for _, req := range stream.Requests() {
+			require.False(req.GetInput().GetEof())
+		}
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		require := require.New(t)
+
+		var stderr bytes.Buffer
+		stream := execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Open("s1"), "stdin_eof")),
+			execclienttest.Respond(execclienttest.WithCapabilities(
+				execclienttest.Attached("i1"), "stdin_eof")),
+			execclienttest.Step{
+				Response: execclienttest.Exit(143),
+				Wait: func(requests []*pb.ExecStreamRequest) bool {
+					for _, req := range requests {
+						if req.GetSignal().GetName() == "TERM" {
+							return true
+						}
+					}
+
+					return false
+				},
+				Desc: "signal TERM",
+			},
+		)
+		c := testClient(t, stream)
+		c.Stdin = io.MultiReader(strings.NewReader("partial"),
+			&testErrReader{err: errors.New("disk on fire")})
+		c.Stderr = &stderr
+		c.CloseStdin = true
+
+		code, _ := c.Run()
+		require.Equal(143, code)
+		require.Contains(stderr.String(), "error reading stdin, stopping the command: disk on fire")
+		for _, req := range stream.Requests() {
+			require.False(req.GetInput().GetEof())
+		}
+	})
+}
+
+func TestClientRun_stdinAbortedOnExit(t *testing.T) {
+	require := require.New(t)
+
+	// The command exits after reading 1 KB of a 100 MB stdin, after which
+	// the server stops reading the stream.
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Step{
+			Response: execclienttest.Exit(3),
+			Wait: func(requests []*pb.ExecStreamRequest) bool {
+				var n int
+				for _, req := range requests {
+					n += len(req.GetInput().GetData())
+				}
+				return n >= 1024
+			},
+			Desc: "1 KB of input",
+		},
+	)
+	stream.InputWindow = 64 * 1024
+
+	stdin := &testCountingReader{r: io.LimitReader(testZeroReader{}, 100*1024*1024)}
+	c := testClient(t, stream)
+	c.Stdin = stdin
+
+	type result struct {
+		code int
+		err  error
+	}
+	doneCh := make(chan result, 1)
+	go func() {
+		code, err := c.Run()
+		doneCh <- result{code, err}
+	}()
+
+	var r result
+	select {
+	case r = <-doneCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't return after the command exited")
+	}
+
+	var exitErr *ExitError
+	require.True(errors.As(r.err, &exitErr))
+	require.Equal(3, r.code)
+
+	// Stdin stops being read, well short of its end
+	time.Sleep(50 * time.Millisecond)
+	require.True(stdin.Count() < 1024*1024)
+}
+
+func TestStdinProgress(t *testing.T) {
+	require := require.New(t)
+
+	p := &stdinProgress{r: strings.NewReader(strings.Repeat("x", 1000)), size: 4000}
+	_, err := io.Copy(ioutil.Discard, p)
+	require.NoError(err)
+	require.Equal("Sending stdin [=====               ] 25% 1.0 kB / 4.0 kB", p.Line())
+}
+
+func TestClientShowStdinProgress(t *testing.T) {
+	require := require.New(t)
+
+	stderr, err := ioutil.TempFile("", "waypoint-exec")
+	require.NoError(err)
+	defer os.Remove(stderr.Name())
+	defer stderr.Close()
+
+	c := testClient(t, execclienttest.NewStream(t))
+	c.Stderr = stderr
+	c.StdinSize = 5
+	c.terminalDetector = &testTerminal{}
+
+	r, stop := c.showStdinProgress(c.Context, strings.NewReader("hello"))
+	_, err = io.Copy(ioutil.Discard, r)
+	require.NoError(err)
+	stop()
+
+	data, err := ioutil.ReadFile(stderr.Name())
+	require.NoError(err)
+	require.True(strings.HasSuffix(string(data),
+		"\rSending stdin [====================] 100% 5 B / 5 B\x1b[K\r\n"))
+}
+
+// testErrReader fails every read with err.
+type testErrReader struct {
+	err error
+}
+
+func (r *testErrReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// testZeroReader reads an endless stream of zeros.
+type testZeroReader struct{}
+
+func (testZeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// testCountingReader counts the bytes read from r.
+type testCountingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *testCountingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	atomic.AddInt64(&r.n, int64(n))
+	return n, err
+}
+
+func (r *testCountingReader) Count() int64 {
+	return atomic.LoadInt64(&r.n)
+}
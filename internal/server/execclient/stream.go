@@ -0,0 +1,51 @@
+package execclient
+
+import (
+	"sync"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// syncStream serializes sends on an exec stream. Input, window changes,
+// and signals are all sent from different goroutines and gRPC streams
+// do not support concurrent calls to SendMsg.
+type syncStream struct {
+	pb.Waypoint_StartExecStreamClient
+
+	// debug, if set, records the messages of the stream.
+	debug *DebugBundle
+
+	lock sync.Mutex
+}
+
+func (s *syncStream) Send(req *pb.ExecStreamRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.debug.event("send", req)
+	return s.Waypoint_StartExecStreamClient.Send(req)
+}
+
+func (s *syncStream) SendMsg(m interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if req, ok := m.(*pb.ExecStreamRequest); ok {
+		s.debug.event("send", req)
+	}
+	return s.Waypoint_StartExecStreamClient.SendMsg(m)
+}
+
+// CloseSend must not be called concurrently with SendMsg either. Input
+// may still be sent while Run returns and closes the stream.
+func (s *syncStream) CloseSend() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.Waypoint_StartExecStreamClient.CloseSend()
+}
+
+func (s *syncStream) Recv() (*pb.ExecStreamResponse, error) {
+	resp, err := s.Waypoint_StartExecStreamClient.Recv()
+	if err == nil {
+		s.debug.event("recv", resp)
+	}
+	return resp, err
+}
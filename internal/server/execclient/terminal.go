@@ -0,0 +1,145 @@
+package execclient
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containerd/console"
+	sshterm "golang.org/x/crypto/ssh/terminal"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// terminalDetector reports whether a file is a terminal.
+type terminalDetector interface {
+	IsTerminal(f *os.File) bool
+}
+
+// rawModer puts a terminal into raw mode. The returned function restores
+// its previous mode.
+type rawModer interface {
+	MakeRaw(f *os.File) (restore func() error, err error)
+}
+
+// consoleSizer returns the size of a terminal in rows and columns.
+type consoleSizer interface {
+	Size(f *os.File) (rows, cols int, err error)
+}
+
+// sysTerminal implements the terminal interfaces for real terminals.
+type sysTerminal struct{}
+
+func (sysTerminal) IsTerminal(f *os.File) bool {
+	return sshterm.IsTerminal(int(f.Fd()))
+}
+
+func (sysTerminal) MakeRaw(f *os.File) (func() error, error) {
+	fd := int(f.Fd())
+	state, err := sshterm.MakeRaw(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() error { return sshterm.Restore(fd, state) }, nil
+}
+
+func (sysTerminal) Size(f *os.File) (int, int, error) {
+	con, err := console.ConsoleFromFile(f)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sz, err := con.Size()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int(sz.Height), int(sz.Width), nil
+}
+
+// isTerminal returns the file behind v if it is a terminal.
+func (c *Client) isTerminal(v interface{}) (*os.File, bool) {
+	f, ok := v.(*os.File)
+	if !ok {
+		return nil, false
+	}
+
+	d := c.terminalDetector
+	if d == nil {
+		d = sysTerminal{}
+	}
+
+	return f, d.IsTerminal(f)
+}
+
+// makeRaw puts the terminal f into raw mode.
+func (c *Client) makeRaw(f *os.File) (func() error, error) {
+	r := c.rawModer
+	if r == nil {
+		r = sysTerminal{}
+	}
+
+	return r.MakeRaw(f)
+}
+
+// The size of the PTY of a session while our terminal reports an invalid
+// size, such as the 0x0 of some terminals embedded in IDEs or of a window
+// that is still opening.
+const (
+	defaultRows = 24
+	defaultCols = 80
+)
+
+// windowSizeCheckInterval is how often we check the size of our terminal
+// while the PTY has the default size because ours was invalid.
+const windowSizeCheckInterval = 250 * time.Millisecond
+
+// A window size is invalid if it is smaller than minRows or minCols, which
+// programs can't draw in, or larger than maxWindowDim in either dimension.
+const (
+	minRows      = 2
+	minCols      = 10
+	maxWindowDim = 10000
+)
+
+// invalidWindowSizeError is the error of windowSize if our terminal
+// reports a size that can't be right.
+type invalidWindowSizeError struct {
+	Rows, Cols int
+}
+
+func (e *invalidWindowSizeError) Error() string {
+	return fmt.Sprintf("the terminal reports an invalid size of %dx%d", e.Cols, e.Rows)
+}
+
+// windowSize returns the size of the terminal f. If the size is invalid,
+// the error is an *invalidWindowSizeError, so that the PTY of the session
+// is never given a size that breaks the programs in it. This is the only
+// place that checks this so that the size a session starts with and the
+// sizes sent as our terminal is resized agree.
+func (c *Client) windowSize(f *os.File) (*pb.ExecStreamRequest_WindowSize, error) {
+	s := c.consoleSizer
+	if s == nil {
+		s = sysTerminal{}
+	}
+
+	rows, cols, err := s.Size(f)
+	if err != nil {
+		return nil, err
+	}
+	if rows < minRows || cols < minCols || rows > maxWindowDim || cols > maxWindowDim {
+		return nil, &invalidWindowSizeError{Rows: rows, Cols: cols}
+	}
+
+	return newWindowSize(rows, cols), nil
+}
+
+func newWindowSize(rows, cols int) *pb.ExecStreamRequest_WindowSize {
+	return &pb.ExecStreamRequest_WindowSize{
+		Rows:   int32(rows),
+		Cols:   int32(cols),
+		Height: int32(rows),
+		Width:  int32(cols),
+	}
+}
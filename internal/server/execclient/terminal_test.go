@@ -0,0 +1,484 @@
+package execclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientWantPTY(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Client   Client
+		Terminal bool
+		Expected bool
+	}{
+		{"terminal", Client{}, true, true},
+		{"no terminal", Client{}, false, false},
+		{"force", Client{ForcePTY: true}, false, true},
+		{"disable", Client{DisablePTY: true}, true, false},
+		{"disable over force", Client{ForcePTY: true, DisablePTY: true}, true, false},
+		{"detach", Client{Detach: true}, true, false},
+		{"detach forced", Client{Detach: true, ForcePTY: true}, false, true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, tt.Client.wantPTY(tt.Terminal))
+		})
+	}
+}
+
+func TestClientRun_pty(t *testing.T) {
+	cases := []struct {
+		Name      string
+		ReadOnly  bool
+		Attached  *pb.ExecStreamResponse
+		Raw       bool
+		OutputPTY bool
+	}{
+		{
+			"raw mode",
+			false,
+			execclienttest.Attached("i1"),
+			true,
+			true,
+		},
+
+		{
+			"read only",
+			true,
+			execclienttest.Attached("i1"),
+			false,
+			true,
+		},
+
+		{
+			"pty unavailable",
+			false,
+			&pb.ExecStreamResponse{
+				Event: &pb.ExecStreamResponse_Attached_{
+					Attached: &pb.ExecStreamResponse_Attached{
+						InstanceId:     "i1",
+						PtyUnavailable: true,
+					},
+				},
+			},
+			false,
+			false,
+		},
+	}
+
+	defer os.Setenv("TERM", os.Getenv("TERM"))
+	os.Setenv("TERM", "xterm-test")
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			stream := execclienttest.NewStream(t,
+				execclienttest.Respond(execclienttest.Open("s1")),
+				execclienttest.Respond(tt.Attached),
+				execclienttest.Respond(execclienttest.Stdout("hello")),
+				execclienttest.Respond(execclienttest.Exit(0)),
+			)
+
+			term := &testTerminal{rows: 24, cols: 80}
+			c, stdout := testTerminalClient(t, stream, term)
+			defer stdout.Close()
+			c.ReadOnly = tt.ReadOnly
+
+			code, err := c.Run()
+			require.NoError(err)
+			require.Equal(0, code)
+
+			// The initial window size is sent with the PTY request
+			start := stream.Start()
+			require.NotNil(start.Pty)
+			require.True(start.Pty.Enable)
+			require.Equal("xterm-test", start.Pty.Term)
+			require.Equal(&pb.ExecStreamRequest_WindowSize{
+				Rows: 24, Cols: 80, Height: 24, Width: 80,
+			}, start.Pty.WindowSize)
+
+			// Raw mode is only entered on stdin and is always restored
+			if tt.Raw {
+				require.Equal([]*os.File{c.Stdin.(*os.File)}, term.raw)
+			} else {
+				require.Empty(term.raw)
+			}
+			require.Equal(len(term.raw), term.restored)
+
+			_, err = stdout.Seek(0, io.SeekStart)
+			require.NoError(err)
+			out, err := ioutil.ReadAll(stdout)
+			require.NoError(err)
+			if tt.OutputPTY {
+				require.Equal("\rhello", string(out))
+			} else {
+				require.Equal("hello", string(out))
+			}
+		})
+	}
+}
+
+func TestClientRun_ptyNotTerminal(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	term := &testTerminal{notTerminal: true}
+	c, stdout := testTerminalClient(t, stream, term)
+	defer stdout.Close()
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	require.Nil(stream.Start().Pty)
+	require.Empty(term.raw)
+	require.Zero(term.sized)
+}
+
+func TestClientRun_ptyDisabled(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Exit(0)),
+	)
+
+	term := &testTerminal{rows: 24, cols: 80}
+	c, stdout := testTerminalClient(t, stream, term)
+	defer stdout.Close()
+	c.DisablePTY = true
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+	require.Nil(stream.Start().Pty)
+	require.Empty(term.raw)
+}
+
+func TestClientRun_ptyRestore(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Last   []execclienttest.Step
+		Cancel bool
+		Panic  bool
+		Error  bool
+	}{
+		{
+			"exit",
+			[]execclienttest.Step{execclienttest.Respond(execclienttest.Exit(0))},
+			false,
+			false,
+			false,
+		},
+
+		{
+			"exit unsuccessfully",
+			[]execclienttest.Step{execclienttest.Respond(execclienttest.Exit(3))},
+			false,
+			false,
+			false,
+		},
+
+		{
+			"stream error",
+			[]execclienttest.Step{execclienttest.Fail(errors.New("connection lost"))},
+			false,
+			false,
+			true,
+		},
+
+		{
+			"canceled",
+			[]execclienttest.Step{execclienttest.AfterInput("never", execclienttest.Exit(0))},
+			true,
+			false,
+			true,
+		},
+
+		{
+			"panic",
+			[]execclienttest.Step{
+				execclienttest.Respond(execclienttest.CommandExit(0, 0)),
+				execclienttest.Respond(execclienttest.Exit(0)),
+			},
+			false,
+			true,
+			false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			stream := execclienttest.NewStream(t, append([]execclienttest.Step{
+				execclienttest.Respond(execclienttest.Open("s1")),
+				execclienttest.Respond(execclienttest.Attached("i1")),
+			}, tt.Last...)...)
+
+			term := &testTerminal{rows: 24, cols: 80}
+			c, stdout := testTerminalClient(t, stream, term)
+			defer stdout.Close()
+
+			// We note how often the terminal was restored by the time the
+			// error is shown.
+			var restoredAtError []int
+			c.Hooks.Error = func(error) {
+				term.lock.Lock()
+				defer term.lock.Unlock()
+				restoredAtError = append(restoredAtError, term.restored)
+			}
+			if tt.Cancel {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				c.Context = ctx
+				time.AfterFunc(10*time.Millisecond, cancel)
+			}
+			if tt.Panic {
+				c.Hooks.CommandExit = func(int, *pb.ExecStreamResponse_Exit) {
+					panic("hook failed")
+				}
+			}
+
+			func() {
+				defer func() {
+					require.Equal(tt.Panic, recover() != nil)
+				}()
+				c.Run()
+			}()
+
+			require.Len(term.raw, 1)
+			require.Equal(1, term.restored)
+			if tt.Error {
+				require.Equal([]int{1}, restoredAtError)
+			} else {
+				require.Empty(restoredAtError)
+			}
+		})
+	}
+}
+
+func TestClientRun_minimal(t *testing.T) {
+	for _, detach := range []bool{false, true} {
+		t.Run(fmt.Sprintf("detach=%v", detach), func(t *testing.T) {
+			require := require.New(t)
+
+			steps := []execclienttest.Step{
+				execclienttest.Respond(execclienttest.Open("s1")),
+			}
+			if !detach {
+				steps = append(steps,
+					execclienttest.Respond(execclienttest.Attached("i1")),
+					execclienttest.Respond(execclienttest.Stdout("hello")),
+					execclienttest.Respond(execclienttest.Exit(0)),
+				)
+			}
+			stream := execclienttest.NewStream(t, steps...)
+
+			stdout, err := ioutil.TempFile("", "waypoint-exec")
+			require.NoError(err)
+			defer os.Remove(stdout.Name())
+			defer stdout.Close()
+
+			// Only the required fields are set, there is no UI, Logger or
+			// Context. Stdout is a terminal so that the UI would be used.
+			term := &testTerminal{rows: 24, cols: 80}
+			c := &Client{
+				Client:       execclienttest.NewWaypoint(stream),
+				DeploymentId: "d1",
+				Stdin:        bytes.NewReader(nil),
+				Stdout:       stdout,
+				Stderr:       ioutil.Discard,
+				Detach:       detach,
+
+				terminalDetector: term,
+				rawModer:         term,
+				consoleSizer:     term,
+			}
+
+			code, err := c.Run()
+			require.NoError(err)
+			require.Equal(0, code)
+
+			data, err := ioutil.ReadFile(stdout.Name())
+			require.NoError(err)
+			if detach {
+				require.Empty(string(data))
+			} else {
+				require.Contains(string(data), "hello")
+			}
+		})
+	}
+}
+
+func TestClientWindowSize(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Rows, Cols int
+		Valid      bool
+	}{
+		{"normal", 24, 80, true},
+		{"smallest", minRows, minCols, true},
+		{"largest", maxWindowDim, maxWindowDim, true},
+		{"zero", 0, 0, false},
+		{"no columns", 24, 0, false},
+		{"too few rows", 1, 80, false},
+		{"too few columns", 24, 5, false},
+		{"negative", -1, 80, false},
+		{"too many columns", 24, maxWindowDim + 1, false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			c := &Client{consoleSizer: &testTerminal{rows: tt.Rows, cols: tt.Cols}}
+			size, err := c.windowSize(os.Stdout)
+			if !tt.Valid {
+				var invalid *invalidWindowSizeError
+				require.True(errors.As(err, &invalid))
+				require.Equal(&invalidWindowSizeError{Rows: tt.Rows, Cols: tt.Cols}, invalid)
+				require.Nil(size)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(newWindowSize(tt.Rows, tt.Cols), size)
+		})
+	}
+}
+
+func TestClientRun_invalidWindowSize(t *testing.T) {
+	require := require.New(t)
+
+	// The session ends once it got the size our terminal ends up with.
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.Step{
+			Response: execclienttest.Exit(0),
+			Wait: func(requests []*pb.ExecStreamRequest) bool {
+				for _, req := range requests {
+					if req.GetWinch() != nil {
+						return true
+					}
+				}
+
+				return false
+			},
+			Desc: "a window size",
+		},
+	)
+
+	// Our terminal reports 0x0 until it opened.
+	term := &testTerminal{}
+	c, stdout := testTerminalClient(t, stream, term)
+	defer stdout.Close()
+	time.AfterFunc(3*windowSizeCheckInterval, func() {
+		term.lock.Lock()
+		defer term.lock.Unlock()
+		term.rows, term.cols = 40, 120
+	})
+
+	code, err := c.Run()
+	require.NoError(err)
+	require.Equal(0, code)
+
+	// The session starts with the default size, then gets ours once it is
+	// valid, and never an invalid one.
+	require.Equal(newWindowSize(defaultRows, defaultCols), stream.Start().Pty.WindowSize)
+	require.Equal([]*pb.ExecStreamRequest_WindowSize{newWindowSize(40, 120)}, stream.Winches())
+}
+
+// testTerminalClient returns a client whose stdin and stdout are files
+// that term reports as terminals. The caller closes the returned stdout.
+func testTerminalClient(
+	t *testing.T,
+	stream *execclienttest.Stream,
+	term *testTerminal,
+) (*Client, *os.File) {
+	stdout, err := ioutil.TempFile("", "waypoint-exec")
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(stdout.Name()))
+
+	// Our stdin is at EOF right away, the fake stream ends the session.
+	stdin, w, err := os.Pipe()
+	require.NoError(t, err)
+	w.Close()
+
+	c := testClient(t, stream)
+	c.UI = terminal.NonInteractiveUI(context.Background())
+	c.Stdin = stdin
+	c.Stdout = stdout
+	c.terminalDetector = term
+	c.rawModer = term
+	c.consoleSizer = term
+	return c, stdout
+}
+
+// testTypeInput replaces the stdin of c with a pipe that v was typed
+// into, at EOF after that.
+func testTypeInput(t *testing.T, c *Client, v string) {
+	stdin, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = io.WriteString(w, v)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	c.Stdin.(*os.File).Close()
+	c.Stdin = stdin
+}
+
+// testTerminal is a fake terminal for every file.
+type testTerminal struct {
+	lock        sync.Mutex
+	notTerminal bool
+	rows, cols  int
+
+	raw      []*os.File
+	restored int
+	sized    int
+}
+
+func (t *testTerminal) IsTerminal(f *os.File) bool {
+	return !t.notTerminal
+}
+
+func (t *testTerminal) MakeRaw(f *os.File) (func() error, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.raw = append(t.raw, f)
+	return func() error {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+		t.restored++
+		return nil
+	}, nil
+}
+
+func (t *testTerminal) Size(f *os.File) (int, int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.sized++
+	return t.rows, t.cols, nil
+}
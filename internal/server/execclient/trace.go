@@ -0,0 +1,223 @@
+package execclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Tracer creates the spans that trace exec sessions. Its methods mirror
+// those of an OpenTelemetry tracer and propagator so that one can be
+// adapted to it with a few lines.
+//
+// Run creates an "exec" span with the child spans "resolve target",
+// "wait for assignment" and "session", in that order, and sets the
+// attributes described by the traceAttr constants on the "exec" span.
+type Tracer interface {
+	// Start starts a span that is a child of the span in ctx, if any, and
+	// returns a context containing the new span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+
+	// Inject adds the trace context of the span in ctx to carrier. These
+	// are sent to the server as gRPC metadata so that its spans can be
+	// linked to ours.
+	Inject(ctx context.Context, carrier map[string]string)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttribute sets an attribute of the span. The value is a string,
+	// bool, int64 or uint64.
+	SetAttribute(key string, value interface{})
+
+	// RecordError records that the span failed with err.
+	RecordError(err error)
+
+	// End ends the span.
+	End()
+}
+
+// The attributes of the "exec" span.
+const (
+	traceAttrDeploymentId  = "waypoint.deployment.id"
+	traceAttrDeploymentSeq = "waypoint.deployment.seq"
+	traceAttrInstanceId    = "waypoint.instance.id"
+	traceAttrSessionId     = "waypoint.exec.session_id"
+	traceAttrPTY           = "waypoint.exec.pty"
+	traceAttrExitCode      = "waypoint.exec.exit_code"
+	traceAttrBytesIn       = "waypoint.exec.bytes_in"
+	traceAttrBytesOut      = "waypoint.exec.bytes_out"
+)
+
+// execTrace traces a single run of a Client and reports its metrics.
+// Its tracing methods do nothing if there is no Tracer, so Run can call
+// them unconditionally.
+type execTrace struct {
+	tracer Tracer
+	ctx    context.Context
+	root   Span
+	phase  Span
+
+	metrics    MetricsSink
+	start      time.Time
+	phaseStart time.Time
+
+	// bytesIn and bytesOut are the bytes of input sent and output
+	// received, and must be accessed atomically.
+	bytesIn  uint64
+	bytesOut uint64
+}
+
+// startTrace starts the "exec" span of a run.
+func (c *Client) startTrace() *execTrace {
+	t := &execTrace{
+		tracer:  c.Tracer,
+		ctx:     c.Context,
+		metrics: c.Metrics,
+		start:   time.Now(),
+	}
+	if t.metrics == nil {
+		t.metrics = noopMetrics{}
+	}
+	t.phaseStart = t.start
+
+	t.metrics.IncrCounter(metricSessionStart, 1)
+	t.metrics.SetGauge(metricSessionActive, float32(atomic.AddInt64(&execActive, 1)))
+
+	if t.tracer == nil {
+		return t
+	}
+
+	t.ctx, t.root = t.tracer.Start(c.Context, "exec")
+	t.root.SetAttribute(traceAttrDeploymentId, c.DeploymentId)
+	t.root.SetAttribute(traceAttrDeploymentSeq, c.DeploymentSeq)
+	if c.InstanceId != "" {
+		t.root.SetAttribute(traceAttrInstanceId, c.InstanceId)
+	}
+	if c.SessionId != "" {
+		t.root.SetAttribute(traceAttrSessionId, c.SessionId)
+	}
+
+	return t
+}
+
+// Phase ends the current child span, if any, and starts the next one.
+func (t *execTrace) Phase(name string) {
+	t.phaseStart = time.Now()
+	if t.tracer == nil {
+		return
+	}
+
+	if t.phase != nil {
+		t.phase.End()
+	}
+	_, t.phase = t.tracer.Start(t.ctx, name)
+}
+
+// Assigned reports the time we waited for an instance to be assigned,
+// which is the time since the current phase began.
+func (t *execTrace) Assigned() {
+	t.metrics.AddSample(metricAssignment, milliseconds(time.Since(t.phaseStart)))
+}
+
+// SetAttribute sets an attribute of the "exec" span.
+func (t *execTrace) SetAttribute(key string, value interface{}) {
+	if t.tracer != nil {
+		t.root.SetAttribute(key, value)
+	}
+}
+
+// StreamContext returns the context for the exec stream, which carries
+// our trace context to the server.
+func (t *execTrace) StreamContext() context.Context {
+	if t.tracer == nil {
+		return t.ctx
+	}
+
+	carrier := map[string]string{}
+	t.tracer.Inject(t.ctx, carrier)
+	ctx := t.ctx
+	for k, v := range carrier {
+		ctx = metadata.AppendToOutgoingContext(ctx, k, v)
+	}
+
+	return ctx
+}
+
+// Output counts received output.
+func (t *execTrace) Output(data []byte) {
+	atomic.AddUint64(&t.bytesOut, uint64(len(data)))
+}
+
+// Input wraps w to count the input sent through it.
+func (t *execTrace) Input(w io.Writer) io.Writer {
+	return &traceWriter{w: w, n: &t.bytesIn}
+}
+
+// RoundTrip reports the round trip time of a ping.
+func (t *execTrace) RoundTrip(d time.Duration) {
+	t.metrics.AddSample(metricRoundTrip, milliseconds(d))
+}
+
+// OutputGap reports that output events were lost, or that an event
+// arrived out of order if lost is zero. See outputSequence.
+func (t *execTrace) OutputGap(lost uint64) {
+	if lost == 0 {
+		t.metrics.IncrCounter(metricOutputReordered, 1)
+		return
+	}
+
+	t.metrics.IncrCounter(metricOutputGaps, 1)
+	t.metrics.IncrCounter(metricOutputLost, float32(lost))
+}
+
+// End reports the metrics of the run and ends all its spans with its
+// result.
+func (t *execTrace) End(code int, err error) {
+	// An unsuccessful exit of the command is in the exit code, not an
+	// error of the session.
+	var exitErr *ExitError
+	failed := err != nil && !errors.As(err, &exitErr)
+
+	t.metrics.SetGauge(metricSessionActive, float32(atomic.AddInt64(&execActive, -1)))
+	t.metrics.IncrCounter(metricSessionEnd, 1)
+	if failed {
+		t.metrics.IncrCounter(metricSessionFailed, 1)
+	}
+	t.metrics.IncrCounter(metricBytesIn, float32(atomic.LoadUint64(&t.bytesIn)))
+	t.metrics.IncrCounter(metricBytesOut, float32(atomic.LoadUint64(&t.bytesOut)))
+	t.metrics.AddSample(metricSessionDuration, milliseconds(time.Since(t.start)))
+
+	if t.tracer == nil {
+		return
+	}
+
+	if t.phase != nil {
+		t.phase.End()
+	}
+
+	t.root.SetAttribute(traceAttrExitCode, int64(code))
+	t.root.SetAttribute(traceAttrBytesIn, atomic.LoadUint64(&t.bytesIn))
+	t.root.SetAttribute(traceAttrBytesOut, atomic.LoadUint64(&t.bytesOut))
+
+	if failed {
+		t.root.RecordError(err)
+	}
+	t.root.End()
+}
+
+// traceWriter counts the bytes written through it.
+type traceWriter struct {
+	w io.Writer
+	n *uint64
+}
+
+func (w *traceWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	atomic.AddUint64(w.n, uint64(n))
+	return n, err
+}
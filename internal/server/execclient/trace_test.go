@@ -0,0 +1,126 @@
+package execclient
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+)
+
+func TestClientTrace(t *testing.T) {
+	require := require.New(t)
+
+	tracer := &testTracer{}
+	waypoint := execclienttest.NewWaypoint(execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.Respond(execclienttest.Stdout("hello\n")),
+		execclienttest.Respond(execclienttest.Exit(3)),
+	))
+
+	var stdout bytes.Buffer
+	c := &Client{
+		Logger:        hclog.NewNullLogger(),
+		Context:       context.Background(),
+		Client:        waypoint,
+		DeploymentId:  "d1",
+		DeploymentSeq: 7,
+		Args:          []string{"true"},
+		Stdin:         bytes.NewReader(nil),
+		Stdout:        &stdout,
+		Stderr:        &stdout,
+		ReadOnly:      true,
+		Tracer:        tracer,
+	}
+
+	code, err := c.Run()
+	require.Error(err)
+	require.Equal(3, code)
+	require.Equal("hello\n", stdout.String())
+
+	// The span tree
+	require.Len(tracer.spans, 4)
+	root := tracer.spans[0]
+	require.Equal("exec", root.name)
+	require.Nil(root.parent)
+	for i, name := range []string{"resolve target", "wait for assignment", "session"} {
+		span := tracer.spans[i+1]
+		require.Equal(name, span.name)
+		require.Equal(root, span.parent)
+	}
+	for _, span := range tracer.spans {
+		require.True(span.ended, span.name)
+	}
+
+	// The attributes
+	require.Equal(map[string]interface{}{
+		traceAttrDeploymentId:  "d1",
+		traceAttrDeploymentSeq: uint64(7),
+		traceAttrSessionId:     "s1",
+		traceAttrInstanceId:    "i1",
+		traceAttrPTY:           false,
+		traceAttrExitCode:      int64(3),
+		traceAttrBytesIn:       uint64(0),
+		traceAttrBytesOut:      uint64(6),
+	}, root.attrs)
+	require.Empty(root.errs, "exit code isn't an error")
+
+	// The trace context is sent to the server
+	md, ok := metadata.FromOutgoingContext(waypoint.Context)
+	require.True(ok)
+	require.Equal([]string{"exec"}, md.Get("x-test-span"))
+}
+
+func TestClientTrace_none(t *testing.T) {
+	c := &Client{Context: context.Background()}
+	trace := c.startTrace()
+
+	// Nothing happens without a tracer
+	trace.Phase("session")
+	trace.SetAttribute("key", "value")
+	trace.Output([]byte("data"))
+	require.Equal(t, c.Context, trace.StreamContext())
+	trace.End(0, nil)
+}
+
+// testTracer is a Tracer that records its spans in memory.
+type testTracer struct {
+	lock  sync.Mutex
+	spans []*testSpan
+}
+
+type testSpanKey struct{}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func (t *testTracer) Inject(ctx context.Context, carrier map[string]string) {
+	if span, ok := ctx.Value(testSpanKey{}).(*testSpan); ok {
+		carrier["x-test-span"] = span.name
+	}
+}
+
+type testSpan struct {
+	name   string
+	parent *testSpan
+	attrs  map[string]interface{}
+	errs   []error
+	ended  bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *testSpan) RecordError(err error)                      { s.errs = append(s.errs, err) }
+func (s *testSpan) End()                                       { s.ended = true }
@@ -0,0 +1,81 @@
+package execclient
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientRun_transcript(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Configure func(*Client)
+	}{
+		{"echo", nil},
+		{"warnings", nil},
+		{"stream_error", nil},
+
+		{
+			"summary",
+			func(c *Client) { c.Summary = true },
+		},
+
+		{
+			"sinks",
+			func(c *Client) {
+				c.Sinks = []*Sink{{Channel: pb.ExecStreamResponse_Output_STDOUT, Name: "out.log"}}
+				c.NoMirror = true
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			testTranscript(t, tt.Name, tt.Configure)
+		})
+	}
+}
+
+// testTranscript runs the session scripted in testdata/transcripts/NAME.txt
+// and compares how it exited and what the client wrote to Stdout, Stderr
+// and its sinks with NAME.golden. Run the test with -update to write the
+// golden file. configure, if set, changes the client before it runs, such
+// as to add sinks. See execclienttest.Script for the script format.
+func testTranscript(t *testing.T, name string, configure func(*Client)) {
+	dir := filepath.Join("testdata", "transcripts")
+	script := execclienttest.LoadScript(t, filepath.Join(dir, name+".txt"))
+
+	var stdout, stderr bytes.Buffer
+	c := testClient(t, execclienttest.NewStream(t, script.Steps...))
+	c.Stdin = bytes.NewReader(script.Stdin)
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	if configure != nil {
+		configure(c)
+	}
+
+	sinks := make([]*bytes.Buffer, len(c.Sinks))
+	for i, s := range c.Sinks {
+		sinks[i] = new(bytes.Buffer)
+		s.W = sinks[i]
+	}
+
+	code, err := c.Run()
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "-- exit --\n%d\n", code)
+	if err != nil {
+		fmt.Fprintf(&out, "%s\n", err)
+	}
+	fmt.Fprintf(&out, "-- stdout --\n%s", stdout.Bytes())
+	fmt.Fprintf(&out, "-- stderr --\n%s", stderr.Bytes())
+	for i, s := range c.Sinks {
+		fmt.Fprintf(&out, "-- sink %s --\n%s", s.Name, sinks[i].Bytes())
+	}
+
+	execclienttest.Golden(t, filepath.Join(dir, name+".golden"), out.Bytes())
+}
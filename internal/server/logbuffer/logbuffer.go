@@ -137,6 +137,7 @@ func (b *Buffer) Reader(maxHistory int32) *Reader {
 				if size > maxHistory {
 					chunks = b.chunks[i:]
 					cursor = uint32(size - maxHistory)
+					break
 				}
 			}
 		}
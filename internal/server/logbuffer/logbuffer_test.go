@@ -369,6 +369,33 @@ func TestBuffer_maxHistoryMultiChunk(t *testing.T) {
 	require.Equal(acc[len(acc)-2], 21)
 }
 
+func TestBuffer_maxHistoryManyChunks(t *testing.T) {
+	require := require.New(t)
+
+	// Tiny chunks, with the history spanning several of them
+	chchunk(t, 4, 4)
+
+	b := New()
+	defer b.Close()
+	for i := 0; i < 15; i++ {
+		b.Write(i)
+	}
+
+	r := b.Reader(5)
+
+	// Read the data
+	var acc []Entry
+	for {
+		vs := r.Read(9, false)
+		if vs == nil {
+			break
+		}
+
+		acc = append(acc, vs...)
+	}
+	require.Equal([]Entry{10, 11, 12, 13, 14}, acc)
+}
+
 func chchunk(t *testing.T, count, size int) {
 	oldcount, oldsize := chunkCount, chunkSize
 	t.Cleanup(func() {
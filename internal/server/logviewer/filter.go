@@ -0,0 +1,93 @@
+package logviewer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// Filter matches log lines against a GetLogStreamRequest filter. It is
+// used by the server to filter the entries it sends and by Viewer to
+// filter them again for servers that don't support filtering.
+//
+// A nil Filter matches every line.
+type Filter struct {
+	contains []string
+	regexps  []*regexp.Regexp
+	invert   bool
+	source   pb.LogBatch_Entry_Source
+	noExec   bool
+}
+
+// NewFilter returns the Filter for f. This returns nil if f is nil or
+// empty and an error if a regular expression is invalid.
+func NewFilter(f *pb.GetLogStreamRequest_Filter) (*Filter, error) {
+	if f == nil || (len(f.Contains) == 0 && len(f.Regexps) == 0 &&
+		f.Source == pb.LogBatch_Entry_UNKNOWN && !f.NoExec) {
+		return nil, nil
+	}
+
+	result := &Filter{
+		contains: f.Contains,
+		invert:   f.Invert,
+		source:   f.Source,
+		noExec:   f.NoExec,
+	}
+	for _, v := range f.Regexps {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %s", v, err)
+		}
+
+		result.regexps = append(result.regexps, re)
+	}
+
+	return result, nil
+}
+
+// MatchEntry returns true if entry matches the filter: its line, its
+// source and whether it is output of an exec session.
+func (f *Filter) MatchEntry(entry *pb.LogBatch_Entry) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.source != pb.LogBatch_Entry_UNKNOWN && entry.Source != f.source {
+		return false
+	}
+	if f.noExec && entry.ExecSessionId != "" {
+		return false
+	}
+
+	return f.Match(entry.Line)
+}
+
+// Match returns true if line matches the filter. The trailing newline of
+// line, if any, isn't matched against. The source of the filter isn't
+// checked, nor whether it is exec output; use MatchEntry for that.
+func (f *Filter) Match(line string) bool {
+	if f == nil {
+		return true
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+	return f.match(line) != f.invert
+}
+
+func (f *Filter) match(line string) bool {
+	for _, v := range f.contains {
+		if !strings.Contains(line, v) {
+			return false
+		}
+	}
+
+	for _, re := range f.regexps {
+		if !re.MatchString(line) {
+			return false
+		}
+	}
+
+	return true
+}
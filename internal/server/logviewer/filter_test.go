@@ -0,0 +1,134 @@
+package logviewer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestFilter(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Filter   *pb.GetLogStreamRequest_Filter
+		Line     string
+		Expected bool
+	}{
+		{
+			"no filter",
+			nil,
+			"anything\n",
+			true,
+		},
+
+		{
+			"substring",
+			&pb.GetLogStreamRequest_Filter{Contains: []string{"error"}},
+			"an error happened\n",
+			true,
+		},
+
+		{
+			"substring no match",
+			&pb.GetLogStreamRequest_Filter{Contains: []string{"error"}},
+			"all good\n",
+			false,
+		},
+
+		{
+			"substrings and together",
+			&pb.GetLogStreamRequest_Filter{Contains: []string{"error", "db"}},
+			"an error happened\n",
+			false,
+		},
+
+		{
+			"regexp ignores the trailing newline",
+			&pb.GetLogStreamRequest_Filter{Regexps: []string{`happened$`}},
+			"an error happened\n",
+			true,
+		},
+
+		{
+			"substring and regexp",
+			&pb.GetLogStreamRequest_Filter{
+				Contains: []string{"error"},
+				Regexps:  []string{`^GET /\w+`},
+			},
+			"GET /users error\n",
+			true,
+		},
+
+		{
+			"invert",
+			&pb.GetLogStreamRequest_Filter{
+				Contains: []string{"healthz"},
+				Invert:   true,
+			},
+			"GET /healthz\n",
+			false,
+		},
+
+		{
+			"invert no match",
+			&pb.GetLogStreamRequest_Filter{
+				Contains: []string{"healthz"},
+				Invert:   true,
+			},
+			"GET /users\n",
+			true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			f, err := NewFilter(tt.Filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.Expected, f.Match(tt.Line))
+		})
+	}
+
+	t.Run("source", func(t *testing.T) {
+		f, err := NewFilter(&pb.GetLogStreamRequest_Filter{
+			Contains: []string{"healthz"},
+			Invert:   true,
+			Source:   pb.LogBatch_Entry_STDERR,
+		})
+		require.NoError(t, err)
+
+		entry := func(source pb.LogBatch_Entry_Source, line string) *pb.LogBatch_Entry {
+			return &pb.LogBatch_Entry{Source: source, Line: line}
+		}
+
+		// The source isn't inverted, and lines without one never match
+		require.True(t, f.MatchEntry(entry(pb.LogBatch_Entry_STDERR, "error\n")))
+		require.False(t, f.MatchEntry(entry(pb.LogBatch_Entry_STDERR, "GET /healthz\n")))
+		require.False(t, f.MatchEntry(entry(pb.LogBatch_Entry_STDOUT, "error\n")))
+		require.False(t, f.MatchEntry(entry(pb.LogBatch_Entry_UNKNOWN, "error\n")))
+	})
+
+	t.Run("no exec", func(t *testing.T) {
+		f, err := NewFilter(&pb.GetLogStreamRequest_Filter{
+			Contains: []string{"healthz"},
+			Invert:   true,
+			NoExec:   true,
+		})
+		require.NoError(t, err)
+
+		// Exec output is left out even if the line matches.
+		require.True(t, f.MatchEntry(&pb.LogBatch_Entry{Line: "error\n"}))
+		require.False(t, f.MatchEntry(&pb.LogBatch_Entry{Line: "error\n", ExecSessionId: "s1"}))
+
+		// This alone is a filter.
+		f, err = NewFilter(&pb.GetLogStreamRequest_Filter{NoExec: true})
+		require.NoError(t, err)
+		require.NotNil(t, f)
+		require.False(t, f.MatchEntry(&pb.LogBatch_Entry{Line: "ls\n", ExecSessionId: "s1"}))
+	})
+
+	t.Run("invalid regexp", func(t *testing.T) {
+		_, err := NewFilter(&pb.GetLogStreamRequest_Filter{Regexps: []string{"("}})
+		require.Error(t, err)
+	})
+}
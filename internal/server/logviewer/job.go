@@ -0,0 +1,176 @@
+package logviewer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint-plugin-sdk/component"
+)
+
+// JobViewer implements component.LogViewer over the terminal output of a
+// job from the job stream endpoint, so that it can be shown like the logs
+// of an instance.
+//
+// Each part of the output is an event of Partition. Steps are shown as a
+// line starting with "==> " so that their boundaries stand out from the
+// output of the steps.
+type JobViewer struct {
+	// Stream is the job stream client to use.
+	Stream pb.Waypoint_GetJobStreamClient
+
+	// Partition is the partition of the events, such as the job ID.
+	Partition string
+
+	// OnState, if set, is called with each change of the job's state.
+	OnState func(*pb.GetJobStreamResponse_State)
+
+	complete *pb.GetJobStreamResponse_Complete
+	steps    map[int32]string
+}
+
+// Complete returns the completion of the job once the stream has ended,
+// or nil if it ended before the job completed.
+func (v *JobViewer) Complete() *pb.GetJobStreamResponse_Complete {
+	return v.complete
+}
+
+// NextLogBatch implements component.LogViewer
+func (v *JobViewer) NextLogBatch(ctx context.Context) ([]component.LogEvent, error) {
+	events, err := v.NextEvents(ctx)
+	return logEvents(events), err
+}
+
+// NextEvents is like NextLogBatch but also returns the stream each part of
+// the output was written to, which is only known for raw output.
+func (v *JobViewer) NextEvents(ctx context.Context) ([]Event, error) {
+	for {
+		resp, err := v.Stream.Recv()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch event := resp.Event.(type) {
+		case *pb.GetJobStreamResponse_State_:
+			if v.OnState != nil {
+				v.OnState(event.State)
+			}
+
+		case *pb.GetJobStreamResponse_Terminal_:
+			var events []Event
+			for _, ev := range event.Terminal.Events {
+				msg := v.message(ev)
+				if msg == "" {
+					continue
+				}
+
+				ts := time.Now()
+				if ev.Timestamp != nil {
+					ts, _ = ptypes.Timestamp(ev.Timestamp)
+				}
+
+				source := pb.LogBatch_Entry_UNKNOWN
+				if raw, ok := ev.Event.(*pb.GetJobStreamResponse_Terminal_Event_Raw_); ok {
+					source = pb.LogBatch_Entry_STDOUT
+					if raw.Raw.Stderr {
+						source = pb.LogBatch_Entry_STDERR
+					}
+				}
+
+				events = append(events, Event{
+					LogEvent: component.LogEvent{
+						Partition: v.Partition,
+						Timestamp: ts,
+						Message:   msg,
+					},
+					Source: source,
+				})
+			}
+
+			if len(events) > 0 {
+				return events, nil
+			}
+
+		case *pb.GetJobStreamResponse_Error_:
+			return nil, status.ErrorProto(event.Error.Error)
+
+		case *pb.GetJobStreamResponse_Complete_:
+			v.complete = event.Complete
+			return nil, nil
+		}
+	}
+}
+
+// message renders a terminal event as log output. Lines end with a
+// newline, while raw output is returned as is since it may be part of a
+// line. This returns an empty string for events with nothing to show.
+func (v *JobViewer) message(ev *pb.GetJobStreamResponse_Terminal_Event) string {
+	switch ev := ev.Event.(type) {
+	case *pb.GetJobStreamResponse_Terminal_Event_Line_:
+		return ev.Line.Msg + "\n"
+
+	case *pb.GetJobStreamResponse_Terminal_Event_Status_:
+		if ev.Status.Msg == "" {
+			return ""
+		}
+
+		return ev.Status.Msg + "\n"
+
+	case *pb.GetJobStreamResponse_Terminal_Event_Raw_:
+		return string(ev.Raw.Data)
+
+	case *pb.GetJobStreamResponse_Terminal_Event_NamedValues_:
+		var buf strings.Builder
+		for _, nv := range ev.NamedValues.Values {
+			fmt.Fprintf(&buf, "%s: %s\n", nv.Name, nv.Value)
+		}
+
+		return buf.String()
+
+	case *pb.GetJobStreamResponse_Terminal_Event_Table_:
+		var buf strings.Builder
+		if len(ev.Table.Headers) > 0 {
+			buf.WriteString(strings.Join(ev.Table.Headers, "  ") + "\n")
+		}
+
+		for _, row := range ev.Table.Rows {
+			values := make([]string, len(row.Entries))
+			for i, entry := range row.Entries {
+				values[i] = entry.Value
+			}
+
+			buf.WriteString(strings.Join(values, "  ") + "\n")
+		}
+
+		return buf.String()
+
+	case *pb.GetJobStreamResponse_Terminal_Event_Step_:
+		// A step is sent again as it is updated, so we only show its
+		// message when it changes. Its output is shown as it arrives.
+		var result string
+		if msg := ev.Step.Msg; msg != "" && msg != v.steps[ev.Step.Id] {
+			if v.steps == nil {
+				v.steps = make(map[int32]string)
+			}
+
+			v.steps[ev.Step.Id] = msg
+			result = "==> " + msg + "\n"
+		}
+
+		return result + string(ev.Step.Output)
+
+	default:
+		return ""
+	}
+}
+
+var _ component.LogViewer = (*JobViewer)(nil)
@@ -0,0 +1,206 @@
+package logviewer
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// defaultMaxValueLen is the default length at which JSONFormatter cuts
+// values short.
+const defaultMaxValueLen = 256
+
+// The keys JSONFormatter looks for the time, level and message of a line
+// under, in order. These cover the common Go, hclog and logstash formats.
+var (
+	jsonTimeKeys    = []string{"time", "ts", "timestamp", "@timestamp"}
+	jsonLevelKeys   = []string{"level", "lvl", "severity", "@level"}
+	jsonMessageKeys = []string{"msg", "message", "@message"}
+)
+
+// jsonLevels orders the levels that a level filter understands.
+var jsonLevels = map[string]int{
+	"trace":    0,
+	"debug":    1,
+	"info":     2,
+	"warn":     3,
+	"warning":  3,
+	"error":    4,
+	"err":      4,
+	"fatal":    5,
+	"critical": 5,
+	"panic":    5,
+}
+
+// JSONFormatter renders log lines that are JSON objects as a compact
+// "TIME LEVEL MESSAGE key=value..." line. Lines that aren't JSON objects
+// are returned unchanged.
+type JSONFormatter struct {
+	// Fields are the keys shown after the message, in order. A key may be
+	// a path into nested objects such as "http.status". If this is empty,
+	// every other key is shown in sorted order.
+	Fields []string
+
+	// Level, if set, hides the JSON lines with a lower level, such as
+	// "warn" to hide debug and info lines. Lines without a level or with
+	// a level that isn't known are always shown.
+	Level string
+
+	// MaxValueLen is the length after which values are cut short. This
+	// defaults to 256.
+	MaxValueLen int
+}
+
+// ValidJSONLevel returns true if v is a level that JSONFormatter.Level
+// understands.
+func ValidJSONLevel(v string) bool {
+	_, ok := jsonLevels[strings.ToLower(v)]
+	return ok
+}
+
+// Format renders line. This returns false if the line is hidden by Level.
+func (f *JSONFormatter) Format(line string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "{") {
+		return line, true
+	}
+
+	dec := json.NewDecoder(strings.NewReader(trimmed))
+	dec.UseNumber()
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil || dec.More() {
+		return line, true
+	}
+
+	ts, tsKey := jsonLookupAny(obj, jsonTimeKeys)
+	level, levelKey := jsonLookupAny(obj, jsonLevelKeys)
+	msg, msgKey := jsonLookupAny(obj, jsonMessageKeys)
+
+	if f.Level != "" && level != nil {
+		min, ok := jsonLevels[strings.ToLower(f.Level)]
+		if v, known := jsonLevels[strings.ToLower(f.value(level))]; ok && known && v < min {
+			return "", false
+		}
+	}
+
+	var parts []string
+	if ts != nil {
+		parts = append(parts, f.value(ts))
+	}
+	if level != nil {
+		parts = append(parts, strings.ToUpper(f.value(level)))
+	}
+	if msg != nil {
+		parts = append(parts, f.value(msg))
+	}
+
+	fields := f.Fields
+	if len(fields) == 0 {
+		for k := range obj {
+			if k != tsKey && k != levelKey && k != msgKey {
+				fields = append(fields, k)
+			}
+		}
+		sort.Strings(fields)
+	}
+
+	for _, k := range fields {
+		v, ok := jsonLookup(obj, k)
+		if !ok {
+			continue
+		}
+
+		value := f.value(v)
+		if _, ok := v.(string); ok {
+			value = f.quote(value)
+		}
+
+		parts = append(parts, k+"="+value)
+	}
+
+	return strings.Join(parts, " "), true
+}
+
+// value renders a JSON value, cutting it short if it is too long. Objects
+// and arrays are rendered as compact JSON.
+func (f *JSONFormatter) value(v interface{}) string {
+	var result string
+	switch v := v.(type) {
+	case string:
+		result = v
+
+	case json.Number:
+		result = v.String()
+
+	case nil:
+		result = "null"
+
+	default:
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err != nil {
+			return ""
+		}
+		result = strings.TrimSuffix(buf.String(), "\n")
+	}
+
+	max := f.MaxValueLen
+	if max <= 0 {
+		max = defaultMaxValueLen
+	}
+	if len(result) > max {
+		// Cut at a rune boundary so that we don't split a character.
+		n := max
+		for n > 0 && !utf8.RuneStart(result[n]) {
+			n--
+		}
+		result = result[:n] + "..."
+	}
+
+	return result
+}
+
+// quote quotes a string value of a key=value pair if it is empty or
+// would be ambiguous unquoted.
+func (f *JSONFormatter) quote(v string) string {
+	if v == "" || strings.ContainsAny(v, " \t\n\"=") {
+		return strconv.Quote(v)
+	}
+
+	return v
+}
+
+// jsonLookupAny returns the value of the first of keys set in obj.
+func jsonLookupAny(obj map[string]interface{}, keys []string) (interface{}, string) {
+	for _, k := range keys {
+		if v, ok := obj[k]; ok {
+			return v, k
+		}
+	}
+
+	return nil, ""
+}
+
+// jsonLookup returns the value of key in obj, where key may be a dotted
+// path into nested objects. A key that contains dots itself is found too.
+func jsonLookup(obj map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := obj[key]; ok {
+		return v, true
+	}
+
+	idx := strings.IndexByte(key, '.')
+	if idx < 0 {
+		return nil, false
+	}
+
+	nested, ok := obj[key[:idx]].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	return jsonLookup(nested, key[idx+1:])
+}
@@ -0,0 +1,170 @@
+package logviewer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONFormatter(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Formatter JSONFormatter
+		Line      string
+		Expected  string
+		Shown     bool
+	}{
+		{
+			"not json",
+			JSONFormatter{},
+			"plain text line",
+			"plain text line",
+			true,
+		},
+
+		{
+			"malformed json",
+			JSONFormatter{},
+			`{"msg": "unterminated`,
+			`{"msg": "unterminated`,
+			true,
+		},
+
+		{
+			"trailing data",
+			JSONFormatter{},
+			`{"msg": "hi"} trailing`,
+			`{"msg": "hi"} trailing`,
+			true,
+		},
+
+		{
+			"array",
+			JSONFormatter{},
+			`[1, 2, 3]`,
+			`[1, 2, 3]`,
+			true,
+		},
+
+		{
+			"basic",
+			JSONFormatter{},
+			`{"time":"2020-10-15T12:00:00Z","level":"info","msg":"listening","port":8080,"addr":"0.0.0.0"}`,
+			`2020-10-15T12:00:00Z INFO listening addr=0.0.0.0 port=8080`,
+			true,
+		},
+
+		{
+			"hclog keys",
+			JSONFormatter{},
+			`{"@timestamp":"2020-10-15T12:00:00Z","@level":"warn","@message":"slow request","@module":"api"}`,
+			`2020-10-15T12:00:00Z WARN slow request @module=api`,
+			true,
+		},
+
+		{
+			"quoted values",
+			JSONFormatter{},
+			`{"msg":"failed","error":"connection refused","query":"a=b","empty":""}`,
+			`failed empty="" error="connection refused" query="a=b"`,
+			true,
+		},
+
+		{
+			"nested objects",
+			JSONFormatter{},
+			`{"msg":"request","http":{"method":"GET","status":200},"tags":["a","b"],"none":null}`,
+			`request http={"method":"GET","status":200} none=null tags=["a","b"]`,
+			true,
+		},
+
+		{
+			"selected fields",
+			JSONFormatter{Fields: []string{"http.status", "user", "missing"}},
+			`{"msg":"request","http":{"method":"GET","status":200},"user":"mitchellh","id":1}`,
+			`request http.status=200 user=mitchellh`,
+			true,
+		},
+
+		{
+			"long values",
+			JSONFormatter{MaxValueLen: 8},
+			`{"msg":"short","body":"` + strings.Repeat("x", 100) + `"}`,
+			`short body=xxxxxxxx...`,
+			true,
+		},
+
+		{
+			"long values keep characters whole",
+			JSONFormatter{MaxValueLen: 4},
+			`{"body":"aaaé"}`,
+			`body=aaa...`,
+			true,
+		},
+
+		{
+			"level shown",
+			JSONFormatter{Level: "warn"},
+			`{"level":"error","msg":"boom"}`,
+			`ERROR boom`,
+			true,
+		},
+
+		{
+			"level hidden",
+			JSONFormatter{Level: "warn"},
+			`{"level":"info","msg":"fine"}`,
+			``,
+			false,
+		},
+
+		{
+			"level case insensitive",
+			JSONFormatter{Level: "WARN"},
+			`{"level":"Warning","msg":"careful"}`,
+			`WARNING careful`,
+			true,
+		},
+
+		{
+			"no level is shown",
+			JSONFormatter{Level: "warn"},
+			`{"msg":"no level"}`,
+			`no level`,
+			true,
+		},
+
+		{
+			"unknown level is shown",
+			JSONFormatter{Level: "warn"},
+			`{"level":"notice","msg":"unknown"}`,
+			`NOTICE unknown`,
+			true,
+		},
+
+		{
+			"non-json with level",
+			JSONFormatter{Level: "error"},
+			`plain text line`,
+			`plain text line`,
+			true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			actual, shown := tt.Formatter.Format(tt.Line)
+			require.Equal(t, tt.Shown, shown)
+			if shown {
+				require.Equal(t, tt.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidJSONLevel(t *testing.T) {
+	require.True(t, ValidJSONLevel("warn"))
+	require.True(t, ValidJSONLevel("ERROR"))
+	require.False(t, ValidJSONLevel("loud"))
+}
@@ -2,44 +2,321 @@ package logviewer
 
 import (
 	"context"
+	"io"
+	"sync"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint-plugin-sdk/component"
 )
 
+var (
+	// reconnectMinWait and reconnectMaxWait bound the wait between
+	// attempts to reconnect, which doubles after each failed attempt.
+	reconnectMinWait = 500 * time.Millisecond
+	reconnectMaxWait = 30 * time.Second
+)
+
+// Event is a log event along with the stream of the app it was written
+// to, if known.
+type Event struct {
+	component.LogEvent
+
+	// Source is the stream the line was written to.
+	Source pb.LogBatch_Entry_Source
+
+	// ExecSessionId is set if the line is output of an exec session that
+	// was mirrored to the logs rather than of the app.
+	ExecSessionId string
+}
+
+// logEvents returns the log events of events.
+func logEvents(events []Event) []component.LogEvent {
+	if events == nil {
+		return nil
+	}
+
+	result := make([]component.LogEvent, len(events))
+	for i, e := range events {
+		result[i] = e.LogEvent
+	}
+
+	return result
+}
+
 // Viewer implements component.LogViewer over the server-side log stream endpoint.
 //
-// TODO(mitchellh): we should support some form of reconnection in the event of
-// network errors.
+// If Reconnect is set, the viewer reconnects when the stream fails with an
+// error that may be temporary, such as the server restarting. The new
+// stream resumes from the last entries received, which aren't returned
+// again.
 type Viewer struct {
 	// Stream is the log stream client to use.
 	Stream pb.Waypoint_GetLogStreamClient
+
+	// Filter, if set, drops the entries that don't match it.
+	Filter *Filter
+
+	// Request is the request that Stream was opened with. This must be set
+	// to reconnect or to use Next.
+	Request *pb.GetLogStreamRequest
+
+	// Reconnect, if set, opens a new stream for req. This is retried with
+	// a backoff until it succeeds, it fails with an error that isn't
+	// temporary or ctx is done.
+	Reconnect func(ctx context.Context, req *pb.GetLogStreamRequest) (pb.Waypoint_GetLogStreamClient, error)
+
+	// OnReconnect, if set, is called with the error that the stream failed
+	// with before waiting to reconnect.
+	OnReconnect func(err error, wait time.Duration)
+
+	// Next, if set, is called when the stream ends to get the request for
+	// another stream to continue with, such as for a newer deployment. The
+	// viewer ends if it returns nil. This requires Reconnect to be set.
+	Next func(ctx context.Context, prev *pb.GetLogStreamRequest) (*pb.GetLogStreamRequest, error)
+
+	// markers are the latest entry received of each instance.
+	markers map[string]*marker
+
+	// deployments are the deployment ID and sequence of each instance.
+	deploymentsLock sync.Mutex
+	deployments     map[string]deployment
+}
+
+type deployment struct {
+	id       string
+	sequence uint64
+}
+
+// marker identifies the latest entry received from an instance by its
+// timestamp and how many entries before it had the same timestamp. When
+// a stream resumes, the entries up to the marker are skipped.
+type marker struct {
+	ts    time.Time
+	count int
+
+	// resuming is true while skipping the entries of a resumed stream,
+	// skipped is how many of them had the marker's timestamp.
+	resuming bool
+	skipped  int
+}
+
+// Deployment returns the ID and sequence of the deployment of an instance
+// that batches have been received from. This is safe to call concurrently
+// with NextLogBatch.
+func (v *Viewer) Deployment(instanceId string) (string, uint64) {
+	v.deploymentsLock.Lock()
+	defer v.deploymentsLock.Unlock()
+	d := v.deployments[instanceId]
+	return d.id, d.sequence
 }
 
 // NextLogBatch implements component.LogViewer
 func (v *Viewer) NextLogBatch(ctx context.Context) ([]component.LogEvent, error) {
+	events, err := v.NextEvents(ctx)
+	return logEvents(events), err
+}
+
+// NextEvents is like NextLogBatch but also returns the stream each line
+// was written to.
+func (v *Viewer) NextEvents(ctx context.Context) ([]Event, error) {
 	// Get the next batch. Note that we specifically do NOT buffer here because
 	// we want to provide the proper amount of backpressure and we expect our
 	// downstream caller to be calling these as quickly as possible.
-	batch, err := v.Stream.Recv()
-	if err != nil {
-		return nil, err
+	for {
+		batch, err := v.Stream.Recv()
+		if err == io.EOF {
+			// The stream ended, such as after the end of a time range. If
+			// we have somewhere to continue, we do.
+			if v.Next == nil || v.Reconnect == nil {
+				return nil, nil
+			}
+
+			req, err := v.Next(ctx, v.Request)
+			if err != nil {
+				return nil, err
+			}
+			if req == nil {
+				return nil, nil
+			}
+
+			if err := v.reconnect(ctx, req, nil); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+		if err != nil {
+			if v.Reconnect == nil || ctx.Err() != nil || !temporary(err) {
+				return nil, err
+			}
+
+			if err := v.reconnect(ctx, v.resumeRequest(), err); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		v.deploymentsLock.Lock()
+		if v.deployments == nil {
+			v.deployments = make(map[string]deployment)
+		}
+		v.deployments[batch.InstanceId] = deployment{
+			id:       batch.DeploymentId,
+			sequence: batch.DeploymentSequence,
+		}
+		v.deploymentsLock.Unlock()
+
+		events := make([]Event, 0, len(batch.Lines))
+		for _, entry := range batch.Lines {
+			ts, _ := ptypes.Timestamp(entry.Timestamp)
+			if v.seen(batch.InstanceId, ts) {
+				continue
+			}
+
+			if !v.Filter.MatchEntry(entry) {
+				continue
+			}
+
+			events = append(events, Event{
+				LogEvent: component.LogEvent{
+					Partition: batch.InstanceId,
+					Timestamp: ts,
+					Message:   entry.Line,
+				},
+				Source:        entry.Source,
+				ExecSessionId: entry.ExecSessionId,
+			})
+		}
+
+		// An empty batch means the end of the stream, so if every entry
+		// was filtered out we wait for the next batch.
+		if len(events) > 0 {
+			return events, nil
+		}
+	}
+}
+
+// reconnect opens a stream for req, retrying with a backoff. cause is the
+// error the previous stream failed with, if any, in which case we wait
+// before the first attempt too.
+func (v *Viewer) reconnect(ctx context.Context, req *pb.GetLogStreamRequest, cause error) error {
+	wait := reconnectMinWait
+	for {
+		if cause != nil {
+			if v.OnReconnect != nil {
+				v.OnReconnect(cause, wait)
+			}
+
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			wait *= 2
+			if wait > reconnectMaxWait {
+				wait = reconnectMaxWait
+			}
+		}
+
+		stream, err := v.Reconnect(ctx, req)
+		if err == nil {
+			v.Stream = stream
+			v.Request = req
+			for _, m := range v.markers {
+				m.resuming = true
+				m.skipped = 0
+			}
+
+			return nil
+		}
+
+		if ctx.Err() != nil || !temporary(err) {
+			return err
+		}
+
+		cause = err
 	}
+}
 
-	events := make([]component.LogEvent, len(batch.Lines))
-	for i, entry := range batch.Lines {
-		ts, _ := ptypes.Timestamp(entry.Timestamp)
+// resumeRequest returns the request to resume the stream with. It asks
+// for the entries since the oldest marker, without limiting the backlog,
+// so that we don't miss any. The entries we've seen are then skipped.
+func (v *Viewer) resumeRequest() *pb.GetLogStreamRequest {
+	req := proto.Clone(v.Request).(*pb.GetLogStreamRequest)
 
-		events[i] = component.LogEvent{
-			Partition: batch.InstanceId,
-			Timestamp: ts,
-			Message:   entry.Line,
+	var since time.Time
+	for _, m := range v.markers {
+		if !m.ts.IsZero() && (since.IsZero() || m.ts.Before(since)) {
+			since = m.ts
 		}
 	}
+	if since.IsZero() {
+		return req
+	}
 
-	return events, nil
+	if ts, err := ptypes.TimestampProto(since); err == nil {
+		req.Since = ts
+		req.LimitBacklog = -1
+	}
+
+	return req
+}
+
+// seen records an entry of an instance with timestamp ts and returns true
+// if it was already received before the stream was resumed.
+func (v *Viewer) seen(instanceId string, ts time.Time) bool {
+	m, ok := v.markers[instanceId]
+	if !ok {
+		if v.markers == nil {
+			v.markers = make(map[string]*marker)
+		}
+
+		m = &marker{}
+		v.markers[instanceId] = m
+	}
+
+	if m.resuming {
+		if ts.Before(m.ts) {
+			return true
+		}
+
+		if ts.Equal(m.ts) && m.skipped < m.count {
+			m.skipped++
+			return true
+		}
+
+		m.resuming = false
+	}
+
+	if ts.Equal(m.ts) {
+		m.count++
+	} else {
+		m.ts = ts
+		m.count = 1
+	}
+
+	return false
+}
+
+// temporary returns true if err may be temporary so that the stream
+// should be reconnected.
+func temporary(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted,
+		codes.Internal, codes.Unknown:
+		return true
+
+	default:
+		return false
+	}
 }
 
 var _ component.LogViewer = (*Viewer)(nil)
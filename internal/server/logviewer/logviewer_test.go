@@ -0,0 +1,287 @@
+package logviewer
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestViewer_reconnect(t *testing.T) {
+	require := require.New(t)
+	testReconnectWait(t)
+
+	start := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+	first := &testStream{
+		batches: []*pb.LogBatch{
+			testBatch(t, "i1", start, "a"),
+			testBatch(t, "i1", start.Add(time.Second), "b", "c"),
+		},
+		err: status.Error(codes.Unavailable, "server restarting"),
+	}
+
+	// The resumed stream has some of the same entries again
+	second := &testStream{
+		batches: []*pb.LogBatch{
+			testBatch(t, "i1", start.Add(time.Second), "b", "c", "d"),
+			testBatch(t, "i1", start.Add(2*time.Second), "e"),
+		},
+		err: io.EOF,
+	}
+
+	var reqs []*pb.GetLogStreamRequest
+	var reconnects int
+	v := &Viewer{
+		Stream:  first,
+		Request: &pb.GetLogStreamRequest{LimitBacklog: 10},
+		Reconnect: func(ctx context.Context, req *pb.GetLogStreamRequest) (pb.Waypoint_GetLogStreamClient, error) {
+			reqs = append(reqs, req)
+
+			// The first attempt fails too
+			if len(reqs) == 1 {
+				return nil, status.Error(codes.Unavailable, "connection refused")
+			}
+
+			return second, nil
+		},
+		OnReconnect: func(err error, wait time.Duration) { reconnects++ },
+	}
+
+	require.Equal([]string{"a", "b", "c", "d", "e"}, testReadAll(t, v))
+	require.Equal(2, reconnects)
+
+	// We resume from the last entry we saw, without limiting the backlog
+	require.Len(reqs, 2)
+	since, err := ptypes.Timestamp(reqs[1].Since)
+	require.NoError(err)
+	require.Equal(start.Add(time.Second), since)
+	require.Equal(int32(-1), reqs[1].LimitBacklog)
+}
+
+func TestViewer_reconnectNotTemporary(t *testing.T) {
+	require := require.New(t)
+	testReconnectWait(t)
+
+	v := &Viewer{
+		Stream: &testStream{
+			err: status.Error(codes.InvalidArgument, "bad request"),
+		},
+		Request: &pb.GetLogStreamRequest{},
+		Reconnect: func(ctx context.Context, req *pb.GetLogStreamRequest) (pb.Waypoint_GetLogStreamClient, error) {
+			t.Fatal("should not reconnect")
+			return nil, nil
+		},
+	}
+
+	_, err := v.NextLogBatch(context.Background())
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestViewer_next(t *testing.T) {
+	require := require.New(t)
+	testReconnectWait(t)
+
+	start := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+	streams := map[string]*testStream{
+		"d2": {
+			batches: []*pb.LogBatch{testBatch(t, "i2", start, "from d2")},
+			err:     io.EOF,
+		},
+	}
+
+	var nexts []string
+	v := &Viewer{
+		Stream: &testStream{
+			batches: []*pb.LogBatch{testBatch(t, "i1", start, "from d1")},
+			err:     io.EOF,
+		},
+		Request: &pb.GetLogStreamRequest{
+			Scope: &pb.GetLogStreamRequest_DeploymentId{DeploymentId: "d1"},
+		},
+		Reconnect: func(ctx context.Context, req *pb.GetLogStreamRequest) (pb.Waypoint_GetLogStreamClient, error) {
+			return streams[req.GetDeploymentId()], nil
+		},
+		Next: func(ctx context.Context, prev *pb.GetLogStreamRequest) (*pb.GetLogStreamRequest, error) {
+			nexts = append(nexts, prev.GetDeploymentId())
+			if prev.GetDeploymentId() == "d2" {
+				return nil, nil
+			}
+
+			return &pb.GetLogStreamRequest{
+				Scope: &pb.GetLogStreamRequest_DeploymentId{DeploymentId: "d2"},
+			}, nil
+		},
+	}
+
+	require.Equal([]string{"from d1", "from d2"}, testReadAll(t, v))
+	require.Equal([]string{"d1", "d2"}, nexts)
+}
+
+// testReadAll returns the lines of every batch until the viewer ends.
+func testReadAll(t *testing.T, v *Viewer) []string {
+	var result []string
+	for {
+		batch, err := v.NextLogBatch(context.Background())
+		require.NoError(t, err)
+		if len(batch) == 0 {
+			return result
+		}
+
+		for _, event := range batch {
+			result = append(result, event.Message)
+		}
+	}
+}
+
+// testBatch returns a batch of lines of an instance logged at ts.
+func testBatch(t *testing.T, instanceId string, ts time.Time, lines ...string) *pb.LogBatch {
+	pts, err := ptypes.TimestampProto(ts)
+	require.NoError(t, err)
+
+	batch := &pb.LogBatch{InstanceId: instanceId}
+	for _, line := range lines {
+		batch.Lines = append(batch.Lines, &pb.LogBatch_Entry{Timestamp: pts, Line: line})
+	}
+
+	return batch
+}
+
+// testReconnectWait makes reconnecting quick for the test.
+func testReconnectWait(t *testing.T) {
+	oldMin, oldMax := reconnectMinWait, reconnectMaxWait
+	t.Cleanup(func() {
+		reconnectMinWait, reconnectMaxWait = oldMin, oldMax
+	})
+
+	reconnectMinWait, reconnectMaxWait = time.Millisecond, 2*time.Millisecond
+}
+
+// testStream returns batches and then fails with err.
+type testStream struct {
+	grpc.ClientStream
+
+	batches []*pb.LogBatch
+	err     error
+}
+
+func (s *testStream) Recv() (*pb.LogBatch, error) {
+	if len(s.batches) == 0 {
+		return nil, s.err
+	}
+
+	batch := s.batches[0]
+	s.batches = s.batches[1:]
+	return batch, nil
+}
+
+func TestJobViewer(t *testing.T) {
+	require := require.New(t)
+
+	start := time.Date(2020, 10, 15, 12, 0, 0, 0, time.UTC)
+	pts, err := ptypes.TimestampProto(start)
+	require.NoError(err)
+
+	terminal := func(events ...*pb.GetJobStreamResponse_Terminal_Event) *pb.GetJobStreamResponse {
+		for _, ev := range events {
+			ev.Timestamp = pts
+		}
+
+		return &pb.GetJobStreamResponse{
+			Event: &pb.GetJobStreamResponse_Terminal_{
+				Terminal: &pb.GetJobStreamResponse_Terminal{Events: events},
+			},
+		}
+	}
+
+	step := func(msg, output string) *pb.GetJobStreamResponse_Terminal_Event {
+		return &pb.GetJobStreamResponse_Terminal_Event{
+			Event: &pb.GetJobStreamResponse_Terminal_Event_Step_{
+				Step: &pb.GetJobStreamResponse_Terminal_Event_Step{
+					Id:     1,
+					Msg:    msg,
+					Output: []byte(output),
+				},
+			},
+		}
+	}
+
+	var states []pb.Job_State
+	v := &JobViewer{
+		Partition: "job-1",
+		Stream: &testJobStream{
+			resps: []*pb.GetJobStreamResponse{
+				{Event: &pb.GetJobStreamResponse_Open_{Open: &pb.GetJobStreamResponse_Open{}}},
+				{Event: &pb.GetJobStreamResponse_State_{State: &pb.GetJobStreamResponse_State{
+					Current: pb.Job_RUNNING,
+				}}},
+				terminal(&pb.GetJobStreamResponse_Terminal_Event{
+					Event: &pb.GetJobStreamResponse_Terminal_Event_Line_{
+						Line: &pb.GetJobStreamResponse_Terminal_Event_Line{Msg: "Building..."},
+					},
+				}),
+				terminal(step("Pulling image", "layer 1\n"), step("Pulling image", "layer 2\n")),
+				terminal(&pb.GetJobStreamResponse_Terminal_Event{
+					Event: &pb.GetJobStreamResponse_Terminal_Event_NamedValues_{
+						NamedValues: &pb.GetJobStreamResponse_Terminal_Event_NamedValues{
+							Values: []*pb.GetJobStreamResponse_Terminal_Event_NamedValue{
+								{Name: "Image", Value: "app:1"},
+							},
+						},
+					},
+				}),
+				{Event: &pb.GetJobStreamResponse_Complete_{Complete: &pb.GetJobStreamResponse_Complete{}}},
+			},
+		},
+		OnState: func(s *pb.GetJobStreamResponse_State) { states = append(states, s.Current) },
+	}
+
+	var messages []string
+	for {
+		batch, err := v.NextLogBatch(context.Background())
+		require.NoError(err)
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, event := range batch {
+			require.Equal("job-1", event.Partition)
+			require.Equal(start, event.Timestamp)
+			messages = append(messages, event.Message)
+		}
+	}
+
+	require.Equal([]string{
+		"Building...\n",
+		"==> Pulling image\nlayer 1\n",
+		"layer 2\n",
+		"Image: app:1\n",
+	}, messages)
+	require.Equal([]pb.Job_State{pb.Job_RUNNING}, states)
+	require.NotNil(v.Complete())
+}
+
+// testJobStream returns resps and then io.EOF.
+type testJobStream struct {
+	grpc.ClientStream
+
+	resps []*pb.GetJobStreamResponse
+}
+
+func (s *testJobStream) Recv() (*pb.GetJobStreamResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package singleprocess
 
 import (
 	"crypto/tls"
+	"sync"
 
 	"github.com/boltdb/bolt"
 
@@ -32,6 +33,24 @@ type service struct {
 	// to have the configs set.
 	urlConfig *configpkg.URL
 	urlClient wphznpb.WaypointHznClient
+
+	// forwardPolicy restricts where dynamic port forwarding can connect.
+	forwardPolicy *forwardPolicy
+
+	// detached are the detached exec sessions by session ID. Sessions are
+	// kept for a while after they exit so clients can still attach.
+	detachedLock sync.Mutex
+	detached     map[string]*execDetached
+
+	// watched are the running exec sessions that other clients can watch,
+	// by session ID.
+	watchedLock sync.Mutex
+	watched     map[string]*execWatched
+
+	// destroyWatch are the channels of the running exec sessions that
+	// receive their deployment if it is destroyed, by deployment ID.
+	destroyLock  sync.Mutex
+	destroyWatch map[string]map[chan *pb.Deployment]struct{}
 }
 
 // New returns a Waypoint server implementation that uses BotlDB plus
@@ -104,6 +123,17 @@ func New(opts ...Option) (pb.WaypointServer, error) {
 		s.urlClient = wphznpb.NewWaypointHznClient(conn)
 	}
 
+	// Parse the port forwarding policy so that a bad pattern fails startup
+	// rather than each session.
+	var fwdCfg *configpkg.PortForward
+	if scfg := cfg.serverConfig; scfg != nil {
+		fwdCfg = scfg.PortForward
+	}
+	s.forwardPolicy, err = newForwardPolicy(fwdCfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set specific server config for the deployment entrypoint binaries
 	if scfg := cfg.serverConfig; scfg != nil && scfg.CEBConfig != nil && scfg.CEBConfig.Addr != "" {
 		// only one advertise address can be configured
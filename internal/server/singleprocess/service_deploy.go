@@ -38,6 +38,13 @@ func (s *service) UpsertDeployment(
 		return nil, err
 	}
 
+	// Exec sessions into a destroyed deployment can't go on, so we end
+	// them with the reason rather than let them fail once the instances
+	// go away.
+	if result.State == pb.Operation_DESTROYED {
+		s.execDeploymentDestroyed(result)
+	}
+
 	// This requires: (1) URL service is enabled (2) auto hostname isn't
 	// explicitly set to false in the request and (3) either the server
 	// default is true or we explicitly ask for it.
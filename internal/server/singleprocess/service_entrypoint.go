@@ -7,17 +7,38 @@ import (
 	"strconv"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/logbuffer"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
+// execEnv returns the environment variables describing where an exec
+// session runs, unless the session opted out of them, followed by the
+// ones the client asked for.
+func execEnv(deployment *pb.Deployment, instanceId string, exec *state.InstanceExec) []string {
+	if exec.NoInjectEnv {
+		return exec.Env
+	}
+
+	env := []string{
+		"WAYPOINT_DEPLOYMENT_ID=" + deployment.Id,
+		"WAYPOINT_DEPLOYMENT_SEQ=" + strconv.FormatUint(deployment.Sequence, 10),
+		"WAYPOINT_APP=" + deployment.Application.Application,
+		"WAYPOINT_WORKSPACE=" + deployment.Workspace.Workspace,
+		"WAYPOINT_INSTANCE_ID=" + instanceId,
+	}
+
+	return append(env, exec.Env...)
+}
+
 // TODO: test
 func (s *service) EntrypointConfig(
 	req *pb.EntrypointConfigRequest,
@@ -45,6 +66,10 @@ func (s *service) EntrypointConfig(
 		Project:      deployment.Application.Project,
 		Application:  deployment.Application.Application,
 		Workspace:    deployment.Workspace.Workspace,
+		Labels:       req.Labels,
+		AllocationId: req.AllocationId,
+		Tasks:        req.Tasks,
+		RegisteredAt: time.Now(),
 		LogBuffer:    logbuffer.New(),
 	}
 	if err := s.state.InstanceCreate(record); err != nil {
@@ -91,9 +116,32 @@ func (s *service) EntrypointConfig(
 		config := &pb.EntrypointConfig{}
 		for _, exec := range execs {
 			config.Exec = append(config.Exec, &pb.EntrypointConfig_Exec{
-				Index: exec.Id,
-				Args:  exec.Args,
-				Pty:   exec.Pty,
+				Index:           exec.Id,
+				Args:            exec.Args,
+				Pty:             exec.Pty,
+				KillGracePeriod: exec.KillGracePeriod,
+				Limits:          exec.Limits,
+				TargetContainer: exec.TargetContainer,
+				StatsInterval:   exec.StatsInterval,
+				OrphanPolicy:    exec.OrphanPolicy,
+				NoStdin:         exec.NoStdin,
+				Script:          exec.Script,
+				Interpreter:     exec.Interpreter,
+				CopyTo:          exec.CopyTo,
+				CopyFrom:        exec.CopyFrom,
+				PortForward:     exec.PortForward,
+				Container:       exec.Container,
+				Task:            exec.Task,
+				Env:             execEnv(deployment, req.InstanceId, exec),
+				SessionId:       exec.SessionId,
+
+				ClientCapabilities: exec.ClientCapabilities,
+				Sequence:           exec.Sequence,
+				KeepGoing:          exec.KeepGoing,
+				Resume:             exec.Resume,
+				MirrorToLogs:       exec.MirrorToLogs,
+				OutputChunkSize:    exec.OutputChunkSize,
+				Detach:             exec.Output != nil,
 			})
 		}
 
@@ -252,6 +300,14 @@ func (s *service) EntrypointExecStream(
 	go func() {
 		defer cancel()
 
+		// Forward the open message so the client knows that the instance
+		// has attached along with any metadata the instance sent.
+		select {
+		case exec.EntrypointEventCh <- req:
+		case <-ctx.Done():
+			return
+		}
+
 		for {
 			log.Trace("waiting for entrypoint exec event")
 			req, err := server.Recv()
@@ -290,6 +346,16 @@ func (s *service) EntrypointExecStream(
 		}
 	}()
 
+	// Client input is only logged at trace level and we scrub it first
+	// since it may contain secrets, such as those typed at a password prompt.
+	var inputLog *scrub.Writer
+	if log.IsTrace() {
+		inputLog = scrub.Default().Writer(log.StandardWriter(&hclog.StandardLoggerOptions{
+			ForceLevel: hclog.Trace,
+		}))
+		defer inputLog.Close()
+	}
+
 	// Loop through our receive loop
 	for {
 		select {
@@ -305,7 +371,7 @@ func (s *service) EntrypointExecStream(
 				return nil
 			}
 
-			if err := s.handleClientExecRequest(log, server, req); err != nil {
+			if err := s.handleClientExecRequest(log, server, req, inputLog); err != nil {
 				return err
 			}
 		}
@@ -316,23 +382,70 @@ func (s *service) handleClientExecRequest(
 	log hclog.Logger,
 	srv pb.Waypoint_EntrypointExecStreamServer,
 	req *pb.ExecStreamRequest,
+	inputLog *scrub.Writer,
 ) error {
-	log.Trace("event received from client", "event", req.Event)
+	// Input and tunnel data may contain secrets so we never log them here.
+	switch req.Event.(type) {
+	case *pb.ExecStreamRequest_Input_, *pb.ExecStreamRequest_Tunnel:
+	default:
+		log.Trace("event received from client", "event", req.Event)
+	}
+
 	var send *pb.EntrypointExecResponse
 	switch event := req.Event.(type) {
 	case *pb.ExecStreamRequest_Input_:
+		// We never log the raw input event, see inputLog.
+		if inputLog != nil {
+			inputLog.Write(event.Input.Data)
+		}
+
 		send = &pb.EntrypointExecResponse{
 			Event: &pb.EntrypointExecResponse_Input{
 				Input: event.Input.Data,
 			},
 		}
 
+		// The end of stdin follows the last of the data, if any.
+		if event.Input.Eof {
+			if len(event.Input.Data) > 0 {
+				if err := srv.Send(send); err != nil {
+					log.Warn("stream error", "err", err)
+					return err
+				}
+			}
+
+			send = &pb.EntrypointExecResponse{
+				Event: &pb.EntrypointExecResponse_InputEof{InputEof: true},
+			}
+		}
+
 	case *pb.ExecStreamRequest_Winch:
 		send = &pb.EntrypointExecResponse{
 			Event: &pb.EntrypointExecResponse_Winch{
 				Winch: event.Winch,
 			},
 		}
+
+	case *pb.ExecStreamRequest_Signal_:
+		send = &pb.EntrypointExecResponse{
+			Event: &pb.EntrypointExecResponse_Signal{
+				Signal: event.Signal,
+			},
+		}
+
+	case *pb.ExecStreamRequest_Tunnel:
+		send = &pb.EntrypointExecResponse{
+			Event: &pb.EntrypointExecResponse_Tunnel{
+				Tunnel: event.Tunnel,
+			},
+		}
+
+	case *pb.ExecStreamRequest_Ping_:
+		send = &pb.EntrypointExecResponse{
+			Event: &pb.EntrypointExecResponse_Ping{
+				Ping: event.Ping,
+			},
+		}
 	}
 
 	// Send our response
@@ -96,6 +96,62 @@ func TestServiceEntrypointConfig(t *testing.T) {
 		require.NotNil(cfgResp.Config.UrlService)
 		require.NotEmpty(cfgResp.Config.UrlService.Labels)
 	})
+
+	t.Run("detached exec", func(t *testing.T) {
+		require := require.New(t)
+
+		// Create our server
+		impl, err := New(WithDB(testDB(t)))
+		require.NoError(err)
+		client := server.TestServer(t, impl)
+
+		// Create a deployment
+		resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+			Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+				Component: &pb.Component{
+					Name: "testapp",
+				},
+			}),
+		})
+		require.NoError(err)
+		deploymentId := resp.Deployment.Id
+
+		// Create the config
+		instanceId, err := server.Id()
+		require.NoError(err)
+		stream, err := client.EntrypointConfig(ctx, &pb.EntrypointConfigRequest{
+			InstanceId:   instanceId,
+			DeploymentId: deploymentId,
+		})
+		require.NoError(err)
+		defer stream.CloseSend()
+
+		// Wait for the first config so that we know we're registered
+		cfgResp, err := stream.Recv()
+		require.NoError(err)
+		require.Empty(cfgResp.Config.Exec)
+
+		// Start a detached session
+		execStream, err := client.StartExecStream(ctx)
+		require.NoError(err)
+		require.NoError(execStream.Send(&pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Start_{
+				Start: &pb.ExecStreamRequest_Start{
+					DeploymentId: deploymentId,
+					Args:         []string{"foo"},
+					Detach:       true,
+				},
+			},
+		}))
+		_, err = execStream.Recv()
+		require.NoError(err)
+
+		// The entrypoint is told the session is detached
+		cfgResp, err = stream.Recv()
+		require.NoError(err)
+		require.Len(cfgResp.Config.Exec, 1)
+		require.True(cfgResp.Config.Exec[0].Detach)
+	})
 }
 
 func TestServiceEntrypointExecStream_badOpen(t *testing.T) {
@@ -1,34 +1,227 @@
 package singleprocess
 
 import (
+	"fmt"
 	"io"
+	"net"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/hashicorp/waypoint/internal/pkg/scrub"
+	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
+// execScriptMaxSize is the maximum size of a script sent with Start. The
+// script is part of the entrypoint config which is resent with every
+// change, so we keep it well below the message size limit.
+const execScriptMaxSize = 256 * 1024
+
+// execKillGracePeriodMax is the longest kill grace period a client may ask
+// for. Longer ones are lowered to it so that a client that disconnects
+// can't keep its command running indefinitely.
+const execKillGracePeriodMax = 10 * time.Minute
+
+// execSessionIdRe matches the session IDs clients may choose. This keeps
+// them safe to show in logs and to use on the command line.
+var execSessionIdRe = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// execServerCapabilities are the optional features of the exec protocol
+// that we support for sessions that a client runs. Detached sessions don't
+// announce any since we don't forward their pings. See
+// ExecStreamRequest.Start.client_capabilities.
+var execServerCapabilities = []string{
+	"ping", execCapabilityStatus, "stdin_eof", "sequence", "attached",
+	execCapabilityClosed,
+}
+
+// execCapabilityStatus is the capability of clients that understand status
+// events. Older clients fail on events before open, so others get none.
+const execCapabilityStatus = "status"
+
+// execAckStatusDelay is how long we wait for the instance to acknowledge a
+// session before telling the client that it hasn't.
+const execAckStatusDelay = 5 * time.Second
+
 func (s *service) StartExecStream(
 	srv pb.Waypoint_StartExecStreamServer,
 ) error {
 	log := hclog.FromContext(srv.Context())
 
-	// Read our first event which must be a Start event.
+	// Read our first event which must be a Start or Attach event.
 	log.Trace("waiting for Start message")
 	req, err := srv.Recv()
 	if err != nil {
 		return err
 	}
+	if attach, ok := req.Event.(*pb.ExecStreamRequest_Attach_); ok {
+		return s.attachExec(log, srv, attach.Attach)
+	}
+	if watch, ok := req.Event.(*pb.ExecStreamRequest_Watch_); ok {
+		return s.watchExec(log, srv, watch.Watch)
+	}
 	start, ok := req.Event.(*pb.ExecStreamRequest_Start_)
 	if !ok {
 		return status.Errorf(codes.FailedPrecondition,
 			"first message must be start type")
 	}
-	log = log.With("deployment_id", start.Start.DeploymentId)
-	log.Debug("exec requested", "args", start.Start.Args)
+
+	// Clients send the session ID so that their logs can be correlated
+	// with ours. Older clients don't, so we generate one for them.
+	sessionId := start.Start.SessionId
+	if sessionId == "" {
+		sessionId, err = server.Id()
+		if err != nil {
+			return err
+		}
+	} else if !execSessionIdRe.MatchString(sessionId) {
+		return status.Errorf(codes.InvalidArgument,
+			"invalid session ID %q", sessionId)
+	} else if s.execSessionExists(sessionId) {
+		return status.Errorf(codes.AlreadyExists,
+			"exec session %q already exists", sessionId)
+	}
+
+	log = log.With("deployment_id", start.Start.DeploymentId, "session_id", sessionId)
+	log.Debug("exec requested", "args", scrub.Default().Args(start.Start.Args),
+		"client_capabilities", start.Start.ClientCapabilities)
+
+	// Validate the grace period here so that a bad value is reported to
+	// the client rather than silently defaulted by the entrypoint.
+	if v := start.Start.KillGracePeriod; v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument,
+				"invalid kill grace period %q: %s", v, err)
+		}
+		if d < 0 {
+			return status.Errorf(codes.InvalidArgument,
+				"kill grace period %q must not be negative", v)
+		}
+		if d > execKillGracePeriodMax {
+			log.Debug("lowering kill grace period to the maximum",
+				"requested", v, "max", execKillGracePeriodMax)
+			start.Start.KillGracePeriod = execKillGracePeriodMax.String()
+		}
+	}
+
+	// A negative niceness would let clients run commands at a higher
+	// priority than the app itself.
+	if v := start.Start.Limits.GetNice(); v < 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"nice value %d must not be negative", v)
+	}
+
+	if v := start.Start.StatsInterval; v != "" {
+		if _, err := time.ParseDuration(v); err != nil {
+			return status.Errorf(codes.InvalidArgument,
+				"invalid stats interval %q: %s", v, err)
+		}
+	}
+
+	if n := len(start.Start.Script); n > execScriptMaxSize {
+		return status.Errorf(codes.InvalidArgument,
+			"script is %d bytes, the maximum size is %d bytes", n, execScriptMaxSize)
+	}
+
+	if len(start.Start.Sequence) > 0 {
+		switch {
+		case len(start.Start.Script) > 0:
+			return status.Errorf(codes.InvalidArgument,
+				"only one of sequence and script can be set")
+
+		case start.Start.CopyTo != nil || start.Start.CopyFrom != nil ||
+			start.Start.PortForward != nil:
+			return status.Errorf(codes.InvalidArgument,
+				"only commands can run in a sequence")
+		}
+
+		for i, cmd := range start.Start.Sequence {
+			if len(cmd.Args) == 0 {
+				return status.Errorf(codes.InvalidArgument,
+					"command %d of the sequence is empty", i+1)
+			}
+		}
+	}
+
+	if start.Start.Container != "" {
+		switch {
+		case start.Start.TargetContainer:
+			return status.Errorf(codes.InvalidArgument,
+				"only one of container and target_container can be set")
+
+		case start.Start.CopyTo != nil || start.Start.CopyFrom != nil ||
+			start.Start.PortForward != nil:
+			return status.Errorf(codes.InvalidArgument,
+				"only commands can run in a named container")
+		}
+	}
+
+	if start.Start.CopyTo != nil || start.Start.CopyFrom != nil {
+		switch {
+		case start.Start.CopyTo != nil && start.Start.CopyFrom != nil:
+			return status.Errorf(codes.InvalidArgument,
+				"only one of copy_to and copy_from can be set")
+
+		case start.Start.CopyTo != nil && start.Start.CopyTo.Path == "",
+			start.Start.CopyFrom != nil && start.Start.CopyFrom.Path == "":
+			return status.Errorf(codes.InvalidArgument,
+				"copy path must be set")
+
+		case start.Start.Detach:
+			return status.Errorf(codes.InvalidArgument,
+				"file copy sessions can't be detached")
+
+		case len(start.Start.Script) > 0:
+			return status.Errorf(codes.InvalidArgument,
+				"file copy sessions can't run a script")
+		}
+	}
+
+	if fwd := start.Start.PortForward; fwd != nil {
+		switch {
+		case start.Start.CopyTo != nil || start.Start.CopyFrom != nil:
+			return status.Errorf(codes.InvalidArgument,
+				"a session can't both copy files and forward ports")
+
+		case fwd.Dynamic && fwd.Reverse:
+			return status.Errorf(codes.InvalidArgument,
+				"dynamic port forwarding can't be reversed")
+
+		case fwd.Udp && (fwd.Reverse || fwd.Dynamic):
+			return status.Errorf(codes.InvalidArgument,
+				"UDP port forwarding can't be reversed or dynamic")
+
+		case !fwd.Dynamic && (fwd.Port <= 0 || fwd.Port > 65535):
+			return status.Errorf(codes.InvalidArgument,
+				"invalid port %d", fwd.Port)
+
+		case fwd.TargetAddr != "" && (fwd.Reverse || fwd.Dynamic):
+			return status.Errorf(codes.InvalidArgument,
+				"a target address can't be used with reverse or dynamic port forwarding")
+
+		case fwd.TargetAddr != "" && net.ParseIP(fwd.TargetAddr) == nil:
+			return status.Errorf(codes.InvalidArgument,
+				"invalid target address %q: must be an IP address", fwd.TargetAddr)
+
+		case start.Start.Detach:
+			return status.Errorf(codes.InvalidArgument,
+				"port forwarding sessions can't be detached")
+
+		case len(start.Start.Script) > 0:
+			return status.Errorf(codes.InvalidArgument,
+				"port forwarding sessions can't run a script")
+		}
+
+		if err := s.forwardTargetAllowed(fwd); err != nil {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
 
 	// Create our exec. We have to populate everything here first because
 	// once we register, this will trigger any watchers to be notified of
@@ -36,25 +229,92 @@ func (s *service) StartExecStream(
 	clientEventCh := make(chan *pb.ExecStreamRequest)
 	eventCh := make(chan *pb.EntrypointExecRequest)
 	execRec := &state.InstanceExec{
+		SessionId:         sessionId,
 		Args:              start.Start.Args,
 		Pty:               start.Start.Pty,
+		KillGracePeriod:   start.Start.KillGracePeriod,
+		Limits:            start.Start.Limits,
+		TargetContainer:   start.Start.TargetContainer,
+		StatsInterval:     start.Start.StatsInterval,
+		OrphanPolicy:      start.Start.OrphanPolicy,
+		NoStdin:           start.Start.NoStdin,
+		Script:            start.Start.Script,
+		Interpreter:       start.Start.Interpreter,
+		CopyTo:            start.Start.CopyTo,
+		CopyFrom:          start.Start.CopyFrom,
+		PortForward:       start.Start.PortForward,
+		Container:         start.Start.Container,
+		Task:              start.Start.Task,
+		NoInjectEnv:       start.Start.NoInjectEnv,
 		ClientEventCh:     clientEventCh,
 		EntrypointEventCh: eventCh,
+
+		ClientCapabilities: start.Start.ClientCapabilities,
+		Env:                start.Start.Env,
+		Sequence:           start.Start.Sequence,
+		KeepGoing:          start.Start.KeepGoing,
+		MirrorToLogs:       start.Start.MirrorToLogs,
+		OutputChunkSize:    start.Start.OutputChunkSize,
+	}
+	if start.Start.Detach {
+		execRec.Output = state.NewInstanceExecOutput(execDetachedOutputSize)
 	}
 
-	// Register the exec session
-	err = s.state.InstanceExecCreateByDeployment(start.Start.DeploymentId, execRec)
+	// We end the session if its deployment is destroyed. This is set up
+	// first so that we can't miss a destroy once the session exists. A
+	// detached session takes over the watch since it outlives this stream.
+	destroyedCh, stopDestroyWatch := s.execDestroyWatch(start.Start.DeploymentId)
+	defer func() { stopDestroyWatch() }()
+
+	// Register the exec session, on a specific instance if requested.
+	if iid := start.Start.InstanceId; iid != "" {
+		err = s.state.InstanceExecCreateByInstance(start.Start.DeploymentId, iid, execRec)
+	} else {
+		err = s.state.InstanceExecCreateByDeployment(start.Start.DeploymentId, execRec)
+	}
 	if err != nil {
 		return err
 	}
 
+	// Tell the client which instance we chose while it waits for it.
+	sendStatus := hasCapability(start.Start.ClientCapabilities, execCapabilityStatus)
+	if sendStatus {
+		if err := execStatus(srv, s.execSelectedStatus(execRec)); err != nil {
+			s.state.InstanceExecDelete(execRec.Id)
+			return err
+		}
+	}
+
+	// A detached session outlives this stream so it deregisters itself
+	// once the command exits.
+	if execRec.Output != nil {
+		stop := stopDestroyWatch
+		stopDestroyWatch = func() {}
+		return s.startDetachedExec(log, srv, execRec, clientEventCh, eventCh,
+			destroyedCh, stop)
+	}
+
 	// Make sure we always deregister it
 	defer s.state.InstanceExecDelete(execRec.Id)
 
-	// Always send the open message. In the future we'll send some metadata here.
+	// Sessions that run a command can be watched by other clients. The
+	// watcher events for our client come in on watchCh, which is nil
+	// otherwise.
+	var watched *execWatched
+	var watchCh <-chan *pb.ExecStreamResponse
+	if execRec.CopyTo == nil && execRec.CopyFrom == nil && execRec.PortForward == nil {
+		watched = s.execWatchedCreate(sessionId, execRec)
+		defer s.execWatchedDelete(sessionId, watched)
+		watchCh = watched.eventCh
+	}
+
+	// Always send the open message.
 	if err := srv.Send(&pb.ExecStreamResponse{
 		Event: &pb.ExecStreamResponse_Open_{
-			Open: &pb.ExecStreamResponse_Open{},
+			Open: &pb.ExecStreamResponse_Open{
+				SessionId:          sessionId,
+				ServerCapabilities: execServerCapabilities,
+			},
 		},
 	}); err != nil {
 		return err
@@ -62,6 +322,7 @@ func (s *service) StartExecStream(
 
 	// Start our receive loop to read data from the client
 	clientCloseCh := make(chan error, 1)
+	deniedCh := make(chan *pb.ExecStreamRequest_TunnelFrame)
 	go func() {
 		defer close(clientEventCh)
 		defer close(clientCloseCh)
@@ -79,10 +340,33 @@ func (s *service) StartExecStream(
 				return
 			}
 
+			// Connections the port forwarding policy doesn't allow never
+			// reach the entrypoint. The client is told directly.
+			if denied := s.forwardDenied(execRec.PortForward, resp); denied != nil {
+				log.Info("port forwarding connection denied", "error", denied.Error)
+				select {
+				case deniedCh <- denied:
+				case <-srv.Context().Done():
+					return
+				}
+
+				continue
+			}
+
 			clientEventCh <- resp
 		}
 	}()
 
+	// If the instance is slow to acknowledge the session, we let the
+	// client know so that it isn't left wondering. ackCh is nil once the
+	// instance attaches, or if the client doesn't understand status events.
+	var ackCh <-chan time.Time
+	if sendStatus {
+		ackTimer := time.NewTimer(execAckStatusDelay)
+		defer ackTimer.Stop()
+		ackCh = ackTimer.C
+	}
+
 	// Loop through and read events
 	for {
 		select {
@@ -92,10 +376,44 @@ func (s *service) StartExecStream(
 			// side as well.
 			return nil
 
+		case <-ackCh:
+			ackCh = nil
+			if err := execStatus(srv, fmt.Sprintf(
+				"instance %s hasn't acknowledged the session after %s, its "+
+					"entrypoint may be unable to reach the server",
+				execRec.InstanceId, execAckStatusDelay)); err != nil {
+				return err
+			}
+
 		case err := <-clientCloseCh:
 			// The client closed the connection so we want to exit the stream.
 			return err
 
+		case denied := <-deniedCh:
+			if err := srv.Send(&pb.ExecStreamResponse{
+				Event: &pb.ExecStreamResponse_Tunnel{
+					Tunnel: denied,
+				},
+			}); err != nil {
+				return err
+			}
+
+		case event := <-watchCh:
+			if err := srv.Send(event); err != nil {
+				return err
+			}
+
+		case d := <-destroyedCh:
+			log.Info("deployment destroyed, closing the session")
+			closedErr := &execClosedError{closed: execDestroyedClosed(d)}
+			if watched != nil {
+				watched.output.Close(closedErr)
+			}
+
+			// Ending our stream ends that of the instance too, which stops
+			// the command as it does when the client goes away.
+			return execClose(srv, start.Start.ClientCapabilities, closedErr)
+
 		case entryReq, active := <-eventCh:
 			// We got an event, exit out of the select and determine our action
 			if !active {
@@ -103,7 +421,11 @@ func (s *service) StartExecStream(
 				return nil
 			}
 
-			exit, err := s.handleEntrypointExecRequest(log, srv, entryReq)
+			if _, ok := entryReq.Event.(*pb.EntrypointExecRequest_Open_); ok {
+				ackCh = nil
+			}
+
+			exit, err := s.handleEntrypointExecRequest(log, srv, watched, entryReq)
 			if exit || err != nil {
 				return err
 			}
@@ -111,21 +433,175 @@ func (s *service) StartExecStream(
 	}
 }
 
+// execSelectedStatus describes the instance selected for a session that
+// was just registered as a status message.
+func (s *service) execSelectedStatus(execRec *state.InstanceExec) string {
+	msg := fmt.Sprintf("instance %s selected, waiting for its entrypoint to acknowledge the session",
+		execRec.InstanceId)
+
+	execs, err := s.state.InstanceExecListByInstanceId(execRec.InstanceId, nil)
+	if err == nil && len(execs) > 1 {
+		msg += fmt.Sprintf(" (it is running %d other sessions)", len(execs)-1)
+	}
+
+	return msg
+}
+
+// execStatus sends a status event with msg to the client.
+func execStatus(srv pb.Waypoint_StartExecStreamServer, msg string) error {
+	return srv.Send(&pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Status_{
+			Status: &pb.ExecStreamResponse_Status{
+				Message: msg,
+			},
+		},
+	})
+}
+
+// hasCapability returns true if caps includes the capability name.
+func hasCapability(caps []string, name string) bool {
+	for _, v := range caps {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *service) handleEntrypointExecRequest(
 	log hclog.Logger,
 	srv pb.Waypoint_StartExecStreamServer,
+	watched *execWatched,
 	entryReq *pb.EntrypointExecRequest,
 ) (bool, error) {
+	send, exit, err := execStreamResponse(log, entryReq)
+	if err != nil {
+		return true, err
+	}
+
+	if send != nil && watched != nil {
+		watched.Write(send)
+	}
+
+	// Send our response
+	if send != nil {
+		if err := srv.Send(send); err != nil {
+			log.Warn("stream error", "err", err)
+			return false, err
+		}
+	}
+
+	return exit, nil
+}
+
+// execStreamResponse converts an event from the entrypoint into the event
+// to send to the client, if any. exit is true if this ends the session.
+// An error event from the entrypoint is returned as err.
+func execStreamResponse(
+	log hclog.Logger,
+	entryReq *pb.EntrypointExecRequest,
+) (send *pb.ExecStreamResponse, exit bool, err error) {
 	log.Trace("event received from entrypoint", "event", entryReq.Event)
-	var send *pb.ExecStreamResponse
-	exit := false
 	switch event := entryReq.Event.(type) {
 	case *pb.EntrypointExecRequest_Output_:
 		send = &pb.ExecStreamResponse{
 			Event: &pb.ExecStreamResponse_Output_{
 				Output: &pb.ExecStreamResponse_Output{
-					Channel: pb.ExecStreamResponse_Output_Channel(event.Output.Channel),
-					Data:    event.Output.Data,
+					Channel:  pb.ExecStreamResponse_Output_Channel(event.Output.Channel),
+					Data:     event.Output.Data,
+					Sequence: event.Output.Sequence,
+				},
+			},
+		}
+
+	case *pb.EntrypointExecRequest_Open_:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Attached_{
+				Attached: &pb.ExecStreamResponse_Attached{
+					InstanceId:        event.Open.InstanceId,
+					UnsupportedLimits: event.Open.UnsupportedLimits,
+					PtyUnavailable:    event.Open.PtyUnavailable,
+					AllocationId:      event.Open.AllocationId,
+					Task:              event.Open.Task,
+
+					InstanceCapabilities: event.Open.Capabilities,
+					Locale:               event.Open.Locale,
+				},
+			},
+		}
+
+	case *pb.EntrypointExecRequest_Stats:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Stats_{
+				Stats: event.Stats,
+			},
+		}
+
+	case *pb.EntrypointExecRequest_CopyProgress:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_CopyProgress_{
+				CopyProgress: event.CopyProgress,
+			},
+		}
+
+	case *pb.EntrypointExecRequest_CopyResult:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_CopyResult_{
+				CopyResult: event.CopyResult,
+			},
+		}
+
+	case *pb.EntrypointExecRequest_CopyPartial:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_CopyPartial_{
+				CopyPartial: event.CopyPartial,
+			},
+		}
+
+	case *pb.EntrypointExecRequest_Tunnel:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Tunnel{
+				Tunnel: event.Tunnel,
+			},
+		}
+
+	case *pb.EntrypointExecRequest_Replayed:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Replayed_{
+				Replayed: event.Replayed,
+			},
+		}
+
+	case *pb.EntrypointExecRequest_Pong:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Pong_{
+				Pong: event.Pong,
+			},
+		}
+
+	case *pb.EntrypointExecRequest_Warning_:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Warning_{
+				Warning: &pb.ExecStreamResponse_Warning{
+					Message: event.Warning.Message,
+				},
+			},
+		}
+
+	case *pb.EntrypointExecRequest_Error_:
+		// The entrypoint terminated the session with an error. We end the
+		// stream with that error so that the client sees the code, such as
+		// ResourceExhausted if the instance is at its session limit.
+		log.Info("entrypoint exec error", "error", event.Error.Error)
+		return nil, true, status.ErrorProto(event.Error.Error)
+
+	case *pb.EntrypointExecRequest_CommandExit_:
+		send = &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_CommandExit_{
+				CommandExit: &pb.ExecStreamResponse_CommandExit{
+					Index: event.CommandExit.Index,
+					Exit:  execStreamExit(event.CommandExit.Exit),
 				},
 			},
 		}
@@ -134,20 +610,44 @@ func (s *service) handleEntrypointExecRequest(
 		exit = true
 		send = &pb.ExecStreamResponse{
 			Event: &pb.ExecStreamResponse_Exit_{
-				Exit: &pb.ExecStreamResponse_Exit{
-					Code: event.Exit.Code,
-				},
+				Exit: execStreamExit(event.Exit),
 			},
 		}
 	}
 
-	// Send our response
-	if send != nil {
-		if err := srv.Send(send); err != nil {
-			log.Warn("stream error", "err", err)
-			return false, err
-		}
+	return send, exit, nil
+}
+
+// execStreamExit converts the exit of a command from the entrypoint for
+// the client.
+func execStreamExit(exit *pb.EntrypointExecRequest_Exit) *pb.ExecStreamResponse_Exit {
+	if exit == nil {
+		return &pb.ExecStreamResponse_Exit{}
 	}
 
-	return exit, nil
+	return &pb.ExecStreamResponse_Exit{
+		Code:       exit.Code,
+		StartError: exit.StartError,
+		Signal:     exit.Signal,
+		CoreDumped: exit.CoreDumped,
+		Usage:      exit.Usage,
+	}
+}
+
+// execSessionExists returns true if a session with the given ID is
+// registered to be watched or attached to. Sessions that only copy files
+// or forward ports aren't registered, but clients never reuse IDs anyway;
+// this only protects the registered sessions from being shadowed.
+func (s *service) execSessionExists(id string) bool {
+	s.watchedLock.Lock()
+	_, ok := s.watched[id]
+	s.watchedLock.Unlock()
+	if ok {
+		return true
+	}
+
+	s.detachedLock.Lock()
+	defer s.detachedLock.Unlock()
+	_, ok = s.detached[id]
+	return ok
 }
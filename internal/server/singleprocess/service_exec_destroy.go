@@ -0,0 +1,100 @@
+package singleprocess
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// execCapabilityClosed is the capability of clients that understand the
+// closed event. Older clients fail on unknown events, so others only see
+// the stream end with the error.
+const execCapabilityClosed = "closed"
+
+// execDestroyWatch registers an exec session into the deployment id so
+// that the returned channel receives the deployment if it is destroyed.
+// The returned function deregisters the session and must be called once
+// the session ends.
+func (s *service) execDestroyWatch(id string) (<-chan *pb.Deployment, func()) {
+	ch := make(chan *pb.Deployment, 1)
+
+	s.destroyLock.Lock()
+	defer s.destroyLock.Unlock()
+	if s.destroyWatch == nil {
+		s.destroyWatch = map[string]map[chan *pb.Deployment]struct{}{}
+	}
+	if s.destroyWatch[id] == nil {
+		s.destroyWatch[id] = map[chan *pb.Deployment]struct{}{}
+	}
+	s.destroyWatch[id][ch] = struct{}{}
+
+	return ch, func() {
+		s.destroyLock.Lock()
+		defer s.destroyLock.Unlock()
+		delete(s.destroyWatch[id], ch)
+		if len(s.destroyWatch[id]) == 0 {
+			delete(s.destroyWatch, id)
+		}
+	}
+}
+
+// execDeploymentDestroyed tells the exec sessions into the deployment d,
+// which was just destroyed, so that they end. Sessions that were already
+// told aren't told again.
+func (s *service) execDeploymentDestroyed(d *pb.Deployment) {
+	s.destroyLock.Lock()
+	defer s.destroyLock.Unlock()
+	for ch := range s.destroyWatch[d.Id] {
+		select {
+		case ch <- d:
+		default:
+		}
+	}
+}
+
+// execDestroyedClosed returns the closed event of a session ended because
+// its deployment d was destroyed.
+func execDestroyedClosed(d *pb.Deployment) *pb.ExecStreamResponse_Closed {
+	return &pb.ExecStreamResponse_Closed{
+		Reason:  pb.ExecStreamResponse_Closed_DEPLOYMENT_DESTROYED,
+		Message: fmt.Sprintf("deployment v%d was destroyed", d.Sequence),
+	}
+}
+
+// execClosedError is the error that ends a session the server closed
+// itself, such as when its deployment is destroyed. Clients see it as
+// an Aborted status.
+type execClosedError struct {
+	closed *pb.ExecStreamResponse_Closed
+}
+
+func (e *execClosedError) Error() string {
+	return e.closed.Message
+}
+
+// GRPCStatus implements the interface used by the status package.
+func (e *execClosedError) GRPCStatus() *status.Status {
+	return status.New(codes.Aborted, e.closed.Message)
+}
+
+// execClose sends the closed event of err to a client with the
+// capabilities caps if it understands it, and returns the error that
+// ends the client's stream.
+func execClose(
+	srv pb.Waypoint_StartExecStreamServer,
+	caps []string,
+	err *execClosedError,
+) error {
+	if hasCapability(caps, execCapabilityClosed) {
+		if err := srv.Send(&pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Closed_{Closed: err.closed},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,332 @@
+package singleprocess
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+)
+
+// execDetachedOutputSize is the maximum size of the output we buffer for
+// a detached exec session. Older output is discarded once this is reached.
+const execDetachedOutputSize = 1024 * 1024
+
+// execDetachedRetention is how long we keep a detached session after it
+// exits so that a client can still attach to see its output and exit code.
+var execDetachedRetention = 1 * time.Hour
+
+// execDetached is a detached exec session.
+type execDetached struct {
+	exec *state.InstanceExec
+
+	// input is the send side of exec.ClientEventCh. Closing it ends the
+	// session, so this is only closed by closeInput when the server ends
+	// the session itself. Use send to send on it.
+	input chan<- *pb.ExecStreamRequest
+
+	// inputLock guards input against being closed during a send, and
+	// inputClosedCh is closed first to stop sends that are blocked.
+	inputLock     sync.RWMutex
+	inputClosed   bool
+	inputClosedCh chan struct{}
+
+	// doneCh is closed once the session has ended.
+	doneCh chan struct{}
+
+	// attached is 1 while a client is attached. We only allow one client
+	// at a time since they'd otherwise compete for input.
+	attached uint32
+
+	// ptyUnavailable is 1 if the session requested a PTY but the instance
+	// couldn't allocate one.
+	ptyUnavailable uint32
+}
+
+// startDetachedExec sends the open message for a detached session and
+// then buffers the session's events in the background until it exits.
+// This returns once the open message is sent so the client can disconnect.
+// The session takes over the destroy watch of destroyedCh and
+// stopDestroyWatch, and closes itself if its deployment is destroyed.
+func (s *service) startDetachedExec(
+	log hclog.Logger,
+	srv pb.Waypoint_StartExecStreamServer,
+	execRec *state.InstanceExec,
+	clientEventCh chan<- *pb.ExecStreamRequest,
+	eventCh <-chan *pb.EntrypointExecRequest,
+	destroyedCh <-chan *pb.Deployment,
+	stopDestroyWatch func(),
+) error {
+	sessionId := execRec.SessionId
+	d := &execDetached{
+		exec:          execRec,
+		input:         clientEventCh,
+		inputClosedCh: make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+
+	s.detachedLock.Lock()
+	if s.detached == nil {
+		s.detached = map[string]*execDetached{}
+	}
+	s.detached[sessionId] = d
+	s.detachedLock.Unlock()
+
+	if err := srv.Send(&pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Open_{
+			Open: &pb.ExecStreamResponse_Open{
+				SessionId: sessionId,
+			},
+		},
+	}); err != nil {
+		stopDestroyWatch()
+		s.execDetachedDelete(sessionId)
+		s.state.InstanceExecDelete(execRec.Id)
+		return err
+	}
+
+	log.Info("detached exec session started")
+	go func() {
+		defer time.AfterFunc(execDetachedRetention, func() {
+			s.execDetachedDelete(sessionId)
+		})
+		defer close(d.doneCh)
+		defer s.state.InstanceExecDelete(execRec.Id)
+		defer stopDestroyWatch()
+
+		// TODO: if the instance goes away before it attaches, we wait
+		// here forever.
+		for {
+			var entryReq *pb.EntrypointExecRequest
+			select {
+			case dep := <-destroyedCh:
+				// The attached client and any that attach later see
+				// the session closed. Closing the input ends the
+				// instance's side, which stops the command.
+				log.Info("deployment destroyed, closing the detached session")
+				execRec.Output.Close(&execClosedError{closed: execDestroyedClosed(dep)})
+				d.closeInput()
+
+			case entryReq = <-eventCh:
+			}
+
+			// This is nil if the session was closed above or the
+			// entrypoint closed its side.
+			if entryReq == nil {
+				break
+			}
+
+			send, exit, err := execStreamResponse(log, entryReq)
+			if err != nil {
+				execRec.Output.Close(err)
+				break
+			}
+
+			if v, ok := send.GetEvent().(*pb.ExecStreamResponse_Attached_); ok && v.Attached.PtyUnavailable {
+				atomic.StoreUint32(&d.ptyUnavailable, 1)
+			}
+
+			if send != nil {
+				execRec.Output.Write(send)
+			}
+			if exit {
+				break
+			}
+		}
+
+		// If the entrypoint went away without an exit, there is nothing
+		// more to wait for either.
+		execRec.Output.Close(nil)
+		log.Info("detached exec session ended")
+	}()
+
+	return nil
+}
+
+// attachExec attaches the client to a detached session. The buffered
+// events are replayed followed by a Replayed event, and then events are
+// streamed live until the session exits or the client disconnects. The
+// session keeps running when the client disconnects.
+func (s *service) attachExec(
+	log hclog.Logger,
+	srv pb.Waypoint_StartExecStreamServer,
+	req *pb.ExecStreamRequest_Attach,
+) error {
+	s.detachedLock.Lock()
+	d, ok := s.detached[req.SessionId]
+	s.detachedLock.Unlock()
+	if !ok {
+		return status.Errorf(codes.NotFound,
+			"exec session %q not found", req.SessionId)
+	}
+
+	log = log.With("session_id", req.SessionId)
+	if !atomic.CompareAndSwapUint32(&d.attached, 0, 1) {
+		return status.Errorf(codes.FailedPrecondition,
+			"another client is already attached to exec session %q", req.SessionId)
+	}
+	defer atomic.StoreUint32(&d.attached, 0)
+
+	exec := d.exec
+	pty := exec.Pty != nil && exec.Pty.Enable && atomic.LoadUint32(&d.ptyUnavailable) == 0
+	if err := srv.Send(&pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Open_{
+			Open: &pb.ExecStreamResponse_Open{
+				SessionId: req.SessionId,
+				Pty:       pty,
+				NoStdin:   exec.NoStdin,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	log.Info("client attached to detached exec session")
+	defer log.Info("client detached from exec session")
+
+	// Forward events from the client to the session.
+	go func() {
+		for {
+			req, err := srv.Recv()
+			if err != nil {
+				return
+			}
+
+			switch req.Event.(type) {
+			case *pb.ExecStreamRequest_Input_:
+				if exec.NoStdin {
+					continue
+				}
+
+			case *pb.ExecStreamRequest_Winch, *pb.ExecStreamRequest_Signal_:
+
+			default:
+				continue
+			}
+
+			if !d.send(srv.Context(), req) {
+				return
+			}
+		}
+	}()
+
+	return followExecOutput(srv, req.ClientCapabilities, exec.Output)
+}
+
+// send sends req to the session. This returns false if the session ended
+// or ctx is done before it could be sent.
+func (d *execDetached) send(ctx context.Context, req *pb.ExecStreamRequest) bool {
+	d.inputLock.RLock()
+	defer d.inputLock.RUnlock()
+	if d.inputClosed {
+		return false
+	}
+
+	select {
+	case d.input <- req:
+		return true
+	case <-d.inputClosedCh:
+	case <-d.doneCh:
+	case <-ctx.Done():
+	}
+
+	return false
+}
+
+// closeInput closes input, which ends the instance's side of the session
+// if it is still running. This must only be called once.
+func (d *execDetached) closeInput() {
+	close(d.inputClosedCh)
+
+	d.inputLock.Lock()
+	defer d.inputLock.Unlock()
+	d.inputClosed = true
+	close(d.input)
+}
+
+// followExecOutput sends the events buffered in output followed by a
+// Replayed event, and then sends events live until the session ends or
+// the client disconnects. caps are the client's capabilities, for the
+// closed event if the server closed the session.
+func followExecOutput(
+	srv pb.Waypoint_StartExecStreamServer,
+	caps []string,
+	output *state.InstanceExecOutput,
+) error {
+	send := func(events []*pb.ExecStreamResponse) error {
+		for _, event := range events {
+			if err := srv.Send(event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// Replay what is buffered and then follow the session live.
+	var idx int64
+	replayed := false
+	for {
+		events, next, ch := output.Read(idx)
+		idx = next
+		if err := send(events); err != nil {
+			return err
+		}
+
+		if !replayed {
+			replayed = true
+			_, dropped := output.Events()
+			if err := srv.Send(&pb.ExecStreamResponse{
+				Event: &pb.ExecStreamResponse_Replayed_{
+					Replayed: &pb.ExecStreamResponse_Replayed{
+						DroppedBytes: dropped,
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+
+		if exit, exitErr, done := output.Exit(); done {
+			// Nothing is written once the session is done but something
+			// may have been written since our read above.
+			events, _, _ := output.Read(idx)
+			if err := send(events); err != nil {
+				return err
+			}
+
+			if closedErr, ok := exitErr.(*execClosedError); ok {
+				return execClose(srv, caps, closedErr)
+			}
+			if exitErr != nil {
+				return exitErr
+			}
+
+			if exit != nil {
+				return srv.Send(&pb.ExecStreamResponse{
+					Event: &pb.ExecStreamResponse_Exit_{Exit: exit},
+				})
+			}
+
+			return nil
+		}
+
+		select {
+		case <-ch:
+		case <-srv.Context().Done():
+			return nil
+		}
+	}
+}
+
+// execDetachedDelete removes a detached session from our registry.
+func (s *service) execDetachedDelete(id string) {
+	s.detachedLock.Lock()
+	defer s.detachedLock.Unlock()
+	delete(s.detached, id)
+}
@@ -0,0 +1,184 @@
+package singleprocess
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// forwardPolicy decides which addresses dynamic port forwarding sessions
+// may connect to. The zero value allows everything.
+type forwardPolicy struct {
+	allow []forwardPattern
+	deny  []forwardPattern
+}
+
+// forwardPattern is a single parsed allow or deny pattern.
+type forwardPattern struct {
+	// host is a glob to match host names and IPs against, unless cidr is
+	// set in which case only IPs within it match.
+	host string
+	cidr *net.IPNet
+
+	// port is the port to match, zero for any.
+	port int
+}
+
+// newForwardPolicy parses the port forwarding policy from the server
+// config. cfg may be nil.
+func newForwardPolicy(cfg *configpkg.PortForward) (*forwardPolicy, error) {
+	var result forwardPolicy
+	if cfg == nil {
+		return &result, nil
+	}
+
+	for _, v := range cfg.Allow {
+		p, err := parseForwardPattern(v)
+		if err != nil {
+			return nil, err
+		}
+
+		result.allow = append(result.allow, p)
+	}
+
+	for _, v := range cfg.Deny {
+		p, err := parseForwardPattern(v)
+		if err != nil {
+			return nil, err
+		}
+
+		result.deny = append(result.deny, p)
+	}
+
+	return &result, nil
+}
+
+// parseForwardPattern parses a "HOST[:PORT]" pattern.
+func parseForwardPattern(v string) (forwardPattern, error) {
+	var result forwardPattern
+	host, port := v, ""
+	if h, p, err := net.SplitHostPort(v); err == nil {
+		host, port = h, p
+	}
+	if host == "" {
+		return result, fmt.Errorf("invalid port forwarding pattern %q: host is required", v)
+	}
+
+	if strings.Contains(host, "/") {
+		_, cidr, err := net.ParseCIDR(host)
+		if err != nil {
+			return result, fmt.Errorf("invalid port forwarding pattern %q: %s", v, err)
+		}
+
+		result.cidr = cidr
+	} else {
+		// Check the glob is valid now so that matching can't fail later.
+		if _, err := path.Match(host, ""); err != nil {
+			return result, fmt.Errorf("invalid port forwarding pattern %q: %s", v, err)
+		}
+
+		result.host = strings.ToLower(host)
+	}
+
+	if port != "" && port != "*" {
+		n, err := strconv.Atoi(port)
+		if err != nil || n <= 0 || n > 65535 {
+			return result, fmt.Errorf("invalid port forwarding pattern %q: invalid port %q", v, port)
+		}
+
+		result.port = n
+	}
+
+	return result, nil
+}
+
+// Allowed returns an error if the policy doesn't allow connecting to addr,
+// which is "host:port".
+func (p *forwardPolicy) Allowed(addr string) error {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %s", addr, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid address %q: invalid port", addr)
+	}
+
+	for _, pat := range p.deny {
+		if pat.match(host, port) {
+			return fmt.Errorf(
+				"connecting to %s is not allowed by the server's port forwarding policy", addr)
+		}
+	}
+
+	if len(p.allow) == 0 {
+		return nil
+	}
+	for _, pat := range p.allow {
+		if pat.match(host, port) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"connecting to %s is not allowed by the server's port forwarding policy", addr)
+}
+
+func (pat *forwardPattern) match(host string, port int) bool {
+	if pat.port != 0 && pat.port != port {
+		return false
+	}
+
+	if pat.cidr != nil {
+		// Names are resolved by the instance so we can only match IPs.
+		ip := net.ParseIP(host)
+		return ip != nil && pat.cidr.Contains(ip)
+	}
+
+	ok, _ := path.Match(pat.host, strings.ToLower(host))
+	return ok
+}
+
+// forwardDenied returns the frame to send back to the client if req opens
+// a connection in a dynamic port forwarding session that the policy
+// doesn't allow. It returns nil if the request can go to the entrypoint.
+func (s *service) forwardDenied(
+	fwd *pb.ExecStreamRequest_PortForward,
+	req *pb.ExecStreamRequest,
+) *pb.ExecStreamRequest_TunnelFrame {
+	if fwd == nil || !fwd.Dynamic {
+		return nil
+	}
+
+	v, ok := req.Event.(*pb.ExecStreamRequest_Tunnel)
+	if !ok || !v.Tunnel.Open {
+		return nil
+	}
+
+	if err := s.forwardPolicy.Allowed(v.Tunnel.Address); err != nil {
+		return &pb.ExecStreamRequest_TunnelFrame{
+			ConnectionId: v.Tunnel.ConnectionId,
+			Error:        err.Error(),
+		}
+	}
+
+	return nil
+}
+
+// forwardTargetAllowed returns an error if the policy doesn't allow the
+// target address of a port forwarding session. Any address other than
+// loopback reaches beyond the instance just like dynamic port forwarding,
+// so the same policy applies.
+func (s *service) forwardTargetAllowed(fwd *pb.ExecStreamRequest_PortForward) error {
+	ip := net.ParseIP(fwd.TargetAddr)
+	if ip == nil || ip.IsLoopback() {
+		return nil
+	}
+
+	return s.forwardPolicy.Allowed(net.JoinHostPort(fwd.TargetAddr, strconv.Itoa(int(fwd.Port))))
+}
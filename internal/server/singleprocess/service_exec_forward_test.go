@@ -0,0 +1,176 @@
+package singleprocess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	configpkg "github.com/hashicorp/waypoint/internal/config"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestForwardPolicy(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Config  *configpkg.PortForward
+		Addr    string
+		Allowed bool
+	}{
+		{
+			"no policy",
+			nil,
+			"example.com:443",
+			true,
+		},
+
+		{
+			"denied host",
+			&configpkg.PortForward{Deny: []string{"*.internal"}},
+			"db.internal:5432",
+			false,
+		},
+
+		{
+			"denied host is case insensitive",
+			&configpkg.PortForward{Deny: []string{"*.internal"}},
+			"DB.Internal:5432",
+			false,
+		},
+
+		{
+			"denied CIDR",
+			&configpkg.PortForward{Deny: []string{"169.254.0.0/16"}},
+			"169.254.169.254:80",
+			false,
+		},
+
+		{
+			"other port isn't denied",
+			&configpkg.PortForward{Deny: []string{"10.0.0.0/8:22"}},
+			"10.1.2.3:5432",
+			true,
+		},
+
+		{
+			"allowed",
+			&configpkg.PortForward{Allow: []string{"10.0.0.0/8", "*.svc:*"}},
+			"api.svc:8080",
+			true,
+		},
+
+		{
+			"not allowed",
+			&configpkg.PortForward{Allow: []string{"10.0.0.0/8"}},
+			"example.com:443",
+			false,
+		},
+
+		{
+			"deny wins over allow",
+			&configpkg.PortForward{
+				Allow: []string{"10.0.0.0/8"},
+				Deny:  []string{"10.0.0.1"},
+			},
+			"10.0.0.1:80",
+			false,
+		},
+
+		{
+			"IPv6",
+			&configpkg.PortForward{Allow: []string{"[fd00::/8]:443"}},
+			"[fd00::1]:443",
+			true,
+		},
+
+		{
+			"invalid address",
+			nil,
+			"example.com",
+			false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			policy, err := newForwardPolicy(tt.Config)
+			require.NoError(err)
+
+			err = policy.Allowed(tt.Addr)
+			if tt.Allowed {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+			}
+		})
+	}
+}
+
+func TestForwardPolicy_invalid(t *testing.T) {
+	for _, v := range []string{"", ":80", "10.0.0.0/33", "[a-", "host:0", "host:http"} {
+		t.Run(v, func(t *testing.T) {
+			_, err := newForwardPolicy(&configpkg.PortForward{Deny: []string{v}})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestServiceStartExecStream_portForwardDenied(t *testing.T) {
+	require := require.New(t)
+
+	impl, err := New(
+		WithDB(testDB(t)),
+		WithConfig(&configpkg.ServerConfig{
+			PortForward: &configpkg.PortForward{Deny: []string{"*.internal"}},
+		}),
+	)
+	require.NoError(err)
+	s := impl.(*service)
+
+	open := func(addr string) *pb.ExecStreamRequest {
+		return &pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Tunnel{
+				Tunnel: &pb.ExecStreamRequest_TunnelFrame{
+					ConnectionId: 1,
+					Open:         true,
+					Address:      addr,
+				},
+			},
+		}
+	}
+	dynamic := &pb.ExecStreamRequest_PortForward{Dynamic: true}
+
+	// Denied connections are answered with an error for the client
+	denied := s.forwardDenied(dynamic, open("db.internal:5432"))
+	require.NotNil(denied)
+	require.Equal(uint64(1), denied.ConnectionId)
+	require.Contains(denied.Error, "not allowed")
+
+	// Allowed connections and other sessions go to the entrypoint
+	require.Nil(s.forwardDenied(dynamic, open("example.com:443")))
+	require.Nil(s.forwardDenied(&pb.ExecStreamRequest_PortForward{Port: 80}, open("")))
+}
+
+func TestServiceForwardTargetAllowed(t *testing.T) {
+	require := require.New(t)
+
+	impl, err := New(
+		WithDB(testDB(t)),
+		WithConfig(&configpkg.ServerConfig{
+			PortForward: &configpkg.PortForward{Deny: []string{"10.0.0.0/8"}},
+		}),
+	)
+	require.NoError(err)
+	s := impl.(*service)
+
+	target := func(addr string) *pb.ExecStreamRequest_PortForward {
+		return &pb.ExecStreamRequest_PortForward{Port: 5432, TargetAddr: addr}
+	}
+
+	require.NoError(s.forwardTargetAllowed(target("")))
+	require.NoError(s.forwardTargetAllowed(target("::1")))
+	require.NoError(s.forwardTargetAllowed(target("127.0.0.2")))
+	require.NoError(s.forwardTargetAllowed(target("192.168.1.5")))
+	require.Error(s.forwardTargetAllowed(target("10.1.2.3")))
+}
@@ -2,6 +2,7 @@ package singleprocess
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"testing"
 	"time"
@@ -93,6 +94,45 @@ func TestServiceStartExecStream_start(t *testing.T) {
 	require.False(active)
 }
 
+func TestServiceStartExecStream_status(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start stream as a client that understands status events
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId:       deploymentId,
+				Args:               []string{"foo", "bar"},
+				ClientCapabilities: []string{"status"},
+			},
+		},
+	}))
+	defer stream.CloseSend()
+
+	// We're told which instance was selected before the open
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.Contains(resp.GetStatus().GetMessage(), "instance "+instanceId+" selected")
+
+	resp, err = stream.Recv()
+	require.NoError(err)
+	open := resp.GetOpen()
+	require.NotNil(open)
+	require.Contains(open.ServerCapabilities, "status")
+}
+
 func TestServiceStartExecStream_eventExit(t *testing.T) {
 	ctx := context.Background()
 	require := require.New(t)
@@ -228,6 +268,523 @@ func TestServiceStartExecStream_entrypointEventChClose(t *testing.T) {
 	require.False(active)
 }
 
+func TestServiceStartExecStream_deploymentDestroyed(t *testing.T) {
+	for _, closed := range []bool{true, false} {
+		name := "closed capability"
+		if !closed {
+			name = "older client"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			require := require.New(t)
+
+			// Create our server
+			impl, err := New(WithDB(testDB(t)))
+			require.NoError(err)
+			client := server.TestServer(t, impl)
+
+			// Create an instance
+			instanceId, deploymentId, closer := TestEntrypoint(t, client)
+			defer closer()
+
+			var caps []string
+			if closed {
+				caps = []string{"closed"}
+			}
+
+			stream, err := client.StartExecStream(ctx)
+			require.NoError(err)
+			require.NoError(stream.Send(&pb.ExecStreamRequest{
+				Event: &pb.ExecStreamRequest_Start_{
+					Start: &pb.ExecStreamRequest_Start{
+						DeploymentId:       deploymentId,
+						Args:               []string{"foo", "bar"},
+						ClientCapabilities: caps,
+					},
+				},
+			}))
+			defer stream.CloseSend()
+
+			resp, err := stream.Recv()
+			require.NoError(err)
+			require.NotNil(resp.GetOpen())
+			exec := testGetInstanceExec(t, impl, instanceId)
+
+			// Destroy the deployment
+			dep := testDestroyDeployment(t, client, deploymentId)
+
+			// We're told why the session ends
+			if closed {
+				resp, err = stream.Recv()
+				require.NoError(err)
+				require.Equal(pb.ExecStreamResponse_Closed_DEPLOYMENT_DESTROYED,
+					resp.GetClosed().GetReason())
+				require.Equal(fmt.Sprintf("deployment v%d was destroyed", dep.Sequence),
+					resp.GetClosed().GetMessage())
+			}
+
+			_, err = stream.Recv()
+			require.Error(err)
+			require.Equal(codes.Aborted, status.Code(err))
+			require.Contains(err.Error(), "was destroyed")
+
+			// The session of the instance ends too
+			_, active := <-exec.ClientEventCh
+			require.False(active)
+		})
+	}
+}
+
+func TestServiceStartExecStream_attachDeploymentDestroyed(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start a detached session
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId: deploymentId,
+				Args:         []string{"foo"},
+				Detach:       true,
+			},
+		},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(err)
+	sessionId := resp.Event.(*pb.ExecStreamResponse_Open_).Open.SessionId
+	_, err = stream.Recv()
+	require.Equal(io.EOF, err)
+	exec := testGetInstanceExec(t, impl, instanceId)
+
+	attach := func() pb.Waypoint_StartExecStreamClient {
+		stream, err := client.StartExecStream(ctx)
+		require.NoError(err)
+		require.NoError(stream.Send(&pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Attach_{
+				Attach: &pb.ExecStreamRequest_Attach{
+					SessionId:          sessionId,
+					ClientCapabilities: []string{"closed"},
+				},
+			},
+		}))
+
+		resp, err := stream.Recv()
+		require.NoError(err)
+		require.NotNil(resp.GetOpen())
+		resp, err = stream.Recv()
+		require.NoError(err)
+		require.NotNil(resp.GetReplayed())
+		return stream
+	}
+
+	requireClosed := func(stream pb.Waypoint_StartExecStreamClient) {
+		resp, err := stream.Recv()
+		require.NoError(err)
+		require.Equal(pb.ExecStreamResponse_Closed_DEPLOYMENT_DESTROYED,
+			resp.GetClosed().GetReason())
+
+		_, err = stream.Recv()
+		require.Equal(codes.Aborted, status.Code(err))
+	}
+
+	// Attach, then destroy the deployment
+	stream = attach()
+	defer stream.CloseSend()
+	testDestroyDeployment(t, client, deploymentId)
+
+	// The attached client is told why the session ends
+	requireClosed(stream)
+
+	// The session of the instance ends too
+	_, active := <-exec.ClientEventCh
+	require.False(active)
+
+	// A client attaching afterwards is told too
+	stream = attach()
+	defer stream.CloseSend()
+	requireClosed(stream)
+}
+
+func TestServiceStartExecStream_detach(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start a detached session
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId: deploymentId,
+				Args:         []string{"foo", "bar"},
+				Detach:       true,
+			},
+		},
+	}))
+
+	// Should open with a session ID
+	{
+		resp, err := stream.Recv()
+		require.NoError(err)
+		open, ok := resp.Event.(*pb.ExecStreamResponse_Open_)
+		require.True(ok, "should be an open")
+		require.NotEmpty(open.Open.SessionId)
+	}
+
+	// The stream ends right away but the session is still registered
+	_, err = stream.Recv()
+	require.Equal(io.EOF, err)
+	exec := testGetInstanceExec(t, impl, instanceId)
+	require.NotNil(exec.Output)
+
+	// Output is buffered
+	exec.EntrypointEventCh <- &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Output_{
+			Output: &pb.EntrypointExecRequest_Output{
+				Data: []byte("hello"),
+			},
+		},
+	}
+	exec.EntrypointEventCh <- &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{
+				Code: 3,
+			},
+		},
+	}
+
+	require.Eventually(func() bool {
+		_, _, done := exec.Output.Exit()
+		return done
+	}, 2*time.Second, 10*time.Millisecond)
+
+	events, _ := exec.Output.Events()
+	require.Len(events, 1)
+	require.Equal([]byte("hello"), events[0].Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+
+	exit, err, _ := exec.Output.Exit()
+	require.NoError(err)
+	require.Equal(int32(3), exit.Code)
+
+	// Once it exits, the session is deregistered
+	require.Eventually(func() bool {
+		list, err := testServiceImpl(impl).state.InstanceExecListByInstanceId(instanceId, nil)
+		require.NoError(err)
+		return len(list) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestServiceStartExecStream_attach(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start a detached session
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId: deploymentId,
+				Args:         []string{"foo"},
+				Detach:       true,
+			},
+		},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(err)
+	sessionId := resp.Event.(*pb.ExecStreamResponse_Open_).Open.SessionId
+
+	exec := testGetInstanceExec(t, impl, instanceId)
+	output := func(v string) *pb.EntrypointExecRequest {
+		return &pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_Output_{
+				Output: &pb.EntrypointExecRequest_Output{Data: []byte(v)},
+			},
+		}
+	}
+	exec.EntrypointEventCh <- output("before")
+
+	// Attach
+	stream, err = client.StartExecStream(ctx)
+	require.NoError(err)
+	defer stream.CloseSend()
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Attach_{
+			Attach: &pb.ExecStreamRequest_Attach{SessionId: sessionId},
+		},
+	}))
+
+	resp, err = stream.Recv()
+	require.NoError(err)
+	open, ok := resp.Event.(*pb.ExecStreamResponse_Open_)
+	require.True(ok, "should be an open")
+	require.Equal(sessionId, open.Open.SessionId)
+
+	// We get the buffered output and then the end of the replay
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.Equal([]byte("before"), resp.Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+	resp, err = stream.Recv()
+	require.NoError(err)
+	_, ok = resp.Event.(*pb.ExecStreamResponse_Replayed_)
+	require.True(ok, "should be replayed")
+
+	// Input goes to the session
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Data: []byte("input")},
+		},
+	}))
+	req := <-exec.ClientEventCh
+	require.Equal([]byte("input"), req.Event.(*pb.ExecStreamRequest_Input_).Input.Data)
+
+	// A second client can't attach at the same time
+	{
+		stream, err := client.StartExecStream(ctx)
+		require.NoError(err)
+		require.NoError(stream.Send(&pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Attach_{
+				Attach: &pb.ExecStreamRequest_Attach{SessionId: sessionId},
+			},
+		}))
+		_, err = stream.Recv()
+		require.Equal(codes.FailedPrecondition, status.Code(err))
+	}
+
+	// Then live output and the exit
+	exec.EntrypointEventCh <- output("after")
+	exec.EntrypointEventCh <- &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{Code: 4},
+		},
+	}
+
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.Equal([]byte("after"), resp.Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.Equal(int32(4), resp.Event.(*pb.ExecStreamResponse_Exit_).Exit.Code)
+	_, err = stream.Recv()
+	require.Equal(io.EOF, err)
+
+	// Attaching after the exit replays everything and the exit
+	stream, err = client.StartExecStream(ctx)
+	require.NoError(err)
+	defer stream.CloseSend()
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Attach_{
+			Attach: &pb.ExecStreamRequest_Attach{SessionId: sessionId},
+		},
+	}))
+
+	var events []*pb.ExecStreamResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		events = append(events, resp)
+	}
+	require.Len(events, 5)
+	require.Equal(int32(4), events[4].Event.(*pb.ExecStreamResponse_Exit_).Exit.Code)
+}
+
+func TestServiceStartExecStream_attachNotFound(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Attach_{
+			Attach: &pb.ExecStreamRequest_Attach{SessionId: "42"},
+		},
+	}))
+
+	_, err = stream.Recv()
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServiceStartExecStream_sessionId(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	start := func(id string) (pb.Waypoint_StartExecStreamClient, error) {
+		stream, err := client.StartExecStream(ctx)
+		require.NoError(err)
+		require.NoError(stream.Send(&pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Start_{
+				Start: &pb.ExecStreamRequest_Start{
+					DeploymentId: deploymentId,
+					Args:         []string{"foo"},
+					SessionId:    id,
+				},
+			},
+		}))
+
+		_, err = stream.Recv()
+		return stream, err
+	}
+
+	// The session uses our ID and passes it to the entrypoint
+	stream, err := start("4f2c9d1e-session")
+	require.NoError(err)
+	defer stream.CloseSend()
+	exec := testGetInstanceExec(t, impl, instanceId)
+	require.Equal("4f2c9d1e-session", exec.SessionId)
+
+	// The ID can't be reused while the session is active
+	_, err = start("4f2c9d1e-session")
+	require.Error(err)
+	require.Equal(codes.AlreadyExists, status.Code(err))
+
+	// IDs are validated
+	_, err = start("no spaces")
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceStartExecStream_scriptTooLarge(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId: "foo",
+				Script:       make([]byte, execScriptMaxSize+1),
+			},
+		},
+	}))
+
+	_, err = stream.Recv()
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceStartExecStream_negativeNice(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId: "foo",
+				Args:         []string{"foo"},
+				Limits:       &pb.ExecStreamRequest_Limits{Nice: -5},
+			},
+		},
+	}))
+
+	_, err = stream.Recv()
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceStartExecStream_killGracePeriod(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	start := func(grace string) (pb.Waypoint_StartExecStreamClient, error) {
+		stream, err := client.StartExecStream(ctx)
+		require.NoError(err)
+		require.NoError(stream.Send(&pb.ExecStreamRequest{
+			Event: &pb.ExecStreamRequest_Start_{
+				Start: &pb.ExecStreamRequest_Start{
+					DeploymentId:    deploymentId,
+					Args:            []string{"foo"},
+					KillGracePeriod: grace,
+				},
+			},
+		}))
+
+		_, err = stream.Recv()
+		return stream, err
+	}
+
+	// Negative grace periods are rejected
+	_, err = start("-1s")
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Long grace periods are lowered to the maximum
+	stream, err := start("1000h")
+	require.NoError(err)
+	defer stream.CloseSend()
+	exec := testGetInstanceExec(t, impl, instanceId)
+	require.Equal(execKillGracePeriodMax.String(), exec.KillGracePeriod)
+}
+
 func testGetInstanceExec(t *testing.T, impl pb.WaypointServer, instanceId string) *state.InstanceExec {
 	ws := memdb.NewWatchSet()
 	list, err := testServiceImpl(impl).state.InstanceExecListByInstanceId(instanceId, ws)
@@ -241,3 +798,23 @@ func testGetInstanceExec(t *testing.T, impl pb.WaypointServer, instanceId string
 
 	return list[0]
 }
+
+// testDestroyDeployment marks the deployment id destroyed and returns it.
+func testDestroyDeployment(t *testing.T, client pb.WaypointClient, id string) *pb.Deployment {
+	ctx := context.Background()
+
+	dep, err := client.GetDeployment(ctx, &pb.GetDeploymentRequest{
+		Ref: &pb.Ref_Operation{
+			Target: &pb.Ref_Operation_Id{Id: id},
+		},
+	})
+	require.NoError(t, err)
+
+	dep.State = pb.Operation_DESTROYED
+	_, err = client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: dep,
+	})
+	require.NoError(t, err)
+
+	return dep
+}
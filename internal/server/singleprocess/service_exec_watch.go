@@ -0,0 +1,153 @@
+package singleprocess
+
+import (
+	"sync/atomic"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
+)
+
+// execWatchOutputSize is the size of the output we buffer for a watched
+// session so that a watcher that joins sees what's on the screen. This is
+// small since the buffer exists for every session, watched or not.
+const execWatchOutputSize = 64 * 1024
+
+// execWatched is a running exec session that other clients can watch.
+type execWatched struct {
+	exec *state.InstanceExec
+
+	// output is a copy of the session's output for watchers. Writes never
+	// block, so slow or disconnected watchers can't hold up the session.
+	output *state.InstanceExecOutput
+
+	// eventCh receives the watcher events for the client running the
+	// session. Sends are dropped rather than block if it is full.
+	eventCh chan *pb.ExecStreamResponse
+
+	// watchers is the number of watching clients.
+	watchers int32
+
+	// ptyUnavailable is 1 if the session requested a PTY but the instance
+	// couldn't allocate one.
+	ptyUnavailable uint32
+}
+
+// execWatchedCreate registers a session so that it can be watched.
+func (s *service) execWatchedCreate(sessionId string, exec *state.InstanceExec) *execWatched {
+	w := &execWatched{
+		exec:    exec,
+		output:  state.NewInstanceExecOutput(execWatchOutputSize),
+		eventCh: make(chan *pb.ExecStreamResponse, 16),
+	}
+
+	s.watchedLock.Lock()
+	defer s.watchedLock.Unlock()
+	if s.watched == nil {
+		s.watched = map[string]*execWatched{}
+	}
+	s.watched[sessionId] = w
+
+	return w
+}
+
+// execWatchedDelete deregisters a session once it ends. Any watchers
+// see the session end too.
+func (s *service) execWatchedDelete(sessionId string, w *execWatched) {
+	s.watchedLock.Lock()
+	delete(s.watched, sessionId)
+	s.watchedLock.Unlock()
+
+	w.output.Close(nil)
+}
+
+// Write copies an event the session sent to its client for watchers.
+// Only output and the exit are of interest to them.
+func (w *execWatched) Write(event *pb.ExecStreamResponse) {
+	switch v := event.Event.(type) {
+	case *pb.ExecStreamResponse_Output_, *pb.ExecStreamResponse_Exit_:
+		w.output.Write(event)
+
+	case *pb.ExecStreamResponse_Attached_:
+		if v.Attached.PtyUnavailable {
+			atomic.StoreUint32(&w.ptyUnavailable, 1)
+		}
+	}
+}
+
+// notify tells the client running the session that a watcher joined or
+// left.
+func (w *execWatched) notify(joined bool) {
+	delta := int32(-1)
+	if joined {
+		delta = 1
+	}
+
+	event := &pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Watcher_{
+			Watcher: &pb.ExecStreamResponse_Watcher{
+				Joined:   joined,
+				Watchers: atomic.AddInt32(&w.watchers, delta),
+			},
+		},
+	}
+
+	select {
+	case w.eventCh <- event:
+	default:
+	}
+}
+
+// watchExec streams the output of a running session to a watching
+// client. The buffered output is replayed followed by a Replayed event,
+// and then output is streamed live until the session exits or the client
+// disconnects. Nothing the client sends reaches the session.
+func (s *service) watchExec(
+	log hclog.Logger,
+	srv pb.Waypoint_StartExecStreamServer,
+	req *pb.ExecStreamRequest_Watch,
+) error {
+	s.watchedLock.Lock()
+	w, ok := s.watched[req.SessionId]
+	s.watchedLock.Unlock()
+	if !ok {
+		return status.Errorf(codes.NotFound,
+			"exec session %q not found or can't be watched", req.SessionId)
+	}
+
+	log = log.With("session_id", req.SessionId)
+
+	exec := w.exec
+	pty := exec.Pty != nil && exec.Pty.Enable && atomic.LoadUint32(&w.ptyUnavailable) == 0
+	if err := srv.Send(&pb.ExecStreamResponse{
+		Event: &pb.ExecStreamResponse_Open_{
+			Open: &pb.ExecStreamResponse_Open{
+				SessionId: req.SessionId,
+				Pty:       pty,
+				NoStdin:   true,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	w.notify(true)
+	defer w.notify(false)
+	log.Info("client watching exec session")
+	defer log.Info("client stopped watching exec session")
+
+	// Discard anything the client sends. We still read so that the
+	// client's sends don't back up.
+	go func() {
+		for {
+			if _, err := srv.Recv(); err != nil {
+				return
+			}
+		}
+	}()
+
+	return followExecOutput(srv, req.ClientCapabilities, w.output)
+}
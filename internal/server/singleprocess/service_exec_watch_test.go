@@ -0,0 +1,209 @@
+package singleprocess
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/waypoint/internal/server"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestServiceStartExecStream_watch(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	instanceId, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start a session
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	defer stream.CloseSend()
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId: deploymentId,
+				Args:         []string{"foo"},
+			},
+		},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(err)
+	sessionId := resp.Event.(*pb.ExecStreamResponse_Open_).Open.SessionId
+
+	exec := testGetInstanceExec(t, impl, instanceId)
+	output := func(v string) *pb.EntrypointExecRequest {
+		return &pb.EntrypointExecRequest{
+			Event: &pb.EntrypointExecRequest_Output_{
+				Output: &pb.EntrypointExecRequest_Output{Data: []byte(v)},
+			},
+		}
+	}
+	exec.EntrypointEventCh <- output("before")
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.Equal([]byte("before"), resp.Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+
+	// Watch
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	watch, err := client.StartExecStream(watchCtx)
+	require.NoError(err)
+	require.NoError(watch.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Watch_{
+			Watch: &pb.ExecStreamRequest_Watch{SessionId: sessionId},
+		},
+	}))
+
+	resp, err = watch.Recv()
+	require.NoError(err)
+	open, ok := resp.Event.(*pb.ExecStreamResponse_Open_)
+	require.True(ok, "should be an open")
+	require.True(open.Open.NoStdin)
+
+	// The watcher gets the buffered output and then the end of the replay
+	resp, err = watch.Recv()
+	require.NoError(err)
+	require.Equal([]byte("before"), resp.Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+	resp, err = watch.Recv()
+	require.NoError(err)
+	_, ok = resp.Event.(*pb.ExecStreamResponse_Replayed_)
+	require.True(ok, "should be replayed")
+
+	// Our client is told about the watcher
+	resp, err = stream.Recv()
+	require.NoError(err)
+	watcher := resp.Event.(*pb.ExecStreamResponse_Watcher_).Watcher
+	require.True(watcher.Joined)
+	require.Equal(int32(1), watcher.Watchers)
+
+	// Input from the watcher never reaches the session
+	require.NoError(watch.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Data: []byte("input")},
+		},
+	}))
+	select {
+	case req := <-exec.ClientEventCh:
+		t.Fatalf("unexpected event: %v", req)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Both get live output
+	exec.EntrypointEventCh <- output("after")
+	for _, s := range []pb.Waypoint_StartExecStreamClient{stream, watch} {
+		resp, err := s.Recv()
+		require.NoError(err)
+		require.Equal([]byte("after"), resp.Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+	}
+
+	// The watcher leaving doesn't affect the session
+	cancel()
+	resp, err = stream.Recv()
+	require.NoError(err)
+	watcher = resp.Event.(*pb.ExecStreamResponse_Watcher_).Watcher
+	require.False(watcher.Joined)
+	require.Equal(int32(0), watcher.Watchers)
+
+	exec.EntrypointEventCh <- &pb.EntrypointExecRequest{
+		Event: &pb.EntrypointExecRequest_Exit_{
+			Exit: &pb.EntrypointExecRequest_Exit{Code: 4},
+		},
+	}
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.Equal(int32(4), resp.Event.(*pb.ExecStreamResponse_Exit_).Exit.Code)
+	_, err = stream.Recv()
+	require.Equal(io.EOF, err)
+
+	// Once it ends, it can't be watched
+	watch, err = client.StartExecStream(ctx)
+	require.NoError(err)
+	defer watch.CloseSend()
+	require.NoError(watch.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Watch_{
+			Watch: &pb.ExecStreamRequest_Watch{SessionId: sessionId},
+		},
+	}))
+	_, err = watch.Recv()
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServiceStartExecStream_watchDeploymentDestroyed(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Create an instance
+	_, deploymentId, closer := TestEntrypoint(t, client)
+	defer closer()
+
+	// Start a session
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	defer stream.CloseSend()
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Start_{
+			Start: &pb.ExecStreamRequest_Start{
+				DeploymentId:       deploymentId,
+				Args:               []string{"foo"},
+				ClientCapabilities: []string{"closed"},
+			},
+		},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(err)
+	sessionId := resp.Event.(*pb.ExecStreamResponse_Open_).Open.SessionId
+
+	// Watch
+	watch, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	defer watch.CloseSend()
+	require.NoError(watch.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Watch_{
+			Watch: &pb.ExecStreamRequest_Watch{
+				SessionId:          sessionId,
+				ClientCapabilities: []string{"closed"},
+			},
+		},
+	}))
+	resp, err = watch.Recv()
+	require.NoError(err)
+	require.NotNil(resp.GetOpen())
+	resp, err = watch.Recv()
+	require.NoError(err)
+	require.NotNil(resp.GetReplayed())
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.True(resp.GetWatcher().GetJoined())
+
+	// Destroy the deployment
+	testDestroyDeployment(t, client, deploymentId)
+
+	// Both are told why the session ends
+	for _, s := range []pb.Waypoint_StartExecStreamClient{stream, watch} {
+		resp, err := s.Recv()
+		require.NoError(err)
+		require.Equal(pb.ExecStreamResponse_Closed_DEPLOYMENT_DESTROYED,
+			resp.GetClosed().GetReason())
+
+		_, err = s.Recv()
+		require.Equal(codes.Aborted, status.Code(err))
+	}
+}
@@ -167,6 +167,15 @@ func (s *service) GetJobStream(
 	log := hclog.FromContext(server.Context())
 	ctx := server.Context()
 
+	var since time.Time
+	if req.Since != nil {
+		var err error
+		since, err = ptypes.Timestamp(req.Since)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid since: %s", err)
+		}
+	}
+
 	// Get the job
 	ws := memdb.NewWatchSet()
 	job, err := s.state.JobById(req.JobId, ws)
@@ -262,7 +271,7 @@ func (s *service) GetJobStream(
 			// is now non-nil, initialize that. This will send any buffered
 			// data down.
 			if eventsCh == nil && job.OutputBuffer != nil {
-				eventsCh, err = s.getJobStreamOutputInit(ctx, job, server)
+				eventsCh, err = s.getJobStreamOutputInit(ctx, job, server, req, since)
 				if err != nil {
 					return err
 				}
@@ -270,7 +279,13 @@ func (s *service) GetJobStream(
 
 			switch job.State {
 			case pb.Job_SUCCESS, pb.Job_ERROR:
-				// TODO(mitchellh): we should drain the output buffer
+				// The runner sends all the output before the job completes,
+				// so send down what we haven't yet.
+				if eventsCh != nil {
+					if err := s.getJobStreamOutputDrain(eventsCh, server); err != nil {
+						return err
+					}
+				}
 
 				// Job is done. For success, error will be nil, so this
 				// populates the event with the proper values.
@@ -284,6 +299,12 @@ func (s *service) GetJobStream(
 				})
 			}
 
+			// Without following, we're done once the buffered output,
+			// if any, is sent.
+			if req.NoFollow {
+				return nil
+			}
+
 		case events := <-eventsCh:
 			if err := server.Send(&pb.GetJobStreamResponse{
 				Event: &pb.GetJobStreamResponse_Terminal_{
@@ -316,9 +337,16 @@ func (s *service) getJobStreamOutputInit(
 	ctx context.Context,
 	job *state.Job,
 	server pb.Waypoint_GetJobStreamServer,
+	req *pb.GetJobStreamRequest,
+	since time.Time,
 ) (<-chan []*pb.GetJobStreamResponse_Terminal_Event, error) {
-	// Send down all our buffered lines.
-	outputR := job.OutputBuffer.Reader(-1)
+	limit := req.LimitBacklog
+	if limit <= 0 {
+		limit = -1
+	}
+
+	// Send down our buffered lines.
+	outputR := job.OutputBuffer.Reader(limit)
 	go outputR.CloseContext(ctx)
 	for {
 		events := s.readJobLogBatch(outputR, false)
@@ -326,6 +354,13 @@ func (s *service) getJobStreamOutputInit(
 			break
 		}
 
+		if !since.IsZero() {
+			events = filterJobEvents(events, since)
+			if len(events) == 0 {
+				continue
+			}
+		}
+
 		if err := server.Send(&pb.GetJobStreamResponse{
 			Event: &pb.GetJobStreamResponse_Terminal_{
 				Terminal: &pb.GetJobStreamResponse_Terminal{
@@ -357,3 +392,54 @@ func (s *service) getJobStreamOutputInit(
 
 	return eventsCh, nil
 }
+
+// jobStreamDrainWait is how long getJobStreamOutputDrain waits for more
+// output before it considers the output drained.
+var jobStreamDrainWait = 100 * time.Millisecond
+
+// getJobStreamOutputDrain sends down the output from eventsCh until there
+// is no more for jobStreamDrainWait. This is used once the job is complete
+// so that output still in flight from the reader isn't lost.
+func (s *service) getJobStreamOutputDrain(
+	eventsCh <-chan []*pb.GetJobStreamResponse_Terminal_Event,
+	server pb.Waypoint_GetJobStreamServer,
+) error {
+	for {
+		select {
+		case events := <-eventsCh:
+			if err := server.Send(&pb.GetJobStreamResponse{
+				Event: &pb.GetJobStreamResponse_Terminal_{
+					Terminal: &pb.GetJobStreamResponse_Terminal{
+						Events: events,
+					},
+				},
+			}); err != nil {
+				return err
+			}
+
+		case <-time.After(jobStreamDrainWait):
+			return nil
+		}
+	}
+}
+
+// filterJobEvents returns the events from since on. Events without a
+// timestamp are kept.
+func filterJobEvents(
+	events []*pb.GetJobStreamResponse_Terminal_Event,
+	since time.Time,
+) []*pb.GetJobStreamResponse_Terminal_Event {
+	result := events[:0:0]
+	for _, ev := range events {
+		if ev.Timestamp != nil {
+			ts, err := ptypes.Timestamp(ev.Timestamp)
+			if err == nil && ts.Before(since) {
+				continue
+			}
+		}
+
+		result = append(result, ev)
+	}
+
+	return result
+}
@@ -347,6 +347,107 @@ func TestServiceGetJobStream_bufferedData(t *testing.T) {
 	}
 }
 
+func TestServiceGetJobStream_backlog(t *testing.T) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(err)
+	client := server.TestServer(t, impl)
+
+	// Initialize our app
+	TestApp(t, client, serverptypes.TestJobNew(t, nil).Application)
+
+	// Create a job
+	queueResp, err := client.QueueJob(ctx, &pb.QueueJobRequest{Job: serverptypes.TestJobNew(t, nil)})
+	require.NoError(err)
+
+	// Register our runner
+	id, _ := TestRunner(t, client, nil)
+
+	// Start a job request
+	runnerStream, err := client.RunnerJobStream(ctx)
+	require.NoError(err)
+	require.NoError(runnerStream.Send(&pb.RunnerJobStreamRequest{
+		Event: &pb.RunnerJobStreamRequest_Request_{
+			Request: &pb.RunnerJobStreamRequest_Request{
+				RunnerId: id,
+			},
+		},
+	}))
+
+	// Wait for assignment and ack
+	{
+		resp, err := runnerStream.Recv()
+		require.NoError(err)
+		_, ok := resp.Event.(*pb.RunnerJobStreamResponse_Assignment)
+		require.True(ok, "should be an assignment")
+
+		require.NoError(runnerStream.Send(&pb.RunnerJobStreamRequest{
+			Event: &pb.RunnerJobStreamRequest_Ack_{
+				Ack: &pb.RunnerJobStreamRequest_Ack{},
+			},
+		}))
+	}
+
+	// Send some output a second apart, leaving the job running
+	start := time.Now().Add(-time.Minute)
+	var events []*pb.GetJobStreamResponse_Terminal_Event
+	for i, msg := range []string{"one", "two", "three"} {
+		events = append(events, &pb.GetJobStreamResponse_Terminal_Event{
+			Timestamp: testTimestamp(t, start.Add(time.Duration(i)*time.Second)),
+			Event: &pb.GetJobStreamResponse_Terminal_Event_Line_{
+				Line: &pb.GetJobStreamResponse_Terminal_Event_Line{Msg: msg},
+			},
+		})
+	}
+	require.NoError(runnerStream.Send(&pb.RunnerJobStreamRequest{
+		Event: &pb.RunnerJobStreamRequest_Terminal{
+			Terminal: &pb.GetJobStreamResponse_Terminal{Events: events},
+		},
+	}))
+
+	// readAll reads the lines of a stream that doesn't follow the job.
+	readAll := func(req *pb.GetJobStreamRequest) []string {
+		req.JobId = queueResp.JobId
+		req.NoFollow = true
+		stream, err := client.GetJobStream(ctx, req)
+		require.NoError(err)
+
+		var result []string
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return result
+			}
+			require.NoError(err)
+
+			if event, ok := resp.Event.(*pb.GetJobStreamResponse_Terminal_); ok {
+				for _, ev := range event.Terminal.Events {
+					result = append(result, ev.Event.(*pb.GetJobStreamResponse_Terminal_Event_Line_).Line.Msg)
+				}
+			}
+		}
+	}
+
+	// The output may take a moment to be written to the buffer
+	require.Eventually(func() bool {
+		return len(readAll(&pb.GetJobStreamRequest{})) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal([]string{"two", "three"}, readAll(&pb.GetJobStreamRequest{
+		LimitBacklog: 2,
+	}))
+	require.Equal([]string{"two", "three"}, readAll(&pb.GetJobStreamRequest{
+		Since: testTimestamp(t, start.Add(time.Second)),
+	}))
+	require.Equal([]string{"three"}, readAll(&pb.GetJobStreamRequest{
+		Since:        testTimestamp(t, start.Add(time.Second)),
+		LimitBacklog: 1,
+	}))
+}
+
 func TestServiceGetJobStream_expired(t *testing.T) {
 	ctx := context.Background()
 	require := require.New(t)
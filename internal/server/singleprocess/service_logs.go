@@ -1,14 +1,20 @@
 package singleprocess
 
 import (
+	"context"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/logbuffer"
+	"github.com/hashicorp/waypoint/internal/server/logviewer"
 	"github.com/hashicorp/waypoint/internal/server/singleprocess/state"
 )
 
@@ -22,11 +28,53 @@ func (s *service) GetLogStream(
 ) error {
 	log := hclog.FromContext(srv.Context())
 
+	// Parse our time range. If since is set the caller wants everything
+	// since then, so unless they set a limit we read the full backlog.
+	var since, until time.Time
+	if req.Since != nil {
+		t, err := ptypes.Timestamp(req.Since)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid since: %s", err)
+		}
+
+		since = t
+		if req.LimitBacklog == 0 {
+			req.LimitBacklog = -1
+		}
+	}
+	if req.Until != nil {
+		t, err := ptypes.Timestamp(req.Until)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid until: %s", err)
+		}
+
+		until = t
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return status.Errorf(codes.InvalidArgument, "until must not be before since")
+	}
+
+	filter, err := logviewer.NewFilter(req.Filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %s", err)
+	}
+
 	// Default the limit
 	if req.LimitBacklog == 0 {
 		req.LimitBacklog = defaultLogLimitBacklog
 	}
 
+	// If we're not following or until is in the past we only send the
+	// backlog and then end the stream. Otherwise we tail, until then if
+	// it is set.
+	history := req.NoFollow || (!until.IsZero() && !until.After(time.Now()))
+	ctx := srv.Context()
+	if !until.IsZero() && !history {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, until)
+		defer cancel()
+	}
+
 	var instanceFunc func(ws memdb.WatchSet) ([]*state.Instance, error)
 	switch scope := req.Scope.(type) {
 	case *pb.GetLogStreamRequest_DeploymentId:
@@ -70,9 +118,13 @@ func (s *service) GetLogStream(
 	var instanceSetLock sync.Mutex
 	instanceSet := make(map[string]struct{})
 
+	// readers tracks the running readers so that we can wait for them to
+	// finish sending before ending a stream with a time range.
+	var readers sync.WaitGroup
+
 	// We loop forever so that we can automatically get any new instances that
 	// join as we have an open log stream.
-	for {
+	for first := true; ; first = false {
 		// Get all our records
 		ws := memdb.NewWatchSet()
 		records, err := instanceFunc(ws)
@@ -81,6 +133,23 @@ func (s *service) GetLogStream(
 		}
 		log.Trace("instances loaded", "len", len(records))
 
+		// If we're only streaming some instances, they must exist when we
+		// start so that a typo isn't a stream that never shows anything.
+		if len(req.InstanceIds) > 0 {
+			if first {
+				if err := checkLogInstances(records, req.InstanceIds); err != nil {
+					return err
+				}
+			}
+
+			records = filterLogInstances(records, req.InstanceIds)
+		}
+
+		if req.EndWhenEmpty && len(records) == 0 {
+			readers.Wait()
+			return nil
+		}
+
 		// For each record, start a goroutine that reads the log entries and sends them.
 		for _, record := range records {
 			instanceId := record.Id
@@ -95,48 +164,164 @@ func (s *service) GetLogStream(
 				continue
 			}
 
+			// Look up the sequence of the deployment for the batches. This
+			// is only informational, so we don't fail if we can't.
+			var deploymentSeq uint64
+			if dep, err := s.state.DeploymentGet(&pb.Ref_Operation{
+				Target: &pb.Ref_Operation_Id{Id: deploymentId},
+			}); err == nil {
+				deploymentSeq = dep.Sequence
+			}
+
 			// Start our reader up
 			r := record.LogBuffer.Reader(req.LimitBacklog)
 			instanceLog := log.With("instance_id", instanceId)
 			instanceLog.Trace("instance log stream starting")
-			go r.CloseContext(srv.Context())
+			go r.CloseContext(ctx)
+			readers.Add(1)
 			go func() {
+				defer readers.Done()
 				defer instanceLog.Debug("instance log stream ending")
+
+				// If we reached until we keep the instance in the set so
+				// that we don't start reading it again.
+				var done bool
 				defer func() {
+					if done {
+						return
+					}
+
 					instanceSetLock.Lock()
 					defer instanceSetLock.Unlock()
 					delete(instanceSet, instanceId)
 				}()
 
-				for {
-					entries := r.Read(64, true)
+				for !done {
+					entries := r.Read(64, !history)
 					if entries == nil {
 						return
 					}
 
-					lines := make([]*pb.LogBatch_Entry, len(entries))
-					for i, v := range entries {
-						lines[i] = v.(*pb.LogBatch_Entry)
+					var lines []*pb.LogBatch_Entry
+					lines, done = filterLogEntries(entries, since, until, filter)
+					if len(lines) == 0 {
+						continue
 					}
 
-					instanceLog.Trace("sending instance log data", "entries", len(entries))
+					instanceLog.Trace("sending instance log data", "entries", len(lines))
 					srv.Send(&pb.LogBatch{
-						DeploymentId: deploymentId,
-						InstanceId:   instanceId,
-						Lines:        lines,
+						DeploymentId:       deploymentId,
+						DeploymentSequence: deploymentSeq,
+						InstanceId:         instanceId,
+						Lines:              lines,
 					})
 				}
 			}()
 		}
 
+		// If we're only sending the backlog, we're done once it is sent.
+		if history {
+			readers.Wait()
+			return nil
+		}
+
 		// Wait for changes or to be done
-		if err := ws.WatchCtx(srv.Context()); err != nil {
+		if err := ws.WatchCtx(ctx); err != nil {
 			// If our context ended, exit with that
 			if err := srv.Context().Err(); err != nil {
 				return err
 			}
 
+			// We reached until, so end the stream once the readers
+			// have sent what they have.
+			if ctx.Err() == context.DeadlineExceeded {
+				readers.Wait()
+				return nil
+			}
+
 			return err
 		}
 	}
 }
+
+// checkLogInstances returns an error if an ID of ids doesn't match exactly
+// one of records. See filterLogInstances for how IDs are matched.
+func checkLogInstances(records []*state.Instance, ids []string) error {
+	for _, id := range ids {
+		var matches []string
+		for _, record := range records {
+			if matchLogInstance(record.Id, id) {
+				matches = append(matches, record.Id)
+			}
+		}
+
+		if len(matches) == 0 {
+			return status.Errorf(codes.NotFound,
+				"instance %q isn't one of the instances being logged", id)
+		}
+
+		if len(matches) > 1 {
+			return status.Errorf(codes.InvalidArgument,
+				"instance %q matches more than one instance: %s",
+				id, strings.Join(matches, ", "))
+		}
+	}
+
+	return nil
+}
+
+// filterLogInstances returns the records of the instances with one of ids.
+// An instance matches an ID that is its full ID or the end of it.
+func filterLogInstances(records []*state.Instance, ids []string) []*state.Instance {
+	var result []*state.Instance
+	for _, record := range records {
+		for _, id := range ids {
+			if matchLogInstance(record.Id, id) {
+				result = append(result, record)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func matchLogInstance(instanceId, id string) bool {
+	return id != "" && strings.HasSuffix(instanceId, id)
+}
+
+// filterLogEntries returns the entries logged between since and until,
+// either of which may be zero for no bound, that match filter. Entries
+// without a timestamp are never filtered by time. done is true once an
+// entry after until is seen, since entries are in the order they were
+// logged.
+func filterLogEntries(
+	entries []logbuffer.Entry,
+	since, until time.Time,
+	filter *logviewer.Filter,
+) (lines []*pb.LogBatch_Entry, done bool) {
+	lines = make([]*pb.LogBatch_Entry, 0, len(entries))
+	for _, v := range entries {
+		entry := v.(*pb.LogBatch_Entry)
+		if entry.Timestamp != nil && (!since.IsZero() || !until.IsZero()) {
+			t, err := ptypes.Timestamp(entry.Timestamp)
+			if err == nil {
+				if !since.IsZero() && t.Before(since) {
+					continue
+				}
+
+				if !until.IsZero() && t.After(until) {
+					return lines, true
+				}
+			}
+		}
+
+		if !filter.MatchEntry(entry) {
+			continue
+		}
+
+		lines = append(lines, entry)
+	}
+
+	return lines, false
+}
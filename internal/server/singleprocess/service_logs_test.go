@@ -2,14 +2,21 @@ package singleprocess
 
 import (
 	"context"
+	"io"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hashicorp/waypoint/internal/server"
 	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/server/logbuffer"
+	"github.com/hashicorp/waypoint/internal/server/logviewer"
 	serverptypes "github.com/hashicorp/waypoint/internal/server/ptypes"
 )
 
@@ -77,6 +84,8 @@ func TestServiceGetLogStream(t *testing.T) {
 	require.NoError(err)
 	require.NotEmpty(batch.Lines)
 	require.Len(batch.Lines, 25)
+	require.Equal(dep.Id, batch.DeploymentId)
+	require.Equal(dep.Sequence, batch.DeploymentSequence)
 }
 
 func TestServiceGetLogStream_byApp(t *testing.T) {
@@ -158,3 +167,451 @@ func TestServiceGetLogStream_byApp(t *testing.T) {
 	require.NotEmpty(batch.Lines)
 	require.Len(batch.Lines, 25)
 }
+
+func TestServiceGetLogStream_noFollow(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Register our instances
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Component: &pb.Component{
+				Name: "testapp",
+			},
+		}),
+	})
+
+	require.NoError(t, err)
+
+	dep := resp.Deployment
+	for _, id := range []string{"1", "2"} {
+		configClient, err := client.EntrypointConfig(ctx, &pb.EntrypointConfigRequest{
+			DeploymentId: dep.Id,
+			InstanceId:   id,
+		})
+		require.NoError(t, err)
+		_, err = configClient.Recv()
+		require.NoError(t, err)
+	}
+
+	require := require.New(t)
+
+	// Send 25 lines to each instance
+	logSendClient, err := client.EntrypointLogStream(ctx)
+	require.NoError(err)
+	for _, id := range []string{"1", "2"} {
+		var entries []*pb.LogBatch_Entry
+		for i := 0; i < 25; i++ {
+			entries = append(entries, &pb.LogBatch_Entry{
+				Line: strconv.Itoa(i),
+			})
+		}
+
+		require.NoError(logSendClient.Send(&pb.EntrypointLogBatch{
+			InstanceId: id,
+			Lines:      entries,
+		}))
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	// Connect to the stream and get the last 10 lines of each instance
+	logRecvClient, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+		Scope: &pb.GetLogStreamRequest_DeploymentId{
+			DeploymentId: dep.Id,
+		},
+		LimitBacklog: 10,
+		NoFollow:     true,
+	})
+	require.NoError(err)
+
+	lines := make(map[string][]string)
+	for {
+		batch, err := logRecvClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+
+		for _, line := range batch.Lines {
+			lines[batch.InstanceId] = append(lines[batch.InstanceId], line.Line)
+		}
+	}
+
+	expected := []string{"15", "16", "17", "18", "19", "20", "21", "22", "23", "24"}
+	require.Equal(map[string][]string{"1": expected, "2": expected}, lines)
+}
+
+func TestServiceGetLogStream_instances(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Register our instances
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Component: &pb.Component{
+				Name: "testapp",
+			},
+		}),
+	})
+
+	require.NoError(t, err)
+
+	dep := resp.Deployment
+	for _, id := range []string{"web-01", "web-11"} {
+		configClient, err := client.EntrypointConfig(ctx, &pb.EntrypointConfigRequest{
+			DeploymentId: dep.Id,
+			InstanceId:   id,
+		})
+		require.NoError(t, err)
+		_, err = configClient.Recv()
+		require.NoError(t, err)
+	}
+
+	// Send a line to each instance
+	logSendClient, err := client.EntrypointLogStream(ctx)
+	require.NoError(t, err)
+	for _, id := range []string{"web-01", "web-11"} {
+		require.NoError(t, logSendClient.Send(&pb.EntrypointLogBatch{
+			InstanceId: id,
+			Lines:      []*pb.LogBatch_Entry{{Line: "hello from " + id}},
+		}))
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	logs := func(ids ...string) ([]*pb.LogBatch, error) {
+		logRecvClient, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+			Scope: &pb.GetLogStreamRequest_DeploymentId{
+				DeploymentId: dep.Id,
+			},
+			InstanceIds: ids,
+			NoFollow:    true,
+		})
+		require.NoError(t, err)
+
+		var result []*pb.LogBatch
+		for {
+			batch, err := logRecvClient.Recv()
+			if err == io.EOF {
+				return result, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, batch)
+		}
+	}
+
+	t.Run("full ID", func(t *testing.T) {
+		batches, err := logs("web-11")
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+		require.Equal(t, "web-11", batches[0].InstanceId)
+		require.Equal(t, "hello from web-11", batches[0].Lines[0].Line)
+	})
+
+	t.Run("short ID", func(t *testing.T) {
+		batches, err := logs("01")
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+		require.Equal(t, "web-01", batches[0].InstanceId)
+	})
+
+	t.Run("unknown instance", func(t *testing.T) {
+		_, err := logs("web-01", "web-03")
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.Contains(t, err.Error(), "web-03")
+	})
+
+	t.Run("ambiguous instance", func(t *testing.T) {
+		_, err := logs("1")
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestServiceGetLogStream_endWhenEmpty(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Register our instances
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Component: &pb.Component{
+				Name: "testapp",
+			},
+		}),
+	})
+
+	require.NoError(t, err)
+
+	dep := resp.Deployment
+	instanceCtx, instanceCancel := context.WithCancel(ctx)
+	defer instanceCancel()
+	configClient, err := client.EntrypointConfig(instanceCtx, &pb.EntrypointConfigRequest{
+		DeploymentId: dep.Id,
+		InstanceId:   "1",
+	})
+	require.NoError(t, err)
+	_, err = configClient.Recv()
+	require.NoError(t, err)
+
+	require := require.New(t)
+
+	logRecvClient, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+		Scope: &pb.GetLogStreamRequest_DeploymentId{
+			DeploymentId: dep.Id,
+		},
+		EndWhenEmpty: true,
+	})
+	require.NoError(err)
+
+	// The stream ends once the instance is gone
+	doneCh := make(chan error, 1)
+	go func() {
+		_, err := logRecvClient.Recv()
+		doneCh <- err
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("stream should not end while the instance is running")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	instanceCancel()
+	select {
+	case err := <-doneCh:
+		require.Equal(io.EOF, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream should end")
+	}
+
+	// A stream with no instances ends right away
+	logRecvClient, err = client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+		Scope: &pb.GetLogStreamRequest_DeploymentId{
+			DeploymentId: dep.Id,
+		},
+		EndWhenEmpty: true,
+	})
+	require.NoError(err)
+	_, err = logRecvClient.Recv()
+	require.Equal(io.EOF, err)
+}
+
+func TestServiceGetLogStream_timeRange(t *testing.T) {
+	ctx := context.Background()
+
+	// Create our server
+	impl, err := New(WithDB(testDB(t)))
+	require.NoError(t, err)
+	client := server.TestServer(t, impl)
+
+	// Register our instances
+	resp, err := client.UpsertDeployment(ctx, &pb.UpsertDeploymentRequest{
+		Deployment: serverptypes.TestValidDeployment(t, &pb.Deployment{
+			Component: &pb.Component{
+				Name: "testapp",
+			},
+		}),
+	})
+
+	require.NoError(t, err)
+
+	dep := resp.Deployment
+	configClient, err := client.EntrypointConfig(ctx, &pb.EntrypointConfigRequest{
+		DeploymentId: dep.Id,
+		InstanceId:   "1",
+	})
+	require.NoError(t, err)
+	_, err = configClient.Recv()
+	require.NoError(t, err)
+
+	require := require.New(t)
+
+	// Send a line a minute for the last 10 minutes
+	start := time.Now().Add(-10 * time.Minute)
+	logSendClient, err := client.EntrypointLogStream(ctx)
+	require.NoError(err)
+	var entries []*pb.LogBatch_Entry
+	for i := 0; i < 10; i++ {
+		entries = append(entries, &pb.LogBatch_Entry{
+			Timestamp: testTimestamp(t, start.Add(time.Duration(i)*time.Minute)),
+			Line:      strconv.Itoa(i),
+		})
+	}
+	require.NoError(logSendClient.Send(&pb.EntrypointLogBatch{
+		InstanceId: "1",
+		Lines:      entries,
+	}))
+	time.Sleep(100 * time.Millisecond)
+
+	// recvAll reads lines until the stream ends.
+	recvAll := func(c pb.Waypoint_GetLogStreamClient) []string {
+		var lines []string
+		for {
+			batch, err := c.Recv()
+			if err == io.EOF {
+				return lines
+			}
+			require.NoError(err)
+
+			for _, line := range batch.Lines {
+				lines = append(lines, line.Line)
+			}
+		}
+	}
+
+	t.Run("until in the past ends after the backlog", func(t *testing.T) {
+		logRecvClient, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+			Scope: &pb.GetLogStreamRequest_DeploymentId{
+				DeploymentId: dep.Id,
+			},
+			Since: testTimestamp(t, start.Add(2*time.Minute)),
+			Until: testTimestamp(t, start.Add(5*time.Minute)),
+		})
+		require.NoError(err)
+		require.Equal([]string{"2", "3", "4", "5"}, recvAll(logRecvClient))
+	})
+
+	t.Run("until in the future tails until then", func(t *testing.T) {
+		logRecvClient, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+			Scope: &pb.GetLogStreamRequest_DeploymentId{
+				DeploymentId: dep.Id,
+			},
+			Since: testTimestamp(t, start.Add(8*time.Minute)),
+			Until: testTimestamp(t, time.Now().Add(500*time.Millisecond)),
+		})
+		require.NoError(err)
+
+		// A line logged while we tail
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(logSendClient.Send(&pb.EntrypointLogBatch{
+			InstanceId: "1",
+			Lines: []*pb.LogBatch_Entry{
+				{Timestamp: testTimestamp(t, time.Now()), Line: "live"},
+			},
+		}))
+
+		require.Equal([]string{"8", "9", "live"}, recvAll(logRecvClient))
+	})
+
+	t.Run("until before since", func(t *testing.T) {
+		logRecvClient, err := client.GetLogStream(ctx, &pb.GetLogStreamRequest{
+			Scope: &pb.GetLogStreamRequest_DeploymentId{
+				DeploymentId: dep.Id,
+			},
+			Since: testTimestamp(t, start.Add(5*time.Minute)),
+			Until: testTimestamp(t, start),
+		})
+		require.NoError(err)
+
+		_, err = logRecvClient.Recv()
+		require.Error(err)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestFilterLogEntries(t *testing.T) {
+	start := time.Now()
+	var entries []logbuffer.Entry
+	for i := 0; i < 5; i++ {
+		entries = append(entries, &pb.LogBatch_Entry{
+			Timestamp: testTimestamp(t, start.Add(time.Duration(i)*time.Second)),
+			Line:      strconv.Itoa(i),
+		})
+	}
+	entries = append(entries, &pb.LogBatch_Entry{Line: "none"})
+
+	cases := []struct {
+		Name   string
+		Since  time.Time
+		Until  time.Time
+		Filter *logviewer.Filter
+		Lines  []string
+		Done   bool
+	}{
+		{
+			"no range",
+			time.Time{},
+			time.Time{},
+			nil,
+			[]string{"0", "1", "2", "3", "4", "none"},
+			false,
+		},
+
+		{
+			"since",
+			start.Add(3 * time.Second),
+			time.Time{},
+			nil,
+			[]string{"3", "4", "none"},
+			false,
+		},
+
+		{
+			"until",
+			time.Time{},
+			start.Add(time.Second),
+			nil,
+			[]string{"0", "1"},
+			true,
+		},
+
+		{
+			"since and until",
+			start.Add(time.Second),
+			start.Add(2 * time.Second),
+			nil,
+			[]string{"1", "2"},
+			true,
+		},
+
+		{
+			"filter",
+			time.Time{},
+			start.Add(3 * time.Second),
+			testLogFilter(t, &pb.GetLogStreamRequest_Filter{Regexps: []string{"^[13]$"}}),
+			[]string{"1", "3"},
+			true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			lines, done := filterLogEntries(entries, tt.Since, tt.Until, tt.Filter)
+			var got []string
+			for _, line := range lines {
+				got = append(got, line.Line)
+			}
+
+			require.Equal(t, tt.Lines, got)
+			require.Equal(t, tt.Done, done)
+		})
+	}
+}
+
+func testLogFilter(t *testing.T, f *pb.GetLogStreamRequest_Filter) *logviewer.Filter {
+	filter, err := logviewer.NewFilter(f)
+	require.NoError(t, err)
+	return filter
+}
+
+func testTimestamp(t *testing.T, v time.Time) *timestamp.Timestamp {
+	ts, err := ptypes.TimestampProto(v)
+	require.NoError(t, err)
+	return ts
+}
@@ -1,6 +1,10 @@
 package state
 
 import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -97,10 +101,19 @@ type Instance struct {
 	Project      string
 	Application  string
 	Workspace    string
+	Labels       map[string]string
+	AllocationId string
+	Tasks        []string
+	RegisteredAt time.Time
 	LogBuffer    *logbuffer.Buffer
 }
 
 func (i *Instance) Proto() *pb.Instance {
+	var registeredAt *timestamp.Timestamp
+	if !i.RegisteredAt.IsZero() {
+		registeredAt, _ = ptypes.TimestampProto(i.RegisteredAt)
+	}
+
 	return &pb.Instance{
 		Id:           i.Id,
 		DeploymentId: i.DeploymentId,
@@ -111,6 +124,10 @@ func (i *Instance) Proto() *pb.Instance {
 		Workspace: &pb.Ref_Workspace{
 			Workspace: i.Workspace,
 		},
+		Labels:       i.Labels,
+		AllocationId: i.AllocationId,
+		Tasks:        i.Tasks,
+		RegisteredAt: registeredAt,
 	}
 }
 
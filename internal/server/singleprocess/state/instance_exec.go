@@ -1,6 +1,7 @@
 package state
 
 import (
+	"strings"
 	"sync/atomic"
 
 	"github.com/hashicorp/go-memdb"
@@ -52,8 +53,53 @@ type InstanceExec struct {
 	Id         int64
 	InstanceId string
 
-	Args []string
-	Pty  *pb.ExecStreamRequest_PTY
+	// SessionId is the ID of the session that clients and logs use.
+	SessionId string
+
+	Args            []string
+	Pty             *pb.ExecStreamRequest_PTY
+	KillGracePeriod string
+	Limits          *pb.ExecStreamRequest_Limits
+	TargetContainer bool
+	StatsInterval   string
+	OrphanPolicy    pb.ExecStreamRequest_OrphanPolicy
+	NoStdin         bool
+	Script          []byte
+	Interpreter     string
+	CopyTo          *pb.ExecStreamRequest_CopyTo
+	CopyFrom        *pb.ExecStreamRequest_CopyFrom
+	PortForward     *pb.ExecStreamRequest_PortForward
+	Container       string
+	Task            string
+	NoInjectEnv     bool
+
+	// ClientCapabilities are the optional features of the exec protocol
+	// that the client supports.
+	ClientCapabilities []string
+
+	// Env are the environment variables the client asked for, set after
+	// the ones we inject.
+	Env []string
+
+	// Sequence are the commands to run after Args and KeepGoing whether
+	// to run them after one fails.
+	Sequence  []*pb.ExecStreamRequest_Command
+	KeepGoing bool
+
+	// MirrorToLogs writes the output of the command to the logs as well.
+	MirrorToLogs bool
+
+	// OutputChunkSize is the most output the entrypoint sends at a time,
+	// or zero for its default.
+	OutputChunkSize uint32
+
+	// Resume, if set, resumes a session on the instance that lost its
+	// entrypoint stream instead of starting a new command.
+	Resume *pb.EntrypointConfig_Exec_Resume
+
+	// Output is set for detached sessions and buffers their output since
+	// no client is attached to receive it.
+	Output *InstanceExecOutput
 
 	ClientEventCh     <-chan *pb.ExecStreamRequest
 	EntrypointEventCh chan<- *pb.EntrypointExecRequest
@@ -116,6 +162,36 @@ func (s *State) InstanceExecCreateByDeployment(did string, exec *InstanceExec) e
 	return nil
 }
 
+// InstanceExecCreateByInstance creates an exec session on the instance
+// with the given ID. The instance must belong to the given deployment.
+func (s *State) InstanceExecCreateByInstance(did, iid string, exec *InstanceExec) error {
+	txn := s.inmem.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(instanceTableName, instanceIdIndexName, iid)
+	if err != nil {
+		return status.Errorf(codes.Aborted, err.Error())
+	}
+	if raw == nil || !strings.EqualFold(raw.(*Instance).DeploymentId, did) {
+		return status.Errorf(codes.NotFound,
+			"Instance %q not found for this deployment.", iid)
+	}
+
+	// Set the instance ID that we'll be using
+	exec.InstanceId = raw.(*Instance).Id
+
+	// Set our ID
+	exec.Id = atomic.AddInt64(&instanceExecId, 1)
+
+	// Insert
+	if err := txn.Insert(instanceExecTableName, exec); err != nil {
+		return status.Errorf(codes.Aborted, err.Error())
+	}
+	txn.Commit()
+
+	return nil
+}
+
 func (s *State) InstanceExecDelete(id int64) error {
 	txn := s.inmem.Txn(true)
 	defer txn.Abort()
@@ -0,0 +1,129 @@
+package state
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// InstanceExecOutput buffers the events of a detached exec session so
+// they can be read once a client attaches. At most max bytes of output
+// are kept; once full, the oldest output is discarded first.
+type InstanceExecOutput struct {
+	lock     sync.Mutex
+	max      int
+	size     int
+	dropped  int64
+	first    int64
+	events   []*pb.ExecStreamResponse
+	exit     *pb.ExecStreamResponse_Exit
+	err      error
+	done     bool
+	notifyCh chan struct{}
+}
+
+// NewInstanceExecOutput returns a buffer that keeps at most max bytes
+// of output.
+func NewInstanceExecOutput(max int) *InstanceExecOutput {
+	return &InstanceExecOutput{max: max, notifyCh: make(chan struct{})}
+}
+
+// Write appends an event to the buffer. Exit events are recorded
+// separately and end the session, see Exit.
+func (o *InstanceExecOutput) Write(event *pb.ExecStreamResponse) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if o.done {
+		return
+	}
+	defer o.notify()
+
+	if exit, ok := event.Event.(*pb.ExecStreamResponse_Exit_); ok {
+		o.exit = exit.Exit
+		o.done = true
+		return
+	}
+
+	o.events = append(o.events, event)
+	o.size += instanceExecEventSize(event)
+
+	// Discard the oldest events until we're within our limit. We always
+	// keep the newest event even if it alone is over the limit.
+	for o.size > o.max && len(o.events) > 1 {
+		n := instanceExecEventSize(o.events[0])
+		o.size -= n
+		o.dropped += int64(n)
+		o.first++
+		o.events[0] = nil
+		o.events = o.events[1:]
+	}
+}
+
+// Close ends the session without an exit event, such as when the
+// instance disconnects. err is the reason, if any.
+func (o *InstanceExecOutput) Close(err error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if !o.done {
+		o.err = err
+		o.done = true
+		o.notify()
+	}
+}
+
+// Events returns the buffered events and the number of bytes of events
+// that were discarded because the buffer was full.
+func (o *InstanceExecOutput) Events() ([]*pb.ExecStreamResponse, int64) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	result := make([]*pb.ExecStreamResponse, len(o.events))
+	copy(result, o.events)
+	return result, o.dropped
+}
+
+// Read returns the buffered events starting at index idx, where the
+// first event ever written is index zero, along with the index to read
+// from next. If events before idx were discarded, this starts at the
+// oldest event still buffered. The returned channel is closed once there
+// are more events to read or the session ends.
+func (o *InstanceExecOutput) Read(idx int64) ([]*pb.ExecStreamResponse, int64, <-chan struct{}) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if idx < o.first {
+		idx = o.first
+	}
+
+	var result []*pb.ExecStreamResponse
+	if offset := idx - o.first; offset < int64(len(o.events)) {
+		result = make([]*pb.ExecStreamResponse, int64(len(o.events))-offset)
+		copy(result, o.events[offset:])
+	}
+
+	return result, idx + int64(len(result)), o.notifyCh
+}
+
+// Exit returns the exit event and any error that ended the session.
+// done is false if the session is still running.
+func (o *InstanceExecOutput) Exit() (exit *pb.ExecStreamResponse_Exit, err error, done bool) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return o.exit, o.err, o.done
+}
+
+// notify wakes up any readers waiting for changes. This must be called
+// with the lock held.
+func (o *InstanceExecOutput) notify() {
+	close(o.notifyCh)
+	o.notifyCh = make(chan struct{})
+}
+
+// instanceExecEventSize is the size of an event for the buffer limit.
+func instanceExecEventSize(event *pb.ExecStreamResponse) int {
+	return proto.Size(event)
+}
@@ -0,0 +1,107 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestInstanceExecOutput(t *testing.T) {
+	output := func(v string) *pb.ExecStreamResponse {
+		return &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Output_{
+				Output: &pb.ExecStreamResponse_Output{Data: []byte(v)},
+			},
+		}
+	}
+
+	t.Run("discards the oldest output", func(t *testing.T) {
+		require := require.New(t)
+
+		size := proto.Size(output("aaaa"))
+		o := NewInstanceExecOutput(size * 2)
+		o.Write(output("aaaa"))
+		o.Write(output("bbbb"))
+		o.Write(output("cccc"))
+
+		events, dropped := o.Events()
+		require.Len(events, 2)
+		require.Equal(int64(size), dropped)
+		require.Equal([]byte("bbbb"), events[0].Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+		require.Equal([]byte("cccc"), events[1].Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+	})
+
+	t.Run("exit", func(t *testing.T) {
+		require := require.New(t)
+
+		o := NewInstanceExecOutput(1024)
+		o.Write(output("hello"))
+		_, _, done := o.Exit()
+		require.False(done)
+
+		o.Write(&pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Exit_{
+				Exit: &pb.ExecStreamResponse_Exit{Code: 2},
+			},
+		})
+
+		// Nothing is written after the exit
+		o.Write(output("ignored"))
+		o.Close(errors.New("ignored"))
+
+		exit, err, done := o.Exit()
+		require.True(done)
+		require.NoError(err)
+		require.Equal(int32(2), exit.Code)
+
+		events, _ := o.Events()
+		require.Len(events, 1)
+	})
+}
+
+func TestInstanceExecOutput_Read(t *testing.T) {
+	require := require.New(t)
+
+	output := func(v string) *pb.ExecStreamResponse {
+		return &pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Output_{
+				Output: &pb.ExecStreamResponse_Output{Data: []byte(v)},
+			},
+		}
+	}
+
+	o := NewInstanceExecOutput(proto.Size(output("aaaa")) * 2)
+	o.Write(output("aaaa"))
+
+	// Read what we have
+	events, next, ch := o.Read(0)
+	require.Len(events, 1)
+	require.Equal(int64(1), next)
+
+	// Nothing new yet
+	events, next, ch = o.Read(next)
+	require.Empty(events)
+	require.Equal(int64(1), next)
+	select {
+	case <-ch:
+		t.Fatal("should not be notified")
+	default:
+	}
+
+	// Writing notifies us
+	o.Write(output("bbbb"))
+	o.Write(output("cccc"))
+	<-ch
+	events, next, _ = o.Read(next)
+	require.Len(events, 2)
+	require.Equal(int64(3), next)
+
+	// Reading from the start skips what was discarded
+	events, _, _ = o.Read(0)
+	require.Len(events, 2)
+	require.Equal([]byte("bbbb"), events[0].Event.(*pb.ExecStreamResponse_Output_).Output.Data)
+}
@@ -23,6 +23,33 @@ func TestInstanceExecCreateByDeploymentId_invalidDeployment(t *testing.T) {
 	require.Equal(codes.ResourceExhausted, status.Code(err))
 }
 
+func TestInstanceExecCreateByInstance(t *testing.T) {
+	require := require.New(t)
+
+	s := TestState(t)
+	defer s.Close()
+
+	// Create an instance
+	instance := testInstance(t, nil)
+	require.NoError(s.InstanceCreate(instance))
+
+	// Exec on that instance
+	rec := &InstanceExec{}
+	require.NoError(s.InstanceExecCreateByInstance(instance.DeploymentId, instance.Id, rec))
+	require.NotEmpty(rec.Id)
+	require.Equal(instance.Id, rec.InstanceId)
+
+	// Unknown instance
+	err := s.InstanceExecCreateByInstance(instance.DeploymentId, "nope", &InstanceExec{})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// Instance of a different deployment
+	err = s.InstanceExecCreateByInstance("other", instance.Id, &InstanceExec{})
+	require.Error(err)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
 func TestInstanceExecCreateByDeploymentId_valid(t *testing.T) {
 	require := require.New(t)
 
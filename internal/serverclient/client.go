@@ -71,6 +71,7 @@ func Connect(ctx context.Context, opts ...ConnectOption) (*grpc.ClientConn, erro
 
 		grpcOpts = append(grpcOpts, grpc.WithPerRPCCredentials(StaticToken(token)))
 	}
+	grpcOpts = append(grpcOpts, cfg.DialOptions...)
 
 	// Connect to this server
 	return grpc.DialContext(ctx, cfg.Addr, grpcOpts...)
@@ -107,6 +108,7 @@ type connectConfig struct {
 	Token         string
 	Optional      bool // See Optional func
 	Timeout       time.Duration
+	DialOptions   []grpc.DialOption // See DialOptions func
 }
 
 // FromEnv sources the connection information from the environment
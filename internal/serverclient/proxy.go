@@ -0,0 +1,138 @@
+package serverclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DialFunc dials a connection to addr, like net.Dialer.DialContext with
+// "tcp" as the network. This is the dialer given to grpc.WithContextDialer.
+type DialFunc func(ctx context.Context, addr string) (net.Conn, error)
+
+// DialOptions specifies additional gRPC dial options, such as a custom
+// dialer. These are applied after the options Connect builds, so they
+// take precedence. This can be specified multiple times.
+func DialOptions(opts ...grpc.DialOption) ConnectOption {
+	return func(c *connectConfig) error {
+		c.DialOptions = append(c.DialOptions, opts...)
+		return nil
+	}
+}
+
+// ProxyDialer returns a DialFunc that connects through the HTTP proxy
+// given by the HTTPS_PROXY and NO_PROXY environment variables (or their
+// lowercase forms), tunneling with an HTTP CONNECT request. If no proxy
+// applies to the address, it connects directly. dial is used to connect
+// to the proxy or the address; if nil, a net.Dialer is used.
+//
+// gRPC honors these variables by itself, but only when no custom dialer
+// is given. Wrapping a custom dialer with this keeps the proxy working.
+func ProxyDialer(dial DialFunc) DialFunc {
+	return proxyDialer(dial, func(u *url.URL) (*url.URL, error) {
+		return http.ProxyFromEnvironment(&http.Request{URL: u})
+	})
+}
+
+// proxyDialer is ProxyDialer with the function choosing the proxy for a
+// URL, so tests don't depend on the environment, which net/http reads only
+// once.
+func proxyDialer(dial DialFunc, proxy func(*url.URL) (*url.URL, error)) DialFunc {
+	if dial == nil {
+		var d net.Dialer
+		dial = func(ctx context.Context, addr string) (net.Conn, error) {
+			return d.DialContext(ctx, "tcp", addr)
+		}
+	}
+
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		proxyURL, err := proxy(&url.URL{Scheme: "https", Host: addr})
+		if err != nil {
+			return nil, fmt.Errorf("error finding the proxy for %s: %w", addr, err)
+		}
+		if proxyURL == nil {
+			return dial(ctx, addr)
+		}
+
+		proxyAddr := proxyURL.Host
+		if proxyURL.Port() == "" {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "80")
+		}
+
+		conn, err := dial(ctx, proxyAddr)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to proxy %s: %w", proxyURL.Host, err)
+		}
+
+		tunnel, err := proxyConnect(ctx, conn, proxyURL, addr)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		return tunnel, nil
+	}
+}
+
+// proxyConnect asks the proxy on conn to tunnel to addr and returns the
+// tunnel.
+func proxyConnect(ctx context.Context, conn net.Conn, proxyURL *url.URL, addr string) (net.Conn, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if u := proxyURL.User; u != nil {
+		password, _ := u.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+
+			base64.StdEncoding.EncodeToString([]byte(u.Username()+":"+password)))
+	}
+
+	// The proxy may never answer, so we give up on it with the context.
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	if err := req.Write(conn); err != nil {
+		return nil, fmt.Errorf("error sending CONNECT to proxy %s: %w", proxyURL.Host, err)
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response of proxy %s: %w", proxyURL.Host, err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s",
+			proxyURL.Host, addr, resp.Status)
+	}
+
+	// The tunnel starts right after the response. Without TLS the server
+	// speaks first, so its first frames may already be buffered.
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+
+	return conn, nil
+}
+
+// bufferedConn is a net.Conn whose reads start with what is buffered.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
@@ -0,0 +1,269 @@
+package serverclient
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/waypoint/internal/clicontext"
+	"github.com/hashicorp/waypoint/internal/config"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestConnect_proxy(t *testing.T) {
+	require := require.New(t)
+
+	// The server is only reachable through the proxy, which resolves this
+	// name to it.
+	const serverName = "waypoint.test:9701"
+	serverAddr := testProxyServer(t)
+	proxy := testProxy(t, map[string]string{serverName: serverAddr})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := Connect(ctx,
+		FromContextConfig(&clicontext.Config{
+			Server: config.Server{Address: serverName},
+		}),
+		DialOptions(grpc.WithContextDialer(proxyDialer(nil, proxy.For))),
+	)
+	require.NoError(err)
+	defer conn.Close()
+
+	client := pb.NewWaypointClient(conn)
+	resp, err := client.GetVersionInfo(ctx, &empty.Empty{})
+	require.NoError(err)
+	require.Equal("proxied", resp.Info.Version)
+
+	// An exec session streams through the tunnel both ways.
+	stream, err := client.StartExecStream(ctx)
+	require.NoError(err)
+	require.NoError(stream.Send(&pb.ExecStreamRequest{
+		Event: &pb.ExecStreamRequest_Input_{
+			Input: &pb.ExecStreamRequest_Input{Data: []byte("hello")},
+		},
+	}))
+	out, err := stream.Recv()
+	require.NoError(err)
+	require.Equal("hello", string(out.GetOutput().GetData()))
+	require.NoError(stream.CloseSend())
+	_, err = stream.Recv()
+	require.Equal(io.EOF, err)
+
+	require.Equal([]string{serverName}, proxy.Targets())
+	require.True(atomic.LoadInt64(&proxy.up) > 0)
+	require.True(atomic.LoadInt64(&proxy.down) > 0)
+}
+
+func TestProxyDialer(t *testing.T) {
+	t.Run("no proxy", func(t *testing.T) {
+		require := require.New(t)
+
+		ln, err := net.Listen("tcp", "127.0.0.1:")
+		require.NoError(err)
+		defer ln.Close()
+		go func() {
+			if conn, err := ln.Accept(); err == nil {
+				conn.Write([]byte("direct"))
+				conn.Close()
+			}
+		}()
+
+		dial := proxyDialer(nil, func(*url.URL) (*url.URL, error) {
+			return nil, nil
+		})
+		conn, err := dial(context.Background(), ln.Addr().String())
+		require.NoError(err)
+		defer conn.Close()
+
+		data, err := ioutil.ReadAll(conn)
+		require.NoError(err)
+		require.Equal("direct", string(data))
+	})
+
+	t.Run("refused", func(t *testing.T) {
+		require := require.New(t)
+
+		proxy := testProxy(t, nil)
+		dial := proxyDialer(nil, proxy.For)
+		_, err := dial(context.Background(), "waypoint.test:9701")
+		require.Error(err)
+		require.Contains(err.Error(), "refused to connect to waypoint.test:9701: 502 Bad Gateway")
+	})
+
+	t.Run("credentials", func(t *testing.T) {
+		require := require.New(t)
+
+		authCh := make(chan string, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authCh <- r.Header.Get("Proxy-Authorization")
+			w.WriteHeader(http.StatusProxyAuthRequired)
+		}))
+		defer srv.Close()
+
+		proxyURL, err := url.Parse(srv.URL)
+		require.NoError(err)
+		proxyURL.User = url.UserPassword("alice", "secret")
+
+		dial := proxyDialer(nil, func(*url.URL) (*url.URL, error) {
+			return proxyURL, nil
+		})
+		_, err = dial(context.Background(), "waypoint.test:9701")
+		require.Error(err)
+		require.Contains(err.Error(), "407")
+		require.Equal("Basic YWxpY2U6c2VjcmV0", <-authCh)
+	})
+}
+
+// testProxyServer starts a server answering GetVersionInfo and echoing the
+// input of exec sessions, and returns its address.
+func testProxyServer(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:")
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	pb.RegisterWaypointServer(s, &testProxyWaypoint{})
+	go s.Serve(ln)
+	t.Cleanup(s.Stop)
+
+	return ln.Addr().String()
+}
+
+type testProxyWaypoint struct {
+	pb.UnimplementedWaypointServer
+}
+
+func (testProxyWaypoint) GetVersionInfo(
+	context.Context, *empty.Empty,
+) (*pb.GetVersionInfoResponse, error) {
+	return &pb.GetVersionInfoResponse{
+		Info: &pb.VersionInfo{Version: "proxied"},
+	}, nil
+}
+
+func (testProxyWaypoint) StartExecStream(srv pb.Waypoint_StartExecStreamServer) error {
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = srv.Send(&pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Output_{
+				Output: &pb.ExecStreamResponse_Output{
+					Data: req.GetInput().GetData(),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// testHTTPProxy is an HTTP proxy that only supports CONNECT to the
+// addresses it knows, counting the bytes tunneled.
+type testHTTPProxy struct {
+	URL   *url.URL
+	hosts map[string]string
+
+	// up and down are the bytes tunneled to and from the server.
+	up, down int64
+
+	lock    sync.Mutex
+	targets []string
+}
+
+func testProxy(t *testing.T, hosts map[string]string) *testHTTPProxy {
+	p := &testHTTPProxy{hosts: hosts}
+	srv := httptest.NewServer(p)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	p.URL = u
+	return p
+}
+
+// For is the proxy function for proxyDialer, always choosing p.
+func (p *testHTTPProxy) For(*url.URL) (*url.URL, error) {
+	return p.URL, nil
+}
+
+// Targets returns the addresses tunneled to.
+func (p *testHTTPProxy) Targets() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return append([]string(nil), p.targets...)
+}
+
+func (p *testHTTPProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	addr, ok := p.hosts[r.Host]
+	if r.Method != http.MethodConnect || !ok {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	server, err := net.Dial("tcp", addr)
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer server.Close()
+
+	client, buf, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	p.lock.Lock()
+	p.targets = append(p.targets, r.Host)
+	p.lock.Unlock()
+
+	if _, err := io.WriteString(client, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		return
+	}
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(&testCountWriter{w: server, n: &p.up}, buf.Reader)
+		server.(*net.TCPConn).CloseWrite()
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(&testCountWriter{w: client, n: &p.down}, server)
+		client.Close()
+		done <- struct{}{}
+	}()
+	<-done
+	<-done
+}
+
+// testCountWriter counts the bytes written to w as they are written.
+type testCountWriter struct {
+	w io.Writer
+	n *int64
+}
+
+func (w *testCountWriter) Write(b []byte) (int, error) {
+	n, err := w.w.Write(b)
+	atomic.AddInt64(w.n, int64(n))
+	return n, err
+}
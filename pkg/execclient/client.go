@@ -0,0 +1,240 @@
+// Package execclient runs commands in the instances of Waypoint
+// deployments, like "waypoint exec" does, for tools built on Waypoint such
+// as chat bots.
+//
+// Compatibility: the exported API of this package and the behavior its
+// documentation describes only change in backwards compatible ways, such
+// as by adding options. What isn't documented, such as the exact text of
+// error messages and of the warnings written to the error output, may
+// change in any release. The package wraps the client that the waypoint
+// CLI uses, so sessions behave the same way as "waypoint exec".
+package execclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+	"github.com/hashicorp/waypoint/internal/server/execclient"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+	"github.com/hashicorp/waypoint/internal/serverclient"
+)
+
+// Client runs a single command in an instance of a deployment. Create it
+// with New and run the command with Run.
+type Client struct {
+	exec  *execclient.Client
+	ui    terminal.UI
+	hooks Hooks
+
+	// inputR and inputW are the pipe that Write sends input through. They
+	// are nil if the input was given with WithStdin.
+	inputR *io.PipeReader
+	inputW *io.PipeWriter
+
+	lock sync.Mutex
+	ran  bool
+}
+
+// Hooks are functions called as the session of Run progresses, such as
+// to report its progress elsewhere. They are called from the goroutine
+// running Run so they should return quickly. Any of them may be nil.
+type Hooks struct {
+	// OnOpen is called with the ID of the session once the server opens
+	// it.
+	OnOpen func(sessionId string)
+
+	// OnAttached is called with the ID of the instance running the
+	// command once it attaches to the session.
+	OnAttached func(instanceId string)
+}
+
+// Phase is a step of Run connecting the session. See WithPhaseCallback.
+type Phase = execclient.Phase
+
+// Stage is the stage of a Phase. Run goes through the stages in order,
+// except that StageAttaching is skipped without a PTY and StageAttached
+// then comes after StageRunning. While waiting for an instance or for it
+// to attach, the progress the server reports is a Phase of the same stage
+// with the progress as its message.
+type Stage = execclient.Stage
+
+const (
+	StageConnecting   = execclient.StageConnecting
+	StageInitializing = execclient.StageInitializing
+	StageWaiting      = execclient.StageWaiting
+	StageOpen         = execclient.StageOpen
+	StageAttaching    = execclient.StageAttaching
+	StageAttached     = execclient.StageAttached
+	StageRunning      = execclient.StageRunning
+)
+
+// Connect connects to the Waypoint server given by the WAYPOINT_SERVER_ADDR,
+// WAYPOINT_SERVER_TLS, WAYPOINT_SERVER_TLS_SKIP_VERIFY and
+// WAYPOINT_SERVER_TOKEN environment variables. The caller closes the
+// connection.
+//
+// opts are additional gRPC dial options, such as a custom dialer. The
+// server is reached through the proxy given by the HTTPS_PROXY and
+// NO_PROXY environment variables, if set, unless a custom dialer is given
+// that isn't wrapped with ProxyDialer.
+func Connect(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return serverclient.Connect(ctx,
+		serverclient.FromEnv(),
+		serverclient.DialOptions(opts...),
+	)
+}
+
+// ProxyDialer wraps dial, for grpc.WithContextDialer, to connect through
+// the HTTP proxy given by the HTTPS_PROXY and NO_PROXY environment
+// variables, if any. If dial is nil, a net.Dialer is used.
+func ProxyDialer(
+	dial func(context.Context, string) (net.Conn, error),
+) func(context.Context, string) (net.Conn, error) {
+	return serverclient.ProxyDialer(dial)
+}
+
+// New returns a client that runs a command in an instance of the
+// deployment with the given ID. conn is a connection to the Waypoint
+// server, such as from Connect. A connection created another way must send
+// the protocol version and authentication token that the server requires.
+//
+// By default the command is the default command of the image, it sees no
+// input beyond what is sent with Write, its output is discarded and the
+// server chooses the instance.
+//
+// Clients may share conn and run at once, so a program running many
+// commands needs only one connection: each session is its own stream on
+// it. The logger and UI given with options may be shared too.
+func New(conn grpc.ClientConnInterface, deploymentId string, opts ...Option) *Client {
+	return newClient(pb.NewWaypointClient(conn), deploymentId, opts...)
+}
+
+func newClient(client pb.WaypointClient, deploymentId string, opts ...Option) *Client {
+	c := &Client{
+		exec: &execclient.Client{
+			Logger:       hclog.NewNullLogger(),
+			Client:       client,
+			DeploymentId: deploymentId,
+			Stdout:       ioutil.Discard,
+			Stderr:       ioutil.Discard,
+			DisablePTY:   true,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.exec.Stdin == nil {
+		c.inputR, c.inputW = io.Pipe()
+		c.exec.Stdin = c.inputR
+	}
+
+	return c
+}
+
+// ErrProtocol is wrapped by the errors of sessions that end because the
+// server or instance broke the exec protocol.
+var ErrProtocol = execclient.ErrProtocol
+
+// Run runs the command and returns its exit code once it exits, or once
+// ctx is canceled. If the command exits unsuccessfully, the error is an
+// *ExitError describing how. Other errors are failures of the session,
+// for which the exit code is zero: canceling ctx returns an error wrapping
+// ctx.Err(), errors breaking the protocol wrap ErrProtocol, and for errors
+// from the server, status.FromError returns their status. Run can only be
+// called once.
+func (c *Client) Run(ctx context.Context) (int, error) {
+	c.lock.Lock()
+	ran := c.ran
+	c.ran = true
+	c.lock.Unlock()
+	if ran {
+		return 0, errors.New("the command of this client was already run")
+	}
+
+	// Writes fail rather than block once the session is over.
+	if c.inputR != nil {
+		defer c.inputR.Close()
+	}
+
+	ui := c.ui
+	if ui == nil {
+		ui = terminal.NonInteractiveUI(ctx)
+	}
+
+	c.exec.Context = ctx
+	c.exec.UI = ui
+	c.exec.KeepUI = c.ui != nil
+	c.exec.Hooks = execclient.Hooks{
+		Open:     c.hooks.OnOpen,
+		Attached: c.hooks.OnAttached,
+	}
+
+	code, err := c.exec.Run()
+	var exitErr *execclient.ExitError
+	if errors.As(err, &exitErr) {
+		err = &ExitError{
+			Code:       exitErr.Code,
+			Signal:     exitErr.Signal,
+			CoreDumped: exitErr.CoreDumped,
+		}
+	}
+
+	return code, err
+}
+
+// Write sends p to the input of the running command. It blocks until the
+// input is sent or the session ends, in which case it returns an error.
+// This can't be used if the input was given with WithStdin.
+func (c *Client) Write(p []byte) (int, error) {
+	if c.inputW == nil {
+		return 0, errors.New("the input of this client is read from the reader given with WithStdin")
+	}
+
+	return c.inputW.Write(p)
+}
+
+// Signal sends a signal to the running command. The name is a signal name
+// such as "HUP" or "SIGUSR1". This is safe to call concurrently with Run.
+// An error is returned if the command isn't running.
+func (c *Client) Signal(name string) error {
+	return c.exec.Signal(name)
+}
+
+// ExitError is returned by Run when the command exits with a non-zero code
+// or is terminated by a signal.
+type ExitError struct {
+	// Code is the exit code. If the command was terminated by a signal,
+	// this is 128 plus the signal number.
+	Code int
+
+	// Signal is the name of the signal that terminated the command, such
+	// as "SIGKILL". This is empty if the command exited on its own.
+	Signal string
+
+	// CoreDumped is true if the command dumped core.
+	CoreDumped bool
+}
+
+func (e *ExitError) Error() string {
+	if e.Signal == "" {
+		return fmt.Sprintf("command exited with code %d", e.Code)
+	}
+
+	msg := "command terminated by signal " + e.Signal
+	if e.CoreDumped {
+		msg += " (core dumped)"
+	}
+
+	return msg
+}
@@ -0,0 +1,175 @@
+package execclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	"github.com/hashicorp/waypoint/internal/server/execclient/execclienttest"
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+func TestClientRun(t *testing.T) {
+	require := require.New(t)
+
+	stream := execclienttest.NewStream(t,
+		execclienttest.Respond(execclienttest.Open("s1")),
+		execclienttest.Respond(execclienttest.Attached("i1")),
+		execclienttest.AfterInput("hello\n", execclienttest.Stdout("hello\n")),
+		execclienttest.Respond(&pb.ExecStreamResponse{
+			Event: &pb.ExecStreamResponse_Exit_{
+				Exit: &pb.ExecStreamResponse_Exit{Code: 130, Signal: "SIGINT"},
+			},
+		}),
+	)
+
+	var stdout bytes.Buffer
+	var sessionId, instanceId string
+	c := newClient(execclienttest.NewWaypoint(stream), "d1",
+		WithArgs("cat"),
+		WithOutput(&stdout, &bytes.Buffer{}),
+		WithHooks(Hooks{
+			OnOpen:     func(id string) { sessionId = id },
+			OnAttached: func(id string) { instanceId = id },
+		}),
+	)
+	go c.Write([]byte("hello\n"))
+
+	code, err := c.Run(context.Background())
+	require.Equal(130, code)
+	require.Equal(&ExitError{Code: 130, Signal: "SIGINT"}, err)
+	require.Equal("hello\n", stdout.String())
+	require.Equal("s1", sessionId)
+	require.Equal("i1", instanceId)
+	require.Equal([]string{"cat"}, stream.Start().Args)
+
+	// The session is over
+	_, err = c.Write([]byte("more\n"))
+	require.Error(err)
+	_, err = c.Run(context.Background())
+	require.Error(err)
+}
+
+func TestClientWrite_stdin(t *testing.T) {
+	c := newClient(nil, "d1", WithStdin(&bytes.Buffer{}))
+	_, err := c.Write([]byte("hello\n"))
+	require.Error(t, err)
+}
+
+// TestClientRun_shared runs many sessions at once that share a Waypoint
+// client, logger and UI, like a bot with one connection to the server.
+// Each session must only see its own input and output. Run it with -race.
+func TestClientRun_shared(t *testing.T) {
+	require := require.New(t)
+
+	const sessions = 50
+	waypoint := &testSharedWaypoint{streams: map[int]*execclienttest.Stream{}}
+	for i := 0; i < sessions; i++ {
+		waypoint.streams[i] = execclienttest.NewStream(t,
+			execclienttest.Respond(execclienttest.Open(fmt.Sprintf("s%d", i))),
+			execclienttest.Respond(execclienttest.Attached(fmt.Sprintf("i%d", i))),
+			execclienttest.AfterInput(fmt.Sprintf("input %d\n", i),
+				execclienttest.Stdout(fmt.Sprintf("output %d\n", i))),
+			execclienttest.AfterInput(fmt.Sprintf("input %d\ninput %d\n", i, i),
+				execclienttest.Stdout(fmt.Sprintf("output %d\n", i))),
+			execclienttest.Respond(execclienttest.Exit(int32(i))),
+		)
+	}
+
+	log := hclog.NewInterceptLogger(&hclog.LoggerOptions{
+		Level:  hclog.Trace,
+		Output: ioutil.Discard,
+	})
+	ui := &testCloseUI{UI: terminal.NonInteractiveUI(context.Background())}
+
+	var wg sync.WaitGroup
+	for i := 0; i < sessions; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert := assert.New(t)
+
+			var stdout, stderr bytes.Buffer
+			var sessionId, instanceId string
+			c := newClient(waypoint, "d1",
+				WithArgs("session", fmt.Sprint(i)),
+				WithOutput(&stdout, &stderr),
+				WithLogger(log),
+				WithUI(ui),
+				WithHooks(Hooks{
+					OnOpen:     func(id string) { sessionId = id },
+					OnAttached: func(id string) { instanceId = id },
+				}),
+			)
+			go func() {
+				input := fmt.Sprintf("input %d\n", i)
+				c.Write([]byte(input))
+				c.Write([]byte(input))
+			}()
+
+			ctx := context.WithValue(context.Background(), testSessionKey{}, i)
+			code, err := c.Run(ctx)
+			if i == 0 {
+				assert.NoError(err)
+			} else {
+				assert.Equal(&ExitError{Code: i}, err)
+			}
+			assert.Equal(i, code)
+
+			want := fmt.Sprintf("output %d\n", i)
+			assert.Equal(want+want, stdout.String())
+			assert.Empty(stderr.String())
+			assert.Equal(fmt.Sprintf("s%d", i), sessionId)
+			assert.Equal(fmt.Sprintf("i%d", i), instanceId)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, stream := range waypoint.streams {
+		require.Equal([]string{"session", fmt.Sprint(i)}, stream.Start().Args)
+		input := fmt.Sprintf("input %d\n", i)
+		require.Equal(input+input, string(stream.Input()))
+	}
+	require.Zero(atomic.LoadInt32(&ui.closed), "shared UI was closed")
+}
+
+// testSessionKey is the context key of the session a stream of
+// testSharedWaypoint is for.
+type testSessionKey struct{}
+
+// testSharedWaypoint is a Waypoint client shared by sessions. The stream
+// of each session is the one for the key in the context it starts with,
+// so that a session getting another's stream shows up as cross-talk.
+type testSharedWaypoint struct {
+	pb.WaypointClient
+	streams map[int]*execclienttest.Stream
+}
+
+func (w *testSharedWaypoint) StartExecStream(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (pb.Waypoint_StartExecStreamClient, error) {
+	return w.streams[ctx.Value(testSessionKey{}).(int)], nil
+}
+
+// testCloseUI is a UI that counts how often it is closed.
+type testCloseUI struct {
+	terminal.UI
+	closed int32
+}
+
+func (ui *testCloseUI) Close() error {
+	atomic.AddInt32(&ui.closed, 1)
+	return nil
+}
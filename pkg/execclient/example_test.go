@@ -0,0 +1,107 @@
+package execclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/waypoint/pkg/execclient"
+)
+
+func Example() {
+	ctx := context.Background()
+
+	// Connect with the WAYPOINT_SERVER_* environment variables.
+	conn, err := execclient.Connect(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	c := execclient.New(conn, "01EXAMPLEDEPLOYMENTID",
+		execclient.WithArgs("uptime"),
+		execclient.WithOutput(os.Stdout, os.Stderr),
+		execclient.WithHooks(execclient.Hooks{
+			OnAttached: func(instanceId string) {
+				fmt.Fprintf(os.Stderr, "running in instance %s\n", instanceId)
+			},
+		}),
+	)
+
+	code, err := c.Run(ctx)
+	var exitErr *execclient.ExitError
+	if errors.As(err, &exitErr) {
+		fmt.Fprintf(os.Stderr, "uptime failed: %s\n", exitErr)
+	} else if err != nil {
+		panic(err)
+	}
+
+	os.Exit(code)
+}
+
+func ExampleClient_Write() {
+	ctx := context.Background()
+	conn, err := execclient.Connect(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	// Send the input of the command as we go rather than from a reader.
+	c := execclient.New(conn, "01EXAMPLEDEPLOYMENTID",
+		execclient.WithArgs("sh"),
+		execclient.WithOutput(os.Stdout, os.Stderr),
+	)
+	go func() {
+		c.Write([]byte("echo hello\n"))
+		c.Write([]byte("exit\n"))
+	}()
+
+	if _, err := c.Run(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func Example_pool() {
+	ctx := context.Background()
+
+	// One connection serves all the sessions, however many run at once.
+	conn, err := execclient.Connect(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	deploymentIds := []string{"01EXAMPLEDEPLOYMENTID", "01OTHERDEPLOYMENTID"}
+	results := make([]string, len(deploymentIds))
+
+	var wg sync.WaitGroup
+	for i, id := range deploymentIds {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+
+			// A client per session, each with its own output.
+			var out strings.Builder
+			c := execclient.New(conn, id,
+				execclient.WithArgs("uptime"),
+				execclient.WithOutput(&out, &out),
+			)
+
+			if _, err := c.Run(ctx); err != nil {
+				results[i] = fmt.Sprintf("%s: %s", id, err)
+				return
+			}
+
+			results[i] = fmt.Sprintf("%s: %s", id, out.String())
+		}(i, id)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		fmt.Println(result)
+	}
+}
@@ -0,0 +1,113 @@
+package execclient
+
+import (
+	"io"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/waypoint-plugin-sdk/terminal"
+
+	pb "github.com/hashicorp/waypoint/internal/server/gen"
+)
+
+// Option configures a Client. See New.
+type Option func(*Client)
+
+// WithArgs runs the command line args rather than the default command of
+// the image.
+func WithArgs(args ...string) Option {
+	return func(c *Client) {
+		c.exec.Args = args
+	}
+}
+
+// WithInstance runs the command in the instance with the given ID rather
+// than the one the server chooses.
+func WithInstance(instanceId string) Option {
+	return func(c *Client) {
+		c.exec.InstanceId = instanceId
+	}
+}
+
+// WithStdin sends what is read from r to the input of the command. Write
+// can't be used then.
+func WithStdin(r io.Reader) Option {
+	return func(c *Client) {
+		c.exec.Stdin = r
+	}
+}
+
+// WithOutput writes the output and error output of the command to stdout
+// and stderr. Warnings about the session are also written to stderr.
+func WithOutput(stdout, stderr io.Writer) Option {
+	return func(c *Client) {
+		c.exec.Stdout = stdout
+		c.exec.Stderr = stderr
+	}
+}
+
+// WithPTY runs the command with a PTY, as if it was run in a terminal.
+// The PTY uses the TERM environment variable of this process.
+func WithPTY() Option {
+	return func(c *Client) {
+		c.exec.DisablePTY = false
+		c.exec.ForcePTY = true
+	}
+}
+
+// WithKillGracePeriod sets how long the command is given to exit after
+// the session ends before it is killed.
+func WithKillGracePeriod(d time.Duration) Option {
+	return func(c *Client) {
+		c.exec.KillGracePeriod = d
+	}
+}
+
+// WithOrphansAllowed leaves processes that the command started in the
+// background running after it exits, rather than killing them.
+func WithOrphansAllowed() Option {
+	return func(c *Client) {
+		c.exec.OrphanPolicy = pb.ExecStreamRequest_ORPHAN_ALLOWED
+	}
+}
+
+// WithConnectTimeout fails Run if the session doesn't open within d. Unlike
+// a deadline on the context given to Run, this doesn't limit the session
+// once it opens.
+func WithConnectTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.exec.ConnectTimeout = d
+	}
+}
+
+// WithLogger sets the logger of the client. Nothing is logged by default.
+func WithLogger(log hclog.Logger) Option {
+	return func(c *Client) {
+		c.exec.Logger = log
+	}
+}
+
+// WithUI sets the UI that progress is shown on when the output is a
+// terminal. The UI isn't closed, so it may be shared by clients.
+func WithUI(ui terminal.UI) Option {
+	return func(c *Client) {
+		c.ui = ui
+	}
+}
+
+// WithHooks sets the hooks called as the session progresses.
+func WithHooks(hooks Hooks) Option {
+	return func(c *Client) {
+		c.hooks = hooks
+	}
+}
+
+// WithPhaseCallback calls f with each phase Run goes through as it
+// connects the session, such as to show progress in a GUI. Like hooks, f
+// is called from the goroutine running Run so it should return quickly.
+func WithPhaseCallback(f func(Phase)) Option {
+	return func(c *Client) {
+		c.exec.PhaseCallback = f
+	}
+}